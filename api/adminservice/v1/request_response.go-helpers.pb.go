@@ -251,35 +251,1441 @@ func (this *DescribeMutableStateResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type DescribeStateMachinesRequest to the protobuf v3 wire format
+func (val *DescribeStateMachinesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeStateMachinesRequest from the protobuf v3 wire format
+func (val *DescribeStateMachinesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeStateMachinesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeStateMachinesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeStateMachinesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeStateMachinesRequest
+	switch t := that.(type) {
+	case *DescribeStateMachinesRequest:
+		that1 = t
+	case DescribeStateMachinesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeStateMachinesResponse to the protobuf v3 wire format
+func (val *DescribeStateMachinesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeStateMachinesResponse from the protobuf v3 wire format
+func (val *DescribeStateMachinesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeStateMachinesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeStateMachinesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeStateMachinesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeStateMachinesResponse
+	switch t := that.(type) {
+	case *DescribeStateMachinesResponse:
+		that1 = t
+	case DescribeStateMachinesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StateMachineNodeInfo to the protobuf v3 wire format
+func (val *StateMachineNodeInfo) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StateMachineNodeInfo from the protobuf v3 wire format
+func (val *StateMachineNodeInfo) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StateMachineNodeInfo) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StateMachineNodeInfo values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StateMachineNodeInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StateMachineNodeInfo
+	switch t := that.(type) {
+	case *StateMachineNodeInfo:
+		that1 = t
+	case StateMachineNodeInfo:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StateMachineTaskInfo to the protobuf v3 wire format
+func (val *StateMachineTaskInfo) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StateMachineTaskInfo from the protobuf v3 wire format
+func (val *StateMachineTaskInfo) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StateMachineTaskInfo) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StateMachineTaskInfo values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StateMachineTaskInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StateMachineTaskInfo
+	switch t := that.(type) {
+	case *StateMachineTaskInfo:
+		that1 = t
+	case StateMachineTaskInfo:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type InvokeStateMachineMethodRequest to the protobuf v3 wire format
+func (val *InvokeStateMachineMethodRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type InvokeStateMachineMethodRequest from the protobuf v3 wire format
+func (val *InvokeStateMachineMethodRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *InvokeStateMachineMethodRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two InvokeStateMachineMethodRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *InvokeStateMachineMethodRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *InvokeStateMachineMethodRequest
+	switch t := that.(type) {
+	case *InvokeStateMachineMethodRequest:
+		that1 = t
+	case InvokeStateMachineMethodRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type InvokeStateMachineMethodResponse to the protobuf v3 wire format
+func (val *InvokeStateMachineMethodResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type InvokeStateMachineMethodResponse from the protobuf v3 wire format
+func (val *InvokeStateMachineMethodResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *InvokeStateMachineMethodResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two InvokeStateMachineMethodResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *InvokeStateMachineMethodResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *InvokeStateMachineMethodResponse
+	switch t := that.(type) {
+	case *InvokeStateMachineMethodResponse:
+		that1 = t
+	case InvokeStateMachineMethodResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteStateMachineNodeRequest to the protobuf v3 wire format
+func (val *DeleteStateMachineNodeRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteStateMachineNodeRequest from the protobuf v3 wire format
+func (val *DeleteStateMachineNodeRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteStateMachineNodeRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteStateMachineNodeRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteStateMachineNodeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteStateMachineNodeRequest
+	switch t := that.(type) {
+	case *DeleteStateMachineNodeRequest:
+		that1 = t
+	case DeleteStateMachineNodeRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteStateMachineNodeResponse to the protobuf v3 wire format
+func (val *DeleteStateMachineNodeResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteStateMachineNodeResponse from the protobuf v3 wire format
+func (val *DeleteStateMachineNodeResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteStateMachineNodeResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteStateMachineNodeResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteStateMachineNodeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteStateMachineNodeResponse
+	switch t := that.(type) {
+	case *DeleteStateMachineNodeResponse:
+		that1 = t
+	case DeleteStateMachineNodeResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ScheduleDelayedSignalRequest to the protobuf v3 wire format
+func (val *ScheduleDelayedSignalRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ScheduleDelayedSignalRequest from the protobuf v3 wire format
+func (val *ScheduleDelayedSignalRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ScheduleDelayedSignalRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ScheduleDelayedSignalRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ScheduleDelayedSignalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ScheduleDelayedSignalRequest
+	switch t := that.(type) {
+	case *ScheduleDelayedSignalRequest:
+		that1 = t
+	case ScheduleDelayedSignalRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ScheduleDelayedSignalResponse to the protobuf v3 wire format
+func (val *ScheduleDelayedSignalResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ScheduleDelayedSignalResponse from the protobuf v3 wire format
+func (val *ScheduleDelayedSignalResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ScheduleDelayedSignalResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ScheduleDelayedSignalResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ScheduleDelayedSignalResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ScheduleDelayedSignalResponse
+	switch t := that.(type) {
+	case *ScheduleDelayedSignalResponse:
+		that1 = t
+	case ScheduleDelayedSignalResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CancelDelayedSignalRequest to the protobuf v3 wire format
+func (val *CancelDelayedSignalRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CancelDelayedSignalRequest from the protobuf v3 wire format
+func (val *CancelDelayedSignalRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CancelDelayedSignalRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CancelDelayedSignalRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CancelDelayedSignalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CancelDelayedSignalRequest
+	switch t := that.(type) {
+	case *CancelDelayedSignalRequest:
+		that1 = t
+	case CancelDelayedSignalRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CancelDelayedSignalResponse to the protobuf v3 wire format
+func (val *CancelDelayedSignalResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CancelDelayedSignalResponse from the protobuf v3 wire format
+func (val *CancelDelayedSignalResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CancelDelayedSignalResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CancelDelayedSignalResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CancelDelayedSignalResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CancelDelayedSignalResponse
+	switch t := that.(type) {
+	case *CancelDelayedSignalResponse:
+		that1 = t
+	case CancelDelayedSignalResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListDelayedSignalsRequest to the protobuf v3 wire format
+func (val *ListDelayedSignalsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListDelayedSignalsRequest from the protobuf v3 wire format
+func (val *ListDelayedSignalsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListDelayedSignalsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListDelayedSignalsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListDelayedSignalsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListDelayedSignalsRequest
+	switch t := that.(type) {
+	case *ListDelayedSignalsRequest:
+		that1 = t
+	case ListDelayedSignalsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListDelayedSignalsResponse to the protobuf v3 wire format
+func (val *ListDelayedSignalsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListDelayedSignalsResponse from the protobuf v3 wire format
+func (val *ListDelayedSignalsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListDelayedSignalsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListDelayedSignalsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListDelayedSignalsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListDelayedSignalsResponse
+	switch t := that.(type) {
+	case *ListDelayedSignalsResponse:
+		that1 = t
+	case ListDelayedSignalsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CreateAPIKeyRequest to the protobuf v3 wire format
+func (val *CreateAPIKeyRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CreateAPIKeyRequest from the protobuf v3 wire format
+func (val *CreateAPIKeyRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CreateAPIKeyRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CreateAPIKeyRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CreateAPIKeyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CreateAPIKeyRequest
+	switch t := that.(type) {
+	case *CreateAPIKeyRequest:
+		that1 = t
+	case CreateAPIKeyRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CreateAPIKeyResponse to the protobuf v3 wire format
+func (val *CreateAPIKeyResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CreateAPIKeyResponse from the protobuf v3 wire format
+func (val *CreateAPIKeyResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CreateAPIKeyResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CreateAPIKeyResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CreateAPIKeyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CreateAPIKeyResponse
+	switch t := that.(type) {
+	case *CreateAPIKeyResponse:
+		that1 = t
+	case CreateAPIKeyResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListAPIKeysRequest to the protobuf v3 wire format
+func (val *ListAPIKeysRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListAPIKeysRequest from the protobuf v3 wire format
+func (val *ListAPIKeysRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListAPIKeysRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListAPIKeysRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListAPIKeysRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListAPIKeysRequest
+	switch t := that.(type) {
+	case *ListAPIKeysRequest:
+		that1 = t
+	case ListAPIKeysRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListAPIKeysResponse to the protobuf v3 wire format
+func (val *ListAPIKeysResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListAPIKeysResponse from the protobuf v3 wire format
+func (val *ListAPIKeysResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListAPIKeysResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListAPIKeysResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListAPIKeysResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListAPIKeysResponse
+	switch t := that.(type) {
+	case *ListAPIKeysResponse:
+		that1 = t
+	case ListAPIKeysResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RotateAPIKeyRequest to the protobuf v3 wire format
+func (val *RotateAPIKeyRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RotateAPIKeyRequest from the protobuf v3 wire format
+func (val *RotateAPIKeyRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RotateAPIKeyRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RotateAPIKeyRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RotateAPIKeyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RotateAPIKeyRequest
+	switch t := that.(type) {
+	case *RotateAPIKeyRequest:
+		that1 = t
+	case RotateAPIKeyRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RotateAPIKeyResponse to the protobuf v3 wire format
+func (val *RotateAPIKeyResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RotateAPIKeyResponse from the protobuf v3 wire format
+func (val *RotateAPIKeyResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RotateAPIKeyResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RotateAPIKeyResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RotateAPIKeyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RotateAPIKeyResponse
+	switch t := that.(type) {
+	case *RotateAPIKeyResponse:
+		that1 = t
+	case RotateAPIKeyResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RevokeAPIKeyRequest to the protobuf v3 wire format
+func (val *RevokeAPIKeyRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RevokeAPIKeyRequest from the protobuf v3 wire format
+func (val *RevokeAPIKeyRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RevokeAPIKeyRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RevokeAPIKeyRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RevokeAPIKeyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RevokeAPIKeyRequest
+	switch t := that.(type) {
+	case *RevokeAPIKeyRequest:
+		that1 = t
+	case RevokeAPIKeyRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RevokeAPIKeyResponse to the protobuf v3 wire format
+func (val *RevokeAPIKeyResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RevokeAPIKeyResponse from the protobuf v3 wire format
+func (val *RevokeAPIKeyResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RevokeAPIKeyResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RevokeAPIKeyResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RevokeAPIKeyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RevokeAPIKeyResponse
+	switch t := that.(type) {
+	case *RevokeAPIKeyResponse:
+		that1 = t
+	case RevokeAPIKeyResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type DescribeHistoryHostRequest to the protobuf v3 wire format
 func (val *DescribeHistoryHostRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DescribeHistoryHostRequest from the protobuf v3 wire format
-func (val *DescribeHistoryHostRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeHistoryHostRequest from the protobuf v3 wire format
+func (val *DescribeHistoryHostRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeHistoryHostRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeHistoryHostRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeHistoryHostRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeHistoryHostRequest
+	switch t := that.(type) {
+	case *DescribeHistoryHostRequest:
+		that1 = t
+	case DescribeHistoryHostRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeHistoryHostResponse to the protobuf v3 wire format
+func (val *DescribeHistoryHostResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeHistoryHostResponse from the protobuf v3 wire format
+func (val *DescribeHistoryHostResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeHistoryHostResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeHistoryHostResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeHistoryHostResponse
+	switch t := that.(type) {
+	case *DescribeHistoryHostResponse:
+		that1 = t
+	case DescribeHistoryHostResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CloseShardRequest to the protobuf v3 wire format
+func (val *CloseShardRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CloseShardRequest from the protobuf v3 wire format
+func (val *CloseShardRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CloseShardRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CloseShardRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CloseShardRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CloseShardRequest
+	switch t := that.(type) {
+	case *CloseShardRequest:
+		that1 = t
+	case CloseShardRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CloseShardResponse to the protobuf v3 wire format
+func (val *CloseShardResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CloseShardResponse from the protobuf v3 wire format
+func (val *CloseShardResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CloseShardResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CloseShardResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CloseShardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CloseShardResponse
+	switch t := that.(type) {
+	case *CloseShardResponse:
+		that1 = t
+	case CloseShardResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetShardRequest to the protobuf v3 wire format
+func (val *GetShardRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetShardRequest from the protobuf v3 wire format
+func (val *GetShardRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetShardRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetShardRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetShardRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetShardRequest
+	switch t := that.(type) {
+	case *GetShardRequest:
+		that1 = t
+	case GetShardRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetShardResponse to the protobuf v3 wire format
+func (val *GetShardResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetShardResponse from the protobuf v3 wire format
+func (val *GetShardResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetShardResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetShardResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetShardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetShardResponse
+	switch t := that.(type) {
+	case *GetShardResponse:
+		that1 = t
+	case GetShardResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListHistoryTasksRequest to the protobuf v3 wire format
+func (val *ListHistoryTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListHistoryTasksRequest from the protobuf v3 wire format
+func (val *ListHistoryTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListHistoryTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListHistoryTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListHistoryTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListHistoryTasksRequest
+	switch t := that.(type) {
+	case *ListHistoryTasksRequest:
+		that1 = t
+	case ListHistoryTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListHistoryTasksResponse to the protobuf v3 wire format
+func (val *ListHistoryTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListHistoryTasksResponse from the protobuf v3 wire format
+func (val *ListHistoryTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListHistoryTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListHistoryTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListHistoryTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListHistoryTasksResponse
+	switch t := that.(type) {
+	case *ListHistoryTasksResponse:
+		that1 = t
+	case ListHistoryTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeHistoryQueueRequest to the protobuf v3 wire format
+func (val *DescribeHistoryQueueRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeHistoryQueueRequest from the protobuf v3 wire format
+func (val *DescribeHistoryQueueRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeHistoryQueueRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeHistoryQueueRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeHistoryQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeHistoryQueueRequest
+	switch t := that.(type) {
+	case *DescribeHistoryQueueRequest:
+		that1 = t
+	case DescribeHistoryQueueRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeHistoryQueueResponse to the protobuf v3 wire format
+func (val *DescribeHistoryQueueResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeHistoryQueueResponse from the protobuf v3 wire format
+func (val *DescribeHistoryQueueResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeHistoryQueueResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeHistoryQueueResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeHistoryQueueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeHistoryQueueResponse
+	switch t := that.(type) {
+	case *DescribeHistoryQueueResponse:
+		that1 = t
+	case DescribeHistoryQueueResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type HistoryQueueReader to the protobuf v3 wire format
+func (val *HistoryQueueReader) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type HistoryQueueReader from the protobuf v3 wire format
+func (val *HistoryQueueReader) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *HistoryQueueReader) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two HistoryQueueReader values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *HistoryQueueReader) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *HistoryQueueReader
+	switch t := that.(type) {
+	case *HistoryQueueReader:
+		that1 = t
+	case HistoryQueueReader:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type HistoryQueueSlice to the protobuf v3 wire format
+func (val *HistoryQueueSlice) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type HistoryQueueSlice from the protobuf v3 wire format
+func (val *HistoryQueueSlice) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *HistoryQueueSlice) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two HistoryQueueSlice values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *HistoryQueueSlice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *HistoryQueueSlice
+	switch t := that.(type) {
+	case *HistoryQueueSlice:
+		that1 = t
+	case HistoryQueueSlice:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type HistoryQueueAlert to the protobuf v3 wire format
+func (val *HistoryQueueAlert) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type HistoryQueueAlert from the protobuf v3 wire format
+func (val *HistoryQueueAlert) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *HistoryQueueAlert) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two HistoryQueueAlert values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *HistoryQueueAlert) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *HistoryQueueAlert
+	switch t := that.(type) {
+	case *HistoryQueueAlert:
+		that1 = t
+	case HistoryQueueAlert:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MitigateHistoryQueueRequest to the protobuf v3 wire format
+func (val *MitigateHistoryQueueRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MitigateHistoryQueueRequest from the protobuf v3 wire format
+func (val *MitigateHistoryQueueRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MitigateHistoryQueueRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MitigateHistoryQueueRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MitigateHistoryQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MitigateHistoryQueueRequest
+	switch t := that.(type) {
+	case *MitigateHistoryQueueRequest:
+		that1 = t
+	case MitigateHistoryQueueRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SplitHistoryQueueSliceAction to the protobuf v3 wire format
+func (val *SplitHistoryQueueSliceAction) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SplitHistoryQueueSliceAction from the protobuf v3 wire format
+func (val *SplitHistoryQueueSliceAction) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SplitHistoryQueueSliceAction) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SplitHistoryQueueSliceAction values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SplitHistoryQueueSliceAction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SplitHistoryQueueSliceAction
+	switch t := that.(type) {
+	case *SplitHistoryQueueSliceAction:
+		that1 = t
+	case SplitHistoryQueueSliceAction:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MoveHistoryQueueNamespaceAction to the protobuf v3 wire format
+func (val *MoveHistoryQueueNamespaceAction) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MoveHistoryQueueNamespaceAction from the protobuf v3 wire format
+func (val *MoveHistoryQueueNamespaceAction) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MoveHistoryQueueNamespaceAction) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MoveHistoryQueueNamespaceAction values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MoveHistoryQueueNamespaceAction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MoveHistoryQueueNamespaceAction
+	switch t := that.(type) {
+	case *MoveHistoryQueueNamespaceAction:
+		that1 = t
+	case MoveHistoryQueueNamespaceAction:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ThrottleHistoryQueueNamespaceAction to the protobuf v3 wire format
+func (val *ThrottleHistoryQueueNamespaceAction) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ThrottleHistoryQueueNamespaceAction from the protobuf v3 wire format
+func (val *ThrottleHistoryQueueNamespaceAction) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DescribeHistoryHostRequest) Size() int {
+func (val *ThrottleHistoryQueueNamespaceAction) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DescribeHistoryHostRequest values are equivalent by recursively
+// Equal returns whether two ThrottleHistoryQueueNamespaceAction values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DescribeHistoryHostRequest) Equal(that interface{}) bool {
+func (this *ThrottleHistoryQueueNamespaceAction) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DescribeHistoryHostRequest
+	var that1 *ThrottleHistoryQueueNamespaceAction
 	switch t := that.(type) {
-	case *DescribeHistoryHostRequest:
+	case *ThrottleHistoryQueueNamespaceAction:
 		that1 = t
-	case DescribeHistoryHostRequest:
+	case ThrottleHistoryQueueNamespaceAction:
 		that1 = &t
 	default:
 		return false
@@ -288,35 +1694,35 @@ func (this *DescribeHistoryHostRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DescribeHistoryHostResponse to the protobuf v3 wire format
-func (val *DescribeHistoryHostResponse) Marshal() ([]byte, error) {
+// Marshal an object of type MitigateHistoryQueueResponse to the protobuf v3 wire format
+func (val *MitigateHistoryQueueResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DescribeHistoryHostResponse from the protobuf v3 wire format
-func (val *DescribeHistoryHostResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type MitigateHistoryQueueResponse from the protobuf v3 wire format
+func (val *MitigateHistoryQueueResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DescribeHistoryHostResponse) Size() int {
+func (val *MitigateHistoryQueueResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DescribeHistoryHostResponse values are equivalent by recursively
+// Equal returns whether two MitigateHistoryQueueResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
+func (this *MitigateHistoryQueueResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DescribeHistoryHostResponse
+	var that1 *MitigateHistoryQueueResponse
 	switch t := that.(type) {
-	case *DescribeHistoryHostResponse:
+	case *MitigateHistoryQueueResponse:
 		that1 = t
-	case DescribeHistoryHostResponse:
+	case MitigateHistoryQueueResponse:
 		that1 = &t
 	default:
 		return false
@@ -325,35 +1731,35 @@ func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type CloseShardRequest to the protobuf v3 wire format
-func (val *CloseShardRequest) Marshal() ([]byte, error) {
+// Marshal an object of type DescribeOutboundQueueRequest to the protobuf v3 wire format
+func (val *DescribeOutboundQueueRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type CloseShardRequest from the protobuf v3 wire format
-func (val *CloseShardRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeOutboundQueueRequest from the protobuf v3 wire format
+func (val *DescribeOutboundQueueRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *CloseShardRequest) Size() int {
+func (val *DescribeOutboundQueueRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two CloseShardRequest values are equivalent by recursively
+// Equal returns whether two DescribeOutboundQueueRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *CloseShardRequest) Equal(that interface{}) bool {
+func (this *DescribeOutboundQueueRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *CloseShardRequest
+	var that1 *DescribeOutboundQueueRequest
 	switch t := that.(type) {
-	case *CloseShardRequest:
+	case *DescribeOutboundQueueRequest:
 		that1 = t
-	case CloseShardRequest:
+	case DescribeOutboundQueueRequest:
 		that1 = &t
 	default:
 		return false
@@ -362,35 +1768,35 @@ func (this *CloseShardRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type CloseShardResponse to the protobuf v3 wire format
-func (val *CloseShardResponse) Marshal() ([]byte, error) {
+// Marshal an object of type DescribeOutboundQueueResponse to the protobuf v3 wire format
+func (val *DescribeOutboundQueueResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type CloseShardResponse from the protobuf v3 wire format
-func (val *CloseShardResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeOutboundQueueResponse from the protobuf v3 wire format
+func (val *DescribeOutboundQueueResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *CloseShardResponse) Size() int {
+func (val *DescribeOutboundQueueResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two CloseShardResponse values are equivalent by recursively
+// Equal returns whether two DescribeOutboundQueueResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *CloseShardResponse) Equal(that interface{}) bool {
+func (this *DescribeOutboundQueueResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *CloseShardResponse
+	var that1 *DescribeOutboundQueueResponse
 	switch t := that.(type) {
-	case *CloseShardResponse:
+	case *DescribeOutboundQueueResponse:
 		that1 = t
-	case CloseShardResponse:
+	case DescribeOutboundQueueResponse:
 		that1 = &t
 	default:
 		return false
@@ -399,35 +1805,35 @@ func (this *CloseShardResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetShardRequest to the protobuf v3 wire format
-func (val *GetShardRequest) Marshal() ([]byte, error) {
+// Marshal an object of type OutboundQueueDestination to the protobuf v3 wire format
+func (val *OutboundQueueDestination) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetShardRequest from the protobuf v3 wire format
-func (val *GetShardRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type OutboundQueueDestination from the protobuf v3 wire format
+func (val *OutboundQueueDestination) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetShardRequest) Size() int {
+func (val *OutboundQueueDestination) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetShardRequest values are equivalent by recursively
+// Equal returns whether two OutboundQueueDestination values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetShardRequest) Equal(that interface{}) bool {
+func (this *OutboundQueueDestination) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetShardRequest
+	var that1 *OutboundQueueDestination
 	switch t := that.(type) {
-	case *GetShardRequest:
+	case *OutboundQueueDestination:
 		that1 = t
-	case GetShardRequest:
+	case OutboundQueueDestination:
 		that1 = &t
 	default:
 		return false
@@ -436,35 +1842,35 @@ func (this *GetShardRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetShardResponse to the protobuf v3 wire format
-func (val *GetShardResponse) Marshal() ([]byte, error) {
+// Marshal an object of type OutboundQueueCircuitBreaker to the protobuf v3 wire format
+func (val *OutboundQueueCircuitBreaker) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetShardResponse from the protobuf v3 wire format
-func (val *GetShardResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type OutboundQueueCircuitBreaker from the protobuf v3 wire format
+func (val *OutboundQueueCircuitBreaker) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetShardResponse) Size() int {
+func (val *OutboundQueueCircuitBreaker) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetShardResponse values are equivalent by recursively
+// Equal returns whether two OutboundQueueCircuitBreaker values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetShardResponse) Equal(that interface{}) bool {
+func (this *OutboundQueueCircuitBreaker) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetShardResponse
+	var that1 *OutboundQueueCircuitBreaker
 	switch t := that.(type) {
-	case *GetShardResponse:
+	case *OutboundQueueCircuitBreaker:
 		that1 = t
-	case GetShardResponse:
+	case OutboundQueueCircuitBreaker:
 		that1 = &t
 	default:
 		return false
@@ -473,35 +1879,35 @@ func (this *GetShardResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ListHistoryTasksRequest to the protobuf v3 wire format
-func (val *ListHistoryTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type UpdateOutboundQueueCircuitBreakerRequest to the protobuf v3 wire format
+func (val *UpdateOutboundQueueCircuitBreakerRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ListHistoryTasksRequest from the protobuf v3 wire format
-func (val *ListHistoryTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type UpdateOutboundQueueCircuitBreakerRequest from the protobuf v3 wire format
+func (val *UpdateOutboundQueueCircuitBreakerRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ListHistoryTasksRequest) Size() int {
+func (val *UpdateOutboundQueueCircuitBreakerRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ListHistoryTasksRequest values are equivalent by recursively
+// Equal returns whether two UpdateOutboundQueueCircuitBreakerRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ListHistoryTasksRequest) Equal(that interface{}) bool {
+func (this *UpdateOutboundQueueCircuitBreakerRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ListHistoryTasksRequest
+	var that1 *UpdateOutboundQueueCircuitBreakerRequest
 	switch t := that.(type) {
-	case *ListHistoryTasksRequest:
+	case *UpdateOutboundQueueCircuitBreakerRequest:
 		that1 = t
-	case ListHistoryTasksRequest:
+	case UpdateOutboundQueueCircuitBreakerRequest:
 		that1 = &t
 	default:
 		return false
@@ -510,35 +1916,35 @@ func (this *ListHistoryTasksRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ListHistoryTasksResponse to the protobuf v3 wire format
-func (val *ListHistoryTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type UpdateOutboundQueueCircuitBreakerResponse to the protobuf v3 wire format
+func (val *UpdateOutboundQueueCircuitBreakerResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ListHistoryTasksResponse from the protobuf v3 wire format
-func (val *ListHistoryTasksResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type UpdateOutboundQueueCircuitBreakerResponse from the protobuf v3 wire format
+func (val *UpdateOutboundQueueCircuitBreakerResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ListHistoryTasksResponse) Size() int {
+func (val *UpdateOutboundQueueCircuitBreakerResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ListHistoryTasksResponse values are equivalent by recursively
+// Equal returns whether two UpdateOutboundQueueCircuitBreakerResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ListHistoryTasksResponse) Equal(that interface{}) bool {
+func (this *UpdateOutboundQueueCircuitBreakerResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ListHistoryTasksResponse
+	var that1 *UpdateOutboundQueueCircuitBreakerResponse
 	switch t := that.(type) {
-	case *ListHistoryTasksResponse:
+	case *UpdateOutboundQueueCircuitBreakerResponse:
 		that1 = t
-	case ListHistoryTasksResponse:
+	case UpdateOutboundQueueCircuitBreakerResponse:
 		that1 = &t
 	default:
 		return false
@@ -3173,3 +4579,225 @@ func (this *DescribeTaskQueuePartitionResponse) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type UpdateNexusEndpointAuthRequest to the protobuf v3 wire format
+func (val *UpdateNexusEndpointAuthRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateNexusEndpointAuthRequest from the protobuf v3 wire format
+func (val *UpdateNexusEndpointAuthRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateNexusEndpointAuthRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateNexusEndpointAuthRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateNexusEndpointAuthRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateNexusEndpointAuthRequest
+	switch t := that.(type) {
+	case *UpdateNexusEndpointAuthRequest:
+		that1 = t
+	case UpdateNexusEndpointAuthRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateNexusEndpointAuthResponse to the protobuf v3 wire format
+func (val *UpdateNexusEndpointAuthResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateNexusEndpointAuthResponse from the protobuf v3 wire format
+func (val *UpdateNexusEndpointAuthResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateNexusEndpointAuthResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateNexusEndpointAuthResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateNexusEndpointAuthResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateNexusEndpointAuthResponse
+	switch t := that.(type) {
+	case *UpdateNexusEndpointAuthResponse:
+		that1 = t
+	case UpdateNexusEndpointAuthResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateNexusEndpointLimitsRequest to the protobuf v3 wire format
+func (val *UpdateNexusEndpointLimitsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateNexusEndpointLimitsRequest from the protobuf v3 wire format
+func (val *UpdateNexusEndpointLimitsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateNexusEndpointLimitsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateNexusEndpointLimitsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateNexusEndpointLimitsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateNexusEndpointLimitsRequest
+	switch t := that.(type) {
+	case *UpdateNexusEndpointLimitsRequest:
+		that1 = t
+	case UpdateNexusEndpointLimitsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateNexusEndpointLimitsResponse to the protobuf v3 wire format
+func (val *UpdateNexusEndpointLimitsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateNexusEndpointLimitsResponse from the protobuf v3 wire format
+func (val *UpdateNexusEndpointLimitsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateNexusEndpointLimitsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateNexusEndpointLimitsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateNexusEndpointLimitsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateNexusEndpointLimitsResponse
+	switch t := that.(type) {
+	case *UpdateNexusEndpointLimitsResponse:
+		that1 = t
+	case UpdateNexusEndpointLimitsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateNexusEndpointAccessPolicyRequest to the protobuf v3 wire format
+func (val *UpdateNexusEndpointAccessPolicyRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateNexusEndpointAccessPolicyRequest from the protobuf v3 wire format
+func (val *UpdateNexusEndpointAccessPolicyRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateNexusEndpointAccessPolicyRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateNexusEndpointAccessPolicyRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateNexusEndpointAccessPolicyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateNexusEndpointAccessPolicyRequest
+	switch t := that.(type) {
+	case *UpdateNexusEndpointAccessPolicyRequest:
+		that1 = t
+	case UpdateNexusEndpointAccessPolicyRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateNexusEndpointAccessPolicyResponse to the protobuf v3 wire format
+func (val *UpdateNexusEndpointAccessPolicyResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateNexusEndpointAccessPolicyResponse from the protobuf v3 wire format
+func (val *UpdateNexusEndpointAccessPolicyResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateNexusEndpointAccessPolicyResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateNexusEndpointAccessPolicyResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateNexusEndpointAccessPolicyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateNexusEndpointAccessPolicyResponse
+	switch t := that.(type) {
+	case *UpdateNexusEndpointAccessPolicyResponse:
+		that1 = t
+	case UpdateNexusEndpointAccessPolicyResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -45,10 +45,24 @@ const (
 	AdminService_RebuildMutableState_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/RebuildMutableState"
 	AdminService_ImportWorkflowExecution_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution"
 	AdminService_DescribeMutableState_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState"
+	AdminService_DescribeStateMachines_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/DescribeStateMachines"
+	AdminService_InvokeStateMachineMethod_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/InvokeStateMachineMethod"
+	AdminService_DeleteStateMachineNode_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/DeleteStateMachineNode"
+	AdminService_ScheduleDelayedSignal_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/ScheduleDelayedSignal"
+	AdminService_CancelDelayedSignal_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/CancelDelayedSignal"
+	AdminService_ListDelayedSignals_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/ListDelayedSignals"
+	AdminService_CreateAPIKey_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/CreateAPIKey"
+	AdminService_ListAPIKeys_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/ListAPIKeys"
+	AdminService_RotateAPIKey_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/RotateAPIKey"
+	AdminService_RevokeAPIKey_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/RevokeAPIKey"
 	AdminService_DescribeHistoryHost_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHost"
 	AdminService_GetShard_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/GetShard"
 	AdminService_CloseShard_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/CloseShard"
 	AdminService_ListHistoryTasks_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/ListHistoryTasks"
+	AdminService_DescribeHistoryQueue_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryQueue"
+	AdminService_MitigateHistoryQueue_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/MitigateHistoryQueue"
+	AdminService_DescribeOutboundQueue_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/DescribeOutboundQueue"
+	AdminService_UpdateOutboundQueueCircuitBreaker_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/UpdateOutboundQueueCircuitBreaker"
 	AdminService_RemoveTask_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/RemoveTask"
 	AdminService_GetWorkflowExecutionRawHistoryV2_FullMethodName  = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistoryV2"
 	AdminService_GetWorkflowExecutionRawHistory_FullMethodName    = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistory"
@@ -83,6 +97,9 @@ const (
 	AdminService_DeepHealthCheck_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/DeepHealthCheck"
 	AdminService_SyncWorkflowState_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/SyncWorkflowState"
 	AdminService_DescribeTaskQueuePartition_FullMethodName        = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueuePartition"
+	AdminService_UpdateNexusEndpointAuth_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/UpdateNexusEndpointAuth"
+	AdminService_UpdateNexusEndpointLimits_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/UpdateNexusEndpointLimits"
+	AdminService_UpdateNexusEndpointAccessPolicy_FullMethodName   = "/temporal.server.api.adminservice.v1.AdminService/UpdateNexusEndpointAccessPolicy"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -97,11 +114,46 @@ type AdminServiceClient interface {
 	ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error)
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
 	DescribeMutableState(ctx context.Context, in *DescribeMutableStateRequest, opts ...grpc.CallOption) (*DescribeMutableStateResponse, error)
+	// DescribeStateMachines returns the hierarchical state machine tree of a workflow execution.
+	DescribeStateMachines(ctx context.Context, in *DescribeStateMachinesRequest, opts ...grpc.CallOption) (*DescribeStateMachinesResponse, error)
+	// InvokeStateMachineMethod invokes a registered remote method on a state machine of a workflow execution.
+	InvokeStateMachineMethod(ctx context.Context, in *InvokeStateMachineMethodRequest, opts ...grpc.CallOption) (*InvokeStateMachineMethodResponse, error)
+	// DeleteStateMachineNode deletes a state machine and its descendants from a workflow execution. Meant for
+	// removing stuck machines. Deletions are not replicated and are rejected for namespaces replicated to multiple
+	// clusters.
+	DeleteStateMachineNode(ctx context.Context, in *DeleteStateMachineNodeRequest, opts ...grpc.CallOption) (*DeleteStateMachineNodeResponse, error)
+	// ScheduleDelayedSignal creates a durable timer on a workflow execution that signals a workflow at the given
+	// time. The timer is hosted either on the target workflow itself or on a proxy execution.
+	ScheduleDelayedSignal(ctx context.Context, in *ScheduleDelayedSignalRequest, opts ...grpc.CallOption) (*ScheduleDelayedSignalResponse, error)
+	// CancelDelayedSignal cancels a delayed signal that has not been delivered yet.
+	CancelDelayedSignal(ctx context.Context, in *CancelDelayedSignalRequest, opts ...grpc.CallOption) (*CancelDelayedSignalResponse, error)
+	// ListDelayedSignals lists the delayed signals hosted by a workflow execution.
+	ListDelayedSignals(ctx context.Context, in *ListDelayedSignalsRequest, opts ...grpc.CallOption) (*ListDelayedSignalsResponse, error)
+	// CreateAPIKey creates an API key for a subject. The key is returned only once; only its hash is stored.
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	// ListAPIKeys lists all API keys without their secrets.
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	// RotateAPIKey replaces the secret of an API key, keeping its subject and permissions.
+	RotateAPIKey(ctx context.Context, in *RotateAPIKeyRequest, opts ...grpc.CallOption) (*RotateAPIKeyResponse, error)
+	// RevokeAPIKey deletes an API key.
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host
 	DescribeHistoryHost(ctx context.Context, in *DescribeHistoryHostRequest, opts ...grpc.CallOption) (*DescribeHistoryHostResponse, error)
 	GetShard(ctx context.Context, in *GetShardRequest, opts ...grpc.CallOption) (*GetShardResponse, error)
 	CloseShard(ctx context.Context, in *CloseShardRequest, opts ...grpc.CallOption) (*CloseShardResponse, error)
 	ListHistoryTasks(ctx context.Context, in *ListHistoryTasksRequest, opts ...grpc.CallOption) (*ListHistoryTasksResponse, error)
+	// DescribeHistoryQueue returns the in-memory state of a history task queue on a shard: its readers, their slices
+	// with ranges and predicates, pending task counts per namespace, and outstanding alerts.
+	DescribeHistoryQueue(ctx context.Context, in *DescribeHistoryQueueRequest, opts ...grpc.CallOption) (*DescribeHistoryQueueResponse, error)
+	// MitigateHistoryQueue runs a manual mitigation on a history task queue of a shard: split a slice by namespace,
+	// move a namespace to a separate reader or temporarily throttle a namespace.
+	MitigateHistoryQueue(ctx context.Context, in *MitigateHistoryQueueRequest, opts ...grpc.CallOption) (*MitigateHistoryQueueResponse, error)
+	// DescribeOutboundQueue lists the destinations of the outbound queue of a shard with their pending task counts
+	// and circuit breaker states.
+	DescribeOutboundQueue(ctx context.Context, in *DescribeOutboundQueueRequest, opts ...grpc.CallOption) (*DescribeOutboundQueueResponse, error)
+	// UpdateOutboundQueueCircuitBreaker forces the circuit breaker of an outbound queue destination open or closed, or
+	// resets it.
+	UpdateOutboundQueueCircuitBreaker(ctx context.Context, in *UpdateOutboundQueueCircuitBreakerRequest, opts ...grpc.CallOption) (*UpdateOutboundQueueCircuitBreakerResponse, error)
 	RemoveTask(ctx context.Context, in *RemoveTaskRequest, opts ...grpc.CallOption) (*RemoveTaskResponse, error)
 	// Returns the raw history of specified workflow execution.  It fails with 'NotFound' if specified workflow
 	// execution in unknown to the service.
@@ -171,6 +223,18 @@ type AdminServiceClient interface {
 	DeepHealthCheck(ctx context.Context, in *DeepHealthCheckRequest, opts ...grpc.CallOption) (*DeepHealthCheckResponse, error)
 	SyncWorkflowState(ctx context.Context, in *SyncWorkflowStateRequest, opts ...grpc.CallOption) (*SyncWorkflowStateResponse, error)
 	DescribeTaskQueuePartition(ctx context.Context, in *DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionResponse, error)
+	// UpdateNexusEndpointAuth sets the credentials presented to an external Nexus endpoint.
+	// Credentials are not part of the public endpoint spec and are preserved when the endpoint is updated via the
+	// operator API.
+	UpdateNexusEndpointAuth(ctx context.Context, in *UpdateNexusEndpointAuthRequest, opts ...grpc.CallOption) (*UpdateNexusEndpointAuthResponse, error)
+	// UpdateNexusEndpointLimits sets the concurrency and rate limits of StartOperation requests to a Nexus endpoint.
+	// Limits are not part of the public endpoint spec and are preserved when the endpoint is updated via the operator
+	// API.
+	UpdateNexusEndpointLimits(ctx context.Context, in *UpdateNexusEndpointLimitsRequest, opts ...grpc.CallOption) (*UpdateNexusEndpointLimitsResponse, error)
+	// UpdateNexusEndpointAccessPolicy sets the namespaces allowed to call a Nexus endpoint.
+	// The policy is not part of the public endpoint spec and is preserved when the endpoint is updated via the operator
+	// API.
+	UpdateNexusEndpointAccessPolicy(ctx context.Context, in *UpdateNexusEndpointAccessPolicyRequest, opts ...grpc.CallOption) (*UpdateNexusEndpointAccessPolicyResponse, error)
 }
 
 type adminServiceClient struct {
@@ -208,6 +272,96 @@ func (c *adminServiceClient) DescribeMutableState(ctx context.Context, in *Descr
 	return out, nil
 }
 
+func (c *adminServiceClient) DescribeStateMachines(ctx context.Context, in *DescribeStateMachinesRequest, opts ...grpc.CallOption) (*DescribeStateMachinesResponse, error) {
+	out := new(DescribeStateMachinesResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeStateMachines_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) InvokeStateMachineMethod(ctx context.Context, in *InvokeStateMachineMethodRequest, opts ...grpc.CallOption) (*InvokeStateMachineMethodResponse, error) {
+	out := new(InvokeStateMachineMethodResponse)
+	err := c.cc.Invoke(ctx, AdminService_InvokeStateMachineMethod_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteStateMachineNode(ctx context.Context, in *DeleteStateMachineNodeRequest, opts ...grpc.CallOption) (*DeleteStateMachineNodeResponse, error) {
+	out := new(DeleteStateMachineNodeResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteStateMachineNode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ScheduleDelayedSignal(ctx context.Context, in *ScheduleDelayedSignalRequest, opts ...grpc.CallOption) (*ScheduleDelayedSignalResponse, error) {
+	out := new(ScheduleDelayedSignalResponse)
+	err := c.cc.Invoke(ctx, AdminService_ScheduleDelayedSignal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CancelDelayedSignal(ctx context.Context, in *CancelDelayedSignalRequest, opts ...grpc.CallOption) (*CancelDelayedSignalResponse, error) {
+	out := new(CancelDelayedSignalResponse)
+	err := c.cc.Invoke(ctx, AdminService_CancelDelayedSignal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDelayedSignals(ctx context.Context, in *ListDelayedSignalsRequest, opts ...grpc.CallOption) (*ListDelayedSignalsResponse, error) {
+	out := new(ListDelayedSignalsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListDelayedSignals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateAPIKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListAPIKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RotateAPIKey(ctx context.Context, in *RotateAPIKeyRequest, opts ...grpc.CallOption) (*RotateAPIKeyResponse, error) {
+	out := new(RotateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, AdminService_RotateAPIKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, AdminService_RevokeAPIKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) DescribeHistoryHost(ctx context.Context, in *DescribeHistoryHostRequest, opts ...grpc.CallOption) (*DescribeHistoryHostResponse, error) {
 	out := new(DescribeHistoryHostResponse)
 	err := c.cc.Invoke(ctx, AdminService_DescribeHistoryHost_FullMethodName, in, out, opts...)
@@ -244,6 +398,42 @@ func (c *adminServiceClient) ListHistoryTasks(ctx context.Context, in *ListHisto
 	return out, nil
 }
 
+func (c *adminServiceClient) DescribeHistoryQueue(ctx context.Context, in *DescribeHistoryQueueRequest, opts ...grpc.CallOption) (*DescribeHistoryQueueResponse, error) {
+	out := new(DescribeHistoryQueueResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeHistoryQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) MitigateHistoryQueue(ctx context.Context, in *MitigateHistoryQueueRequest, opts ...grpc.CallOption) (*MitigateHistoryQueueResponse, error) {
+	out := new(MitigateHistoryQueueResponse)
+	err := c.cc.Invoke(ctx, AdminService_MitigateHistoryQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeOutboundQueue(ctx context.Context, in *DescribeOutboundQueueRequest, opts ...grpc.CallOption) (*DescribeOutboundQueueResponse, error) {
+	out := new(DescribeOutboundQueueResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeOutboundQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateOutboundQueueCircuitBreaker(ctx context.Context, in *UpdateOutboundQueueCircuitBreakerRequest, opts ...grpc.CallOption) (*UpdateOutboundQueueCircuitBreakerResponse, error) {
+	out := new(UpdateOutboundQueueCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateOutboundQueueCircuitBreaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) RemoveTask(ctx context.Context, in *RemoveTaskRequest, opts ...grpc.CallOption) (*RemoveTaskResponse, error) {
 	out := new(RemoveTaskResponse)
 	err := c.cc.Invoke(ctx, AdminService_RemoveTask_FullMethodName, in, out, opts...)
@@ -572,6 +762,33 @@ func (c *adminServiceClient) DescribeTaskQueuePartition(ctx context.Context, in
 	return out, nil
 }
 
+func (c *adminServiceClient) UpdateNexusEndpointAuth(ctx context.Context, in *UpdateNexusEndpointAuthRequest, opts ...grpc.CallOption) (*UpdateNexusEndpointAuthResponse, error) {
+	out := new(UpdateNexusEndpointAuthResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateNexusEndpointAuth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateNexusEndpointLimits(ctx context.Context, in *UpdateNexusEndpointLimitsRequest, opts ...grpc.CallOption) (*UpdateNexusEndpointLimitsResponse, error) {
+	out := new(UpdateNexusEndpointLimitsResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateNexusEndpointLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateNexusEndpointAccessPolicy(ctx context.Context, in *UpdateNexusEndpointAccessPolicyRequest, opts ...grpc.CallOption) (*UpdateNexusEndpointAccessPolicyResponse, error) {
+	out := new(UpdateNexusEndpointAccessPolicyResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateNexusEndpointAccessPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -584,11 +801,46 @@ type AdminServiceServer interface {
 	ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error)
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
 	DescribeMutableState(context.Context, *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error)
+	// DescribeStateMachines returns the hierarchical state machine tree of a workflow execution.
+	DescribeStateMachines(context.Context, *DescribeStateMachinesRequest) (*DescribeStateMachinesResponse, error)
+	// InvokeStateMachineMethod invokes a registered remote method on a state machine of a workflow execution.
+	InvokeStateMachineMethod(context.Context, *InvokeStateMachineMethodRequest) (*InvokeStateMachineMethodResponse, error)
+	// DeleteStateMachineNode deletes a state machine and its descendants from a workflow execution. Meant for
+	// removing stuck machines. Deletions are not replicated and are rejected for namespaces replicated to multiple
+	// clusters.
+	DeleteStateMachineNode(context.Context, *DeleteStateMachineNodeRequest) (*DeleteStateMachineNodeResponse, error)
+	// ScheduleDelayedSignal creates a durable timer on a workflow execution that signals a workflow at the given
+	// time. The timer is hosted either on the target workflow itself or on a proxy execution.
+	ScheduleDelayedSignal(context.Context, *ScheduleDelayedSignalRequest) (*ScheduleDelayedSignalResponse, error)
+	// CancelDelayedSignal cancels a delayed signal that has not been delivered yet.
+	CancelDelayedSignal(context.Context, *CancelDelayedSignalRequest) (*CancelDelayedSignalResponse, error)
+	// ListDelayedSignals lists the delayed signals hosted by a workflow execution.
+	ListDelayedSignals(context.Context, *ListDelayedSignalsRequest) (*ListDelayedSignalsResponse, error)
+	// CreateAPIKey creates an API key for a subject. The key is returned only once; only its hash is stored.
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	// ListAPIKeys lists all API keys without their secrets.
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	// RotateAPIKey replaces the secret of an API key, keeping its subject and permissions.
+	RotateAPIKey(context.Context, *RotateAPIKeyRequest) (*RotateAPIKeyResponse, error)
+	// RevokeAPIKey deletes an API key.
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host
 	DescribeHistoryHost(context.Context, *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error)
 	GetShard(context.Context, *GetShardRequest) (*GetShardResponse, error)
 	CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error)
 	ListHistoryTasks(context.Context, *ListHistoryTasksRequest) (*ListHistoryTasksResponse, error)
+	// DescribeHistoryQueue returns the in-memory state of a history task queue on a shard: its readers, their slices
+	// with ranges and predicates, pending task counts per namespace, and outstanding alerts.
+	DescribeHistoryQueue(context.Context, *DescribeHistoryQueueRequest) (*DescribeHistoryQueueResponse, error)
+	// MitigateHistoryQueue runs a manual mitigation on a history task queue of a shard: split a slice by namespace,
+	// move a namespace to a separate reader or temporarily throttle a namespace.
+	MitigateHistoryQueue(context.Context, *MitigateHistoryQueueRequest) (*MitigateHistoryQueueResponse, error)
+	// DescribeOutboundQueue lists the destinations of the outbound queue of a shard with their pending task counts
+	// and circuit breaker states.
+	DescribeOutboundQueue(context.Context, *DescribeOutboundQueueRequest) (*DescribeOutboundQueueResponse, error)
+	// UpdateOutboundQueueCircuitBreaker forces the circuit breaker of an outbound queue destination open or closed, or
+	// resets it.
+	UpdateOutboundQueueCircuitBreaker(context.Context, *UpdateOutboundQueueCircuitBreakerRequest) (*UpdateOutboundQueueCircuitBreakerResponse, error)
 	RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error)
 	// Returns the raw history of specified workflow execution.  It fails with 'NotFound' if specified workflow
 	// execution in unknown to the service.
@@ -658,6 +910,18 @@ type AdminServiceServer interface {
 	DeepHealthCheck(context.Context, *DeepHealthCheckRequest) (*DeepHealthCheckResponse, error)
 	SyncWorkflowState(context.Context, *SyncWorkflowStateRequest) (*SyncWorkflowStateResponse, error)
 	DescribeTaskQueuePartition(context.Context, *DescribeTaskQueuePartitionRequest) (*DescribeTaskQueuePartitionResponse, error)
+	// UpdateNexusEndpointAuth sets the credentials presented to an external Nexus endpoint.
+	// Credentials are not part of the public endpoint spec and are preserved when the endpoint is updated via the
+	// operator API.
+	UpdateNexusEndpointAuth(context.Context, *UpdateNexusEndpointAuthRequest) (*UpdateNexusEndpointAuthResponse, error)
+	// UpdateNexusEndpointLimits sets the concurrency and rate limits of StartOperation requests to a Nexus endpoint.
+	// Limits are not part of the public endpoint spec and are preserved when the endpoint is updated via the operator
+	// API.
+	UpdateNexusEndpointLimits(context.Context, *UpdateNexusEndpointLimitsRequest) (*UpdateNexusEndpointLimitsResponse, error)
+	// UpdateNexusEndpointAccessPolicy sets the namespaces allowed to call a Nexus endpoint.
+	// The policy is not part of the public endpoint spec and is preserved when the endpoint is updated via the operator
+	// API.
+	UpdateNexusEndpointAccessPolicy(context.Context, *UpdateNexusEndpointAccessPolicyRequest) (*UpdateNexusEndpointAccessPolicyResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -674,6 +938,36 @@ func (UnimplementedAdminServiceServer) ImportWorkflowExecution(context.Context,
 func (UnimplementedAdminServiceServer) DescribeMutableState(context.Context, *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeMutableState not implemented")
 }
+func (UnimplementedAdminServiceServer) DescribeStateMachines(context.Context, *DescribeStateMachinesRequest) (*DescribeStateMachinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeStateMachines not implemented")
+}
+func (UnimplementedAdminServiceServer) InvokeStateMachineMethod(context.Context, *InvokeStateMachineMethodRequest) (*InvokeStateMachineMethodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvokeStateMachineMethod not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteStateMachineNode(context.Context, *DeleteStateMachineNodeRequest) (*DeleteStateMachineNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStateMachineNode not implemented")
+}
+func (UnimplementedAdminServiceServer) ScheduleDelayedSignal(context.Context, *ScheduleDelayedSignalRequest) (*ScheduleDelayedSignalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleDelayedSignal not implemented")
+}
+func (UnimplementedAdminServiceServer) CancelDelayedSignal(context.Context, *CancelDelayedSignalRequest) (*CancelDelayedSignalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDelayedSignal not implemented")
+}
+func (UnimplementedAdminServiceServer) ListDelayedSignals(context.Context, *ListDelayedSignalsRequest) (*ListDelayedSignalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDelayedSignals not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedAdminServiceServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedAdminServiceServer) RotateAPIKey(context.Context, *RotateAPIKeyRequest) (*RotateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateAPIKey not implemented")
+}
+func (UnimplementedAdminServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
 func (UnimplementedAdminServiceServer) DescribeHistoryHost(context.Context, *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeHistoryHost not implemented")
 }
@@ -686,6 +980,18 @@ func (UnimplementedAdminServiceServer) CloseShard(context.Context, *CloseShardRe
 func (UnimplementedAdminServiceServer) ListHistoryTasks(context.Context, *ListHistoryTasksRequest) (*ListHistoryTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListHistoryTasks not implemented")
 }
+func (UnimplementedAdminServiceServer) DescribeHistoryQueue(context.Context, *DescribeHistoryQueueRequest) (*DescribeHistoryQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeHistoryQueue not implemented")
+}
+func (UnimplementedAdminServiceServer) MitigateHistoryQueue(context.Context, *MitigateHistoryQueueRequest) (*MitigateHistoryQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MitigateHistoryQueue not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeOutboundQueue(context.Context, *DescribeOutboundQueueRequest) (*DescribeOutboundQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeOutboundQueue not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateOutboundQueueCircuitBreaker(context.Context, *UpdateOutboundQueueCircuitBreakerRequest) (*UpdateOutboundQueueCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOutboundQueueCircuitBreaker not implemented")
+}
 func (UnimplementedAdminServiceServer) RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveTask not implemented")
 }
@@ -788,6 +1094,15 @@ func (UnimplementedAdminServiceServer) SyncWorkflowState(context.Context, *SyncW
 func (UnimplementedAdminServiceServer) DescribeTaskQueuePartition(context.Context, *DescribeTaskQueuePartitionRequest) (*DescribeTaskQueuePartitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueuePartition not implemented")
 }
+func (UnimplementedAdminServiceServer) UpdateNexusEndpointAuth(context.Context, *UpdateNexusEndpointAuthRequest) (*UpdateNexusEndpointAuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNexusEndpointAuth not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateNexusEndpointLimits(context.Context, *UpdateNexusEndpointLimitsRequest) (*UpdateNexusEndpointLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNexusEndpointLimits not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateNexusEndpointAccessPolicy(context.Context, *UpdateNexusEndpointAccessPolicyRequest) (*UpdateNexusEndpointAccessPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNexusEndpointAccessPolicy not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -855,6 +1170,186 @@ func _AdminService_DescribeMutableState_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_DescribeStateMachines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeStateMachinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeStateMachines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeStateMachines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeStateMachines(ctx, req.(*DescribeStateMachinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_InvokeStateMachineMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvokeStateMachineMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).InvokeStateMachineMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_InvokeStateMachineMethod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).InvokeStateMachineMethod(ctx, req.(*InvokeStateMachineMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteStateMachineNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStateMachineNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteStateMachineNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteStateMachineNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteStateMachineNode(ctx, req.(*DeleteStateMachineNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ScheduleDelayedSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleDelayedSignalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ScheduleDelayedSignal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ScheduleDelayedSignal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ScheduleDelayedSignal(ctx, req.(*ScheduleDelayedSignalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CancelDelayedSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDelayedSignalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CancelDelayedSignal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CancelDelayedSignal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CancelDelayedSignal(ctx, req.(*CancelDelayedSignalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDelayedSignals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDelayedSignalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDelayedSignals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListDelayedSignals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDelayedSignals(ctx, req.(*ListDelayedSignalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RotateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RotateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RotateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RotateAPIKey(ctx, req.(*RotateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_DescribeHistoryHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeHistoryHostRequest)
 	if err := dec(in); err != nil {
@@ -927,6 +1422,78 @@ func _AdminService_ListHistoryTasks_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_DescribeHistoryQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeHistoryQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeHistoryQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeHistoryQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeHistoryQueue(ctx, req.(*DescribeHistoryQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_MitigateHistoryQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MitigateHistoryQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MitigateHistoryQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_MitigateHistoryQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MitigateHistoryQueue(ctx, req.(*MitigateHistoryQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeOutboundQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeOutboundQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeOutboundQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeOutboundQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeOutboundQueue(ctx, req.(*DescribeOutboundQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateOutboundQueueCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOutboundQueueCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateOutboundQueueCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateOutboundQueueCircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateOutboundQueueCircuitBreaker(ctx, req.(*UpdateOutboundQueueCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_RemoveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveTaskRequest)
 	if err := dec(in); err != nil {
@@ -1547,6 +2114,60 @@ func _AdminService_DescribeTaskQueuePartition_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_UpdateNexusEndpointAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNexusEndpointAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateNexusEndpointAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateNexusEndpointAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateNexusEndpointAuth(ctx, req.(*UpdateNexusEndpointAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateNexusEndpointLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNexusEndpointLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateNexusEndpointLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateNexusEndpointLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateNexusEndpointLimits(ctx, req.(*UpdateNexusEndpointLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateNexusEndpointAccessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNexusEndpointAccessPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateNexusEndpointAccessPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateNexusEndpointAccessPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateNexusEndpointAccessPolicy(ctx, req.(*UpdateNexusEndpointAccessPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1566,6 +2187,46 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeMutableState",
 			Handler:    _AdminService_DescribeMutableState_Handler,
 		},
+		{
+			MethodName: "DescribeStateMachines",
+			Handler:    _AdminService_DescribeStateMachines_Handler,
+		},
+		{
+			MethodName: "InvokeStateMachineMethod",
+			Handler:    _AdminService_InvokeStateMachineMethod_Handler,
+		},
+		{
+			MethodName: "DeleteStateMachineNode",
+			Handler:    _AdminService_DeleteStateMachineNode_Handler,
+		},
+		{
+			MethodName: "ScheduleDelayedSignal",
+			Handler:    _AdminService_ScheduleDelayedSignal_Handler,
+		},
+		{
+			MethodName: "CancelDelayedSignal",
+			Handler:    _AdminService_CancelDelayedSignal_Handler,
+		},
+		{
+			MethodName: "ListDelayedSignals",
+			Handler:    _AdminService_ListDelayedSignals_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _AdminService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _AdminService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RotateAPIKey",
+			Handler:    _AdminService_RotateAPIKey_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _AdminService_RevokeAPIKey_Handler,
+		},
 		{
 			MethodName: "DescribeHistoryHost",
 			Handler:    _AdminService_DescribeHistoryHost_Handler,
@@ -1582,6 +2243,22 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListHistoryTasks",
 			Handler:    _AdminService_ListHistoryTasks_Handler,
 		},
+		{
+			MethodName: "DescribeHistoryQueue",
+			Handler:    _AdminService_DescribeHistoryQueue_Handler,
+		},
+		{
+			MethodName: "MitigateHistoryQueue",
+			Handler:    _AdminService_MitigateHistoryQueue_Handler,
+		},
+		{
+			MethodName: "DescribeOutboundQueue",
+			Handler:    _AdminService_DescribeOutboundQueue_Handler,
+		},
+		{
+			MethodName: "UpdateOutboundQueueCircuitBreaker",
+			Handler:    _AdminService_UpdateOutboundQueueCircuitBreaker_Handler,
+		},
 		{
 			MethodName: "RemoveTask",
 			Handler:    _AdminService_RemoveTask_Handler,
@@ -1714,6 +2391,18 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeTaskQueuePartition",
 			Handler:    _AdminService_DescribeTaskQueuePartition_Handler,
 		},
+		{
+			MethodName: "UpdateNexusEndpointAuth",
+			Handler:    _AdminService_UpdateNexusEndpointAuth_Handler,
+		},
+		{
+			MethodName: "UpdateNexusEndpointLimits",
+			Handler:    _AdminService_UpdateNexusEndpointLimits_Handler,
+		},
+		{
+			MethodName: "UpdateNexusEndpointAccessPolicy",
+			Handler:    _AdminService_UpdateNexusEndpointAccessPolicy_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
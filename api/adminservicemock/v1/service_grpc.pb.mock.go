@@ -146,6 +146,26 @@ func (mr *MockAdminServiceClientMockRecorder) CancelDLQJob(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDLQJob", reflect.TypeOf((*MockAdminServiceClient)(nil).CancelDLQJob), varargs...)
 }
 
+// CancelDelayedSignal mocks base method.
+func (m *MockAdminServiceClient) CancelDelayedSignal(ctx context.Context, in *adminservice.CancelDelayedSignalRequest, opts ...grpc.CallOption) (*adminservice.CancelDelayedSignalResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelDelayedSignal", varargs...)
+	ret0, _ := ret[0].(*adminservice.CancelDelayedSignalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelDelayedSignal indicates an expected call of CancelDelayedSignal.
+func (mr *MockAdminServiceClientMockRecorder) CancelDelayedSignal(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDelayedSignal", reflect.TypeOf((*MockAdminServiceClient)(nil).CancelDelayedSignal), varargs...)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceClient) CloseShard(ctx context.Context, in *adminservice.CloseShardRequest, opts ...grpc.CallOption) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -166,6 +186,26 @@ func (mr *MockAdminServiceClientMockRecorder) CloseShard(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceClient)(nil).CloseShard), varargs...)
 }
 
+// CreateAPIKey mocks base method.
+func (m *MockAdminServiceClient) CreateAPIKey(ctx context.Context, in *adminservice.CreateAPIKeyRequest, opts ...grpc.CallOption) (*adminservice.CreateAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAPIKey", varargs...)
+	ret0, _ := ret[0].(*adminservice.CreateAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockAdminServiceClientMockRecorder) CreateAPIKey(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockAdminServiceClient)(nil).CreateAPIKey), varargs...)
+}
+
 // DeepHealthCheck mocks base method.
 func (m *MockAdminServiceClient) DeepHealthCheck(ctx context.Context, in *adminservice.DeepHealthCheckRequest, opts ...grpc.CallOption) (*adminservice.DeepHealthCheckResponse, error) {
 	m.ctrl.T.Helper()
@@ -186,6 +226,26 @@ func (mr *MockAdminServiceClientMockRecorder) DeepHealthCheck(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceClient)(nil).DeepHealthCheck), varargs...)
 }
 
+// DeleteStateMachineNode mocks base method.
+func (m *MockAdminServiceClient) DeleteStateMachineNode(ctx context.Context, in *adminservice.DeleteStateMachineNodeRequest, opts ...grpc.CallOption) (*adminservice.DeleteStateMachineNodeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteStateMachineNode", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteStateMachineNodeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStateMachineNode indicates an expected call of DeleteStateMachineNode.
+func (mr *MockAdminServiceClientMockRecorder) DeleteStateMachineNode(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStateMachineNode", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteStateMachineNode), varargs...)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *adminservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -266,6 +326,26 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeHistoryHost(ctx, in any, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeHistoryHost), varargs...)
 }
 
+// DescribeHistoryQueue mocks base method.
+func (m *MockAdminServiceClient) DescribeHistoryQueue(ctx context.Context, in *adminservice.DescribeHistoryQueueRequest, opts ...grpc.CallOption) (*adminservice.DescribeHistoryQueueResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeHistoryQueue", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeHistoryQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHistoryQueue indicates an expected call of DescribeHistoryQueue.
+func (mr *MockAdminServiceClientMockRecorder) DescribeHistoryQueue(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryQueue", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeHistoryQueue), varargs...)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockAdminServiceClient) DescribeMutableState(ctx context.Context, in *adminservice.DescribeMutableStateRequest, opts ...grpc.CallOption) (*adminservice.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -286,6 +366,46 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeOutboundQueue mocks base method.
+func (m *MockAdminServiceClient) DescribeOutboundQueue(ctx context.Context, in *adminservice.DescribeOutboundQueueRequest, opts ...grpc.CallOption) (*adminservice.DescribeOutboundQueueResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeOutboundQueue", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeOutboundQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOutboundQueue indicates an expected call of DescribeOutboundQueue.
+func (mr *MockAdminServiceClientMockRecorder) DescribeOutboundQueue(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOutboundQueue", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeOutboundQueue), varargs...)
+}
+
+// DescribeStateMachines mocks base method.
+func (m *MockAdminServiceClient) DescribeStateMachines(ctx context.Context, in *adminservice.DescribeStateMachinesRequest, opts ...grpc.CallOption) (*adminservice.DescribeStateMachinesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeStateMachines", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeStateMachinesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeStateMachines indicates an expected call of DescribeStateMachines.
+func (mr *MockAdminServiceClientMockRecorder) DescribeStateMachines(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStateMachines", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeStateMachines), varargs...)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) DescribeTaskQueuePartition(ctx context.Context, in *adminservice.DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -546,6 +666,46 @@ func (mr *MockAdminServiceClientMockRecorder) ImportWorkflowExecution(ctx, in an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).ImportWorkflowExecution), varargs...)
 }
 
+// InvokeStateMachineMethod mocks base method.
+func (m *MockAdminServiceClient) InvokeStateMachineMethod(ctx context.Context, in *adminservice.InvokeStateMachineMethodRequest, opts ...grpc.CallOption) (*adminservice.InvokeStateMachineMethodResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InvokeStateMachineMethod", varargs...)
+	ret0, _ := ret[0].(*adminservice.InvokeStateMachineMethodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvokeStateMachineMethod indicates an expected call of InvokeStateMachineMethod.
+func (mr *MockAdminServiceClientMockRecorder) InvokeStateMachineMethod(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvokeStateMachineMethod", reflect.TypeOf((*MockAdminServiceClient)(nil).InvokeStateMachineMethod), varargs...)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockAdminServiceClient) ListAPIKeys(ctx context.Context, in *adminservice.ListAPIKeysRequest, opts ...grpc.CallOption) (*adminservice.ListAPIKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAPIKeys", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListAPIKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockAdminServiceClientMockRecorder) ListAPIKeys(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockAdminServiceClient)(nil).ListAPIKeys), varargs...)
+}
+
 // ListClusterMembers mocks base method.
 func (m *MockAdminServiceClient) ListClusterMembers(ctx context.Context, in *adminservice.ListClusterMembersRequest, opts ...grpc.CallOption) (*adminservice.ListClusterMembersResponse, error) {
 	m.ctrl.T.Helper()
@@ -586,6 +746,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListClusters(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockAdminServiceClient)(nil).ListClusters), varargs...)
 }
 
+// ListDelayedSignals mocks base method.
+func (m *MockAdminServiceClient) ListDelayedSignals(ctx context.Context, in *adminservice.ListDelayedSignalsRequest, opts ...grpc.CallOption) (*adminservice.ListDelayedSignalsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDelayedSignals", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListDelayedSignalsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDelayedSignals indicates an expected call of ListDelayedSignals.
+func (mr *MockAdminServiceClientMockRecorder) ListDelayedSignals(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDelayedSignals", reflect.TypeOf((*MockAdminServiceClient)(nil).ListDelayedSignals), varargs...)
+}
+
 // ListHistoryTasks mocks base method.
 func (m *MockAdminServiceClient) ListHistoryTasks(ctx context.Context, in *adminservice.ListHistoryTasksRequest, opts ...grpc.CallOption) (*adminservice.ListHistoryTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -666,6 +846,26 @@ func (mr *MockAdminServiceClientMockRecorder) MergeDLQTasks(ctx, in any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQTasks), varargs...)
 }
 
+// MitigateHistoryQueue mocks base method.
+func (m *MockAdminServiceClient) MitigateHistoryQueue(ctx context.Context, in *adminservice.MitigateHistoryQueueRequest, opts ...grpc.CallOption) (*adminservice.MitigateHistoryQueueResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MitigateHistoryQueue", varargs...)
+	ret0, _ := ret[0].(*adminservice.MitigateHistoryQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MitigateHistoryQueue indicates an expected call of MitigateHistoryQueue.
+func (mr *MockAdminServiceClientMockRecorder) MitigateHistoryQueue(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MitigateHistoryQueue", reflect.TypeOf((*MockAdminServiceClient)(nil).MitigateHistoryQueue), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -846,6 +1046,66 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// RevokeAPIKey mocks base method.
+func (m *MockAdminServiceClient) RevokeAPIKey(ctx context.Context, in *adminservice.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*adminservice.RevokeAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeAPIKey", varargs...)
+	ret0, _ := ret[0].(*adminservice.RevokeAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockAdminServiceClientMockRecorder) RevokeAPIKey(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockAdminServiceClient)(nil).RevokeAPIKey), varargs...)
+}
+
+// RotateAPIKey mocks base method.
+func (m *MockAdminServiceClient) RotateAPIKey(ctx context.Context, in *adminservice.RotateAPIKeyRequest, opts ...grpc.CallOption) (*adminservice.RotateAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RotateAPIKey", varargs...)
+	ret0, _ := ret[0].(*adminservice.RotateAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateAPIKey indicates an expected call of RotateAPIKey.
+func (mr *MockAdminServiceClientMockRecorder) RotateAPIKey(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateAPIKey", reflect.TypeOf((*MockAdminServiceClient)(nil).RotateAPIKey), varargs...)
+}
+
+// ScheduleDelayedSignal mocks base method.
+func (m *MockAdminServiceClient) ScheduleDelayedSignal(ctx context.Context, in *adminservice.ScheduleDelayedSignalRequest, opts ...grpc.CallOption) (*adminservice.ScheduleDelayedSignalResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ScheduleDelayedSignal", varargs...)
+	ret0, _ := ret[0].(*adminservice.ScheduleDelayedSignalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleDelayedSignal indicates an expected call of ScheduleDelayedSignal.
+func (mr *MockAdminServiceClientMockRecorder) ScheduleDelayedSignal(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleDelayedSignal", reflect.TypeOf((*MockAdminServiceClient)(nil).ScheduleDelayedSignal), varargs...)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
 	m.ctrl.T.Helper()
@@ -886,6 +1146,86 @@ func (mr *MockAdminServiceClientMockRecorder) SyncWorkflowState(ctx, in any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceClient)(nil).SyncWorkflowState), varargs...)
 }
 
+// UpdateNexusEndpointAccessPolicy mocks base method.
+func (m *MockAdminServiceClient) UpdateNexusEndpointAccessPolicy(ctx context.Context, in *adminservice.UpdateNexusEndpointAccessPolicyRequest, opts ...grpc.CallOption) (*adminservice.UpdateNexusEndpointAccessPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateNexusEndpointAccessPolicy", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateNexusEndpointAccessPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNexusEndpointAccessPolicy indicates an expected call of UpdateNexusEndpointAccessPolicy.
+func (mr *MockAdminServiceClientMockRecorder) UpdateNexusEndpointAccessPolicy(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNexusEndpointAccessPolicy", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateNexusEndpointAccessPolicy), varargs...)
+}
+
+// UpdateNexusEndpointAuth mocks base method.
+func (m *MockAdminServiceClient) UpdateNexusEndpointAuth(ctx context.Context, in *adminservice.UpdateNexusEndpointAuthRequest, opts ...grpc.CallOption) (*adminservice.UpdateNexusEndpointAuthResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateNexusEndpointAuth", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateNexusEndpointAuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNexusEndpointAuth indicates an expected call of UpdateNexusEndpointAuth.
+func (mr *MockAdminServiceClientMockRecorder) UpdateNexusEndpointAuth(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNexusEndpointAuth", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateNexusEndpointAuth), varargs...)
+}
+
+// UpdateNexusEndpointLimits mocks base method.
+func (m *MockAdminServiceClient) UpdateNexusEndpointLimits(ctx context.Context, in *adminservice.UpdateNexusEndpointLimitsRequest, opts ...grpc.CallOption) (*adminservice.UpdateNexusEndpointLimitsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateNexusEndpointLimits", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateNexusEndpointLimitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNexusEndpointLimits indicates an expected call of UpdateNexusEndpointLimits.
+func (mr *MockAdminServiceClientMockRecorder) UpdateNexusEndpointLimits(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNexusEndpointLimits", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateNexusEndpointLimits), varargs...)
+}
+
+// UpdateOutboundQueueCircuitBreaker mocks base method.
+func (m *MockAdminServiceClient) UpdateOutboundQueueCircuitBreaker(ctx context.Context, in *adminservice.UpdateOutboundQueueCircuitBreakerRequest, opts ...grpc.CallOption) (*adminservice.UpdateOutboundQueueCircuitBreakerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateOutboundQueueCircuitBreaker", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateOutboundQueueCircuitBreakerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOutboundQueueCircuitBreaker indicates an expected call of UpdateOutboundQueueCircuitBreaker.
+func (mr *MockAdminServiceClientMockRecorder) UpdateOutboundQueueCircuitBreaker(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOutboundQueueCircuitBreaker", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateOutboundQueueCircuitBreaker), varargs...)
+}
+
 // MockAdminService_StreamWorkflowReplicationMessagesClient is a mock of AdminService_StreamWorkflowReplicationMessagesClient interface.
 type MockAdminService_StreamWorkflowReplicationMessagesClient struct {
 	ctrl     *gomock.Controller
@@ -1106,6 +1446,21 @@ func (mr *MockAdminServiceServerMockRecorder) CancelDLQJob(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDLQJob", reflect.TypeOf((*MockAdminServiceServer)(nil).CancelDLQJob), arg0, arg1)
 }
 
+// CancelDelayedSignal mocks base method.
+func (m *MockAdminServiceServer) CancelDelayedSignal(arg0 context.Context, arg1 *adminservice.CancelDelayedSignalRequest) (*adminservice.CancelDelayedSignalResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelDelayedSignal", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CancelDelayedSignalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelDelayedSignal indicates an expected call of CancelDelayedSignal.
+func (mr *MockAdminServiceServerMockRecorder) CancelDelayedSignal(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDelayedSignal", reflect.TypeOf((*MockAdminServiceServer)(nil).CancelDelayedSignal), arg0, arg1)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceServer) CloseShard(arg0 context.Context, arg1 *adminservice.CloseShardRequest) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -1121,6 +1476,21 @@ func (mr *MockAdminServiceServerMockRecorder) CloseShard(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceServer)(nil).CloseShard), arg0, arg1)
 }
 
+// CreateAPIKey mocks base method.
+func (m *MockAdminServiceServer) CreateAPIKey(arg0 context.Context, arg1 *adminservice.CreateAPIKeyRequest) (*adminservice.CreateAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CreateAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockAdminServiceServerMockRecorder) CreateAPIKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockAdminServiceServer)(nil).CreateAPIKey), arg0, arg1)
+}
+
 // DeepHealthCheck mocks base method.
 func (m *MockAdminServiceServer) DeepHealthCheck(arg0 context.Context, arg1 *adminservice.DeepHealthCheckRequest) (*adminservice.DeepHealthCheckResponse, error) {
 	m.ctrl.T.Helper()
@@ -1136,6 +1506,21 @@ func (mr *MockAdminServiceServerMockRecorder) DeepHealthCheck(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceServer)(nil).DeepHealthCheck), arg0, arg1)
 }
 
+// DeleteStateMachineNode mocks base method.
+func (m *MockAdminServiceServer) DeleteStateMachineNode(arg0 context.Context, arg1 *adminservice.DeleteStateMachineNodeRequest) (*adminservice.DeleteStateMachineNodeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStateMachineNode", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteStateMachineNodeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStateMachineNode indicates an expected call of DeleteStateMachineNode.
+func (mr *MockAdminServiceServerMockRecorder) DeleteStateMachineNode(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStateMachineNode", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteStateMachineNode), arg0, arg1)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1196,6 +1581,21 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeHistoryHost(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeHistoryHost), arg0, arg1)
 }
 
+// DescribeHistoryQueue mocks base method.
+func (m *MockAdminServiceServer) DescribeHistoryQueue(arg0 context.Context, arg1 *adminservice.DescribeHistoryQueueRequest) (*adminservice.DescribeHistoryQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeHistoryQueue", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeHistoryQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHistoryQueue indicates an expected call of DescribeHistoryQueue.
+func (mr *MockAdminServiceServerMockRecorder) DescribeHistoryQueue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryQueue", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeHistoryQueue), arg0, arg1)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockAdminServiceServer) DescribeMutableState(arg0 context.Context, arg1 *adminservice.DescribeMutableStateRequest) (*adminservice.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -1211,6 +1611,36 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeOutboundQueue mocks base method.
+func (m *MockAdminServiceServer) DescribeOutboundQueue(arg0 context.Context, arg1 *adminservice.DescribeOutboundQueueRequest) (*adminservice.DescribeOutboundQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOutboundQueue", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeOutboundQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOutboundQueue indicates an expected call of DescribeOutboundQueue.
+func (mr *MockAdminServiceServerMockRecorder) DescribeOutboundQueue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOutboundQueue", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeOutboundQueue), arg0, arg1)
+}
+
+// DescribeStateMachines mocks base method.
+func (m *MockAdminServiceServer) DescribeStateMachines(arg0 context.Context, arg1 *adminservice.DescribeStateMachinesRequest) (*adminservice.DescribeStateMachinesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeStateMachines", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeStateMachinesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeStateMachines indicates an expected call of DescribeStateMachines.
+func (mr *MockAdminServiceServerMockRecorder) DescribeStateMachines(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStateMachines", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeStateMachines), arg0, arg1)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) DescribeTaskQueuePartition(arg0 context.Context, arg1 *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1406,6 +1836,36 @@ func (mr *MockAdminServiceServerMockRecorder) ImportWorkflowExecution(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).ImportWorkflowExecution), arg0, arg1)
 }
 
+// InvokeStateMachineMethod mocks base method.
+func (m *MockAdminServiceServer) InvokeStateMachineMethod(arg0 context.Context, arg1 *adminservice.InvokeStateMachineMethodRequest) (*adminservice.InvokeStateMachineMethodResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvokeStateMachineMethod", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.InvokeStateMachineMethodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvokeStateMachineMethod indicates an expected call of InvokeStateMachineMethod.
+func (mr *MockAdminServiceServerMockRecorder) InvokeStateMachineMethod(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvokeStateMachineMethod", reflect.TypeOf((*MockAdminServiceServer)(nil).InvokeStateMachineMethod), arg0, arg1)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockAdminServiceServer) ListAPIKeys(arg0 context.Context, arg1 *adminservice.ListAPIKeysRequest) (*adminservice.ListAPIKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListAPIKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockAdminServiceServerMockRecorder) ListAPIKeys(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockAdminServiceServer)(nil).ListAPIKeys), arg0, arg1)
+}
+
 // ListClusterMembers mocks base method.
 func (m *MockAdminServiceServer) ListClusterMembers(arg0 context.Context, arg1 *adminservice.ListClusterMembersRequest) (*adminservice.ListClusterMembersResponse, error) {
 	m.ctrl.T.Helper()
@@ -1436,6 +1896,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListClusters(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockAdminServiceServer)(nil).ListClusters), arg0, arg1)
 }
 
+// ListDelayedSignals mocks base method.
+func (m *MockAdminServiceServer) ListDelayedSignals(arg0 context.Context, arg1 *adminservice.ListDelayedSignalsRequest) (*adminservice.ListDelayedSignalsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDelayedSignals", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListDelayedSignalsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDelayedSignals indicates an expected call of ListDelayedSignals.
+func (mr *MockAdminServiceServerMockRecorder) ListDelayedSignals(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDelayedSignals", reflect.TypeOf((*MockAdminServiceServer)(nil).ListDelayedSignals), arg0, arg1)
+}
+
 // ListHistoryTasks mocks base method.
 func (m *MockAdminServiceServer) ListHistoryTasks(arg0 context.Context, arg1 *adminservice.ListHistoryTasksRequest) (*adminservice.ListHistoryTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1496,6 +1971,21 @@ func (mr *MockAdminServiceServerMockRecorder) MergeDLQTasks(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).MergeDLQTasks), arg0, arg1)
 }
 
+// MitigateHistoryQueue mocks base method.
+func (m *MockAdminServiceServer) MitigateHistoryQueue(arg0 context.Context, arg1 *adminservice.MitigateHistoryQueueRequest) (*adminservice.MitigateHistoryQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MitigateHistoryQueue", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.MitigateHistoryQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MitigateHistoryQueue indicates an expected call of MitigateHistoryQueue.
+func (mr *MockAdminServiceServerMockRecorder) MitigateHistoryQueue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MitigateHistoryQueue", reflect.TypeOf((*MockAdminServiceServer)(nil).MitigateHistoryQueue), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1631,6 +2121,51 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
 
+// RevokeAPIKey mocks base method.
+func (m *MockAdminServiceServer) RevokeAPIKey(arg0 context.Context, arg1 *adminservice.RevokeAPIKeyRequest) (*adminservice.RevokeAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RevokeAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockAdminServiceServerMockRecorder) RevokeAPIKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockAdminServiceServer)(nil).RevokeAPIKey), arg0, arg1)
+}
+
+// RotateAPIKey mocks base method.
+func (m *MockAdminServiceServer) RotateAPIKey(arg0 context.Context, arg1 *adminservice.RotateAPIKeyRequest) (*adminservice.RotateAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RotateAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateAPIKey indicates an expected call of RotateAPIKey.
+func (mr *MockAdminServiceServerMockRecorder) RotateAPIKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateAPIKey", reflect.TypeOf((*MockAdminServiceServer)(nil).RotateAPIKey), arg0, arg1)
+}
+
+// ScheduleDelayedSignal mocks base method.
+func (m *MockAdminServiceServer) ScheduleDelayedSignal(arg0 context.Context, arg1 *adminservice.ScheduleDelayedSignalRequest) (*adminservice.ScheduleDelayedSignalResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleDelayedSignal", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ScheduleDelayedSignalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleDelayedSignal indicates an expected call of ScheduleDelayedSignal.
+func (mr *MockAdminServiceServerMockRecorder) ScheduleDelayedSignal(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleDelayedSignal", reflect.TypeOf((*MockAdminServiceServer)(nil).ScheduleDelayedSignal), arg0, arg1)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
 	m.ctrl.T.Helper()
@@ -1660,6 +2195,66 @@ func (mr *MockAdminServiceServerMockRecorder) SyncWorkflowState(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceServer)(nil).SyncWorkflowState), arg0, arg1)
 }
 
+// UpdateNexusEndpointAccessPolicy mocks base method.
+func (m *MockAdminServiceServer) UpdateNexusEndpointAccessPolicy(arg0 context.Context, arg1 *adminservice.UpdateNexusEndpointAccessPolicyRequest) (*adminservice.UpdateNexusEndpointAccessPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNexusEndpointAccessPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateNexusEndpointAccessPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNexusEndpointAccessPolicy indicates an expected call of UpdateNexusEndpointAccessPolicy.
+func (mr *MockAdminServiceServerMockRecorder) UpdateNexusEndpointAccessPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNexusEndpointAccessPolicy", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateNexusEndpointAccessPolicy), arg0, arg1)
+}
+
+// UpdateNexusEndpointAuth mocks base method.
+func (m *MockAdminServiceServer) UpdateNexusEndpointAuth(arg0 context.Context, arg1 *adminservice.UpdateNexusEndpointAuthRequest) (*adminservice.UpdateNexusEndpointAuthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNexusEndpointAuth", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateNexusEndpointAuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNexusEndpointAuth indicates an expected call of UpdateNexusEndpointAuth.
+func (mr *MockAdminServiceServerMockRecorder) UpdateNexusEndpointAuth(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNexusEndpointAuth", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateNexusEndpointAuth), arg0, arg1)
+}
+
+// UpdateNexusEndpointLimits mocks base method.
+func (m *MockAdminServiceServer) UpdateNexusEndpointLimits(arg0 context.Context, arg1 *adminservice.UpdateNexusEndpointLimitsRequest) (*adminservice.UpdateNexusEndpointLimitsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNexusEndpointLimits", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateNexusEndpointLimitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNexusEndpointLimits indicates an expected call of UpdateNexusEndpointLimits.
+func (mr *MockAdminServiceServerMockRecorder) UpdateNexusEndpointLimits(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNexusEndpointLimits", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateNexusEndpointLimits), arg0, arg1)
+}
+
+// UpdateOutboundQueueCircuitBreaker mocks base method.
+func (m *MockAdminServiceServer) UpdateOutboundQueueCircuitBreaker(arg0 context.Context, arg1 *adminservice.UpdateOutboundQueueCircuitBreakerRequest) (*adminservice.UpdateOutboundQueueCircuitBreakerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOutboundQueueCircuitBreaker", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateOutboundQueueCircuitBreakerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOutboundQueueCircuitBreaker indicates an expected call of UpdateOutboundQueueCircuitBreaker.
+func (mr *MockAdminServiceServerMockRecorder) UpdateOutboundQueueCircuitBreaker(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOutboundQueueCircuitBreaker", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateOutboundQueueCircuitBreaker), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAdminServiceServer mocks base method.
 func (m *MockAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {
 	m.ctrl.T.Helper()
@@ -106,3 +106,26 @@ func CallbackStateFromString(s string) (CallbackState, error) {
 	}
 	return CallbackState(0), fmt.Errorf("%s is not a valid CallbackState", s)
 }
+
+var (
+	DelayedSignalState_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Waiting":     1,
+		"Scheduled":   2,
+		"BackingOff":  3,
+		"Failed":      4,
+		"Succeeded":   5,
+		"Canceled":    6,
+	}
+)
+
+// DelayedSignalStateFromString parses a DelayedSignalState value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to DelayedSignalState
+func DelayedSignalStateFromString(s string) (DelayedSignalState, error) {
+	if v, ok := DelayedSignalState_value[s]; ok {
+		return DelayedSignalState(v), nil
+	} else if v, ok := DelayedSignalState_shorthandValue[s]; ok {
+		return DelayedSignalState(v), nil
+	}
+	return DelayedSignalState(0), fmt.Errorf("%s is not a valid DelayedSignalState", s)
+}
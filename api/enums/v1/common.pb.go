@@ -301,6 +301,93 @@ func (CallbackState) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_common_proto_rawDescGZIP(), []int{3}
 }
 
+// State of a delayed signal.
+type DelayedSignalState int32
+
+const (
+	// Default value, unspecified state.
+	DELAYED_SIGNAL_STATE_UNSPECIFIED DelayedSignalState = 0
+	// Delayed signal is waiting for its fire time.
+	DELAYED_SIGNAL_STATE_WAITING DelayedSignalState = 1
+	// Delayed signal is in the queue waiting to be delivered or is currently being delivered.
+	DELAYED_SIGNAL_STATE_SCHEDULED DelayedSignalState = 2
+	// Delivery has failed with a retryable error and is backing off before the next attempt.
+	DELAYED_SIGNAL_STATE_BACKING_OFF DelayedSignalState = 3
+	// Delivery has failed with a non-retryable error.
+	DELAYED_SIGNAL_STATE_FAILED DelayedSignalState = 4
+	// Signal was delivered to the target workflow.
+	DELAYED_SIGNAL_STATE_SUCCEEDED DelayedSignalState = 5
+	// Delayed signal was canceled before it was delivered.
+	DELAYED_SIGNAL_STATE_CANCELED DelayedSignalState = 6
+)
+
+// Enum value maps for DelayedSignalState.
+var (
+	DelayedSignalState_name = map[int32]string{
+		0: "DELAYED_SIGNAL_STATE_UNSPECIFIED",
+		1: "DELAYED_SIGNAL_STATE_WAITING",
+		2: "DELAYED_SIGNAL_STATE_SCHEDULED",
+		3: "DELAYED_SIGNAL_STATE_BACKING_OFF",
+		4: "DELAYED_SIGNAL_STATE_FAILED",
+		5: "DELAYED_SIGNAL_STATE_SUCCEEDED",
+		6: "DELAYED_SIGNAL_STATE_CANCELED",
+	}
+	DelayedSignalState_value = map[string]int32{
+		"DELAYED_SIGNAL_STATE_UNSPECIFIED": 0,
+		"DELAYED_SIGNAL_STATE_WAITING":     1,
+		"DELAYED_SIGNAL_STATE_SCHEDULED":   2,
+		"DELAYED_SIGNAL_STATE_BACKING_OFF": 3,
+		"DELAYED_SIGNAL_STATE_FAILED":      4,
+		"DELAYED_SIGNAL_STATE_SUCCEEDED":   5,
+		"DELAYED_SIGNAL_STATE_CANCELED":    6,
+	}
+)
+
+func (x DelayedSignalState) Enum() *DelayedSignalState {
+	p := new(DelayedSignalState)
+	*p = x
+	return p
+}
+
+func (x DelayedSignalState) String() string {
+	switch x {
+	case DELAYED_SIGNAL_STATE_UNSPECIFIED:
+		return "Unspecified"
+	case DELAYED_SIGNAL_STATE_WAITING:
+		return "Waiting"
+	case DELAYED_SIGNAL_STATE_SCHEDULED:
+		return "Scheduled"
+	case DELAYED_SIGNAL_STATE_BACKING_OFF:
+		return "BackingOff"
+	case DELAYED_SIGNAL_STATE_FAILED:
+		return "Failed"
+	case DELAYED_SIGNAL_STATE_SUCCEEDED:
+		return "Succeeded"
+	case DELAYED_SIGNAL_STATE_CANCELED:
+		return "Canceled"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (DelayedSignalState) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_common_proto_enumTypes[4].Descriptor()
+}
+
+func (DelayedSignalState) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_common_proto_enumTypes[4]
+}
+
+func (x DelayedSignalState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DelayedSignalState.Descriptor instead.
+func (DelayedSignalState) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_common_proto_rawDescGZIP(), []int{4}
+}
+
 var File_temporal_server_api_enums_v1_common_proto protoreflect.FileDescriptor
 
 var file_temporal_server_api_enums_v1_common_proto_rawDesc = []byte{
@@ -343,10 +430,27 @@ var file_temporal_server_api_enums_v1_common_proto_rawDesc = []byte{
 	0x42, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
 	0x44, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f,
 	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10,
-	0x05, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c,
-	0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65,
-	0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x05, 0x2a, 0x8e, 0x02, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x44, 0x45, 0x4c, 0x41,
+	0x59, 0x45, 0x44, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20,
+	0x0a, 0x1c, 0x44, 0x45, 0x4c, 0x41, 0x59, 0x45, 0x44, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01,
+	0x12, 0x22, 0x0a, 0x1e, 0x44, 0x45, 0x4c, 0x41, 0x59, 0x45, 0x44, 0x5f, 0x53, 0x49, 0x47, 0x4e,
+	0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x44, 0x45, 0x4c, 0x41, 0x59, 0x45, 0x44, 0x5f,
+	0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x41, 0x43,
+	0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x45,
+	0x4c, 0x41, 0x59, 0x45, 0x44, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x22, 0x0a, 0x1e, 0x44,
+	0x45, 0x4c, 0x41, 0x59, 0x45, 0x44, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x05, 0x12,
+	0x21, 0x0a, 0x1d, 0x44, 0x45, 0x4c, 0x41, 0x59, 0x45, 0x44, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41,
+	0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44,
+	0x10, 0x06, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -361,12 +465,13 @@ func file_temporal_server_api_enums_v1_common_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_enums_v1_common_proto_rawDescData
 }
 
-var file_temporal_server_api_enums_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_temporal_server_api_enums_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
 var file_temporal_server_api_enums_v1_common_proto_goTypes = []interface{}{
 	(DeadLetterQueueType)(0), // 0: temporal.server.api.enums.v1.DeadLetterQueueType
 	(ChecksumFlavor)(0),      // 1: temporal.server.api.enums.v1.ChecksumFlavor
 	(SchedulerState)(0),      // 2: temporal.server.api.enums.v1.SchedulerState
 	(CallbackState)(0),       // 3: temporal.server.api.enums.v1.CallbackState
+	(DelayedSignalState)(0),  // 4: temporal.server.api.enums.v1.DelayedSignalState
 }
 var file_temporal_server_api_enums_v1_common_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -386,7 +491,7 @@ func file_temporal_server_api_enums_v1_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_temporal_server_api_enums_v1_common_proto_rawDesc,
-			NumEnums:      4,
+			NumEnums:      5,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
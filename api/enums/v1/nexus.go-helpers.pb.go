@@ -39,6 +39,7 @@ var (
 		"Failed":      5,
 		"Canceled":    6,
 		"TimedOut":    7,
+		"Blocked":     8,
 	}
 )
 
@@ -66,6 +66,9 @@ const (
 	// Operation timed out - exceeded the user supplied schedule-to-close timeout.
 	// Any attempts to complete the operation in this state will be ignored.
 	NEXUS_OPERATION_STATE_TIMED_OUT NexusOperationState = 7
+	// Operation could not be started because its endpoint is at capacity (see NexusEndpointLimits). It is waiting in
+	// the outbound queue for capacity to free up. Not considered a failed attempt.
+	NEXUS_OPERATION_STATE_BLOCKED NexusOperationState = 8
 )
 
 // Enum value maps for NexusOperationState.
@@ -79,6 +82,7 @@ var (
 		5: "NEXUS_OPERATION_STATE_FAILED",
 		6: "NEXUS_OPERATION_STATE_CANCELED",
 		7: "NEXUS_OPERATION_STATE_TIMED_OUT",
+		8: "NEXUS_OPERATION_STATE_BLOCKED",
 	}
 	NexusOperationState_value = map[string]int32{
 		"NEXUS_OPERATION_STATE_UNSPECIFIED": 0,
@@ -89,6 +93,7 @@ var (
 		"NEXUS_OPERATION_STATE_FAILED":      5,
 		"NEXUS_OPERATION_STATE_CANCELED":    6,
 		"NEXUS_OPERATION_STATE_TIMED_OUT":   7,
+		"NEXUS_OPERATION_STATE_BLOCKED":     8,
 	}
 )
 
@@ -116,6 +121,10 @@ func (x NexusOperationState) String() string {
 		return "Canceled"
 	case NEXUS_OPERATION_STATE_TIMED_OUT:
 		return "TimedOut"
+	case NEXUS_OPERATION_STATE_BLOCKED:
+		return "Blocked"
+
+		// Deprecated: Use NexusOperationState.Descriptor instead.
 	default:
 		return strconv.Itoa(int(x))
 	}
@@ -134,7 +143,6 @@ func (x NexusOperationState) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use NexusOperationState.Descriptor instead.
 func (NexusOperationState) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_nexus_proto_rawDescGZIP(), []int{0}
 }
@@ -146,7 +154,7 @@ var file_temporal_server_api_enums_v1_nexus_proto_rawDesc = []byte{
 	0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6e,
 	0x65, 0x78, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x74, 0x65, 0x6d, 0x70,
 	0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2a, 0xbb, 0x02, 0x0a, 0x13, 0x4e, 0x65, 0x78,
+	0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2a, 0xde, 0x02, 0x0a, 0x13, 0x4e, 0x65, 0x78,
 	0x75, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
 	0x12, 0x25, 0x0a, 0x21, 0x4e, 0x45, 0x58, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
 	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
@@ -166,10 +174,12 @@ var file_temporal_server_api_enums_v1_nexus_proto_rawDesc = []byte{
 	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10,
 	0x06, 0x12, 0x23, 0x0a, 0x1f, 0x4e, 0x45, 0x58, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41,
 	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x44,
-	0x5f, 0x4f, 0x55, 0x54, 0x10, 0x07, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d,
-	0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75,
-	0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x4f, 0x55, 0x54, 0x10, 0x07, 0x12, 0x21, 0x0a, 0x1d, 0x4e, 0x45, 0x58, 0x55, 0x53, 0x5f,
+	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x08, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e,
+	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b,
+	0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
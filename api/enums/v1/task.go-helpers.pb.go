@@ -111,3 +111,23 @@ func TaskPriorityFromString(s string) (TaskPriority, error) {
 	}
 	return TaskPriority(0), fmt.Errorf("%s is not a valid TaskPriority", s)
 }
+
+var (
+	CircuitBreakerAction_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"ForceOpen":   1,
+		"ForceClosed": 2,
+		"Reset":       3,
+	}
+)
+
+// CircuitBreakerActionFromString parses a CircuitBreakerAction value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to CircuitBreakerAction
+func CircuitBreakerActionFromString(s string) (CircuitBreakerAction, error) {
+	if v, ok := CircuitBreakerAction_value[s]; ok {
+		return CircuitBreakerAction(v), nil
+	} else if v, ok := CircuitBreakerAction_shorthandValue[s]; ok {
+		return CircuitBreakerAction(v), nil
+	}
+	return CircuitBreakerAction(0), fmt.Errorf("%s is not a valid CircuitBreakerAction", s)
+}
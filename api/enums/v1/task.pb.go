@@ -367,6 +367,74 @@ func (TaskPriority) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_task_proto_rawDescGZIP(), []int{2}
 }
 
+// Operator override of the circuit breaker of an outbound queue destination.
+type CircuitBreakerAction int32
+
+const (
+	CIRCUIT_BREAKER_ACTION_UNSPECIFIED CircuitBreakerAction = 0
+	// Reject all tasks for the destination until the circuit breaker is reset.
+	CIRCUIT_BREAKER_ACTION_FORCE_OPEN CircuitBreakerAction = 1
+	// Allow all tasks for the destination regardless of failures until the circuit breaker is reset.
+	CIRCUIT_BREAKER_ACTION_FORCE_CLOSED CircuitBreakerAction = 2
+	// Clear any override and reset the circuit breaker to closed, discarding its counts.
+	CIRCUIT_BREAKER_ACTION_RESET CircuitBreakerAction = 3
+)
+
+// Enum value maps for CircuitBreakerAction.
+var (
+	CircuitBreakerAction_name = map[int32]string{
+		0: "CIRCUIT_BREAKER_ACTION_UNSPECIFIED",
+		1: "CIRCUIT_BREAKER_ACTION_FORCE_OPEN",
+		2: "CIRCUIT_BREAKER_ACTION_FORCE_CLOSED",
+		3: "CIRCUIT_BREAKER_ACTION_RESET",
+	}
+	CircuitBreakerAction_value = map[string]int32{
+		"CIRCUIT_BREAKER_ACTION_UNSPECIFIED":  0,
+		"CIRCUIT_BREAKER_ACTION_FORCE_OPEN":   1,
+		"CIRCUIT_BREAKER_ACTION_FORCE_CLOSED": 2,
+		"CIRCUIT_BREAKER_ACTION_RESET":        3,
+	}
+)
+
+func (x CircuitBreakerAction) Enum() *CircuitBreakerAction {
+	p := new(CircuitBreakerAction)
+	*p = x
+	return p
+}
+
+func (x CircuitBreakerAction) String() string {
+	switch x {
+	case CIRCUIT_BREAKER_ACTION_UNSPECIFIED:
+		return "Unspecified"
+	case CIRCUIT_BREAKER_ACTION_FORCE_OPEN:
+		return "ForceOpen"
+	case CIRCUIT_BREAKER_ACTION_FORCE_CLOSED:
+		return "ForceClosed"
+	case CIRCUIT_BREAKER_ACTION_RESET:
+		return "Reset"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (CircuitBreakerAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_task_proto_enumTypes[3].Descriptor()
+}
+
+func (CircuitBreakerAction) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_task_proto_enumTypes[3]
+}
+
+func (x CircuitBreakerAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CircuitBreakerAction.Descriptor instead.
+func (CircuitBreakerAction) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_task_proto_rawDescGZIP(), []int{3}
+}
+
 var File_temporal_server_api_enums_v1_task_proto protoreflect.FileDescriptor
 
 var file_temporal_server_api_enums_v1_task_proto_rawDesc = []byte{
@@ -458,11 +526,22 @@ var file_temporal_server_api_enums_v1_task_proto_rawDesc = []byte{
 	0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
 	0x12, 0x16, 0x0a, 0x12, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54,
 	0x59, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x41, 0x53, 0x4b,
-	0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x0a, 0x42,
-	0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69,
-	0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75,
-	0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x0a, 0x2a,
+	0xb0, 0x01, 0x0a, 0x14, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x22, 0x43, 0x49, 0x52, 0x43,
+	0x55, 0x49, 0x54, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x45, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x25, 0x0a, 0x21, 0x43, 0x49, 0x52, 0x43, 0x55, 0x49, 0x54, 0x5f, 0x42, 0x52, 0x45, 0x41,
+	0x4b, 0x45, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45,
+	0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x43, 0x49, 0x52, 0x43, 0x55,
+	0x49, 0x54, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x45, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x20, 0x0a, 0x1c, 0x43, 0x49, 0x52, 0x43, 0x55, 0x49, 0x54, 0x5f, 0x42, 0x52, 0x45, 0x41,
+	0x4b, 0x45, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54,
+	0x10, 0x03, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -477,11 +556,12 @@ func file_temporal_server_api_enums_v1_task_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_enums_v1_task_proto_rawDescData
 }
 
-var file_temporal_server_api_enums_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_temporal_server_api_enums_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
 var file_temporal_server_api_enums_v1_task_proto_goTypes = []interface{}{
-	(TaskSource)(0),   // 0: temporal.server.api.enums.v1.TaskSource
-	(TaskType)(0),     // 1: temporal.server.api.enums.v1.TaskType
-	(TaskPriority)(0), // 2: temporal.server.api.enums.v1.TaskPriority
+	(TaskSource)(0),           // 0: temporal.server.api.enums.v1.TaskSource
+	(TaskType)(0),             // 1: temporal.server.api.enums.v1.TaskType
+	(TaskPriority)(0),         // 2: temporal.server.api.enums.v1.TaskPriority
+	(CircuitBreakerAction)(0), // 3: temporal.server.api.enums.v1.CircuitBreakerAction
 }
 var file_temporal_server_api_enums_v1_task_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -501,7 +581,7 @@ func file_temporal_server_api_enums_v1_task_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_temporal_server_api_enums_v1_task_proto_rawDesc,
-			NumEnums:      3,
+			NumEnums:      4,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
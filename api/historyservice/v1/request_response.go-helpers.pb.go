@@ -2471,6 +2471,376 @@ func (this *DescribeMutableStateResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type DescribeStateMachinesRequest to the protobuf v3 wire format
+func (val *DescribeStateMachinesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeStateMachinesRequest from the protobuf v3 wire format
+func (val *DescribeStateMachinesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeStateMachinesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeStateMachinesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeStateMachinesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeStateMachinesRequest
+	switch t := that.(type) {
+	case *DescribeStateMachinesRequest:
+		that1 = t
+	case DescribeStateMachinesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeStateMachinesResponse to the protobuf v3 wire format
+func (val *DescribeStateMachinesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeStateMachinesResponse from the protobuf v3 wire format
+func (val *DescribeStateMachinesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeStateMachinesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeStateMachinesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeStateMachinesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeStateMachinesResponse
+	switch t := that.(type) {
+	case *DescribeStateMachinesResponse:
+		that1 = t
+	case DescribeStateMachinesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteStateMachineNodeRequest to the protobuf v3 wire format
+func (val *DeleteStateMachineNodeRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteStateMachineNodeRequest from the protobuf v3 wire format
+func (val *DeleteStateMachineNodeRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteStateMachineNodeRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteStateMachineNodeRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteStateMachineNodeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteStateMachineNodeRequest
+	switch t := that.(type) {
+	case *DeleteStateMachineNodeRequest:
+		that1 = t
+	case DeleteStateMachineNodeRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteStateMachineNodeResponse to the protobuf v3 wire format
+func (val *DeleteStateMachineNodeResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteStateMachineNodeResponse from the protobuf v3 wire format
+func (val *DeleteStateMachineNodeResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteStateMachineNodeResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteStateMachineNodeResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteStateMachineNodeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteStateMachineNodeResponse
+	switch t := that.(type) {
+	case *DeleteStateMachineNodeResponse:
+		that1 = t
+	case DeleteStateMachineNodeResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ScheduleDelayedSignalRequest to the protobuf v3 wire format
+func (val *ScheduleDelayedSignalRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ScheduleDelayedSignalRequest from the protobuf v3 wire format
+func (val *ScheduleDelayedSignalRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ScheduleDelayedSignalRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ScheduleDelayedSignalRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ScheduleDelayedSignalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ScheduleDelayedSignalRequest
+	switch t := that.(type) {
+	case *ScheduleDelayedSignalRequest:
+		that1 = t
+	case ScheduleDelayedSignalRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ScheduleDelayedSignalResponse to the protobuf v3 wire format
+func (val *ScheduleDelayedSignalResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ScheduleDelayedSignalResponse from the protobuf v3 wire format
+func (val *ScheduleDelayedSignalResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ScheduleDelayedSignalResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ScheduleDelayedSignalResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ScheduleDelayedSignalResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ScheduleDelayedSignalResponse
+	switch t := that.(type) {
+	case *ScheduleDelayedSignalResponse:
+		that1 = t
+	case ScheduleDelayedSignalResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CancelDelayedSignalRequest to the protobuf v3 wire format
+func (val *CancelDelayedSignalRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CancelDelayedSignalRequest from the protobuf v3 wire format
+func (val *CancelDelayedSignalRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CancelDelayedSignalRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CancelDelayedSignalRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CancelDelayedSignalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CancelDelayedSignalRequest
+	switch t := that.(type) {
+	case *CancelDelayedSignalRequest:
+		that1 = t
+	case CancelDelayedSignalRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CancelDelayedSignalResponse to the protobuf v3 wire format
+func (val *CancelDelayedSignalResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CancelDelayedSignalResponse from the protobuf v3 wire format
+func (val *CancelDelayedSignalResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CancelDelayedSignalResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CancelDelayedSignalResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CancelDelayedSignalResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CancelDelayedSignalResponse
+	switch t := that.(type) {
+	case *CancelDelayedSignalResponse:
+		that1 = t
+	case CancelDelayedSignalResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListDelayedSignalsRequest to the protobuf v3 wire format
+func (val *ListDelayedSignalsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListDelayedSignalsRequest from the protobuf v3 wire format
+func (val *ListDelayedSignalsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListDelayedSignalsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListDelayedSignalsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListDelayedSignalsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListDelayedSignalsRequest
+	switch t := that.(type) {
+	case *ListDelayedSignalsRequest:
+		that1 = t
+	case ListDelayedSignalsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListDelayedSignalsResponse to the protobuf v3 wire format
+func (val *ListDelayedSignalsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListDelayedSignalsResponse from the protobuf v3 wire format
+func (val *ListDelayedSignalsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListDelayedSignalsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListDelayedSignalsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListDelayedSignalsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListDelayedSignalsResponse
+	switch t := that.(type) {
+	case *ListDelayedSignalsResponse:
+		that1 = t
+	case ListDelayedSignalsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type DescribeHistoryHostRequest to the protobuf v3 wire format
 func (val *DescribeHistoryHostRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -4802,6 +5172,302 @@ func (this *ListTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type DescribeQueueRequest to the protobuf v3 wire format
+func (val *DescribeQueueRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeQueueRequest from the protobuf v3 wire format
+func (val *DescribeQueueRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeQueueRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeQueueRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeQueueRequest
+	switch t := that.(type) {
+	case *DescribeQueueRequest:
+		that1 = t
+	case DescribeQueueRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeQueueResponse to the protobuf v3 wire format
+func (val *DescribeQueueResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeQueueResponse from the protobuf v3 wire format
+func (val *DescribeQueueResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeQueueResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeQueueResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeQueueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeQueueResponse
+	switch t := that.(type) {
+	case *DescribeQueueResponse:
+		that1 = t
+	case DescribeQueueResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MitigateQueueRequest to the protobuf v3 wire format
+func (val *MitigateQueueRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MitigateQueueRequest from the protobuf v3 wire format
+func (val *MitigateQueueRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MitigateQueueRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MitigateQueueRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MitigateQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MitigateQueueRequest
+	switch t := that.(type) {
+	case *MitigateQueueRequest:
+		that1 = t
+	case MitigateQueueRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MitigateQueueResponse to the protobuf v3 wire format
+func (val *MitigateQueueResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MitigateQueueResponse from the protobuf v3 wire format
+func (val *MitigateQueueResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MitigateQueueResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MitigateQueueResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MitigateQueueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MitigateQueueResponse
+	switch t := that.(type) {
+	case *MitigateQueueResponse:
+		that1 = t
+	case MitigateQueueResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeOutboundQueueRequest to the protobuf v3 wire format
+func (val *DescribeOutboundQueueRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeOutboundQueueRequest from the protobuf v3 wire format
+func (val *DescribeOutboundQueueRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeOutboundQueueRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeOutboundQueueRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeOutboundQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeOutboundQueueRequest
+	switch t := that.(type) {
+	case *DescribeOutboundQueueRequest:
+		that1 = t
+	case DescribeOutboundQueueRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeOutboundQueueResponse to the protobuf v3 wire format
+func (val *DescribeOutboundQueueResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeOutboundQueueResponse from the protobuf v3 wire format
+func (val *DescribeOutboundQueueResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeOutboundQueueResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeOutboundQueueResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeOutboundQueueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeOutboundQueueResponse
+	switch t := that.(type) {
+	case *DescribeOutboundQueueResponse:
+		that1 = t
+	case DescribeOutboundQueueResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateOutboundQueueCircuitBreakerRequest to the protobuf v3 wire format
+func (val *UpdateOutboundQueueCircuitBreakerRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateOutboundQueueCircuitBreakerRequest from the protobuf v3 wire format
+func (val *UpdateOutboundQueueCircuitBreakerRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateOutboundQueueCircuitBreakerRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateOutboundQueueCircuitBreakerRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateOutboundQueueCircuitBreakerRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateOutboundQueueCircuitBreakerRequest
+	switch t := that.(type) {
+	case *UpdateOutboundQueueCircuitBreakerRequest:
+		that1 = t
+	case UpdateOutboundQueueCircuitBreakerRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateOutboundQueueCircuitBreakerResponse to the protobuf v3 wire format
+func (val *UpdateOutboundQueueCircuitBreakerResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateOutboundQueueCircuitBreakerResponse from the protobuf v3 wire format
+func (val *UpdateOutboundQueueCircuitBreakerResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateOutboundQueueCircuitBreakerResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateOutboundQueueCircuitBreakerResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateOutboundQueueCircuitBreakerResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateOutboundQueueCircuitBreakerResponse
+	switch t := that.(type) {
+	case *UpdateOutboundQueueCircuitBreakerResponse:
+		that1 = t
+	case UpdateOutboundQueueCircuitBreakerResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type CompleteNexusOperationRequest to the protobuf v3 wire format
 func (val *CompleteNexusOperationRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -74,6 +74,11 @@ const (
 	HistoryService_SyncShardStatus_FullMethodName                        = "/temporal.server.api.historyservice.v1.HistoryService/SyncShardStatus"
 	HistoryService_SyncActivity_FullMethodName                           = "/temporal.server.api.historyservice.v1.HistoryService/SyncActivity"
 	HistoryService_DescribeMutableState_FullMethodName                   = "/temporal.server.api.historyservice.v1.HistoryService/DescribeMutableState"
+	HistoryService_DescribeStateMachines_FullMethodName                  = "/temporal.server.api.historyservice.v1.HistoryService/DescribeStateMachines"
+	HistoryService_DeleteStateMachineNode_FullMethodName                 = "/temporal.server.api.historyservice.v1.HistoryService/DeleteStateMachineNode"
+	HistoryService_ScheduleDelayedSignal_FullMethodName                  = "/temporal.server.api.historyservice.v1.HistoryService/ScheduleDelayedSignal"
+	HistoryService_CancelDelayedSignal_FullMethodName                    = "/temporal.server.api.historyservice.v1.HistoryService/CancelDelayedSignal"
+	HistoryService_ListDelayedSignals_FullMethodName                     = "/temporal.server.api.historyservice.v1.HistoryService/ListDelayedSignals"
 	HistoryService_DescribeHistoryHost_FullMethodName                    = "/temporal.server.api.historyservice.v1.HistoryService/DescribeHistoryHost"
 	HistoryService_CloseShard_FullMethodName                             = "/temporal.server.api.historyservice.v1.HistoryService/CloseShard"
 	HistoryService_GetShard_FullMethodName                               = "/temporal.server.api.historyservice.v1.HistoryService/GetShard"
@@ -104,6 +109,10 @@ const (
 	HistoryService_ListQueues_FullMethodName                             = "/temporal.server.api.historyservice.v1.HistoryService/ListQueues"
 	HistoryService_AddTasks_FullMethodName                               = "/temporal.server.api.historyservice.v1.HistoryService/AddTasks"
 	HistoryService_ListTasks_FullMethodName                              = "/temporal.server.api.historyservice.v1.HistoryService/ListTasks"
+	HistoryService_DescribeQueue_FullMethodName                          = "/temporal.server.api.historyservice.v1.HistoryService/DescribeQueue"
+	HistoryService_MitigateQueue_FullMethodName                          = "/temporal.server.api.historyservice.v1.HistoryService/MitigateQueue"
+	HistoryService_DescribeOutboundQueue_FullMethodName                  = "/temporal.server.api.historyservice.v1.HistoryService/DescribeOutboundQueue"
+	HistoryService_UpdateOutboundQueueCircuitBreaker_FullMethodName      = "/temporal.server.api.historyservice.v1.HistoryService/UpdateOutboundQueueCircuitBreaker"
 	HistoryService_CompleteNexusOperation_FullMethodName                 = "/temporal.server.api.historyservice.v1.HistoryService/CompleteNexusOperation"
 	HistoryService_InvokeStateMachineMethod_FullMethodName               = "/temporal.server.api.historyservice.v1.HistoryService/InvokeStateMachineMethod"
 	HistoryService_DeepHealthCheck_FullMethodName                        = "/temporal.server.api.historyservice.v1.HistoryService/DeepHealthCheck"
@@ -248,6 +257,16 @@ type HistoryServiceClient interface {
 	SyncActivity(ctx context.Context, in *SyncActivityRequest, opts ...grpc.CallOption) (*SyncActivityResponse, error)
 	// DescribeMutableState returns information about the internal states of workflow mutable state.
 	DescribeMutableState(ctx context.Context, in *DescribeMutableStateRequest, opts ...grpc.CallOption) (*DescribeMutableStateResponse, error)
+	// DescribeStateMachines returns the hierarchical state machine tree of a workflow execution.
+	DescribeStateMachines(ctx context.Context, in *DescribeStateMachinesRequest, opts ...grpc.CallOption) (*DescribeStateMachinesResponse, error)
+	// DeleteStateMachineNode deletes a state machine and its descendants from a workflow execution.
+	DeleteStateMachineNode(ctx context.Context, in *DeleteStateMachineNodeRequest, opts ...grpc.CallOption) (*DeleteStateMachineNodeResponse, error)
+	// ScheduleDelayedSignal creates a durable timer on a workflow execution that signals a workflow when it fires.
+	ScheduleDelayedSignal(ctx context.Context, in *ScheduleDelayedSignalRequest, opts ...grpc.CallOption) (*ScheduleDelayedSignalResponse, error)
+	// CancelDelayedSignal cancels a delayed signal that has not been delivered yet.
+	CancelDelayedSignal(ctx context.Context, in *CancelDelayedSignalRequest, opts ...grpc.CallOption) (*CancelDelayedSignalResponse, error)
+	// ListDelayedSignals lists the delayed signals hosted by a workflow execution.
+	ListDelayedSignals(ctx context.Context, in *ListDelayedSignalsRequest, opts ...grpc.CallOption) (*ListDelayedSignalsResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host.
 	DescribeHistoryHost(ctx context.Context, in *DescribeHistoryHostRequest, opts ...grpc.CallOption) (*DescribeHistoryHostResponse, error)
 	// CloseShard close the shard.
@@ -316,6 +335,10 @@ type HistoryServiceClient interface {
 	// in the same shard. Calls to the persistence API will be batched by workflow run.
 	AddTasks(ctx context.Context, in *AddTasksRequest, opts ...grpc.CallOption) (*AddTasksResponse, error)
 	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	DescribeQueue(ctx context.Context, in *DescribeQueueRequest, opts ...grpc.CallOption) (*DescribeQueueResponse, error)
+	MitigateQueue(ctx context.Context, in *MitigateQueueRequest, opts ...grpc.CallOption) (*MitigateQueueResponse, error)
+	DescribeOutboundQueue(ctx context.Context, in *DescribeOutboundQueueRequest, opts ...grpc.CallOption) (*DescribeOutboundQueueResponse, error)
+	UpdateOutboundQueueCircuitBreaker(ctx context.Context, in *UpdateOutboundQueueCircuitBreakerRequest, opts ...grpc.CallOption) (*UpdateOutboundQueueCircuitBreakerResponse, error)
 	// Complete an async Nexus Operation using a completion token. The completion state could be successful, failed, or
 	// canceled.
 	CompleteNexusOperation(ctx context.Context, in *CompleteNexusOperationRequest, opts ...grpc.CallOption) (*CompleteNexusOperationResponse, error)
@@ -625,6 +648,51 @@ func (c *historyServiceClient) DescribeMutableState(ctx context.Context, in *Des
 	return out, nil
 }
 
+func (c *historyServiceClient) DescribeStateMachines(ctx context.Context, in *DescribeStateMachinesRequest, opts ...grpc.CallOption) (*DescribeStateMachinesResponse, error) {
+	out := new(DescribeStateMachinesResponse)
+	err := c.cc.Invoke(ctx, HistoryService_DescribeStateMachines_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) DeleteStateMachineNode(ctx context.Context, in *DeleteStateMachineNodeRequest, opts ...grpc.CallOption) (*DeleteStateMachineNodeResponse, error) {
+	out := new(DeleteStateMachineNodeResponse)
+	err := c.cc.Invoke(ctx, HistoryService_DeleteStateMachineNode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ScheduleDelayedSignal(ctx context.Context, in *ScheduleDelayedSignalRequest, opts ...grpc.CallOption) (*ScheduleDelayedSignalResponse, error) {
+	out := new(ScheduleDelayedSignalResponse)
+	err := c.cc.Invoke(ctx, HistoryService_ScheduleDelayedSignal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) CancelDelayedSignal(ctx context.Context, in *CancelDelayedSignalRequest, opts ...grpc.CallOption) (*CancelDelayedSignalResponse, error) {
+	out := new(CancelDelayedSignalResponse)
+	err := c.cc.Invoke(ctx, HistoryService_CancelDelayedSignal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ListDelayedSignals(ctx context.Context, in *ListDelayedSignalsRequest, opts ...grpc.CallOption) (*ListDelayedSignalsResponse, error) {
+	out := new(ListDelayedSignalsResponse)
+	err := c.cc.Invoke(ctx, HistoryService_ListDelayedSignals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) DescribeHistoryHost(ctx context.Context, in *DescribeHistoryHostRequest, opts ...grpc.CallOption) (*DescribeHistoryHostResponse, error) {
 	out := new(DescribeHistoryHostResponse)
 	err := c.cc.Invoke(ctx, HistoryService_DescribeHistoryHost_FullMethodName, in, out, opts...)
@@ -917,6 +985,42 @@ func (c *historyServiceClient) ListTasks(ctx context.Context, in *ListTasksReque
 	return out, nil
 }
 
+func (c *historyServiceClient) DescribeQueue(ctx context.Context, in *DescribeQueueRequest, opts ...grpc.CallOption) (*DescribeQueueResponse, error) {
+	out := new(DescribeQueueResponse)
+	err := c.cc.Invoke(ctx, HistoryService_DescribeQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) MitigateQueue(ctx context.Context, in *MitigateQueueRequest, opts ...grpc.CallOption) (*MitigateQueueResponse, error) {
+	out := new(MitigateQueueResponse)
+	err := c.cc.Invoke(ctx, HistoryService_MitigateQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) DescribeOutboundQueue(ctx context.Context, in *DescribeOutboundQueueRequest, opts ...grpc.CallOption) (*DescribeOutboundQueueResponse, error) {
+	out := new(DescribeOutboundQueueResponse)
+	err := c.cc.Invoke(ctx, HistoryService_DescribeOutboundQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UpdateOutboundQueueCircuitBreaker(ctx context.Context, in *UpdateOutboundQueueCircuitBreakerRequest, opts ...grpc.CallOption) (*UpdateOutboundQueueCircuitBreakerResponse, error) {
+	out := new(UpdateOutboundQueueCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, HistoryService_UpdateOutboundQueueCircuitBreaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) CompleteNexusOperation(ctx context.Context, in *CompleteNexusOperationRequest, opts ...grpc.CallOption) (*CompleteNexusOperationResponse, error) {
 	out := new(CompleteNexusOperationResponse)
 	err := c.cc.Invoke(ctx, HistoryService_CompleteNexusOperation_FullMethodName, in, out, opts...)
@@ -1099,6 +1203,16 @@ type HistoryServiceServer interface {
 	SyncActivity(context.Context, *SyncActivityRequest) (*SyncActivityResponse, error)
 	// DescribeMutableState returns information about the internal states of workflow mutable state.
 	DescribeMutableState(context.Context, *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error)
+	// DescribeStateMachines returns the hierarchical state machine tree of a workflow execution.
+	DescribeStateMachines(context.Context, *DescribeStateMachinesRequest) (*DescribeStateMachinesResponse, error)
+	// DeleteStateMachineNode deletes a state machine and its descendants from a workflow execution.
+	DeleteStateMachineNode(context.Context, *DeleteStateMachineNodeRequest) (*DeleteStateMachineNodeResponse, error)
+	// ScheduleDelayedSignal creates a durable timer on a workflow execution that signals a workflow when it fires.
+	ScheduleDelayedSignal(context.Context, *ScheduleDelayedSignalRequest) (*ScheduleDelayedSignalResponse, error)
+	// CancelDelayedSignal cancels a delayed signal that has not been delivered yet.
+	CancelDelayedSignal(context.Context, *CancelDelayedSignalRequest) (*CancelDelayedSignalResponse, error)
+	// ListDelayedSignals lists the delayed signals hosted by a workflow execution.
+	ListDelayedSignals(context.Context, *ListDelayedSignalsRequest) (*ListDelayedSignalsResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host.
 	DescribeHistoryHost(context.Context, *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error)
 	// CloseShard close the shard.
@@ -1167,6 +1281,10 @@ type HistoryServiceServer interface {
 	// in the same shard. Calls to the persistence API will be batched by workflow run.
 	AddTasks(context.Context, *AddTasksRequest) (*AddTasksResponse, error)
 	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	DescribeQueue(context.Context, *DescribeQueueRequest) (*DescribeQueueResponse, error)
+	MitigateQueue(context.Context, *MitigateQueueRequest) (*MitigateQueueResponse, error)
+	DescribeOutboundQueue(context.Context, *DescribeOutboundQueueRequest) (*DescribeOutboundQueueResponse, error)
+	UpdateOutboundQueueCircuitBreaker(context.Context, *UpdateOutboundQueueCircuitBreakerRequest) (*UpdateOutboundQueueCircuitBreakerResponse, error)
 	// Complete an async Nexus Operation using a completion token. The completion state could be successful, failed, or
 	// canceled.
 	CompleteNexusOperation(context.Context, *CompleteNexusOperationRequest) (*CompleteNexusOperationResponse, error)
@@ -1281,6 +1399,21 @@ func (UnimplementedHistoryServiceServer) SyncActivity(context.Context, *SyncActi
 func (UnimplementedHistoryServiceServer) DescribeMutableState(context.Context, *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeMutableState not implemented")
 }
+func (UnimplementedHistoryServiceServer) DescribeStateMachines(context.Context, *DescribeStateMachinesRequest) (*DescribeStateMachinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeStateMachines not implemented")
+}
+func (UnimplementedHistoryServiceServer) DeleteStateMachineNode(context.Context, *DeleteStateMachineNodeRequest) (*DeleteStateMachineNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStateMachineNode not implemented")
+}
+func (UnimplementedHistoryServiceServer) ScheduleDelayedSignal(context.Context, *ScheduleDelayedSignalRequest) (*ScheduleDelayedSignalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleDelayedSignal not implemented")
+}
+func (UnimplementedHistoryServiceServer) CancelDelayedSignal(context.Context, *CancelDelayedSignalRequest) (*CancelDelayedSignalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDelayedSignal not implemented")
+}
+func (UnimplementedHistoryServiceServer) ListDelayedSignals(context.Context, *ListDelayedSignalsRequest) (*ListDelayedSignalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDelayedSignals not implemented")
+}
 func (UnimplementedHistoryServiceServer) DescribeHistoryHost(context.Context, *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeHistoryHost not implemented")
 }
@@ -1371,6 +1504,18 @@ func (UnimplementedHistoryServiceServer) AddTasks(context.Context, *AddTasksRequ
 func (UnimplementedHistoryServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
 }
+func (UnimplementedHistoryServiceServer) DescribeQueue(context.Context, *DescribeQueueRequest) (*DescribeQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeQueue not implemented")
+}
+func (UnimplementedHistoryServiceServer) MitigateQueue(context.Context, *MitigateQueueRequest) (*MitigateQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MitigateQueue not implemented")
+}
+func (UnimplementedHistoryServiceServer) DescribeOutboundQueue(context.Context, *DescribeOutboundQueueRequest) (*DescribeOutboundQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeOutboundQueue not implemented")
+}
+func (UnimplementedHistoryServiceServer) UpdateOutboundQueueCircuitBreaker(context.Context, *UpdateOutboundQueueCircuitBreakerRequest) (*UpdateOutboundQueueCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOutboundQueueCircuitBreaker not implemented")
+}
 func (UnimplementedHistoryServiceServer) CompleteNexusOperation(context.Context, *CompleteNexusOperationRequest) (*CompleteNexusOperationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CompleteNexusOperation not implemented")
 }
@@ -1975,6 +2120,96 @@ func _HistoryService_DescribeMutableState_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_DescribeStateMachines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeStateMachinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribeStateMachines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_DescribeStateMachines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribeStateMachines(ctx, req.(*DescribeStateMachinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_DeleteStateMachineNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStateMachineNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DeleteStateMachineNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_DeleteStateMachineNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DeleteStateMachineNode(ctx, req.(*DeleteStateMachineNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ScheduleDelayedSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleDelayedSignalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ScheduleDelayedSignal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ScheduleDelayedSignal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ScheduleDelayedSignal(ctx, req.(*ScheduleDelayedSignalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_CancelDelayedSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDelayedSignalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).CancelDelayedSignal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_CancelDelayedSignal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).CancelDelayedSignal(ctx, req.(*CancelDelayedSignalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ListDelayedSignals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDelayedSignalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ListDelayedSignals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ListDelayedSignals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ListDelayedSignals(ctx, req.(*ListDelayedSignalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_DescribeHistoryHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeHistoryHostRequest)
 	if err := dec(in); err != nil {
@@ -2523,6 +2758,78 @@ func _HistoryService_ListTasks_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_DescribeQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribeQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_DescribeQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribeQueue(ctx, req.(*DescribeQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_MitigateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MitigateQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).MitigateQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_MitigateQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).MitigateQueue(ctx, req.(*MitigateQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_DescribeOutboundQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeOutboundQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribeOutboundQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_DescribeOutboundQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribeOutboundQueue(ctx, req.(*DescribeOutboundQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UpdateOutboundQueueCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOutboundQueueCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UpdateOutboundQueueCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_UpdateOutboundQueueCircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UpdateOutboundQueueCircuitBreaker(ctx, req.(*UpdateOutboundQueueCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_CompleteNexusOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CompleteNexusOperationRequest)
 	if err := dec(in); err != nil {
@@ -2748,6 +3055,26 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeMutableState",
 			Handler:    _HistoryService_DescribeMutableState_Handler,
 		},
+		{
+			MethodName: "DescribeStateMachines",
+			Handler:    _HistoryService_DescribeStateMachines_Handler,
+		},
+		{
+			MethodName: "DeleteStateMachineNode",
+			Handler:    _HistoryService_DeleteStateMachineNode_Handler,
+		},
+		{
+			MethodName: "ScheduleDelayedSignal",
+			Handler:    _HistoryService_ScheduleDelayedSignal_Handler,
+		},
+		{
+			MethodName: "CancelDelayedSignal",
+			Handler:    _HistoryService_CancelDelayedSignal_Handler,
+		},
+		{
+			MethodName: "ListDelayedSignals",
+			Handler:    _HistoryService_ListDelayedSignals_Handler,
+		},
 		{
 			MethodName: "DescribeHistoryHost",
 			Handler:    _HistoryService_DescribeHistoryHost_Handler,
@@ -2864,6 +3191,22 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTasks",
 			Handler:    _HistoryService_ListTasks_Handler,
 		},
+		{
+			MethodName: "DescribeQueue",
+			Handler:    _HistoryService_DescribeQueue_Handler,
+		},
+		{
+			MethodName: "MitigateQueue",
+			Handler:    _HistoryService_MitigateQueue_Handler,
+		},
+		{
+			MethodName: "DescribeOutboundQueue",
+			Handler:    _HistoryService_DescribeOutboundQueue_Handler,
+		},
+		{
+			MethodName: "UpdateOutboundQueueCircuitBreaker",
+			Handler:    _HistoryService_UpdateOutboundQueueCircuitBreaker_Handler,
+		},
 		{
 			MethodName: "CompleteNexusOperation",
 			Handler:    _HistoryService_CompleteNexusOperation_Handler,
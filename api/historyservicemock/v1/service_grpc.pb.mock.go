@@ -86,6 +86,26 @@ func (mr *MockHistoryServiceClientMockRecorder) AddTasks(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTasks", reflect.TypeOf((*MockHistoryServiceClient)(nil).AddTasks), varargs...)
 }
 
+// CancelDelayedSignal mocks base method.
+func (m *MockHistoryServiceClient) CancelDelayedSignal(ctx context.Context, in *historyservice.CancelDelayedSignalRequest, opts ...grpc.CallOption) (*historyservice.CancelDelayedSignalResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelDelayedSignal", varargs...)
+	ret0, _ := ret[0].(*historyservice.CancelDelayedSignalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelDelayedSignal indicates an expected call of CancelDelayedSignal.
+func (mr *MockHistoryServiceClientMockRecorder) CancelDelayedSignal(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDelayedSignal", reflect.TypeOf((*MockHistoryServiceClient)(nil).CancelDelayedSignal), varargs...)
+}
+
 // CloseShard mocks base method.
 func (m *MockHistoryServiceClient) CloseShard(ctx context.Context, in *historyservice.CloseShardRequest, opts ...grpc.CallOption) (*historyservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -166,6 +186,26 @@ func (mr *MockHistoryServiceClientMockRecorder) DeleteDLQTasks(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDLQTasks", reflect.TypeOf((*MockHistoryServiceClient)(nil).DeleteDLQTasks), varargs...)
 }
 
+// DeleteStateMachineNode mocks base method.
+func (m *MockHistoryServiceClient) DeleteStateMachineNode(ctx context.Context, in *historyservice.DeleteStateMachineNodeRequest, opts ...grpc.CallOption) (*historyservice.DeleteStateMachineNodeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteStateMachineNode", varargs...)
+	ret0, _ := ret[0].(*historyservice.DeleteStateMachineNodeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStateMachineNode indicates an expected call of DeleteStateMachineNode.
+func (mr *MockHistoryServiceClientMockRecorder) DeleteStateMachineNode(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStateMachineNode", reflect.TypeOf((*MockHistoryServiceClient)(nil).DeleteStateMachineNode), varargs...)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockHistoryServiceClient) DeleteWorkflowExecution(ctx context.Context, in *historyservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -246,6 +286,66 @@ func (mr *MockHistoryServiceClientMockRecorder) DescribeMutableState(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeOutboundQueue mocks base method.
+func (m *MockHistoryServiceClient) DescribeOutboundQueue(ctx context.Context, in *historyservice.DescribeOutboundQueueRequest, opts ...grpc.CallOption) (*historyservice.DescribeOutboundQueueResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeOutboundQueue", varargs...)
+	ret0, _ := ret[0].(*historyservice.DescribeOutboundQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOutboundQueue indicates an expected call of DescribeOutboundQueue.
+func (mr *MockHistoryServiceClientMockRecorder) DescribeOutboundQueue(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOutboundQueue", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeOutboundQueue), varargs...)
+}
+
+// DescribeQueue mocks base method.
+func (m *MockHistoryServiceClient) DescribeQueue(ctx context.Context, in *historyservice.DescribeQueueRequest, opts ...grpc.CallOption) (*historyservice.DescribeQueueResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeQueue", varargs...)
+	ret0, _ := ret[0].(*historyservice.DescribeQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeQueue indicates an expected call of DescribeQueue.
+func (mr *MockHistoryServiceClientMockRecorder) DescribeQueue(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueue", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeQueue), varargs...)
+}
+
+// DescribeStateMachines mocks base method.
+func (m *MockHistoryServiceClient) DescribeStateMachines(ctx context.Context, in *historyservice.DescribeStateMachinesRequest, opts ...grpc.CallOption) (*historyservice.DescribeStateMachinesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeStateMachines", varargs...)
+	ret0, _ := ret[0].(*historyservice.DescribeStateMachinesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeStateMachines indicates an expected call of DescribeStateMachines.
+func (mr *MockHistoryServiceClientMockRecorder) DescribeStateMachines(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStateMachines", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeStateMachines), varargs...)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockHistoryServiceClient) DescribeWorkflowExecution(ctx context.Context, in *historyservice.DescribeWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -626,6 +726,26 @@ func (mr *MockHistoryServiceClientMockRecorder) IsWorkflowTaskValid(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowTaskValid", reflect.TypeOf((*MockHistoryServiceClient)(nil).IsWorkflowTaskValid), varargs...)
 }
 
+// ListDelayedSignals mocks base method.
+func (m *MockHistoryServiceClient) ListDelayedSignals(ctx context.Context, in *historyservice.ListDelayedSignalsRequest, opts ...grpc.CallOption) (*historyservice.ListDelayedSignalsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDelayedSignals", varargs...)
+	ret0, _ := ret[0].(*historyservice.ListDelayedSignalsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDelayedSignals indicates an expected call of ListDelayedSignals.
+func (mr *MockHistoryServiceClientMockRecorder) ListDelayedSignals(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDelayedSignals", reflect.TypeOf((*MockHistoryServiceClient)(nil).ListDelayedSignals), varargs...)
+}
+
 // ListQueues mocks base method.
 func (m *MockHistoryServiceClient) ListQueues(ctx context.Context, in *historyservice.ListQueuesRequest, opts ...grpc.CallOption) (*historyservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -686,6 +806,26 @@ func (mr *MockHistoryServiceClientMockRecorder) MergeDLQMessages(ctx, in any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockHistoryServiceClient)(nil).MergeDLQMessages), varargs...)
 }
 
+// MitigateQueue mocks base method.
+func (m *MockHistoryServiceClient) MitigateQueue(ctx context.Context, in *historyservice.MitigateQueueRequest, opts ...grpc.CallOption) (*historyservice.MitigateQueueResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MitigateQueue", varargs...)
+	ret0, _ := ret[0].(*historyservice.MitigateQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MitigateQueue indicates an expected call of MitigateQueue.
+func (mr *MockHistoryServiceClientMockRecorder) MitigateQueue(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MitigateQueue", reflect.TypeOf((*MockHistoryServiceClient)(nil).MitigateQueue), varargs...)
+}
+
 // PollMutableState mocks base method.
 func (m *MockHistoryServiceClient) PollMutableState(ctx context.Context, in *historyservice.PollMutableStateRequest, opts ...grpc.CallOption) (*historyservice.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -1146,6 +1286,26 @@ func (mr *MockHistoryServiceClientMockRecorder) RespondWorkflowTaskFailed(ctx, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondWorkflowTaskFailed", reflect.TypeOf((*MockHistoryServiceClient)(nil).RespondWorkflowTaskFailed), varargs...)
 }
 
+// ScheduleDelayedSignal mocks base method.
+func (m *MockHistoryServiceClient) ScheduleDelayedSignal(ctx context.Context, in *historyservice.ScheduleDelayedSignalRequest, opts ...grpc.CallOption) (*historyservice.ScheduleDelayedSignalResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ScheduleDelayedSignal", varargs...)
+	ret0, _ := ret[0].(*historyservice.ScheduleDelayedSignalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleDelayedSignal indicates an expected call of ScheduleDelayedSignal.
+func (mr *MockHistoryServiceClientMockRecorder) ScheduleDelayedSignal(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleDelayedSignal", reflect.TypeOf((*MockHistoryServiceClient)(nil).ScheduleDelayedSignal), varargs...)
+}
+
 // ScheduleWorkflowTask mocks base method.
 func (m *MockHistoryServiceClient) ScheduleWorkflowTask(ctx context.Context, in *historyservice.ScheduleWorkflowTaskRequest, opts ...grpc.CallOption) (*historyservice.ScheduleWorkflowTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -1346,6 +1506,26 @@ func (mr *MockHistoryServiceClientMockRecorder) UpdateActivityOptions(ctx, in an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpdateActivityOptions), varargs...)
 }
 
+// UpdateOutboundQueueCircuitBreaker mocks base method.
+func (m *MockHistoryServiceClient) UpdateOutboundQueueCircuitBreaker(ctx context.Context, in *historyservice.UpdateOutboundQueueCircuitBreakerRequest, opts ...grpc.CallOption) (*historyservice.UpdateOutboundQueueCircuitBreakerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateOutboundQueueCircuitBreaker", varargs...)
+	ret0, _ := ret[0].(*historyservice.UpdateOutboundQueueCircuitBreakerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOutboundQueueCircuitBreaker indicates an expected call of UpdateOutboundQueueCircuitBreaker.
+func (mr *MockHistoryServiceClientMockRecorder) UpdateOutboundQueueCircuitBreaker(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOutboundQueueCircuitBreaker", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpdateOutboundQueueCircuitBreaker), varargs...)
+}
+
 // UpdateWorkflowExecution mocks base method.
 func (m *MockHistoryServiceClient) UpdateWorkflowExecution(ctx context.Context, in *historyservice.UpdateWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.UpdateWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1581,6 +1761,21 @@ func (mr *MockHistoryServiceServerMockRecorder) AddTasks(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTasks", reflect.TypeOf((*MockHistoryServiceServer)(nil).AddTasks), arg0, arg1)
 }
 
+// CancelDelayedSignal mocks base method.
+func (m *MockHistoryServiceServer) CancelDelayedSignal(arg0 context.Context, arg1 *historyservice.CancelDelayedSignalRequest) (*historyservice.CancelDelayedSignalResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelDelayedSignal", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.CancelDelayedSignalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelDelayedSignal indicates an expected call of CancelDelayedSignal.
+func (mr *MockHistoryServiceServerMockRecorder) CancelDelayedSignal(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDelayedSignal", reflect.TypeOf((*MockHistoryServiceServer)(nil).CancelDelayedSignal), arg0, arg1)
+}
+
 // CloseShard mocks base method.
 func (m *MockHistoryServiceServer) CloseShard(arg0 context.Context, arg1 *historyservice.CloseShardRequest) (*historyservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -1641,6 +1836,21 @@ func (mr *MockHistoryServiceServerMockRecorder) DeleteDLQTasks(arg0, arg1 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDLQTasks", reflect.TypeOf((*MockHistoryServiceServer)(nil).DeleteDLQTasks), arg0, arg1)
 }
 
+// DeleteStateMachineNode mocks base method.
+func (m *MockHistoryServiceServer) DeleteStateMachineNode(arg0 context.Context, arg1 *historyservice.DeleteStateMachineNodeRequest) (*historyservice.DeleteStateMachineNodeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStateMachineNode", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DeleteStateMachineNodeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStateMachineNode indicates an expected call of DeleteStateMachineNode.
+func (mr *MockHistoryServiceServerMockRecorder) DeleteStateMachineNode(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStateMachineNode", reflect.TypeOf((*MockHistoryServiceServer)(nil).DeleteStateMachineNode), arg0, arg1)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockHistoryServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *historyservice.DeleteWorkflowExecutionRequest) (*historyservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1701,6 +1911,51 @@ func (mr *MockHistoryServiceServerMockRecorder) DescribeMutableState(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeOutboundQueue mocks base method.
+func (m *MockHistoryServiceServer) DescribeOutboundQueue(arg0 context.Context, arg1 *historyservice.DescribeOutboundQueueRequest) (*historyservice.DescribeOutboundQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOutboundQueue", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DescribeOutboundQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOutboundQueue indicates an expected call of DescribeOutboundQueue.
+func (mr *MockHistoryServiceServerMockRecorder) DescribeOutboundQueue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOutboundQueue", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeOutboundQueue), arg0, arg1)
+}
+
+// DescribeQueue mocks base method.
+func (m *MockHistoryServiceServer) DescribeQueue(arg0 context.Context, arg1 *historyservice.DescribeQueueRequest) (*historyservice.DescribeQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeQueue", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DescribeQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeQueue indicates an expected call of DescribeQueue.
+func (mr *MockHistoryServiceServerMockRecorder) DescribeQueue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueue", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeQueue), arg0, arg1)
+}
+
+// DescribeStateMachines mocks base method.
+func (m *MockHistoryServiceServer) DescribeStateMachines(arg0 context.Context, arg1 *historyservice.DescribeStateMachinesRequest) (*historyservice.DescribeStateMachinesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeStateMachines", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DescribeStateMachinesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeStateMachines indicates an expected call of DescribeStateMachines.
+func (mr *MockHistoryServiceServerMockRecorder) DescribeStateMachines(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStateMachines", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeStateMachines), arg0, arg1)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockHistoryServiceServer) DescribeWorkflowExecution(arg0 context.Context, arg1 *historyservice.DescribeWorkflowExecutionRequest) (*historyservice.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1986,6 +2241,21 @@ func (mr *MockHistoryServiceServerMockRecorder) IsWorkflowTaskValid(arg0, arg1 a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowTaskValid", reflect.TypeOf((*MockHistoryServiceServer)(nil).IsWorkflowTaskValid), arg0, arg1)
 }
 
+// ListDelayedSignals mocks base method.
+func (m *MockHistoryServiceServer) ListDelayedSignals(arg0 context.Context, arg1 *historyservice.ListDelayedSignalsRequest) (*historyservice.ListDelayedSignalsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDelayedSignals", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ListDelayedSignalsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDelayedSignals indicates an expected call of ListDelayedSignals.
+func (mr *MockHistoryServiceServerMockRecorder) ListDelayedSignals(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDelayedSignals", reflect.TypeOf((*MockHistoryServiceServer)(nil).ListDelayedSignals), arg0, arg1)
+}
+
 // ListQueues mocks base method.
 func (m *MockHistoryServiceServer) ListQueues(arg0 context.Context, arg1 *historyservice.ListQueuesRequest) (*historyservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -2031,6 +2301,21 @@ func (mr *MockHistoryServiceServerMockRecorder) MergeDLQMessages(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockHistoryServiceServer)(nil).MergeDLQMessages), arg0, arg1)
 }
 
+// MitigateQueue mocks base method.
+func (m *MockHistoryServiceServer) MitigateQueue(arg0 context.Context, arg1 *historyservice.MitigateQueueRequest) (*historyservice.MitigateQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MitigateQueue", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.MitigateQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MitigateQueue indicates an expected call of MitigateQueue.
+func (mr *MockHistoryServiceServerMockRecorder) MitigateQueue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MitigateQueue", reflect.TypeOf((*MockHistoryServiceServer)(nil).MitigateQueue), arg0, arg1)
+}
+
 // PollMutableState mocks base method.
 func (m *MockHistoryServiceServer) PollMutableState(arg0 context.Context, arg1 *historyservice.PollMutableStateRequest) (*historyservice.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -2376,6 +2661,21 @@ func (mr *MockHistoryServiceServerMockRecorder) RespondWorkflowTaskFailed(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondWorkflowTaskFailed", reflect.TypeOf((*MockHistoryServiceServer)(nil).RespondWorkflowTaskFailed), arg0, arg1)
 }
 
+// ScheduleDelayedSignal mocks base method.
+func (m *MockHistoryServiceServer) ScheduleDelayedSignal(arg0 context.Context, arg1 *historyservice.ScheduleDelayedSignalRequest) (*historyservice.ScheduleDelayedSignalResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleDelayedSignal", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ScheduleDelayedSignalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleDelayedSignal indicates an expected call of ScheduleDelayedSignal.
+func (mr *MockHistoryServiceServerMockRecorder) ScheduleDelayedSignal(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleDelayedSignal", reflect.TypeOf((*MockHistoryServiceServer)(nil).ScheduleDelayedSignal), arg0, arg1)
+}
+
 // ScheduleWorkflowTask mocks base method.
 func (m *MockHistoryServiceServer) ScheduleWorkflowTask(arg0 context.Context, arg1 *historyservice.ScheduleWorkflowTaskRequest) (*historyservice.ScheduleWorkflowTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -2525,6 +2825,21 @@ func (mr *MockHistoryServiceServerMockRecorder) UpdateActivityOptions(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpdateActivityOptions), arg0, arg1)
 }
 
+// UpdateOutboundQueueCircuitBreaker mocks base method.
+func (m *MockHistoryServiceServer) UpdateOutboundQueueCircuitBreaker(arg0 context.Context, arg1 *historyservice.UpdateOutboundQueueCircuitBreakerRequest) (*historyservice.UpdateOutboundQueueCircuitBreakerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOutboundQueueCircuitBreaker", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UpdateOutboundQueueCircuitBreakerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOutboundQueueCircuitBreaker indicates an expected call of UpdateOutboundQueueCircuitBreaker.
+func (mr *MockHistoryServiceServerMockRecorder) UpdateOutboundQueueCircuitBreaker(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOutboundQueueCircuitBreaker", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpdateOutboundQueueCircuitBreaker), arg0, arg1)
+}
+
 // UpdateWorkflowExecution mocks base method.
 func (m *MockHistoryServiceServer) UpdateWorkflowExecution(arg0 context.Context, arg1 *historyservice.UpdateWorkflowExecutionRequest) (*historyservice.UpdateWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
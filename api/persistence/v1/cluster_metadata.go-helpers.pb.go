@@ -66,6 +66,43 @@ func (this *ClusterMetadata) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type APIKey to the protobuf v3 wire format
+func (val *APIKey) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type APIKey from the protobuf v3 wire format
+func (val *APIKey) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *APIKey) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two APIKey values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *APIKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *APIKey
+	switch t := that.(type) {
+	case *APIKey:
+		that1 = t
+	case APIKey:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type IndexSearchAttributes to the protobuf v3 wire format
 func (val *IndexSearchAttributes) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
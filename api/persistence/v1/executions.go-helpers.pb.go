@@ -732,6 +732,43 @@ func (this *CallbackInfo) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type DelayedSignalInfo to the protobuf v3 wire format
+func (val *DelayedSignalInfo) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DelayedSignalInfo from the protobuf v3 wire format
+func (val *DelayedSignalInfo) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DelayedSignalInfo) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DelayedSignalInfo values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DelayedSignalInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DelayedSignalInfo
+	switch t := that.(type) {
+	case *DelayedSignalInfo:
+		that1 = t
+	case DelayedSignalInfo:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type NexusOperationInfo to the protobuf v3 wire format
 func (val *NexusOperationInfo) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
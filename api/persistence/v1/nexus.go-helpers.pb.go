@@ -66,6 +66,80 @@ func (this *NexusEndpointSpec) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type NexusEndpointAccessPolicy to the protobuf v3 wire format
+func (val *NexusEndpointAccessPolicy) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NexusEndpointAccessPolicy from the protobuf v3 wire format
+func (val *NexusEndpointAccessPolicy) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NexusEndpointAccessPolicy) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NexusEndpointAccessPolicy values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NexusEndpointAccessPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NexusEndpointAccessPolicy
+	switch t := that.(type) {
+	case *NexusEndpointAccessPolicy:
+		that1 = t
+	case NexusEndpointAccessPolicy:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type NexusEndpointLimits to the protobuf v3 wire format
+func (val *NexusEndpointLimits) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NexusEndpointLimits from the protobuf v3 wire format
+func (val *NexusEndpointLimits) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NexusEndpointLimits) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NexusEndpointLimits values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NexusEndpointLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NexusEndpointLimits
+	switch t := that.(type) {
+	case *NexusEndpointLimits:
+		that1 = t
+	case NexusEndpointLimits:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type NexusEndpointTarget to the protobuf v3 wire format
 func (val *NexusEndpointTarget) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -103,6 +177,43 @@ func (this *NexusEndpointTarget) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type NexusEndpointAuth to the protobuf v3 wire format
+func (val *NexusEndpointAuth) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NexusEndpointAuth from the protobuf v3 wire format
+func (val *NexusEndpointAuth) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NexusEndpointAuth) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NexusEndpointAuth values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NexusEndpointAuth) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NexusEndpointAuth
+	switch t := that.(type) {
+	case *NexusEndpointAuth:
+		that1 = t
+	case NexusEndpointAuth:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type NexusEndpoint to the protobuf v3 wire format
 func (val *NexusEndpoint) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -73,6 +73,16 @@ func (c *clientImpl) CancelDLQJob(
 	return c.client.CancelDLQJob(ctx, request, opts...)
 }
 
+func (c *clientImpl) CancelDelayedSignal(
+	ctx context.Context,
+	request *adminservice.CancelDelayedSignalRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CancelDelayedSignalResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CancelDelayedSignal(ctx, request, opts...)
+}
+
 func (c *clientImpl) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -83,6 +93,16 @@ func (c *clientImpl) CloseShard(
 	return c.client.CloseShard(ctx, request, opts...)
 }
 
+func (c *clientImpl) CreateAPIKey(
+	ctx context.Context,
+	request *adminservice.CreateAPIKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateAPIKeyResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CreateAPIKey(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeepHealthCheck(
 	ctx context.Context,
 	request *adminservice.DeepHealthCheckRequest,
@@ -93,6 +113,16 @@ func (c *clientImpl) DeepHealthCheck(
 	return c.client.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *clientImpl) DeleteStateMachineNode(
+	ctx context.Context,
+	request *adminservice.DeleteStateMachineNodeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteStateMachineNodeResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteStateMachineNode(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -133,6 +163,16 @@ func (c *clientImpl) DescribeHistoryHost(
 	return c.client.DescribeHistoryHost(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeHistoryQueue(
+	ctx context.Context,
+	request *adminservice.DescribeHistoryQueueRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeHistoryQueueResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeHistoryQueue(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeMutableState(
 	ctx context.Context,
 	request *adminservice.DescribeMutableStateRequest,
@@ -143,6 +183,26 @@ func (c *clientImpl) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeOutboundQueue(
+	ctx context.Context,
+	request *adminservice.DescribeOutboundQueueRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeOutboundQueueResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeOutboundQueue(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeStateMachines(
+	ctx context.Context,
+	request *adminservice.DescribeStateMachinesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeStateMachinesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeStateMachines(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -273,6 +333,26 @@ func (c *clientImpl) ImportWorkflowExecution(
 	return c.client.ImportWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *clientImpl) InvokeStateMachineMethod(
+	ctx context.Context,
+	request *adminservice.InvokeStateMachineMethodRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.InvokeStateMachineMethodResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.InvokeStateMachineMethod(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListAPIKeys(
+	ctx context.Context,
+	request *adminservice.ListAPIKeysRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListAPIKeysResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListAPIKeys(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -293,6 +373,16 @@ func (c *clientImpl) ListClusters(
 	return c.client.ListClusters(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListDelayedSignals(
+	ctx context.Context,
+	request *adminservice.ListDelayedSignalsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDelayedSignalsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListDelayedSignals(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -333,6 +423,16 @@ func (c *clientImpl) MergeDLQTasks(
 	return c.client.MergeDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) MitigateHistoryQueue(
+	ctx context.Context,
+	request *adminservice.MitigateHistoryQueueRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MitigateHistoryQueueResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.MitigateHistoryQueue(ctx, request, opts...)
+}
+
 func (c *clientImpl) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -423,6 +523,36 @@ func (c *clientImpl) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) RevokeAPIKey(
+	ctx context.Context,
+	request *adminservice.RevokeAPIKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RevokeAPIKeyResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RevokeAPIKey(ctx, request, opts...)
+}
+
+func (c *clientImpl) RotateAPIKey(
+	ctx context.Context,
+	request *adminservice.RotateAPIKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RotateAPIKeyResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RotateAPIKey(ctx, request, opts...)
+}
+
+func (c *clientImpl) ScheduleDelayedSignal(
+	ctx context.Context,
+	request *adminservice.ScheduleDelayedSignalRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ScheduleDelayedSignalResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ScheduleDelayedSignal(ctx, request, opts...)
+}
+
 func (c *clientImpl) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -432,3 +562,43 @@ func (c *clientImpl) SyncWorkflowState(
 	defer cancel()
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *clientImpl) UpdateNexusEndpointAccessPolicy(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointAccessPolicyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNexusEndpointAccessPolicyResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateNexusEndpointAccessPolicy(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateNexusEndpointAuth(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointAuthRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNexusEndpointAuthResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateNexusEndpointAuth(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateNexusEndpointLimits(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointLimitsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNexusEndpointLimitsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateNexusEndpointLimits(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateOutboundQueueCircuitBreaker(
+	ctx context.Context,
+	request *adminservice.UpdateOutboundQueueCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateOutboundQueueCircuitBreakerResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateOutboundQueueCircuitBreaker(ctx, request, opts...)
+}
@@ -89,6 +89,20 @@ func (c *metricClient) CancelDLQJob(
 	return c.client.CancelDLQJob(ctx, request, opts...)
 }
 
+func (c *metricClient) CancelDelayedSignal(
+	ctx context.Context,
+	request *adminservice.CancelDelayedSignalRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CancelDelayedSignalResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientCancelDelayedSignal")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CancelDelayedSignal(ctx, request, opts...)
+}
+
 func (c *metricClient) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -103,6 +117,20 @@ func (c *metricClient) CloseShard(
 	return c.client.CloseShard(ctx, request, opts...)
 }
 
+func (c *metricClient) CreateAPIKey(
+	ctx context.Context,
+	request *adminservice.CreateAPIKeyRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CreateAPIKeyResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientCreateAPIKey")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CreateAPIKey(ctx, request, opts...)
+}
+
 func (c *metricClient) DeepHealthCheck(
 	ctx context.Context,
 	request *adminservice.DeepHealthCheckRequest,
@@ -117,6 +145,20 @@ func (c *metricClient) DeepHealthCheck(
 	return c.client.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *metricClient) DeleteStateMachineNode(
+	ctx context.Context,
+	request *adminservice.DeleteStateMachineNodeRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DeleteStateMachineNodeResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDeleteStateMachineNode")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteStateMachineNode(ctx, request, opts...)
+}
+
 func (c *metricClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -173,6 +215,20 @@ func (c *metricClient) DescribeHistoryHost(
 	return c.client.DescribeHistoryHost(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeHistoryQueue(
+	ctx context.Context,
+	request *adminservice.DescribeHistoryQueueRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeHistoryQueueResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeHistoryQueue")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeHistoryQueue(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeMutableState(
 	ctx context.Context,
 	request *adminservice.DescribeMutableStateRequest,
@@ -187,6 +243,34 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeOutboundQueue(
+	ctx context.Context,
+	request *adminservice.DescribeOutboundQueueRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeOutboundQueueResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeOutboundQueue")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeOutboundQueue(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeStateMachines(
+	ctx context.Context,
+	request *adminservice.DescribeStateMachinesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeStateMachinesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeStateMachines")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeStateMachines(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -369,6 +453,34 @@ func (c *metricClient) ImportWorkflowExecution(
 	return c.client.ImportWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) InvokeStateMachineMethod(
+	ctx context.Context,
+	request *adminservice.InvokeStateMachineMethodRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.InvokeStateMachineMethodResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientInvokeStateMachineMethod")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.InvokeStateMachineMethod(ctx, request, opts...)
+}
+
+func (c *metricClient) ListAPIKeys(
+	ctx context.Context,
+	request *adminservice.ListAPIKeysRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListAPIKeysResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListAPIKeys")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListAPIKeys(ctx, request, opts...)
+}
+
 func (c *metricClient) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -397,6 +509,20 @@ func (c *metricClient) ListClusters(
 	return c.client.ListClusters(ctx, request, opts...)
 }
 
+func (c *metricClient) ListDelayedSignals(
+	ctx context.Context,
+	request *adminservice.ListDelayedSignalsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListDelayedSignalsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListDelayedSignals")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListDelayedSignals(ctx, request, opts...)
+}
+
 func (c *metricClient) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -453,6 +579,20 @@ func (c *metricClient) MergeDLQTasks(
 	return c.client.MergeDLQTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) MitigateHistoryQueue(
+	ctx context.Context,
+	request *adminservice.MitigateHistoryQueueRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.MitigateHistoryQueueResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientMitigateHistoryQueue")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.MitigateHistoryQueue(ctx, request, opts...)
+}
+
 func (c *metricClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -579,6 +719,48 @@ func (c *metricClient) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) RevokeAPIKey(
+	ctx context.Context,
+	request *adminservice.RevokeAPIKeyRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RevokeAPIKeyResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientRevokeAPIKey")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RevokeAPIKey(ctx, request, opts...)
+}
+
+func (c *metricClient) RotateAPIKey(
+	ctx context.Context,
+	request *adminservice.RotateAPIKeyRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RotateAPIKeyResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientRotateAPIKey")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RotateAPIKey(ctx, request, opts...)
+}
+
+func (c *metricClient) ScheduleDelayedSignal(
+	ctx context.Context,
+	request *adminservice.ScheduleDelayedSignalRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ScheduleDelayedSignalResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientScheduleDelayedSignal")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ScheduleDelayedSignal(ctx, request, opts...)
+}
+
 func (c *metricClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -592,3 +774,59 @@ func (c *metricClient) SyncWorkflowState(
 
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *metricClient) UpdateNexusEndpointAccessPolicy(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointAccessPolicyRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateNexusEndpointAccessPolicyResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateNexusEndpointAccessPolicy")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateNexusEndpointAccessPolicy(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateNexusEndpointAuth(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointAuthRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateNexusEndpointAuthResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateNexusEndpointAuth")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateNexusEndpointAuth(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateNexusEndpointLimits(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointLimitsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateNexusEndpointLimitsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateNexusEndpointLimits")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateNexusEndpointLimits(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateOutboundQueueCircuitBreaker(
+	ctx context.Context,
+	request *adminservice.UpdateOutboundQueueCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateOutboundQueueCircuitBreakerResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateOutboundQueueCircuitBreaker")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateOutboundQueueCircuitBreaker(ctx, request, opts...)
+}
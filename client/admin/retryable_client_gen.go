@@ -95,6 +95,21 @@ func (c *retryableClient) CancelDLQJob(
 	return resp, err
 }
 
+func (c *retryableClient) CancelDelayedSignal(
+	ctx context.Context,
+	request *adminservice.CancelDelayedSignalRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CancelDelayedSignalResponse, error) {
+	var resp *adminservice.CancelDelayedSignalResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CancelDelayedSignal(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -110,6 +125,21 @@ func (c *retryableClient) CloseShard(
 	return resp, err
 }
 
+func (c *retryableClient) CreateAPIKey(
+	ctx context.Context,
+	request *adminservice.CreateAPIKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateAPIKeyResponse, error) {
+	var resp *adminservice.CreateAPIKeyResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CreateAPIKey(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeepHealthCheck(
 	ctx context.Context,
 	request *adminservice.DeepHealthCheckRequest,
@@ -125,6 +155,21 @@ func (c *retryableClient) DeepHealthCheck(
 	return resp, err
 }
 
+func (c *retryableClient) DeleteStateMachineNode(
+	ctx context.Context,
+	request *adminservice.DeleteStateMachineNodeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteStateMachineNodeResponse, error) {
+	var resp *adminservice.DeleteStateMachineNodeResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteStateMachineNode(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -185,6 +230,21 @@ func (c *retryableClient) DescribeHistoryHost(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeHistoryQueue(
+	ctx context.Context,
+	request *adminservice.DescribeHistoryQueueRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeHistoryQueueResponse, error) {
+	var resp *adminservice.DescribeHistoryQueueResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeHistoryQueue(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeMutableState(
 	ctx context.Context,
 	request *adminservice.DescribeMutableStateRequest,
@@ -200,6 +260,36 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeOutboundQueue(
+	ctx context.Context,
+	request *adminservice.DescribeOutboundQueueRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeOutboundQueueResponse, error) {
+	var resp *adminservice.DescribeOutboundQueueResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeOutboundQueue(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeStateMachines(
+	ctx context.Context,
+	request *adminservice.DescribeStateMachinesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeStateMachinesResponse, error) {
+	var resp *adminservice.DescribeStateMachinesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeStateMachines(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -395,6 +485,36 @@ func (c *retryableClient) ImportWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) InvokeStateMachineMethod(
+	ctx context.Context,
+	request *adminservice.InvokeStateMachineMethodRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.InvokeStateMachineMethodResponse, error) {
+	var resp *adminservice.InvokeStateMachineMethodResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.InvokeStateMachineMethod(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListAPIKeys(
+	ctx context.Context,
+	request *adminservice.ListAPIKeysRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListAPIKeysResponse, error) {
+	var resp *adminservice.ListAPIKeysResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListAPIKeys(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -425,6 +545,21 @@ func (c *retryableClient) ListClusters(
 	return resp, err
 }
 
+func (c *retryableClient) ListDelayedSignals(
+	ctx context.Context,
+	request *adminservice.ListDelayedSignalsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDelayedSignalsResponse, error) {
+	var resp *adminservice.ListDelayedSignalsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListDelayedSignals(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -485,6 +620,21 @@ func (c *retryableClient) MergeDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) MitigateHistoryQueue(
+	ctx context.Context,
+	request *adminservice.MitigateHistoryQueueRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MitigateHistoryQueueResponse, error) {
+	var resp *adminservice.MitigateHistoryQueueResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MitigateHistoryQueue(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -620,6 +770,51 @@ func (c *retryableClient) ResendReplicationTasks(
 	return resp, err
 }
 
+func (c *retryableClient) RevokeAPIKey(
+	ctx context.Context,
+	request *adminservice.RevokeAPIKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RevokeAPIKeyResponse, error) {
+	var resp *adminservice.RevokeAPIKeyResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RevokeAPIKey(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RotateAPIKey(
+	ctx context.Context,
+	request *adminservice.RotateAPIKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RotateAPIKeyResponse, error) {
+	var resp *adminservice.RotateAPIKeyResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RotateAPIKey(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ScheduleDelayedSignal(
+	ctx context.Context,
+	request *adminservice.ScheduleDelayedSignalRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ScheduleDelayedSignalResponse, error) {
+	var resp *adminservice.ScheduleDelayedSignalResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ScheduleDelayedSignal(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -634,3 +829,63 @@ func (c *retryableClient) SyncWorkflowState(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) UpdateNexusEndpointAccessPolicy(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointAccessPolicyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNexusEndpointAccessPolicyResponse, error) {
+	var resp *adminservice.UpdateNexusEndpointAccessPolicyResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateNexusEndpointAccessPolicy(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateNexusEndpointAuth(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointAuthRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNexusEndpointAuthResponse, error) {
+	var resp *adminservice.UpdateNexusEndpointAuthResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateNexusEndpointAuth(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateNexusEndpointLimits(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointLimitsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNexusEndpointLimitsResponse, error) {
+	var resp *adminservice.UpdateNexusEndpointLimitsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateNexusEndpointLimits(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateOutboundQueueCircuitBreaker(
+	ctx context.Context,
+	request *adminservice.UpdateOutboundQueueCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateOutboundQueueCircuitBreakerResponse, error) {
+	var resp *adminservice.UpdateOutboundQueueCircuitBreakerResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateOutboundQueueCircuitBreaker(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -331,6 +331,90 @@ func (c *clientImpl) ListTasks(
 	return response, nil
 }
 
+func (c *clientImpl) DescribeQueue(
+	ctx context.Context,
+	in *historyservice.DescribeQueueRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeQueueResponse, error) {
+	// Same as ListTasks, the shardId field is nested in the admin request.
+	shardID := in.Request.GetShardId()
+	var response *historyservice.DescribeQueueResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DescribeQueue(ctx, in, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) MitigateQueue(
+	ctx context.Context,
+	in *historyservice.MitigateQueueRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.MitigateQueueResponse, error) {
+	// Same as ListTasks, the shardId field is nested in the admin request.
+	shardID := in.Request.GetShardId()
+	var response *historyservice.MitigateQueueResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.MitigateQueue(ctx, in, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) DescribeOutboundQueue(
+	ctx context.Context,
+	in *historyservice.DescribeOutboundQueueRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeOutboundQueueResponse, error) {
+	// Same as ListTasks, the shardId field is nested in the admin request.
+	shardID := in.Request.GetShardId()
+	var response *historyservice.DescribeOutboundQueueResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DescribeOutboundQueue(ctx, in, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) UpdateOutboundQueueCircuitBreaker(
+	ctx context.Context,
+	in *historyservice.UpdateOutboundQueueCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateOutboundQueueCircuitBreakerResponse, error) {
+	// Same as ListTasks, the shardId field is nested in the admin request.
+	shardID := in.Request.GetShardId()
+	var response *historyservice.UpdateOutboundQueueCircuitBreakerResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.UpdateOutboundQueueCircuitBreaker(ctx, in, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 // getAnyClient returns an arbitrary client by looking up a client by a sequentially increasing shard ID. This is useful
 // for history APIs that are shard-agnostic (e.g. namespace or DLQ v2 APIs).
 func (c *clientImpl) getAnyClient(apiName string) (historyservice.HistoryServiceClient, error) {
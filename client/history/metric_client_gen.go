@@ -47,6 +47,20 @@ func (c *metricClient) AddTasks(
 	return c.client.AddTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) CancelDelayedSignal(
+	ctx context.Context,
+	request *historyservice.CancelDelayedSignalRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.CancelDelayedSignalResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientCancelDelayedSignal")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CancelDelayedSignal(ctx, request, opts...)
+}
+
 func (c *metricClient) CloseShard(
 	ctx context.Context,
 	request *historyservice.CloseShardRequest,
@@ -103,6 +117,20 @@ func (c *metricClient) DeleteDLQTasks(
 	return c.client.DeleteDLQTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) DeleteStateMachineNode(
+	ctx context.Context,
+	request *historyservice.DeleteStateMachineNodeRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.DeleteStateMachineNodeResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientDeleteStateMachineNode")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteStateMachineNode(ctx, request, opts...)
+}
+
 func (c *metricClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.DeleteWorkflowExecutionRequest,
@@ -159,6 +187,48 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeOutboundQueue(
+	ctx context.Context,
+	request *historyservice.DescribeOutboundQueueRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.DescribeOutboundQueueResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientDescribeOutboundQueue")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeOutboundQueue(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeQueue(
+	ctx context.Context,
+	request *historyservice.DescribeQueueRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.DescribeQueueResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientDescribeQueue")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeQueue(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeStateMachines(
+	ctx context.Context,
+	request *historyservice.DescribeStateMachinesRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.DescribeStateMachinesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientDescribeStateMachines")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeStateMachines(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.DescribeWorkflowExecutionRequest,
@@ -425,6 +495,20 @@ func (c *metricClient) IsWorkflowTaskValid(
 	return c.client.IsWorkflowTaskValid(ctx, request, opts...)
 }
 
+func (c *metricClient) ListDelayedSignals(
+	ctx context.Context,
+	request *historyservice.ListDelayedSignalsRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.ListDelayedSignalsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientListDelayedSignals")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListDelayedSignals(ctx, request, opts...)
+}
+
 func (c *metricClient) ListQueues(
 	ctx context.Context,
 	request *historyservice.ListQueuesRequest,
@@ -467,6 +551,20 @@ func (c *metricClient) MergeDLQMessages(
 	return c.client.MergeDLQMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) MitigateQueue(
+	ctx context.Context,
+	request *historyservice.MitigateQueueRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.MitigateQueueResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientMitigateQueue")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.MitigateQueue(ctx, request, opts...)
+}
+
 func (c *metricClient) PollMutableState(
 	ctx context.Context,
 	request *historyservice.PollMutableStateRequest,
@@ -789,6 +887,20 @@ func (c *metricClient) RespondWorkflowTaskFailed(
 	return c.client.RespondWorkflowTaskFailed(ctx, request, opts...)
 }
 
+func (c *metricClient) ScheduleDelayedSignal(
+	ctx context.Context,
+	request *historyservice.ScheduleDelayedSignalRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.ScheduleDelayedSignalResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientScheduleDelayedSignal")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ScheduleDelayedSignal(ctx, request, opts...)
+}
+
 func (c *metricClient) ScheduleWorkflowTask(
 	ctx context.Context,
 	request *historyservice.ScheduleWorkflowTaskRequest,
@@ -915,6 +1027,20 @@ func (c *metricClient) UpdateActivityOptions(
 	return c.client.UpdateActivityOptions(ctx, request, opts...)
 }
 
+func (c *metricClient) UpdateOutboundQueueCircuitBreaker(
+	ctx context.Context,
+	request *historyservice.UpdateOutboundQueueCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.UpdateOutboundQueueCircuitBreakerResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientUpdateOutboundQueueCircuitBreaker")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateOutboundQueueCircuitBreaker(ctx, request, opts...)
+}
+
 func (c *metricClient) UpdateWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.UpdateWorkflowExecutionRequest,
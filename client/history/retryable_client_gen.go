@@ -50,6 +50,21 @@ func (c *retryableClient) AddTasks(
 	return resp, err
 }
 
+func (c *retryableClient) CancelDelayedSignal(
+	ctx context.Context,
+	request *historyservice.CancelDelayedSignalRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.CancelDelayedSignalResponse, error) {
+	var resp *historyservice.CancelDelayedSignalResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CancelDelayedSignal(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CloseShard(
 	ctx context.Context,
 	request *historyservice.CloseShardRequest,
@@ -110,6 +125,21 @@ func (c *retryableClient) DeleteDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) DeleteStateMachineNode(
+	ctx context.Context,
+	request *historyservice.DeleteStateMachineNodeRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DeleteStateMachineNodeResponse, error) {
+	var resp *historyservice.DeleteStateMachineNodeResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteStateMachineNode(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.DeleteWorkflowExecutionRequest,
@@ -170,6 +200,51 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeOutboundQueue(
+	ctx context.Context,
+	request *historyservice.DescribeOutboundQueueRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeOutboundQueueResponse, error) {
+	var resp *historyservice.DescribeOutboundQueueResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeOutboundQueue(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeQueue(
+	ctx context.Context,
+	request *historyservice.DescribeQueueRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeQueueResponse, error) {
+	var resp *historyservice.DescribeQueueResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeQueue(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeStateMachines(
+	ctx context.Context,
+	request *historyservice.DescribeStateMachinesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeStateMachinesResponse, error) {
+	var resp *historyservice.DescribeStateMachinesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeStateMachines(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.DescribeWorkflowExecutionRequest,
@@ -455,6 +530,21 @@ func (c *retryableClient) IsWorkflowTaskValid(
 	return resp, err
 }
 
+func (c *retryableClient) ListDelayedSignals(
+	ctx context.Context,
+	request *historyservice.ListDelayedSignalsRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ListDelayedSignalsResponse, error) {
+	var resp *historyservice.ListDelayedSignalsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListDelayedSignals(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListQueues(
 	ctx context.Context,
 	request *historyservice.ListQueuesRequest,
@@ -500,6 +590,21 @@ func (c *retryableClient) MergeDLQMessages(
 	return resp, err
 }
 
+func (c *retryableClient) MitigateQueue(
+	ctx context.Context,
+	request *historyservice.MitigateQueueRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.MitigateQueueResponse, error) {
+	var resp *historyservice.MitigateQueueResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MitigateQueue(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PollMutableState(
 	ctx context.Context,
 	request *historyservice.PollMutableStateRequest,
@@ -845,6 +950,21 @@ func (c *retryableClient) RespondWorkflowTaskFailed(
 	return resp, err
 }
 
+func (c *retryableClient) ScheduleDelayedSignal(
+	ctx context.Context,
+	request *historyservice.ScheduleDelayedSignalRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ScheduleDelayedSignalResponse, error) {
+	var resp *historyservice.ScheduleDelayedSignalResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ScheduleDelayedSignal(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ScheduleWorkflowTask(
 	ctx context.Context,
 	request *historyservice.ScheduleWorkflowTaskRequest,
@@ -980,6 +1100,21 @@ func (c *retryableClient) UpdateActivityOptions(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateOutboundQueueCircuitBreaker(
+	ctx context.Context,
+	request *historyservice.UpdateOutboundQueueCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateOutboundQueueCircuitBreakerResponse, error) {
+	var resp *historyservice.UpdateOutboundQueueCircuitBreakerResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateOutboundQueueCircuitBreaker(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.UpdateWorkflowExecutionRequest,
@@ -103,14 +103,18 @@ var (
 		"retryableClient.history.StreamWorkflowReplicationMessages": true,
 
 		// these are non-standard implementations. do not generate.
-		"client.history.DescribeHistoryHost":    true,
-		"client.history.GetReplicationMessages": true,
-		"client.history.GetReplicationStatus":   true,
-		"client.history.GetDLQTasks":            true,
-		"client.history.DeleteDLQTasks":         true,
-		"client.history.ListQueues":             true,
-		"client.history.ListTasks":              true,
-		"client.history.DeepHealthCheck":        true,
+		"client.history.DescribeHistoryHost":               true,
+		"client.history.GetReplicationMessages":            true,
+		"client.history.GetReplicationStatus":              true,
+		"client.history.GetDLQTasks":                       true,
+		"client.history.DeleteDLQTasks":                    true,
+		"client.history.ListQueues":                        true,
+		"client.history.ListTasks":                         true,
+		"client.history.DescribeQueue":                     true,
+		"client.history.MitigateQueue":                     true,
+		"client.history.DescribeOutboundQueue":             true,
+		"client.history.UpdateOutboundQueueCircuitBreaker": true,
+		"client.history.DeepHealthCheck":                   true,
 		// these need to pick a partition. too complicated.
 		"client.matching.AddActivityTask":       true,
 		"client.matching.AddWorkflowTask":       true,
@@ -139,6 +143,8 @@ var (
 		// these get routed to the parent
 		"RecordChildExecutionCompletedRequest.ChildExecution":          true,
 		"VerifyChildExecutionCompletionRecordedRequest.ChildExecution": true,
+		// routed to the execution that hosts the delayed signal, not the workflow being signaled
+		"ScheduleDelayedSignalRequest.Target": true,
 	}
 )
 
@@ -0,0 +1,93 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package apikey
+
+import (
+	"strings"
+
+	"go.temporal.io/server/common/authorization"
+)
+
+const authorizationBearer = "bearer"
+
+type claimMapper struct {
+	manager Manager
+	next    authorization.ClaimMapper
+}
+
+var _ authorization.ClaimMapper = (*claimMapper)(nil)
+var _ authorization.ClaimMapperWithAuthInfoRequired = (*claimMapper)(nil)
+
+// NewClaimMapper returns a claim mapper which maps "Bearer tmprl_..." tokens to the subject and permissions of
+// the API key and passes all other calls to next. If next is the noop claim mapper, calls without an API key
+// get no roles, unless the caller presents a verified client certificate: such internode callers, e.g. the
+// worker service, keep the system access granted by the noop claim mapper.
+func NewClaimMapper(manager Manager, next authorization.ClaimMapper) authorization.ClaimMapper {
+	return &claimMapper{
+		manager: manager,
+		next:    next,
+	}
+}
+
+func (c *claimMapper) GetClaims(authInfo *authorization.AuthInfo) (*authorization.Claims, error) {
+	if key, ok := bearerKey(authInfo); ok {
+		apiKey, err := c.manager.Authenticate(key)
+		if err != nil {
+			return nil, err
+		}
+		claims := &authorization.Claims{Subject: apiKey.GetSubject()}
+		for _, permission := range apiKey.GetPermissions() {
+			namespace, role, ok := authorization.ParsePermission(permission)
+			if !ok {
+				continue
+			}
+			authorization.AddRole(claims, namespace, role)
+		}
+		return claims, nil
+	}
+	if authorization.IsNoopClaimMapper(c.next) && authInfo.TLSSubject == nil {
+		return &authorization.Claims{}, nil
+	}
+	return c.next.GetClaims(authInfo)
+}
+
+func (c *claimMapper) AuthInfoRequired() bool {
+	if authorization.IsNoopClaimMapper(c.next) {
+		return false
+	}
+	if next, ok := c.next.(authorization.ClaimMapperWithAuthInfoRequired); ok {
+		return next.AuthInfoRequired()
+	}
+	return true
+}
+
+func bearerKey(authInfo *authorization.AuthInfo) (string, bool) {
+	if authInfo == nil {
+		return "", false
+	}
+	scheme, token, ok := strings.Cut(authInfo.AuthToken, " ")
+	if !ok || !strings.EqualFold(scheme, authorizationBearer) || !strings.HasPrefix(token, KeyPrefix) {
+		return "", false
+	}
+	return token, true
+}
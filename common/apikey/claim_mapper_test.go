@@ -0,0 +1,93 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package apikey
+
+import (
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.uber.org/mock/gomock"
+)
+
+type testClaimMapper struct {
+	authInfoRequired bool
+}
+
+func (*testClaimMapper) GetClaims(authInfo *authorization.AuthInfo) (*authorization.Claims, error) {
+	return &authorization.Claims{Subject: "next"}, nil
+}
+
+func (m *testClaimMapper) AuthInfoRequired() bool {
+	return m.authInfoRequired
+}
+
+func TestClaimMapper_APIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	manager := NewMockManager(ctrl)
+	claimMapper := NewClaimMapper(manager, &testClaimMapper{})
+
+	manager.EXPECT().Authenticate("tmprl_id_secret").Return(&persistencespb.APIKey{
+		Subject:     "ci",
+		Permissions: []string{"default:write", "temporal-system:admin", "other:read"},
+	}, nil)
+	claims, err := claimMapper.GetClaims(&authorization.AuthInfo{AuthToken: "Bearer tmprl_id_secret"})
+	require.NoError(t, err)
+	require.Equal(t, "ci", claims.Subject)
+	require.Equal(t, authorization.RoleAdmin, claims.System)
+	require.Equal(t, map[string]authorization.Role{
+		"default": authorization.RoleWriter,
+		"other":   authorization.RoleReader,
+	}, claims.Namespaces)
+
+	manager.EXPECT().Authenticate("tmprl_id_wrong").Return(nil, serviceerror.NewPermissionDenied("invalid API key", ""))
+	_, err = claimMapper.GetClaims(&authorization.AuthInfo{AuthToken: "bearer tmprl_id_wrong"})
+	var permissionDenied *serviceerror.PermissionDenied
+	require.ErrorAs(t, err, &permissionDenied)
+}
+
+func TestClaimMapper_Next(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	manager := NewMockManager(ctrl)
+
+	claimMapper := NewClaimMapper(manager, &testClaimMapper{authInfoRequired: true})
+	claims, err := claimMapper.GetClaims(&authorization.AuthInfo{AuthToken: "Bearer eyJhbGciOi"})
+	require.NoError(t, err)
+	require.Equal(t, "next", claims.Subject)
+	require.True(t, claimMapper.(authorization.ClaimMapperWithAuthInfoRequired).AuthInfoRequired())
+
+	// Calls without an API key get no roles instead of the admin role of the noop claim mapper.
+	claimMapper = NewClaimMapper(manager, authorization.NewNoopClaimMapper())
+	claims, err = claimMapper.GetClaims(&authorization.AuthInfo{})
+	require.NoError(t, err)
+	require.Equal(t, &authorization.Claims{}, claims)
+	require.False(t, claimMapper.(authorization.ClaimMapperWithAuthInfoRequired).AuthInfoRequired())
+
+	// Internode callers with a verified client certificate keep the admin role of the noop claim mapper.
+	claims, err = claimMapper.GetClaims(&authorization.AuthInfo{TLSSubject: &pkix.Name{CommonName: "worker"}})
+	require.NoError(t, err)
+	require.Equal(t, authorization.RoleAdmin, claims.System)
+}
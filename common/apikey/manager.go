@@ -0,0 +1,365 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination manager_mock.go
+
+package apikey
+
+import (
+	"cmp"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/persistence"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// KeyPrefix is the prefix of all API keys. Keys have the format "tmprl_<id>_<secret>".
+	KeyPrefix = "tmprl_"
+
+	idLength     = 8
+	secretLength = 32
+
+	cacheRefreshInterval              = 10 * time.Second
+	cacheRefreshIfUnavailableInterval = 5 * time.Second
+	// Unknown key IDs refresh the cache at most this often, so that new keys can be used right away without
+	// allowing invalid keys to hit persistence on every call.
+	cacheRefreshOnMissInterval = time.Second
+	cacheRefreshTimeout        = 5 * time.Second
+)
+
+type (
+	// Manager manages the API keys stored in the cluster metadata and authenticates calls with them.
+	Manager interface {
+		// Create creates an API key and returns the key, which is not stored, along with its metadata.
+		Create(ctx context.Context, subject string, permissions []string, description string, expireTime *timestamppb.Timestamp) (string, *persistencespb.APIKey, error)
+		// List returns the metadata of all keys.
+		List(ctx context.Context) ([]*persistencespb.APIKey, error)
+		// Rotate replaces the secret of the key with the given ID and returns the new key.
+		Rotate(ctx context.Context, id string) (string, *persistencespb.APIKey, error)
+		// Revoke deletes the key with the given ID.
+		Revoke(ctx context.Context, id string) error
+		// Authenticate returns the metadata of a valid key. Changes made by other hosts are picked up within
+		// the cache refresh interval.
+		Authenticate(key string) (*persistencespb.APIKey, error)
+	}
+
+	managerImpl struct {
+		clusterMetadataManager persistence.ClusterMetadataManager
+		timeSource             clock.TimeSource
+
+		cacheUpdateMutex sync.Mutex
+		cache            atomic.Pointer[cache]
+	}
+
+	cache struct {
+		keys      map[string]*persistencespb.APIKey
+		dbVersion int64
+		loadedAt  time.Time
+		expireOn  time.Time
+	}
+)
+
+var (
+	errInvalidKey = serviceerror.NewPermissionDenied("invalid API key", "")
+	errExpiredKey = serviceerror.NewPermissionDenied("expired API key", "")
+
+	_ Manager = (*managerImpl)(nil)
+)
+
+func NewManager(
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	timeSource clock.TimeSource,
+) *managerImpl {
+	m := &managerImpl{
+		clusterMetadataManager: clusterMetadataManager,
+		timeSource:             timeSource,
+	}
+	m.cache.Store(&cache{})
+	return m
+}
+
+func (m *managerImpl) Create(
+	ctx context.Context,
+	subject string,
+	permissions []string,
+	description string,
+	expireTime *timestamppb.Timestamp,
+) (string, *persistencespb.APIKey, error) {
+	if subject == "" {
+		return "", nil, serviceerror.NewInvalidArgument("Subject is not set.")
+	}
+	if err := validatePermissions(permissions); err != nil {
+		return "", nil, err
+	}
+	if expireTime != nil && !expireTime.AsTime().After(m.timeSource.Now()) {
+		return "", nil, serviceerror.NewInvalidArgument("Expire time is in the past.")
+	}
+
+	id, err := randomHex(idLength)
+	if err != nil {
+		return "", nil, err
+	}
+	key, secretHash, err := newKey(id)
+	if err != nil {
+		return "", nil, err
+	}
+	now := timestamppb.New(m.timeSource.Now())
+	apiKey := &persistencespb.APIKey{
+		Id:          id,
+		Subject:     subject,
+		Permissions: permissions,
+		Description: description,
+		SecretHash:  secretHash,
+		CreateTime:  now,
+		RotateTime:  now,
+		ExpireTime:  expireTime,
+	}
+	err = m.update(ctx, func(keys map[string]*persistencespb.APIKey) error {
+		keys[id] = apiKey
+		return nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return key, withoutSecret(apiKey), nil
+}
+
+func (m *managerImpl) List(ctx context.Context) ([]*persistencespb.APIKey, error) {
+	resp, err := m.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	apiKeys := make([]*persistencespb.APIKey, 0, len(resp.GetApiKeys()))
+	for _, apiKey := range resp.GetApiKeys() {
+		apiKeys = append(apiKeys, withoutSecret(apiKey))
+	}
+	slices.SortFunc(apiKeys, func(a, b *persistencespb.APIKey) int {
+		return cmp.Or(a.GetCreateTime().AsTime().Compare(b.GetCreateTime().AsTime()), strings.Compare(a.GetId(), b.GetId()))
+	})
+	return apiKeys, nil
+}
+
+func (m *managerImpl) Rotate(ctx context.Context, id string) (string, *persistencespb.APIKey, error) {
+	key, secretHash, err := newKey(id)
+	if err != nil {
+		return "", nil, err
+	}
+	var apiKey *persistencespb.APIKey
+	err = m.update(ctx, func(keys map[string]*persistencespb.APIKey) error {
+		var ok bool
+		if apiKey, ok = keys[id]; !ok {
+			return serviceerror.NewNotFound(fmt.Sprintf("API key %q not found.", id))
+		}
+		apiKey.SecretHash = secretHash
+		apiKey.RotateTime = timestamppb.New(m.timeSource.Now())
+		return nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return key, withoutSecret(apiKey), nil
+}
+
+func (m *managerImpl) Revoke(ctx context.Context, id string) error {
+	return m.update(ctx, func(keys map[string]*persistencespb.APIKey) error {
+		if _, ok := keys[id]; !ok {
+			return serviceerror.NewNotFound(fmt.Sprintf("API key %q not found.", id))
+		}
+		delete(keys, id)
+		return nil
+	})
+}
+
+func (m *managerImpl) Authenticate(key string) (*persistencespb.APIKey, error) {
+	id, secret, ok := parseKey(key)
+	if !ok {
+		return nil, errInvalidKey
+	}
+
+	now := m.timeSource.Now()
+	keyCache, err := m.getCache(now, false)
+	if err != nil {
+		return nil, err
+	}
+	apiKey, ok := keyCache.keys[id]
+	if !ok && keyCache.loadedAt.Add(cacheRefreshOnMissInterval).Before(now) {
+		if keyCache, err = m.getCache(now, true); err != nil {
+			return nil, err
+		}
+		apiKey, ok = keyCache.keys[id]
+	}
+	if !ok {
+		return nil, errInvalidKey
+	}
+
+	secretHash := sha256.Sum256([]byte(secret))
+	if subtle.ConstantTimeCompare(secretHash[:], apiKey.GetSecretHash()) != 1 {
+		return nil, errInvalidKey
+	}
+	if apiKey.ExpireTime != nil && !apiKey.ExpireTime.AsTime().After(now) {
+		return nil, errExpiredKey
+	}
+	return apiKey, nil
+}
+
+// update applies fn to the keys in the cluster metadata and saves them. Concurrent updates fail with a
+// version mismatch error and can be retried.
+func (m *managerImpl) update(ctx context.Context, fn func(keys map[string]*persistencespb.APIKey) error) error {
+	resp, err := m.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	clusterMetadata := resp.ClusterMetadata
+	if clusterMetadata.ApiKeys == nil {
+		clusterMetadata.ApiKeys = make(map[string]*persistencespb.APIKey)
+	}
+	if err := fn(clusterMetadata.ApiKeys); err != nil {
+		return err
+	}
+	applied, err := m.clusterMetadataManager.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: clusterMetadata,
+		Version:         resp.Version,
+	})
+	// Flush local cache, even if there was an error, which is most likely version mismatch (=stale cache).
+	m.cache.Store(&cache{})
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return serviceerror.NewUnavailable("Unable to save API keys to cluster metadata.")
+	}
+	return nil
+}
+
+func (m *managerImpl) getCache(now time.Time, forceRefresh bool) (*cache, error) {
+	keyCache := m.cache.Load()
+	if !forceRefresh && keyCache.expireOn.After(now) {
+		return keyCache, nil
+	}
+
+	m.cacheUpdateMutex.Lock()
+	defer m.cacheUpdateMutex.Unlock()
+
+	// Another caller may have refreshed the cache while this one was waiting.
+	if latest := m.cache.Load(); latest != keyCache && latest.expireOn.After(now) {
+		return latest, nil
+	}
+	return m.refreshCache(m.cache.Load(), now)
+}
+
+func (m *managerImpl) refreshCache(keyCache *cache, now time.Time) (*cache, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), cacheRefreshTimeout)
+	defer cancel()
+	ctx = headers.SetCallerInfo(ctx, headers.SystemBackgroundCallerInfo)
+
+	resp, err := m.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		refreshed := *keyCache
+		refreshed.loadedAt = now
+		switch err.(type) {
+		case *serviceerror.NotFound:
+			// NotFound means cluster metadata was never persisted and no keys are defined.
+			refreshed.expireOn = now.Add(cacheRefreshInterval)
+		case *serviceerror.Unavailable:
+			// If persistence is Unavailable, keep using the existing cache for a while.
+			refreshed.expireOn = now.Add(cacheRefreshIfUnavailableInterval)
+		default:
+			return keyCache, err
+		}
+		m.cache.Store(&refreshed)
+		return &refreshed, nil
+	}
+
+	refreshed := &cache{
+		keys:      keyCache.keys,
+		dbVersion: keyCache.dbVersion,
+		loadedAt:  now,
+		expireOn:  now.Add(cacheRefreshInterval),
+	}
+	// resp.Version <= dbVersion means DB is not changed.
+	if resp.Version > keyCache.dbVersion || keyCache.keys == nil {
+		refreshed.keys = resp.GetApiKeys()
+		refreshed.dbVersion = resp.Version
+	}
+	m.cache.Store(refreshed)
+	return refreshed, nil
+}
+
+func validatePermissions(permissions []string) error {
+	for _, permission := range permissions {
+		if namespace, role, ok := authorization.ParsePermission(permission); !ok || namespace == "" || role == authorization.RoleUndefined {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid permission %q, expected \"<namespace>:<read|write|worker|admin>\".", permission))
+		}
+	}
+	return nil
+}
+
+// newKey generates a key for id. Returns the key and the hash of its secret.
+func newKey(id string) (string, []byte, error) {
+	secret, err := randomHex(secretLength)
+	if err != nil {
+		return "", nil, err
+	}
+	secretHash := sha256.Sum256([]byte(secret))
+	return KeyPrefix + id + "_" + secret, secretHash[:], nil
+}
+
+func parseKey(key string) (id string, secret string, ok bool) {
+	rest, ok := strings.CutPrefix(key, KeyPrefix)
+	if !ok {
+		return "", "", false
+	}
+	id, secret, ok = strings.Cut(rest, "_")
+	return id, secret, ok && id != "" && secret != ""
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Join(errors.New("unable to generate random API key"), err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func withoutSecret(apiKey *persistencespb.APIKey) *persistencespb.APIKey {
+	apiKey = proto.Clone(apiKey).(*persistencespb.APIKey)
+	apiKey.SecretHash = nil
+	return apiKey
+}
@@ -0,0 +1,192 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package apikey
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/persistence"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeClusterMetadataManager keeps the current cluster metadata in memory and implements version checks.
+type fakeClusterMetadataManager struct {
+	persistence.ClusterMetadataManager
+
+	sync.Mutex
+	metadata *persistencespb.ClusterMetadata
+	version  int64
+	gets     int
+}
+
+func (f *fakeClusterMetadataManager) GetCurrentClusterMetadata(context.Context) (*persistence.GetClusterMetadataResponse, error) {
+	f.Lock()
+	defer f.Unlock()
+	f.gets++
+	if f.metadata == nil {
+		return nil, serviceerror.NewNotFound("cluster metadata not found")
+	}
+	return &persistence.GetClusterMetadataResponse{
+		ClusterMetadata: proto.Clone(f.metadata).(*persistencespb.ClusterMetadata),
+		Version:         f.version,
+	}, nil
+}
+
+func (f *fakeClusterMetadataManager) SaveClusterMetadata(_ context.Context, request *persistence.SaveClusterMetadataRequest) (bool, error) {
+	f.Lock()
+	defer f.Unlock()
+	if request.Version != f.version {
+		return false, serviceerror.NewUnavailable("version mismatch")
+	}
+	f.metadata = proto.Clone(request.ClusterMetadata).(*persistencespb.ClusterMetadata)
+	f.version++
+	return true, nil
+}
+
+func newTestManager() (*managerImpl, *fakeClusterMetadataManager, *clock.EventTimeSource) {
+	store := &fakeClusterMetadataManager{
+		metadata: &persistencespb.ClusterMetadata{ClusterName: "active"},
+		version:  1,
+	}
+	timeSource := clock.NewEventTimeSource().Update(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	return NewManager(store, timeSource), store, timeSource
+}
+
+func TestManager_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+	m, store, timeSource := newTestManager()
+
+	key, apiKey, err := m.Create(ctx, "ci", []string{"default:write", "system:read"}, "CI pipeline", nil)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(key, KeyPrefix+apiKey.GetId()+"_"))
+	require.Nil(t, apiKey.GetSecretHash())
+	require.NotContains(t, store.metadata.ApiKeys[apiKey.GetId()].GetSecretHash(), key)
+	require.Equal(t, "active", store.metadata.GetClusterName())
+
+	authenticated, err := m.Authenticate(key)
+	require.NoError(t, err)
+	require.Equal(t, "ci", authenticated.GetSubject())
+
+	_, err = m.Authenticate(key + "0")
+	require.Error(t, err)
+	_, err = m.Authenticate("not-a-key")
+	require.Error(t, err)
+
+	timeSource.Advance(time.Minute)
+	rotatedKey, rotated, err := m.Rotate(ctx, apiKey.GetId())
+	require.NoError(t, err)
+	require.NotEqual(t, key, rotatedKey)
+	require.True(t, rotated.GetRotateTime().AsTime().After(rotated.GetCreateTime().AsTime()))
+	_, err = m.Authenticate(key)
+	require.Error(t, err)
+	_, err = m.Authenticate(rotatedKey)
+	require.NoError(t, err)
+
+	apiKeys, err := m.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, apiKeys, 1)
+	require.Nil(t, apiKeys[0].GetSecretHash())
+
+	require.NoError(t, m.Revoke(ctx, apiKey.GetId()))
+	_, err = m.Authenticate(rotatedKey)
+	require.Error(t, err)
+
+	var notFound *serviceerror.NotFound
+	require.ErrorAs(t, m.Revoke(ctx, apiKey.GetId()), &notFound)
+	_, _, err = m.Rotate(ctx, apiKey.GetId())
+	require.ErrorAs(t, err, &notFound)
+}
+
+func TestManager_Create_Invalid(t *testing.T) {
+	ctx := context.Background()
+	m, _, timeSource := newTestManager()
+	var invalidArgument *serviceerror.InvalidArgument
+
+	_, _, err := m.Create(ctx, "", nil, "", nil)
+	require.ErrorAs(t, err, &invalidArgument)
+	_, _, err = m.Create(ctx, "ci", []string{"default"}, "", nil)
+	require.ErrorAs(t, err, &invalidArgument)
+	_, _, err = m.Create(ctx, "ci", []string{"default:owner"}, "", nil)
+	require.ErrorAs(t, err, &invalidArgument)
+	_, _, err = m.Create(ctx, "ci", nil, "", timestamppb.New(timeSource.Now()))
+	require.ErrorAs(t, err, &invalidArgument)
+}
+
+func TestManager_Authenticate_Expired(t *testing.T) {
+	m, _, timeSource := newTestManager()
+
+	key, _, err := m.Create(context.Background(), "ci", nil, "", timestamppb.New(timeSource.Now().Add(time.Hour)))
+	require.NoError(t, err)
+	_, err = m.Authenticate(key)
+	require.NoError(t, err)
+
+	timeSource.Advance(time.Hour)
+	_, err = m.Authenticate(key)
+	var permissionDenied *serviceerror.PermissionDenied
+	require.ErrorAs(t, err, &permissionDenied)
+}
+
+func TestManager_Authenticate_Cache(t *testing.T) {
+	m, store, timeSource := newTestManager()
+	other := NewManager(store, timeSource)
+
+	key, apiKey, err := m.Create(context.Background(), "ci", nil, "", nil)
+	require.NoError(t, err)
+	_, err = other.Authenticate(key)
+	require.NoError(t, err)
+
+	// Invalid keys for unknown IDs refresh the cache at most once per cacheRefreshOnMissInterval.
+	gets := store.gets
+	for i := 0; i < 3; i++ {
+		_, err = other.Authenticate(KeyPrefix + "unknown_secret")
+		require.Error(t, err)
+	}
+	require.Equal(t, gets, store.gets)
+
+	// Keys revoked on another host are accepted until the cache expires.
+	require.NoError(t, m.Revoke(context.Background(), apiKey.GetId()))
+	_, err = other.Authenticate(key)
+	require.NoError(t, err)
+	timeSource.Advance(cacheRefreshInterval + time.Second)
+	_, err = other.Authenticate(key)
+	require.Error(t, err)
+}
+
+func TestValidatePermissions(t *testing.T) {
+	require.NoError(t, validatePermissions([]string{"default:read", "temporal-system:worker", "prod:ADMIN"}))
+	for _, permission := range []string{"", "default", ":read", "default:", "default:owner", "a:b:read"} {
+		require.Error(t, validatePermissions([]string{permission}), permission)
+	}
+	_, role, _ := authorization.ParsePermission("default:admin")
+	require.Equal(t, authorization.RoleAdmin, role)
+}
@@ -0,0 +1,212 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package audit writes structured records of authorization decisions and mutating API calls received by the
+// frontend service to pluggable sinks.
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	DecisionAllow = "allow"
+	DecisionDeny  = "deny"
+	// DecisionError means that the claims couldn't be mapped or the authorizer failed.
+	DecisionError = "error"
+
+	defaultMaxSizeMB   = 100
+	defaultMaxBackups  = 5
+	defaultHTTPTimeout = 10 * time.Second
+	defaultBufferSize  = 1000
+)
+
+type (
+	// Record is a single entry of the audit log.
+	Record struct {
+		Time      time.Time `json:"time"`
+		RequestID string    `json:"requestId,omitempty"`
+		API       string    `json:"api"`
+		Namespace string    `json:"namespace,omitempty"`
+		Subject   string    `json:"subject,omitempty"`
+		Claims    *Claims   `json:"claims,omitempty"`
+		Target    *Target   `json:"target,omitempty"`
+		// Decision is empty if no authorizer is configured or the call was rejected before it was authorized.
+		Decision string `json:"decision,omitempty"`
+		Reason   string `json:"reason,omitempty"`
+		// Code is the gRPC status code the call completed with.
+		Code  string `json:"code"`
+		Error string `json:"error,omitempty"`
+		// Request is the JSON encoded request with all payloads redacted. Only set if requests are included.
+		Request json.RawMessage `json:"request,omitempty"`
+		// Details are operation specific attributes added by the handler, see [AddDetails].
+		Details map[string]string `json:"details,omitempty"`
+	}
+
+	// Claims are the roles of the caller.
+	Claims struct {
+		System     []string            `json:"system,omitempty"`
+		Namespaces map[string][]string `json:"namespaces,omitempty"`
+	}
+
+	// Target is the workflow or Nexus endpoint targeted by the call.
+	Target struct {
+		WorkflowID    string `json:"workflowId,omitempty"`
+		RunID         string `json:"runId,omitempty"`
+		NexusEndpoint string `json:"nexusEndpoint,omitempty"`
+	}
+
+	// Sink is the destination of audit records. Implementations must be safe for concurrent use.
+	Sink interface {
+		Write(record *Record) error
+		Close() error
+	}
+
+	writerSink struct {
+		lock   sync.Mutex
+		writer io.Writer
+	}
+)
+
+var (
+	errSinkClosed     = errors.New("audit sink is closed")
+	errSinkBufferFull = errors.New("audit sink buffer is full")
+)
+
+// NewSinksFromConfig creates the sinks of cfg. Returns no sinks if the audit log isn't enabled.
+func NewSinksFromConfig(cfg *config.Audit, logger log.Logger) ([]Sink, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	if len(cfg.Sinks) == 0 {
+		return nil, errors.New("audit log is enabled but no sinks are configured")
+	}
+	sinks := make([]Sink, 0, len(cfg.Sinks))
+	for _, sinkCfg := range cfg.Sinks {
+		sink, err := newSinkFromConfig(&sinkCfg, logger)
+		if err != nil {
+			for _, s := range sinks {
+				_ = s.Close()
+			}
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	return sinks, nil
+}
+
+func newSinkFromConfig(cfg *config.AuditSink, logger log.Logger) (Sink, error) {
+	switch strings.ToLower(cfg.Type) {
+	case "file":
+		maxSizeMB := cfg.MaxSizeMB
+		if maxSizeMB <= 0 {
+			maxSizeMB = defaultMaxSizeMB
+		}
+		maxBackups := cfg.MaxBackups
+		if maxBackups <= 0 {
+			maxBackups = defaultMaxBackups
+		}
+		return NewFileSink(cfg.Filepath, int64(maxSizeMB)<<20, maxBackups)
+	case "stdout":
+		return NewWriterSink(os.Stdout), nil
+	case "http":
+		timeout := cfg.Timeout
+		if timeout <= 0 {
+			timeout = defaultHTTPTimeout
+		}
+		bufferSize := cfg.BufferSize
+		if bufferSize <= 0 {
+			bufferSize = defaultBufferSize
+		}
+		return NewHTTPSink(cfg.URL, timeout, bufferSize, logger)
+	}
+	return nil, fmt.Errorf("unknown audit sink type: %s", cfg.Type)
+}
+
+// NewWriterSink creates a sink that writes records as newline delimited JSON to writer. Closing the sink
+// doesn't close writer.
+func NewWriterSink(writer io.Writer) Sink {
+	return &writerSink{writer: writer}
+}
+
+func (s *writerSink) Write(record *Record) error {
+	line, err := encodeRecord(record)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err = s.writer.Write(line)
+	return err
+}
+
+func (s *writerSink) Close() error {
+	return nil
+}
+
+func encodeRecord(record *Record) ([]byte, error) {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	return append(line, '\n'), nil
+}
+
+func newClaims(claims *authorization.Claims) *Claims {
+	c := &Claims{System: roleNames(claims.System)}
+	for ns, role := range claims.Namespaces {
+		if c.Namespaces == nil {
+			c.Namespaces = make(map[string][]string, len(claims.Namespaces))
+		}
+		c.Namespaces[ns] = roleNames(role)
+	}
+	return c
+}
+
+func roleNames(role authorization.Role) []string {
+	var names []string
+	for _, r := range []struct {
+		role authorization.Role
+		name string
+	}{
+		{authorization.RoleWorker, "worker"},
+		{authorization.RoleReader, "reader"},
+		{authorization.RoleWriter, "writer"},
+		{authorization.RoleAdmin, "admin"},
+	} {
+		if role&r.role != 0 {
+			names = append(names, r.name)
+		}
+	}
+	return names
+}
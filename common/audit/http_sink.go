@@ -0,0 +1,163 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	httpSinkMaxBatchSize  = 100
+	httpSinkFlushInterval = time.Second
+)
+
+type httpSink struct {
+	url     string
+	client  *http.Client
+	logger  log.Logger
+	records chan *Record
+
+	stopOnce sync.Once
+	stopC    chan struct{}
+	doneC    chan struct{}
+}
+
+// NewHTTPSink creates a sink that forwards records to url. Records are buffered and posted in batches of
+// newline delimited JSON by a background goroutine, so writes never block the call being audited. If the
+// buffer of bufferSize records is full, records are dropped and Write returns an error.
+func NewHTTPSink(url string, timeout time.Duration, bufferSize int, logger log.Logger) (Sink, error) {
+	if url == "" {
+		return nil, errors.New("audit http sink: url is not set")
+	}
+	s := &httpSink{
+		url:     url,
+		client:  &http.Client{Timeout: timeout},
+		logger:  logger,
+		records: make(chan *Record, bufferSize),
+		stopC:   make(chan struct{}),
+		doneC:   make(chan struct{}),
+	}
+	go s.run()
+	return s, nil
+}
+
+func (s *httpSink) Write(record *Record) error {
+	select {
+	case <-s.stopC:
+		return errSinkClosed
+	default:
+	}
+	select {
+	case s.records <- record:
+		return nil
+	default:
+		return errSinkBufferFull
+	}
+}
+
+// Close stops the sink after posting the buffered records.
+func (s *httpSink) Close() error {
+	s.stopOnce.Do(func() { close(s.stopC) })
+	<-s.doneC
+	return nil
+}
+
+func (s *httpSink) run() {
+	defer close(s.doneC)
+
+	ticker := time.NewTicker(httpSinkFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*Record, 0, httpSinkMaxBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := s.post(batch); err != nil {
+			s.logger.Warn("Unable to forward audit records.", tag.Counter(len(batch)), tag.Error(err))
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case record := <-s.records:
+			batch = append(batch, record)
+			if len(batch) >= httpSinkMaxBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-s.stopC:
+			for {
+				select {
+				case record := <-s.records:
+					batch = append(batch, record)
+					if len(batch) >= httpSinkMaxBatchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *httpSink) post(batch []*Record) error {
+	var body bytes.Buffer
+	for _, record := range batch {
+		line, err := encodeRecord(record)
+		if err != nil {
+			return err
+		}
+		body.Write(line)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, s.url, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"context"
+	"maps"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/api"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/masker"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type (
+	// Interceptor writes an audit record for every mutating workflow, namespace and admin API call. It must be
+	// placed before the authorization interceptor in the chain to record its decision.
+	Interceptor struct {
+		sinks          []Sink
+		includeRequest bool
+		logger         log.Logger
+		timeSource     clock.TimeSource
+	}
+
+	recordContextKey struct{}
+
+	hasNamespace interface {
+		GetNamespace() string
+	}
+
+	hasRequestID interface {
+		GetRequestId() string
+	}
+
+	hasWorkflowExecution interface {
+		GetWorkflowExecution() *commonpb.WorkflowExecution
+	}
+
+	hasWorkflowID interface {
+		GetWorkflowId() string
+	}
+)
+
+// workerAPIPrefixes are the prefixes of the method names that workers use to poll for and complete tasks. These
+// calls are made at a high rate and are not audited.
+var workerAPIPrefixes = []string{
+	"Poll",
+	"Respond",
+	"RecordActivityTaskHeartbeat",
+}
+
+// NewInterceptor creates an audit interceptor that writes records to sinks. If includeRequest is set, records
+// include the request with all payloads redacted.
+func NewInterceptor(
+	sinks []Sink,
+	includeRequest bool,
+	logger log.Logger,
+	timeSource clock.TimeSource,
+) *Interceptor {
+	return &Interceptor{
+		sinks:          sinks,
+		includeRequest: includeRequest,
+		logger:         logger,
+		timeSource:     timeSource,
+	}
+}
+
+func (i *Interceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if len(i.sinks) == 0 || !IsAuditedAPI(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	record := i.newRecord(req, info.FullMethod)
+	ctx = context.WithValue(ctx, recordContextKey{}, record)
+	ctx = authorization.WithDecisionObserver(ctx, func(claims *authorization.Claims, result authorization.Result, err error) {
+		if claims != nil {
+			record.Subject = claims.Subject
+			record.Claims = newClaims(claims)
+		}
+		switch {
+		case err != nil:
+			record.Decision = DecisionError
+		case result.Decision == authorization.DecisionAllow:
+			record.Decision = DecisionAllow
+		default:
+			record.Decision = DecisionDeny
+		}
+		record.Reason = result.Reason
+	})
+
+	resp, err := handler(ctx, req)
+	record.Code = serviceerror.ToStatus(err).Code().String()
+	if err != nil {
+		record.Error = err.Error()
+	}
+	i.write(record)
+	return resp, err
+}
+
+// AddDetails adds operation specific attributes to the audit record of the call in ctx, e.g. the reason given for a
+// manual intervention. It's a noop if the call isn't audited. Must not be called concurrently for the same call.
+func AddDetails(ctx context.Context, details map[string]string) {
+	record, ok := ctx.Value(recordContextKey{}).(*Record)
+	if !ok {
+		return
+	}
+	if record.Details == nil {
+		record.Details = make(map[string]string, len(details))
+	}
+	maps.Copy(record.Details, details)
+}
+
+// Close closes all sinks.
+func (i *Interceptor) Close() {
+	for _, sink := range i.sinks {
+		if err := sink.Close(); err != nil {
+			i.logger.Warn("Unable to close audit sink.", tag.Error(err))
+		}
+	}
+}
+
+// IsAuditedAPI returns true if calls to fullApiName are audited: all write and admin APIs except the ones used
+// by workers to poll for and complete tasks.
+func IsAuditedAPI(fullApiName string) bool {
+	methodName := api.MethodName(fullApiName)
+	for _, prefix := range workerAPIPrefixes {
+		if strings.HasPrefix(methodName, prefix) {
+			return false
+		}
+	}
+	access := api.GetMethodMetadata(fullApiName).Access
+	return access == api.AccessWrite || access == api.AccessAdmin
+}
+
+func (i *Interceptor) newRecord(req interface{}, apiName string) *Record {
+	record := &Record{
+		Time: i.timeSource.Now().UTC(),
+		API:  apiName,
+	}
+	if r, ok := req.(hasNamespace); ok {
+		record.Namespace = r.GetNamespace()
+	}
+	if r, ok := req.(hasRequestID); ok {
+		record.RequestID = r.GetRequestId()
+	}
+	switch r := req.(type) {
+	case hasWorkflowExecution:
+		if execution := r.GetWorkflowExecution(); execution != nil {
+			record.Target = &Target{WorkflowID: execution.GetWorkflowId(), RunID: execution.GetRunId()}
+		}
+	case hasWorkflowID:
+		if workflowID := r.GetWorkflowId(); workflowID != "" {
+			record.Target = &Target{WorkflowID: workflowID}
+		}
+	}
+	if msg, ok := req.(proto.Message); ok && i.includeRequest {
+		request, err := protojson.Marshal(masker.MaskProto(msg, masker.DefaultProtoFieldNames))
+		if err != nil {
+			i.logger.Warn("Unable to encode request for audit record.", tag.Error(err))
+		} else {
+			record.Request = request
+		}
+	}
+	return record
+}
+
+// WriteDecision writes an audit record for an authorization decision made outside of the gRPC interceptor chain,
+// e.g. by the Nexus HTTP handler. The record time is set to the current time.
+func (i *Interceptor) WriteDecision(record *Record, claims *authorization.Claims) {
+	if len(i.sinks) == 0 {
+		return
+	}
+	record.Time = i.timeSource.Now().UTC()
+	if claims != nil {
+		record.Subject = claims.Subject
+		record.Claims = newClaims(claims)
+	}
+	i.write(record)
+}
+
+func (i *Interceptor) write(record *Record) {
+	for _, sink := range i.sinks {
+		if err := sink.Write(record); err != nil {
+			i.logger.Warn("Unable to write audit record.", tag.Error(err))
+		}
+	}
+}
@@ -0,0 +1,249 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"google.golang.org/grpc"
+)
+
+type (
+	testSink struct {
+		records []*Record
+	}
+
+	denyTerminateAuthorizer struct{}
+
+	existingNamespaces struct{}
+)
+
+func (s *testSink) Write(record *Record) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *testSink) Close() error {
+	return nil
+}
+
+func (denyTerminateAuthorizer) Authorize(_ context.Context, _ *authorization.Claims, target *authorization.CallTarget) (authorization.Result, error) {
+	if strings.HasSuffix(target.APIName, "/TerminateWorkflowExecution") {
+		return authorization.Result{Decision: authorization.DecisionDeny, Reason: "no terminate"}, nil
+	}
+	return authorization.Result{Decision: authorization.DecisionAllow}, nil
+}
+
+func (existingNamespaces) Exists(namespace.Name) error {
+	return nil
+}
+
+func newTestChain(sink Sink, now time.Time) func(ctx context.Context, fullMethod string, req interface{}, handlerErr error) (bool, error) {
+	auditInterceptor := NewInterceptor(
+		[]Sink{sink},
+		true,
+		log.NewNoopLogger(),
+		clock.NewEventTimeSource().Update(now),
+	)
+	authInterceptor := authorization.NewInterceptor(
+		authorization.NewNoopClaimMapper(),
+		denyTerminateAuthorizer{},
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+		existingNamespaces{},
+		nil,
+		"",
+		"",
+	)
+	return func(ctx context.Context, fullMethod string, req interface{}, handlerErr error) (bool, error) {
+		called := false
+		info := &grpc.UnaryServerInfo{FullMethod: fullMethod}
+		_, err := auditInterceptor.Intercept(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return authInterceptor.Intercept(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return nil, handlerErr
+			})
+		})
+		return called, err
+	}
+}
+
+func TestInterceptor_AllowedCall(t *testing.T) {
+	sink := &testSink{}
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	call := newTestChain(sink, now)
+
+	called, err := call(context.Background(), "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution",
+		&workflowservice.StartWorkflowExecutionRequest{
+			Namespace:  "test-namespace",
+			WorkflowId: "workflow-id",
+			RequestId:  "request-id",
+			Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{
+				{Data: []byte("secret-input")},
+			}},
+		}, nil)
+	require.NoError(t, err)
+	require.True(t, called)
+
+	require.Len(t, sink.records, 1)
+	record := sink.records[0]
+	require.Equal(t, now, record.Time)
+	require.Equal(t, "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution", record.API)
+	require.Equal(t, "test-namespace", record.Namespace)
+	require.Equal(t, "request-id", record.RequestID)
+	require.Equal(t, &Target{WorkflowID: "workflow-id"}, record.Target)
+	require.Equal(t, &Claims{System: []string{"admin"}}, record.Claims)
+	require.Equal(t, DecisionAllow, record.Decision)
+	require.Equal(t, "OK", record.Code)
+	require.Contains(t, string(record.Request), "workflow-id")
+	require.NotContains(t, string(record.Request), "secret-input")
+}
+
+func TestInterceptor_DeniedCall(t *testing.T) {
+	sink := &testSink{}
+	call := newTestChain(sink, time.Now())
+
+	called, err := call(context.Background(), "/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution",
+		&workflowservice.TerminateWorkflowExecutionRequest{
+			Namespace:         "test-namespace",
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: "run-id"},
+		}, nil)
+	var permissionDenied *serviceerror.PermissionDenied
+	require.ErrorAs(t, err, &permissionDenied)
+	require.False(t, called)
+
+	require.Len(t, sink.records, 1)
+	record := sink.records[0]
+	require.Equal(t, &Target{WorkflowID: "workflow-id", RunID: "run-id"}, record.Target)
+	require.Equal(t, DecisionDeny, record.Decision)
+	require.Equal(t, "no terminate", record.Reason)
+	require.Equal(t, "PermissionDenied", record.Code)
+}
+
+func TestInterceptor_FailedCall(t *testing.T) {
+	sink := &testSink{}
+	call := newTestChain(sink, time.Now())
+
+	_, err := call(context.Background(), "/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution",
+		&workflowservice.SignalWorkflowExecutionRequest{Namespace: "test-namespace"},
+		serviceerror.NewNotFound("workflow not found"))
+	require.Error(t, err)
+
+	require.Len(t, sink.records, 1)
+	require.Equal(t, DecisionAllow, sink.records[0].Decision)
+	require.Equal(t, "NotFound", sink.records[0].Code)
+	require.Equal(t, "workflow not found", sink.records[0].Error)
+}
+
+func TestInterceptor_NotAuditedCall(t *testing.T) {
+	sink := &testSink{}
+	call := newTestChain(sink, time.Now())
+
+	for _, fullMethod := range []string{
+		"/temporal.api.workflowservice.v1.WorkflowService/DescribeWorkflowExecution",
+		"/temporal.api.workflowservice.v1.WorkflowService/PollWorkflowTaskQueue",
+		"/temporal.api.workflowservice.v1.WorkflowService/RespondActivityTaskCompleted",
+	} {
+		called, err := call(context.Background(), fullMethod, &workflowservice.DescribeWorkflowExecutionRequest{}, nil)
+		require.NoError(t, err)
+		require.True(t, called)
+	}
+	require.Empty(t, sink.records)
+}
+
+func TestIsAuditedAPI(t *testing.T) {
+	require.True(t, IsAuditedAPI("/temporal.api.workflowservice.v1.WorkflowService/ResetWorkflowExecution"))
+	require.True(t, IsAuditedAPI("/temporal.api.workflowservice.v1.WorkflowService/UpdateNamespace"))
+	require.True(t, IsAuditedAPI("/temporal.api.operatorservice.v1.OperatorService/AddSearchAttributes"))
+	require.True(t, IsAuditedAPI("/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState"))
+	require.False(t, IsAuditedAPI("/temporal.api.workflowservice.v1.WorkflowService/ListWorkflowExecutions"))
+	require.False(t, IsAuditedAPI("/temporal.api.workflowservice.v1.WorkflowService/RecordActivityTaskHeartbeat"))
+	require.False(t, IsAuditedAPI("/grpc.health.v1.Health/Check"))
+}
+
+func TestInterceptor_NoSinks(t *testing.T) {
+	interceptor := NewInterceptor(nil, false, log.NewNoopLogger(), clock.NewRealTimeSource())
+	handlerErr := errors.New("handler error")
+	_, err := interceptor.Intercept(
+		context.Background(),
+		&workflowservice.TerminateWorkflowExecutionRequest{},
+		&grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution"},
+		func(ctx context.Context, req interface{}) (interface{}, error) { return nil, handlerErr },
+	)
+	require.ErrorIs(t, err, handlerErr)
+}
+
+func TestInterceptor_WriteDecision(t *testing.T) {
+	sink := &testSink{}
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	interceptor := NewInterceptor([]Sink{sink}, false, log.NewNoopLogger(), clock.NewEventTimeSource().Update(now))
+	interceptor.WriteDecision(&Record{
+		API:       "DispatchNexusTask",
+		Namespace: "caller",
+		Target:    &Target{NexusEndpoint: "endpoint"},
+		Decision:  DecisionDeny,
+		Reason:    "denied",
+		Code:      "PermissionDenied",
+	}, &authorization.Claims{Subject: "subject", System: authorization.RoleAdmin})
+
+	require.Len(t, sink.records, 1)
+	record := sink.records[0]
+	require.Equal(t, now, record.Time)
+	require.Equal(t, "subject", record.Subject)
+	require.Equal(t, []string{"admin"}, record.Claims.System)
+	require.Equal(t, "endpoint", record.Target.NexusEndpoint)
+	require.Equal(t, DecisionDeny, record.Decision)
+}
+
+func TestInterceptor_AddDetails(t *testing.T) {
+	sink := &testSink{}
+	interceptor := NewInterceptor([]Sink{sink}, false, log.NewNoopLogger(), clock.NewRealTimeSource())
+	_, err := interceptor.Intercept(
+		context.Background(),
+		&workflowservice.TerminateWorkflowExecutionRequest{},
+		&grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			AddDetails(ctx, map[string]string{"reason": "stuck"})
+			return nil, nil
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, sink.records, 1)
+	require.Equal(t, map[string]string{"reason": "stuck"}, sink.records[0].Details)
+
+	// Noop outside of audited calls.
+	AddDetails(context.Background(), map[string]string{"reason": "stuck"})
+}
@@ -0,0 +1,167 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+func readRecords(t *testing.T, r io.Reader) []*Record {
+	t.Helper()
+	var records []*Record
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		record := &Record{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
+
+func readRecordsFile(t *testing.T, path string) []*Record {
+	t.Helper()
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+	return readRecords(t, file)
+}
+
+func TestWriterSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewWriterSink(&buf)
+	require.NoError(t, sink.Write(&Record{API: "api-1", Code: "OK"}))
+	require.NoError(t, sink.Write(&Record{API: "api-2", Code: "OK"}))
+	require.NoError(t, sink.Close())
+
+	records := readRecords(t, &buf)
+	require.Len(t, records, 2)
+	require.Equal(t, "api-1", records[0].API)
+	require.Equal(t, "api-2", records[1].API)
+}
+
+func TestFileSink_Rotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	line, err := encodeRecord(&Record{API: "api", Code: "OK"})
+	require.NoError(t, err)
+
+	// Each file fits two records.
+	sink, err := NewFileSink(path, int64(2*len(line)), 2)
+	require.NoError(t, err)
+	for i := 0; i < 7; i++ {
+		require.NoError(t, sink.Write(&Record{API: "api", Code: "OK"}))
+	}
+	require.NoError(t, sink.Close())
+	require.ErrorIs(t, sink.Write(&Record{}), errSinkClosed)
+
+	require.Len(t, readRecordsFile(t, path), 1)
+	require.Len(t, readRecordsFile(t, path+".1"), 2)
+	require.Len(t, readRecordsFile(t, path+".2"), 2)
+	require.NoFileExists(t, path+".3")
+
+	// Reopening the file appends to it.
+	sink, err = NewFileSink(path, int64(2*len(line)), 2)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(&Record{API: "api", Code: "OK"}))
+	require.NoError(t, sink.Close())
+	require.Len(t, readRecordsFile(t, path), 2)
+}
+
+func TestHTTPSink(t *testing.T) {
+	var lock sync.Mutex
+	var received []*Record
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+		records := readRecords(t, r.Body)
+		lock.Lock()
+		received = append(received, records...)
+		lock.Unlock()
+	}))
+	defer server.Close()
+
+	sink, err := NewHTTPSink(server.URL, time.Second, 10, log.NewNoopLogger())
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, sink.Write(&Record{API: "api", Code: "OK"}))
+	}
+	// Close posts the buffered records.
+	require.NoError(t, sink.Close())
+	require.ErrorIs(t, sink.Write(&Record{}), errSinkClosed)
+
+	lock.Lock()
+	defer lock.Unlock()
+	require.Len(t, received, 5)
+}
+
+func TestHTTPSink_BufferFull(t *testing.T) {
+	sink := &httpSink{
+		records: make(chan *Record, 1),
+		stopC:   make(chan struct{}),
+	}
+	// The background goroutine isn't running, so the buffer isn't drained.
+	require.NoError(t, sink.Write(&Record{}))
+	require.ErrorIs(t, sink.Write(&Record{}), errSinkBufferFull)
+}
+
+func TestNewSinksFromConfig(t *testing.T) {
+	sinks, err := NewSinksFromConfig(&config.Audit{}, log.NewNoopLogger())
+	require.NoError(t, err)
+	require.Empty(t, sinks)
+
+	_, err = NewSinksFromConfig(&config.Audit{Enabled: true}, log.NewNoopLogger())
+	require.Error(t, err)
+
+	_, err = NewSinksFromConfig(&config.Audit{
+		Enabled: true,
+		Sinks:   []config.AuditSink{{Type: "stdout"}, {Type: "kafka"}},
+	}, log.NewNoopLogger())
+	require.ErrorContains(t, err, "unknown audit sink type")
+
+	sinks, err = NewSinksFromConfig(&config.Audit{
+		Enabled: true,
+		Sinks: []config.AuditSink{
+			{Type: "stdout"},
+			{Type: "file", Filepath: filepath.Join(t.TempDir(), "audit.log")},
+			{Type: "http", URL: "http://localhost:1"},
+		},
+	}, log.NewNoopLogger())
+	require.NoError(t, err)
+	require.Len(t, sinks, 3)
+	for _, sink := range sinks {
+		require.NoError(t, sink.Close())
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"strings"
 
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
 )
 
 const (
@@ -89,10 +90,21 @@ func GetAuthorizerFromConfig(config *config.Authorization) (Authorizer, error) {
 		return NewNoopAuthorizer(), nil
 	case "default":
 		return NewDefaultAuthorizer(), nil
+	case "policy":
+		return nil, fmt.Errorf("authorizer %s requires a logger, use GetAuthorizerFromConfigWithLogger", config.Authorizer)
 	}
 	return nil, fmt.Errorf("unknown authorizer: %s", config.Authorizer)
 }
 
+// GetAuthorizerFromConfigWithLogger works like GetAuthorizerFromConfig, but also supports authorizers that log,
+// such as the policy authorizer, which use the given logger.
+func GetAuthorizerFromConfigWithLogger(config *config.Authorization, logger log.Logger) (Authorizer, error) {
+	if strings.ToLower(config.Authorizer) == "policy" {
+		return NewPolicyAuthorizer(&config.Policy, logger)
+	}
+	return GetAuthorizerFromConfig(config)
+}
+
 func IsNoopAuthorizer(authorizer Authorizer) bool {
 	_, ok := authorizer.(*noopAuthorizer)
 	return ok
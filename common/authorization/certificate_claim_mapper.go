@@ -0,0 +1,148 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/x509"
+	"fmt"
+	"strings"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	certificateFieldCommonName         = "cn"
+	certificateFieldOrganizationalUnit = "ou"
+	certificateFieldDNSName            = "san-dns"
+	certificateFieldURI                = "san-uri"
+)
+
+type (
+	certificateClaimMapper struct {
+		mappings   []certificateMapping
+		revocation *revocationChecker
+	}
+
+	certificateMapping struct {
+		roleMapping
+		field string
+	}
+)
+
+var errCertificateRevoked = serviceerror.NewPermissionDenied("client certificate is revoked", "")
+
+var _ ClaimMapper = (*certificateClaimMapper)(nil)
+
+// NewCertificateClaimMapper creates a claim mapper that grants roles to the verified client certificate of mTLS
+// connections according to the rules in cfg. The subject of the claims is the common name of the certificate.
+// Calls with a certificate revoked by one of the configured CRLs or OCSP responses are rejected.
+func NewCertificateClaimMapper(cfg *config.CertificateClaimMapper, logger log.Logger) (ClaimMapper, error) {
+	return newCertificateClaimMapper(cfg, logger, clock.NewRealTimeSource())
+}
+
+func newCertificateClaimMapper(
+	cfg *config.CertificateClaimMapper,
+	logger log.Logger,
+	timeSource clock.TimeSource,
+) (*certificateClaimMapper, error) {
+	mappings := make([]certificateMapping, 0, len(cfg.Rules))
+	for _, rule := range cfg.Rules {
+		mapping, err := newCertificateMapping(rule)
+		if err != nil {
+			return nil, fmt.Errorf("certificate claim mapper: rule for field %q with template %q: %w", rule.Field, rule.Template, err)
+		}
+		mappings = append(mappings, mapping)
+	}
+	revocation, err := newRevocationChecker(&cfg.Revocation, logger, timeSource)
+	if err != nil {
+		return nil, fmt.Errorf("certificate claim mapper: %w", err)
+	}
+	return &certificateClaimMapper{
+		mappings:   mappings,
+		revocation: revocation,
+	}, nil
+}
+
+func newCertificateMapping(cfg config.CertificateMapping) (certificateMapping, error) {
+	field := strings.ToLower(cfg.Field)
+	switch field {
+	case certificateFieldCommonName, certificateFieldOrganizationalUnit, certificateFieldDNSName, certificateFieldURI:
+	default:
+		return certificateMapping{}, fmt.Errorf("unknown field %q", cfg.Field)
+	}
+	mapping, err := newWildcardRoleMapping(config.RoleMapping{
+		Claim:                field,
+		Template:             cfg.Template,
+		Namespace:            cfg.Namespace,
+		Role:                 cfg.Role,
+		AllowSystemNamespace: cfg.AllowSystemNamespace,
+	})
+	if err != nil {
+		return certificateMapping{}, err
+	}
+	return certificateMapping{roleMapping: mapping, field: field}, nil
+}
+
+func (m *certificateClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
+	cert := PeerCert(authInfo.TLSConnection)
+	if cert == nil {
+		// Calls without a client certificate, e.g. with only an auth header, get no roles.
+		return &Claims{}, nil
+	}
+	var issuer *x509.Certificate
+	if chain := authInfo.TLSConnection.State.VerifiedChains[0]; len(chain) > 1 {
+		issuer = chain[1]
+	}
+	if m.revocation.isRevoked(cert, issuer) {
+		return nil, errCertificateRevoked
+	}
+
+	claims := &Claims{Subject: cert.Subject.CommonName}
+	for _, mapping := range m.mappings {
+		mapping.applyValues(certificateFieldValues(cert, mapping.field), claims)
+	}
+	return claims, nil
+}
+
+func certificateFieldValues(cert *x509.Certificate, field string) []string {
+	switch field {
+	case certificateFieldCommonName:
+		if cert.Subject.CommonName != "" {
+			return []string{cert.Subject.CommonName}
+		}
+	case certificateFieldOrganizationalUnit:
+		return cert.Subject.OrganizationalUnit
+	case certificateFieldDNSName:
+		return cert.DNSNames
+	case certificateFieldURI:
+		values := make([]string, 0, len(cert.URIs))
+		for _, uri := range cert.URIs {
+			values = append(values, uri.String())
+		}
+		return values
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"golang.org/x/crypto/ocsp"
+	"google.golang.org/grpc/credentials"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key}
+}
+
+func (ca *testCA) issue(t *testing.T, serial int64, template *x509.Certificate) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template.SerialNumber = big.NewInt(serial)
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, key.Public(), ca.key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func (ca *testCA) crl(t *testing.T, serials ...int64) []byte {
+	t.Helper()
+	entries := make([]x509.RevocationListEntry, 0, len(serials))
+	for _, serial := range serials {
+		entries = append(entries, x509.RevocationListEntry{SerialNumber: big.NewInt(serial), RevocationTime: time.Now()})
+	}
+	der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:                    big.NewInt(1),
+		ThisUpdate:                time.Now(),
+		NextUpdate:                time.Now().Add(time.Hour),
+		RevokedCertificateEntries: entries,
+	}, ca.cert, ca.key)
+	require.NoError(t, err)
+	return der
+}
+
+func (ca *testCA) ocspResponse(t *testing.T, cert *x509.Certificate, status int) []byte {
+	t.Helper()
+	der, err := ocsp.CreateResponse(ca.cert, ca.cert, ocsp.Response{
+		Status:       status,
+		SerialNumber: cert.SerialNumber,
+		ThisUpdate:   time.Now(),
+		RevokedAt:    time.Now(),
+	}, ca.key)
+	require.NoError(t, err)
+	return der
+}
+
+func tlsAuthInfo(cert *x509.Certificate, ca *testCA) *AuthInfo {
+	return &AuthInfo{
+		TLSSubject: &cert.Subject,
+		TLSConnection: &credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert, ca.cert}}},
+		},
+	}
+}
+
+func TestCertificateClaimMapper_Rules(t *testing.T) {
+	ca := newTestCA(t, "ca")
+	claimMapper, err := NewCertificateClaimMapper(&config.CertificateClaimMapper{
+		Rules: []config.CertificateMapping{
+			{Field: "san-uri", Template: "spiffe://example.org/temporal/{namespace}/{role}"},
+			{Field: "san-dns", Template: "*.workers.example.org", Namespace: "builds", Role: "worker"},
+			{Field: "ou", Template: "temporal-operators", Namespace: "temporal-system", Role: "admin"},
+			{Field: "CN", Template: "{namespace}-reader", Role: "read"},
+		},
+	}, log.NewNoopLogger())
+	require.NoError(t, err)
+
+	cert := ca.issue(t, 2, &x509.Certificate{
+		Subject: pkix.Name{CommonName: "payments-reader", OrganizationalUnit: []string{"temporal-operators"}},
+		URIs: []*url.URL{
+			{Scheme: "spiffe", Host: "example.org", Path: "/temporal/payments/write"},
+			{Scheme: "spiffe", Host: "example.org", Path: "/other/billing/admin"},
+		},
+		DNSNames: []string{"linux.workers.example.org", "example.org"},
+	})
+	claims, err := claimMapper.GetClaims(tlsAuthInfo(cert, ca))
+	require.NoError(t, err)
+	require.Equal(t, "payments-reader", claims.Subject)
+	require.Equal(t, RoleAdmin, claims.System)
+	require.Equal(t, map[string]Role{
+		"payments": RoleWriter | RoleReader,
+		"builds":   RoleWorker,
+	}, claims.Namespaces)
+
+	cert = ca.issue(t, 3, &x509.Certificate{Subject: pkix.Name{CommonName: "unknown"}})
+	claims, err = claimMapper.GetClaims(tlsAuthInfo(cert, ca))
+	require.NoError(t, err)
+	require.Equal(t, &Claims{Subject: "unknown"}, claims)
+
+	claims, err = claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer token"})
+	require.NoError(t, err)
+	require.Equal(t, &Claims{}, claims)
+}
+
+func TestCertificateClaimMapper_InvalidRules(t *testing.T) {
+	for name, rule := range map[string]config.CertificateMapping{
+		"unknown field":    {Field: "email", Template: "{namespace}-{role}"},
+		"missing template": {Field: "cn"},
+		"missing role":     {Field: "cn", Template: "{namespace}"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewCertificateClaimMapper(&config.CertificateClaimMapper{
+				Rules: []config.CertificateMapping{rule},
+			}, log.NewNoopLogger())
+			require.Error(t, err)
+		})
+	}
+
+	_, err := NewCertificateClaimMapper(&config.CertificateClaimMapper{
+		Revocation: config.CertificateRevocation{CRLFiles: []string{filepath.Join(t.TempDir(), "missing.crl")}},
+	}, log.NewNoopLogger())
+	require.Error(t, err)
+}
+
+func TestCertificateClaimMapper_Revocation(t *testing.T) {
+	ca := newTestCA(t, "ca")
+	otherCA := newTestCA(t, "other-ca")
+	dir := t.TempDir()
+	crlPath := filepath.Join(dir, "ca.crl")
+	ocspPath := filepath.Join(dir, "cert.ocsp")
+	modTime := time.Now().Add(-time.Hour)
+
+	valid := ca.issue(t, 10, &x509.Certificate{Subject: pkix.Name{CommonName: "valid"}})
+	crlRevoked := ca.issue(t, 11, &x509.Certificate{Subject: pkix.Name{CommonName: "crl-revoked"}})
+	ocspRevoked := ca.issue(t, 12, &x509.Certificate{Subject: pkix.Name{CommonName: "ocsp-revoked"}})
+	otherIssuer := otherCA.issue(t, 11, &x509.Certificate{Subject: pkix.Name{CommonName: "other-issuer"}})
+
+	writePolicyFile(t, crlPath, string(pem.EncodeToMemory(&pem.Block{Type: pemTypeCRL, Bytes: ca.crl(t, 11)})), modTime)
+	writePolicyFile(t, ocspPath, string(ca.ocspResponse(t, ocspRevoked, ocsp.Revoked)), modTime)
+
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	claimMapper, err := newCertificateClaimMapper(&config.CertificateClaimMapper{
+		Revocation: config.CertificateRevocation{
+			CRLFiles:          []string{crlPath},
+			OCSPResponseFiles: []string{ocspPath},
+			PollInterval:      time.Minute,
+		},
+	}, log.NewNoopLogger(), timeSource)
+	require.NoError(t, err)
+
+	assertRevoked := func(cert *x509.Certificate, ca *testCA, revoked bool) {
+		t.Helper()
+		_, err := claimMapper.GetClaims(tlsAuthInfo(cert, ca))
+		if revoked {
+			require.ErrorIs(t, err, errCertificateRevoked, cert.Subject.CommonName)
+		} else {
+			require.NoError(t, err, cert.Subject.CommonName)
+		}
+	}
+	assertRevoked(valid, ca, false)
+	assertRevoked(crlRevoked, ca, true)
+	assertRevoked(ocspRevoked, ca, true)
+	// Same serial number as a revoked certificate, but a different issuer.
+	assertRevoked(otherIssuer, otherCA, false)
+
+	// Updated files are picked up after the poll interval. DER encoded CRLs are supported as well.
+	modTime = modTime.Add(time.Minute)
+	writePolicyFile(t, crlPath, string(ca.crl(t, 10)), modTime)
+	writePolicyFile(t, ocspPath, string(ca.ocspResponse(t, ocspRevoked, ocsp.Good)), modTime)
+	assertRevoked(valid, ca, false)
+	timeSource.Advance(time.Minute)
+	assertRevoked(valid, ca, true)
+	assertRevoked(crlRevoked, ca, false)
+	assertRevoked(ocspRevoked, ca, false)
+
+	// An invalid file is ignored and the previous revocation lists stay in effect.
+	modTime = modTime.Add(time.Minute)
+	writePolicyFile(t, crlPath, "not a CRL", modTime)
+	timeSource.Advance(time.Minute)
+	assertRevoked(valid, ca, true)
+}
+
+func TestCertificateClaimMapper_RevocationSignature(t *testing.T) {
+	ca := newTestCA(t, "ca")
+	// An attacker controlled CA with the same name as the real CA.
+	impostor := newTestCA(t, "ca")
+	crlPath := filepath.Join(t.TempDir(), "impostor.crl")
+	writePolicyFile(t, crlPath, string(impostor.crl(t, 20)), time.Now())
+
+	claimMapper, err := NewCertificateClaimMapper(&config.CertificateClaimMapper{
+		Revocation: config.CertificateRevocation{CRLFiles: []string{crlPath}},
+	}, log.NewNoopLogger())
+	require.NoError(t, err)
+
+	cert := ca.issue(t, 20, &x509.Certificate{Subject: pkix.Name{CommonName: "valid"}})
+	_, err = claimMapper.GetClaims(tlsAuthInfo(cert, ca))
+	require.NoError(t, err)
+}
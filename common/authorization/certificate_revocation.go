@@ -0,0 +1,237 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"golang.org/x/crypto/ocsp"
+)
+
+const (
+	defaultRevocationPollInterval = time.Minute
+	pemTypeCRL                    = "X509 CRL"
+)
+
+type (
+	// revocationChecker checks certificates against local CRL and OCSP response files. The files are checked for
+	// changes at most once per poll interval and reloaded when one of them was modified. If the modified files
+	// can't be loaded, the error is logged and the previous revocation lists stay in effect.
+	revocationChecker struct {
+		crlFiles          []string
+		ocspResponseFiles []string
+		pollInterval      time.Duration
+		logger            log.Logger
+		timeSource        clock.TimeSource
+
+		revoked   atomic.Pointer[revokedCertificates]
+		lastCheck atomic.Int64 // unix nanos
+
+		reloadLock sync.Mutex
+		modTimes   map[string]time.Time
+	}
+
+	// revokedCertificates indexes the loaded revocation sources by serial number. Signatures are only verified
+	// for entries that match the serial number of a certificate, which keeps the common case cheap.
+	revokedCertificates struct {
+		crls          map[string][]*x509.RevocationList
+		ocspResponses map[string][][]byte
+	}
+)
+
+func newRevocationChecker(
+	cfg *config.CertificateRevocation,
+	logger log.Logger,
+	timeSource clock.TimeSource,
+) (*revocationChecker, error) {
+	pollInterval := cfg.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultRevocationPollInterval
+	}
+	c := &revocationChecker{
+		crlFiles:          cfg.CRLFiles,
+		ocspResponseFiles: cfg.OCSPResponseFiles,
+		pollInterval:      pollInterval,
+		logger:            logger,
+		timeSource:        timeSource,
+	}
+	if err := c.reload(); err != nil {
+		return nil, err
+	}
+	c.lastCheck.Store(timeSource.Now().UnixNano())
+	return c, nil
+}
+
+// isRevoked returns true if cert is revoked by a CRL or OCSP response signed by issuer. If issuer is nil, e.g.
+// because the certificate is self-signed, signatures aren't verified.
+func (c *revocationChecker) isRevoked(cert *x509.Certificate, issuer *x509.Certificate) bool {
+	if len(c.crlFiles) == 0 && len(c.ocspResponseFiles) == 0 {
+		return false
+	}
+	c.maybeReload()
+
+	revoked := c.revoked.Load()
+	serial := cert.SerialNumber.String()
+	for _, crl := range revoked.crls[serial] {
+		if !bytes.Equal(crl.RawIssuer, cert.RawIssuer) {
+			continue
+		}
+		if issuer != nil && crl.CheckSignatureFrom(issuer) != nil {
+			continue
+		}
+		return true
+	}
+	for _, response := range revoked.ocspResponses[serial] {
+		parsed, err := ocsp.ParseResponseForCert(response, cert, issuer)
+		if err != nil {
+			continue
+		}
+		if parsed.Status == ocsp.Revoked {
+			return true
+		}
+	}
+	return false
+}
+
+// maybeReload reloads the files if one of them was modified. Only one caller per poll interval checks the files.
+func (c *revocationChecker) maybeReload() {
+	now := c.timeSource.Now().UnixNano()
+	last := c.lastCheck.Load()
+	if now-last < c.pollInterval.Nanoseconds() || !c.lastCheck.CompareAndSwap(last, now) {
+		return
+	}
+	if err := c.reload(); err != nil {
+		c.logger.Error("Unable to reload certificate revocation files.", tag.Error(err))
+	}
+}
+
+func (c *revocationChecker) reload() error {
+	c.reloadLock.Lock()
+	defer c.reloadLock.Unlock()
+
+	modTimes := make(map[string]time.Time, len(c.crlFiles)+len(c.ocspResponseFiles))
+	modified := c.revoked.Load() == nil
+	for _, path := range append(append([]string(nil), c.crlFiles...), c.ocspResponseFiles...) {
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("revocation file: %s: %w", path, err)
+		}
+		modTimes[path] = info.ModTime()
+		if !info.ModTime().Equal(c.modTimes[path]) {
+			modified = true
+		}
+	}
+	if !modified {
+		return nil
+	}
+
+	revoked := &revokedCertificates{
+		crls:          make(map[string][]*x509.RevocationList),
+		ocspResponses: make(map[string][][]byte),
+	}
+	for _, path := range c.crlFiles {
+		crls, err := loadCRLs(path)
+		if err != nil {
+			return fmt.Errorf("CRL file: %s: %w", path, err)
+		}
+		for _, crl := range crls {
+			for _, entry := range crl.RevokedCertificateEntries {
+				serial := entry.SerialNumber.String()
+				revoked.crls[serial] = append(revoked.crls[serial], crl)
+			}
+		}
+	}
+	for _, path := range c.ocspResponseFiles {
+		response, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("OCSP response file: %s: %w", path, err)
+		}
+		// The signature is verified when the response is used, because the issuer is only known then.
+		parsed, err := ocsp.ParseResponse(response, nil)
+		if err != nil {
+			return fmt.Errorf("OCSP response file: %s: %w", path, err)
+		}
+		serial := serialString(parsed.SerialNumber)
+		revoked.ocspResponses[serial] = append(revoked.ocspResponses[serial], response)
+	}
+
+	c.revoked.Store(revoked)
+	c.modTimes = modTimes
+	c.logger.Info("Loaded certificate revocation files.",
+		tag.NewStringsTag("crl-files", c.crlFiles),
+		tag.NewStringsTag("ocsp-response-files", c.ocspResponseFiles))
+	return nil
+}
+
+// loadCRLs parses the PEM encoded CRLs in path, or a single DER encoded CRL if the file isn't PEM.
+func loadCRLs(path string) ([]*x509.RevocationList, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var crls []*x509.RevocationList
+	rest := contents
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != pemTypeCRL {
+			continue
+		}
+		crl, err := x509.ParseRevocationList(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		crls = append(crls, crl)
+	}
+	if crls != nil {
+		return crls, nil
+	}
+	crl, err := x509.ParseRevocationList(contents)
+	if err != nil {
+		return nil, errors.Join(errors.New("file contains neither PEM nor DER encoded CRLs"), err)
+	}
+	return []*x509.RevocationList{crl}, nil
+}
+
+func serialString(serial *big.Int) string {
+	if serial == nil {
+		return ""
+	}
+	return serial.String()
+}
@@ -82,13 +82,23 @@ func (*noopClaimMapper) AuthInfoRequired() bool {
 	return false
 }
 
+func IsNoopClaimMapper(claimMapper ClaimMapper) bool {
+	_, ok := claimMapper.(*noopClaimMapper)
+	return ok
+}
+
 func GetClaimMapperFromConfig(config *config.Authorization, logger log.Logger) (ClaimMapper, error) {
 
 	switch strings.ToLower(config.ClaimMapper) {
 	case "":
 		return NewNoopClaimMapper(), nil
 	case "default":
+		if _, err := newRoleMappings(config.RoleMappings); err != nil {
+			return nil, err
+		}
 		return NewDefaultJWTClaimMapper(NewDefaultTokenKeyProvider(config, logger), config, logger), nil
+	case "certificate":
+		return NewCertificateClaimMapper(&config.CertificateClaimMapper, logger)
 	}
 	return nil, fmt.Errorf("unknown claim mapper: %s", config.ClaimMapper)
 }
@@ -185,6 +185,10 @@ func (s *defaultAuthorizerSuite) TestGetAuthorizerFromConfigNoop() {
 func (s *defaultAuthorizerSuite) TestGetAuthorizerFromConfigDefault() {
 	s.testGetAuthorizerFromConfig("default", true, reflect.TypeOf(&defaultAuthorizer{}))
 }
+func (s *defaultAuthorizerSuite) TestGetAuthorizerFromConfigPolicy() {
+	// the policy authorizer is only created by GetAuthorizerFromConfigWithLogger
+	s.testGetAuthorizerFromConfig("policy", false, nil)
+}
 func (s *defaultAuthorizerSuite) TestGetAuthorizerFromConfigUnknown() {
 	s.testGetAuthorizerFromConfig("foo", false, nil)
 }
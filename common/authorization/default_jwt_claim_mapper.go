@@ -27,12 +27,14 @@ package authorization
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v4"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/primitives"
 )
 
@@ -52,6 +54,9 @@ type defaultJWTClaimMapper struct {
 	keyProvider          TokenKeyProvider
 	logger               log.Logger
 	permissionsClaimName string
+	issuers              []string
+	audiences            []string
+	roleMappings         []roleMapping
 }
 
 func NewDefaultJWTClaimMapper(provider TokenKeyProvider, cfg *config.Authorization, logger log.Logger) ClaimMapper {
@@ -59,7 +64,23 @@ func NewDefaultJWTClaimMapper(provider TokenKeyProvider, cfg *config.Authorizati
 	if claimName == "" {
 		claimName = defaultPermissionsClaimName
 	}
-	return &defaultJWTClaimMapper{keyProvider: provider, logger: logger, permissionsClaimName: claimName}
+	issuers := cfg.Issuers
+	if len(issuers) == 0 && cfg.JWTKeyProvider.IssuerURL != "" {
+		issuers = []string{cfg.JWTKeyProvider.IssuerURL}
+	}
+	roleMappings, err := newRoleMappings(cfg.RoleMappings)
+	if err != nil {
+		// GetClaimMapperFromConfig fails on invalid role mappings, this is only reached by custom builds.
+		logger.Error("Ignoring invalid role mappings.", tag.Error(err))
+	}
+	return &defaultJWTClaimMapper{
+		keyProvider:          provider,
+		logger:               logger,
+		permissionsClaimName: claimName,
+		issuers:              issuers,
+		audiences:            cfg.Audiences,
+		roleMappings:         roleMappings,
+	}
 }
 
 var _ ClaimMapper = (*defaultJWTClaimMapper)(nil)
@@ -87,6 +108,9 @@ func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 	if !ok {
 		return nil, serviceerror.NewPermissionDenied("unexpected value type of \"sub\" claim", "")
 	}
+	if err := a.validateIssuerAndAudience(jwtClaims); err != nil {
+		return nil, err
+	}
 	claims.Subject = subject
 	permissions, ok := jwtClaims[a.permissionsClaimName].([]interface{})
 	if ok {
@@ -95,9 +119,32 @@ func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 			return nil, err
 		}
 	}
+	for i := range a.roleMappings {
+		a.roleMappings[i].apply(jwtClaims, &claims)
+	}
 	return &claims, nil
 }
 
+func (a *defaultJWTClaimMapper) validateIssuerAndAudience(jwtClaims jwt.MapClaims) error {
+	if len(a.issuers) > 0 {
+		issuer, _ := jwtClaims["iss"].(string)
+		if !slices.ContainsFunc(a.issuers, func(expected string) bool {
+			// Issuer URLs are compared ignoring a trailing slash, which some IdPs add and others don't.
+			return strings.TrimSuffix(expected, "/") == strings.TrimSuffix(issuer, "/")
+		}) {
+			return serviceerror.NewPermissionDenied("issuer mismatch", "")
+		}
+	}
+	if len(a.audiences) > 0 {
+		if !slices.ContainsFunc(a.audiences, func(audience string) bool {
+			return jwtClaims.VerifyAudience(audience, true)
+		}) {
+			return serviceerror.NewPermissionDenied("audience mismatch", "")
+		}
+	}
+	return nil
+}
+
 func (a *defaultJWTClaimMapper) extractPermissions(permissions []interface{}, claims *Claims) error {
 	for _, permission := range permissions {
 		p, ok := permission.(string)
@@ -105,22 +152,12 @@ func (a *defaultJWTClaimMapper) extractPermissions(permissions []interface{}, cl
 			a.logger.Warn(fmt.Sprintf("ignoring permission that is not a string: %v", permission))
 			continue
 		}
-		parts := strings.Split(p, ":")
-		if len(parts) != 2 {
+		namespace, role, ok := ParsePermission(p)
+		if !ok {
 			a.logger.Warn(fmt.Sprintf("ignoring permission in unexpected format: %v", permission))
 			continue
 		}
-		namespace := parts[0]
-		if namespace == permissionScopeSystem {
-			claims.System |= permissionToRole(parts[1])
-		} else {
-			if claims.Namespaces == nil {
-				claims.Namespaces = make(map[string]Role)
-			}
-			role := claims.Namespaces[namespace]
-			role |= permissionToRole(parts[1])
-			claims.Namespaces[namespace] = role
-		}
+		AddRole(claims, namespace, role)
 	}
 	return nil
 }
@@ -179,6 +216,16 @@ func parseJWTWithAudience(tokenString string, keyProvider TokenKeyProvider, audi
 	return claims, nil
 }
 
+// ParsePermission parses a permission in the "<namespace>:<role>" format, e.g. "default:write". The role is
+// RoleUndefined if it isn't one of read, write, worker or admin. Returns false if the format is invalid.
+func ParsePermission(permission string) (namespace string, role Role, ok bool) {
+	parts := strings.Split(permission, ":")
+	if len(parts) != 2 {
+		return "", RoleUndefined, false
+	}
+	return parts[0], permissionToRole(parts[1]), true
+}
+
 func permissionToRole(permission string) Role {
 	switch strings.ToLower(permission) {
 	case permissionRead:
@@ -262,6 +262,77 @@ func (s *defaultClaimMapperSuite) TestIgnoreAudience() {
 	s.NoError(err)
 }
 
+func (s *defaultClaimMapperSuite) TestIssuers() {
+	tokenString, err := s.tokenGenerator.generateRSAToken(testSubject, permissionsAdmin, errorTestOptionNoError)
+	s.NoError(err)
+	authInfo := &AuthInfo{AuthToken: AddBearer(tokenString)}
+
+	claimMapper := NewDefaultJWTClaimMapper(s.tokenGenerator, &config.Authorization{Issuers: []string{"other", "test/"}}, s.logger)
+	_, err = claimMapper.GetClaims(authInfo)
+	s.NoError(err)
+
+	claimMapper = NewDefaultJWTClaimMapper(s.tokenGenerator, &config.Authorization{Issuers: []string{"other"}}, s.logger)
+	_, err = claimMapper.GetClaims(authInfo)
+	s.ErrorContains(err, "issuer mismatch")
+
+	// Defaults to the issuer URL of the key provider.
+	claimMapper = NewDefaultJWTClaimMapper(s.tokenGenerator, &config.Authorization{
+		JWTKeyProvider: config.JWTKeyProvider{IssuerURL: "https://idp.example.com"},
+	}, s.logger)
+	_, err = claimMapper.GetClaims(authInfo)
+	s.ErrorContains(err, "issuer mismatch")
+}
+
+func (s *defaultClaimMapperSuite) TestAudiences() {
+	tokenString, err := s.tokenGenerator.generateRSAToken(testSubject, permissionsAdmin, errorTestOptionNoError)
+	s.NoError(err)
+	authInfo := &AuthInfo{AuthToken: AddBearer(tokenString)}
+
+	claimMapper := NewDefaultJWTClaimMapper(s.tokenGenerator, &config.Authorization{Audiences: []string{"other", "test-audience"}}, s.logger)
+	_, err = claimMapper.GetClaims(authInfo)
+	s.NoError(err)
+
+	claimMapper = NewDefaultJWTClaimMapper(s.tokenGenerator, &config.Authorization{Audiences: []string{"other"}}, s.logger)
+	_, err = claimMapper.GetClaims(authInfo)
+	s.ErrorContains(err, "audience mismatch")
+}
+
+func (s *defaultClaimMapperSuite) TestRoleMappings() {
+	tokenString, err := s.tokenGenerator.generateTokenWithClaims(jwt.MapClaims{
+		"sub":          testSubject,
+		"exp":          time.Now().Add(time.Hour).Unix(),
+		"groups":       []interface{}{"temporal-payments-prod-write", "temporal-billing-read", "other-group", 42},
+		"realm_access": map[string]interface{}{"roles": []interface{}{"temporal-operator"}},
+		"role":         "auditor",
+	})
+	s.NoError(err)
+	claimMapper := NewDefaultJWTClaimMapper(s.tokenGenerator, &config.Authorization{
+		RoleMappings: []config.RoleMapping{
+			{Claim: "groups", Template: "temporal-{namespace}-{role}"},
+			{Claim: "realm_access.roles", Template: "temporal-operator", Namespace: primitives.SystemLocalNamespace, Role: "admin"},
+			{Claim: "role", Template: "auditor", Namespace: "payments-prod", Role: "read"},
+		},
+	}, s.logger)
+
+	claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(tokenString)})
+	s.NoError(err)
+	s.Equal(testSubject, claims.Subject)
+	s.Equal(RoleAdmin, claims.System)
+	s.Equal(map[string]Role{
+		"payments-prod": RoleWriter | RoleReader,
+		"billing":       RoleReader,
+	}, claims.Namespaces)
+}
+
+func (s *defaultClaimMapperSuite) TestGetClaimMapperFromConfigInvalidRoleMapping() {
+	cfg := config.Authorization{
+		ClaimMapper:  "default",
+		RoleMappings: []config.RoleMapping{{Claim: "groups", Template: "temporal-{role}"}},
+	}
+	_, err := GetClaimMapperFromConfig(&cfg, s.logger)
+	s.ErrorContains(err, "namespace is required")
+}
+
 func (s *defaultClaimMapperSuite) testGetClaimMapperFromConfig(name string, valid bool, cmType reflect.Type) {
 
 	cfg := config.Authorization{}
@@ -366,6 +437,12 @@ func (tg *tokenGenerator) generateToken(alg keyAlgorithm, subject string, permis
 	return "", fmt.Errorf("unexpected condition")
 }
 
+func (tg *tokenGenerator) generateTokenWithClaims(claims jwt.MapClaims) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "test-key"
+	return token.SignedString(tg.rsaPrivateKey)
+}
+
 func (tg *tokenGenerator) EcdsaKey(alg string, kid string) (*ecdsa.PublicKey, error) {
 	return tg.ecdsaPublicKey, nil
 }
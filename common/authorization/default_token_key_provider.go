@@ -30,6 +30,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -42,6 +43,15 @@ import (
 	"gopkg.in/go-jose/go-jose.v2"
 )
 
+const (
+	oidcDiscoveryPath    = "/.well-known/openid-configuration"
+	oidcDiscoveryTimeout = 10 * time.Second
+)
+
+// oidcDiscoveryClient retrieves OpenID Connect discovery documents. The timeout prevents an unresponsive issuer
+// from blocking the key refresh forever.
+var oidcDiscoveryClient = &http.Client{Timeout: oidcDiscoveryTimeout}
+
 // Default token key provider
 type defaultTokenKeyProvider struct {
 	config   config.JWTKeyProvider
@@ -64,7 +74,7 @@ func NewDefaultTokenKeyProvider(cfg *config.Authorization, logger log.Logger) *d
 func (a *defaultTokenKeyProvider) initialize() {
 	a.rsaKeys = make(map[string]*rsa.PublicKey)
 	a.ecKeys = make(map[string]*ecdsa.PublicKey)
-	if a.config.HasSourceURIsConfigured() {
+	if a.config.HasKeySourcesConfigured() {
 		err := a.updateKeys()
 		if err != nil {
 			a.logger.Error("error during initial retrieval of token keys: ", tag.Error(err))
@@ -122,7 +132,7 @@ func (a *defaultTokenKeyProvider) timerCallback() {
 			return
 		case <-a.ticker.C:
 		}
-		if a.config.HasSourceURIsConfigured() {
+		if a.config.HasKeySourcesConfigured() {
 			err := a.updateKeys()
 			if err != nil {
 				a.logger.Error("error while refreshing token keys: ", tag.Error(err))
@@ -132,14 +142,22 @@ func (a *defaultTokenKeyProvider) timerCallback() {
 }
 
 func (a *defaultTokenKeyProvider) updateKeys() error {
-	if !a.config.HasSourceURIsConfigured() {
+	if !a.config.HasKeySourcesConfigured() {
 		return fmt.Errorf("no URIs configured for retrieving token keys")
 	}
 
 	rsaKeys := make(map[string]*rsa.PublicKey)
 	ecKeys := make(map[string]*ecdsa.PublicKey)
 
-	for _, uri := range a.config.KeySourceURIs {
+	uris := a.config.KeySourceURIs
+	if issuerURL := strings.TrimSpace(a.config.IssuerURL); issuerURL != "" {
+		jwksURI, err := discoverJWKSURI(issuerURL)
+		if err != nil {
+			return err
+		}
+		uris = append(slices.Clip(uris), jwksURI)
+	}
+	for _, uri := range uris {
 		if strings.TrimSpace(uri) == "" {
 			continue
 		}
@@ -189,6 +207,36 @@ func (a *defaultTokenKeyProvider) updateKeysFromURI(
 	return nil
 }
 
+// discoverJWKSURI returns the JWKS URI from the OpenID Connect discovery document of issuerURL.
+func discoverJWKSURI(issuerURL string) (_ string, err error) {
+	resp, err := oidcDiscoveryClient.Get(strings.TrimSuffix(issuerURL, "/") + oidcDiscoveryPath)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		err = multierr.Combine(err, resp.Body.Close())
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status of OpenID Connect discovery document for %s: %s", issuerURL, resp.Status)
+	}
+
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&discovery); err != nil {
+		return "", err
+	}
+	// The issuer in the discovery document must be identical to the URL it was retrieved from.
+	if strings.TrimSuffix(discovery.Issuer, "/") != strings.TrimSuffix(issuerURL, "/") {
+		return "", fmt.Errorf("issuer mismatch in OpenID Connect discovery document: expected %s, got %s", issuerURL, discovery.Issuer)
+	}
+	if discovery.JWKSURI == "" {
+		return "", fmt.Errorf("no jwks_uri in OpenID Connect discovery document for %s", issuerURL)
+	}
+	return discovery.JWKSURI, nil
+}
+
 func (a *defaultTokenKeyProvider) HmacKey(alg string, kid string) ([]byte, error) {
 	return nil, fmt.Errorf("unsupported key type HMAC for: %s", alg)
 }
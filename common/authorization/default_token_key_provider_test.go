@@ -0,0 +1,81 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"gopkg.in/go-jose/go-jose.v2"
+)
+
+func newOIDCServer(t *testing.T, key *ecdsa.PrivateKey, issuer func(serverURL string) string) *httptest.Server {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	mux.HandleFunc(oidcDiscoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   issuer(server.URL),
+			"jwks_uri": server.URL + "/keys",
+		}))
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: "oidc-key", Algorithm: "ES256", Use: "sig"},
+		}}))
+	})
+	return server
+}
+
+func TestDefaultTokenKeyProvider_OIDCDiscovery(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	server := newOIDCServer(t, key, func(serverURL string) string { return serverURL })
+	defer server.Close()
+
+	provider := NewDefaultTokenKeyProvider(&config.Authorization{
+		JWTKeyProvider: config.JWTKeyProvider{IssuerURL: server.URL + "/"},
+	}, log.NewNoopLogger())
+
+	publicKey, err := provider.EcdsaKey(jwt.SigningMethodES256.Name, "oidc-key")
+	require.NoError(t, err)
+	require.True(t, key.PublicKey.Equal(publicKey))
+}
+
+func TestDiscoverJWKSURI_IssuerMismatch(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	server := newOIDCServer(t, key, func(string) string { return "https://other.example.com" })
+	defer server.Close()
+
+	_, err = discoverJWKSURI(server.URL)
+	require.ErrorContains(t, err, "issuer mismatch")
+}
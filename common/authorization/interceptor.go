@@ -43,8 +43,9 @@ import (
 )
 
 type (
-	contextKeyMappedClaims struct{}
-	contextKeyAuthHeader   struct{}
+	contextKeyMappedClaims     struct{}
+	contextKeyAuthHeader       struct{}
+	contextKeyDecisionObserver struct{}
 )
 
 type (
@@ -57,6 +58,10 @@ type (
 		// Exists returns nil if the namespace exists, otherwise an error.
 		Exists(name namespace.Name) error
 	}
+
+	// DecisionObserver is notified of the claims and the authorization result of a call. err is set if the
+	// claims couldn't be mapped or the authorizer failed.
+	DecisionObserver func(claims *Claims, result Result, err error)
 )
 
 const (
@@ -73,6 +78,18 @@ var (
 	AuthHeader   contextKeyAuthHeader
 )
 
+// WithDecisionObserver returns a context which makes the Interceptor report its authorization decision for the
+// call to observer.
+func WithDecisionObserver(ctx context.Context, observer DecisionObserver) context.Context {
+	return context.WithValue(ctx, contextKeyDecisionObserver{}, observer)
+}
+
+func observeDecision(ctx context.Context, claims *Claims, result Result, err error) {
+	if observer, ok := ctx.Value(contextKeyDecisionObserver{}).(DecisionObserver); ok {
+		observer(claims, result, err)
+	}
+}
+
 // TLSInfoFromContext extracts TLS information from the context's peer value.
 func TLSInfoFromContext(ctx context.Context) *credentials.TLSInfo {
 	p, ok := peer.FromContext(ctx)
@@ -154,6 +171,7 @@ func (a *Interceptor) Intercept(
 		claims, err = a.GetClaims(authInfo)
 		if err != nil {
 			a.logger.Error("Authorization error", tag.Error(err))
+			observeDecision(ctx, nil, Result{}, err)
 			// return a generic error to the caller without disclosing details
 			return nil, errUnauthorized
 		}
@@ -243,6 +261,7 @@ func (a *Interceptor) Authorize(ctx context.Context, claims *Claims, ct *CallTar
 	startTime := time.Now().UTC()
 	result, err := a.authorizer.Authorize(ctx, claims, ct)
 	metrics.ServiceAuthorizationLatency.With(mh).Record(time.Since(startTime))
+	observeDecision(ctx, claims, result, err)
 	if err != nil {
 		metrics.ServiceErrAuthorizeFailedCounter.With(mh).Record(1)
 		a.logger.Error("Authorization error", tag.Error(err))
@@ -0,0 +1,398 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/api"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultPolicyPollInterval = 10 * time.Second
+
+	policyEffectAllow = "allow"
+	policyEffectDeny  = "deny"
+	// policyDefaultRoles delegates calls that match no rule to the default, role based authorizer.
+	policyDefaultRoles = "roles"
+)
+
+type (
+	// Policy is the content of a policy file. Calls by callers whose system role grants the access required by
+	// the API (e.g. internal services) are always allowed. All other calls are evaluated against the rules: if
+	// any matching rule denies the call, it is denied; otherwise, if any matching rule allows it, it is allowed.
+	// Calls that match no rule get the Default decision, which is "deny" (the default), "allow" or "roles".
+	//
+	// Example:
+	//
+	//	default: roles
+	//	rules:
+	//	  - name: payments-start
+	//	    effect: allow
+	//	    subjects: ["team-payments"]
+	//	    namespaces: ["payments"]
+	//	    apis: ["StartWorkflowExecution", "SignalWithStartWorkflowExecution"]
+	//	    workflowTypes: ["Payment*"]
+	//	  # SignalWorkflowExecution requests have no workflow type, so signals are allowed by workflow ID.
+	//	  - name: payments-signal
+	//	    effect: allow
+	//	    subjects: ["team-payments"]
+	//	    namespaces: ["payments"]
+	//	    apis: ["SignalWorkflowExecution"]
+	//	    workflowIds: ["payment-*"]
+	//	  - name: ci-no-terminate
+	//	    effect: deny
+	//	    subjects: ["ci"]
+	//	    apis: ["TerminateWorkflowExecution", "ResetWorkflowExecution"]
+	Policy struct {
+		Default string       `yaml:"default"`
+		Rules   []PolicyRule `yaml:"rules"`
+	}
+
+	// PolicyRule matches a call if all of its non-empty conditions match. Every condition is a list of patterns
+	// (see path.Match) and matches if any of the patterns matches. APIs are matched by their method name
+	// (e.g. "StartWorkflowExecution") or by their full name.
+	//
+	// WorkflowIDs, WorkflowTypes and TaskQueues are only known for requests that contain the corresponding
+	// field. An allow rule never matches a request that lacks the field, while a deny rule always matches it,
+	// so that a deny rule can't be bypassed with a request that doesn't carry the field. Many requests only
+	// identify the workflow by its ID, so a rule with WorkflowTypes must list its APIs, and the policy is
+	// rejected if any of them matches an API whose requests have no workflow type, such as
+	// SignalWorkflowExecution.
+	PolicyRule struct {
+		Name          string   `yaml:"name"`
+		Effect        string   `yaml:"effect"`
+		Subjects      []string `yaml:"subjects"`
+		Namespaces    []string `yaml:"namespaces"`
+		APIs          []string `yaml:"apis"`
+		WorkflowIDs   []string `yaml:"workflowIds"`
+		WorkflowTypes []string `yaml:"workflowTypes"`
+		TaskQueues    []string `yaml:"taskQueues"`
+	}
+
+	policyAuthorizer struct {
+		filepath     string
+		pollInterval time.Duration
+		logger       log.Logger
+		timeSource   clock.TimeSource
+		roles        Authorizer
+
+		policy    atomic.Pointer[Policy]
+		lastCheck atomic.Int64 // unix nanos
+
+		reloadLock sync.Mutex
+		modTime    time.Time
+	}
+
+	hasWorkflowExecution interface {
+		GetWorkflowExecution() *commonpb.WorkflowExecution
+	}
+
+	hasWorkflowID interface {
+		GetWorkflowId() string
+	}
+
+	hasWorkflowType interface {
+		GetWorkflowType() *commonpb.WorkflowType
+	}
+
+	hasTaskQueue interface {
+		GetTaskQueue() *taskqueuepb.TaskQueue
+	}
+)
+
+var _ Authorizer = (*policyAuthorizer)(nil)
+
+// untypedWorkflowAPIs are the method names of the workflow service APIs whose requests have no workflow type.
+var untypedWorkflowAPIs = workflowAPIsWithoutWorkflowType()
+
+// NewPolicyAuthorizer creates an authorizer that evaluates the rules of the policy file in cfg. The file is
+// checked for changes at most once per cfg.PollInterval and reloaded when it was modified. If a modified file
+// can't be loaded, the error is logged and the previous policy stays in effect.
+func NewPolicyAuthorizer(cfg *config.AuthorizationPolicy, logger log.Logger) (Authorizer, error) {
+	return newPolicyAuthorizer(cfg, logger, clock.NewRealTimeSource())
+}
+
+func newPolicyAuthorizer(
+	cfg *config.AuthorizationPolicy,
+	logger log.Logger,
+	timeSource clock.TimeSource,
+) (*policyAuthorizer, error) {
+	if cfg.Filepath == "" {
+		return nil, errors.New("policy authorizer: policy file path is not set")
+	}
+	pollInterval := cfg.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultPolicyPollInterval
+	}
+	a := &policyAuthorizer{
+		filepath:     cfg.Filepath,
+		pollInterval: pollInterval,
+		logger:       logger,
+		timeSource:   timeSource,
+		roles:        NewDefaultAuthorizer(),
+	}
+	if err := a.reload(); err != nil {
+		return nil, err
+	}
+	a.lastCheck.Store(timeSource.Now().UnixNano())
+	return a, nil
+}
+
+// Authorize determines if an API call by given claims should be allowed or denied
+// according to the rules of the policy file. Health check APIs are always allowed.
+func (a *policyAuthorizer) Authorize(ctx context.Context, claims *Claims, target *CallTarget) (Result, error) {
+	if IsHealthCheckAPI(target.APIName) {
+		return resultAllow, nil
+	}
+	if claims != nil && claims.System >= getRequiredRole(api.GetMethodMetadata(target.APIName).Access) {
+		return resultAllow, nil
+	}
+	a.maybeReload()
+
+	policy := a.policy.Load()
+	call := newPolicyCall(claims, target)
+	var allowedBy string
+	for _, rule := range policy.Rules {
+		if !rule.matches(call) {
+			continue
+		}
+		if rule.Effect == policyEffectDeny {
+			return Result{Decision: DecisionDeny, Reason: fmt.Sprintf("denied by policy rule %q", rule.Name)}, nil
+		}
+		if allowedBy == "" {
+			allowedBy = rule.Name
+		}
+	}
+	if allowedBy != "" {
+		return resultAllow, nil
+	}
+
+	switch policy.Default {
+	case policyEffectAllow:
+		return resultAllow, nil
+	case policyDefaultRoles:
+		return a.roles.Authorize(ctx, claims, target)
+	default:
+		return resultDeny, nil
+	}
+}
+
+// maybeReload reloads the policy file if it was modified. Only one caller per poll interval checks the file.
+func (a *policyAuthorizer) maybeReload() {
+	now := a.timeSource.Now().UnixNano()
+	last := a.lastCheck.Load()
+	if now-last < a.pollInterval.Nanoseconds() || !a.lastCheck.CompareAndSwap(last, now) {
+		return
+	}
+	if err := a.reload(); err != nil {
+		a.logger.Error("Unable to reload authorization policy file.", tag.Error(err))
+	}
+}
+
+func (a *policyAuthorizer) reload() error {
+	a.reloadLock.Lock()
+	defer a.reloadLock.Unlock()
+
+	info, err := os.Stat(a.filepath)
+	if err != nil {
+		return fmt.Errorf("policy file: %s: %w", a.filepath, err)
+	}
+	if a.policy.Load() != nil && info.ModTime().Equal(a.modTime) {
+		return nil
+	}
+	contents, err := os.ReadFile(a.filepath)
+	if err != nil {
+		return fmt.Errorf("policy file: %s: %w", a.filepath, err)
+	}
+	policy, err := parsePolicy(contents)
+	if err != nil {
+		return fmt.Errorf("policy file: %s: %w", a.filepath, err)
+	}
+	a.policy.Store(policy)
+	a.modTime = info.ModTime()
+	a.logger.Info("Loaded authorization policy file.", tag.NewStringTag("policy-file", a.filepath), tag.Counter(len(policy.Rules)))
+	return nil
+}
+
+func parsePolicy(contents []byte) (*Policy, error) {
+	policy := &Policy{}
+	if err := yaml.Unmarshal(contents, policy); err != nil {
+		return nil, fmt.Errorf("decode error: %w", err)
+	}
+	policy.Default = strings.ToLower(policy.Default)
+	switch policy.Default {
+	case "":
+		policy.Default = policyEffectDeny
+	case policyEffectAllow, policyEffectDeny, policyDefaultRoles:
+	default:
+		return nil, fmt.Errorf("invalid default decision %q", policy.Default)
+	}
+	for i := range policy.Rules {
+		rule := &policy.Rules[i]
+		if rule.Name == "" {
+			rule.Name = fmt.Sprintf("#%d", i+1)
+		}
+		rule.Effect = strings.ToLower(rule.Effect)
+		if rule.Effect != policyEffectAllow && rule.Effect != policyEffectDeny {
+			return nil, fmt.Errorf("rule %q: invalid effect %q", rule.Name, rule.Effect)
+		}
+		for _, patterns := range [][]string{
+			rule.Subjects, rule.Namespaces, rule.APIs, rule.WorkflowIDs, rule.WorkflowTypes, rule.TaskQueues,
+		} {
+			for _, pattern := range patterns {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return nil, fmt.Errorf("rule %q: invalid pattern %q: %w", rule.Name, pattern, err)
+				}
+			}
+		}
+		if err := validateWorkflowTypes(rule); err != nil {
+			return nil, fmt.Errorf("rule %q: %w", rule.Name, err)
+		}
+	}
+	return policy, nil
+}
+
+// validateWorkflowTypes rejects rules that match calls by workflow type and also match APIs whose requests
+// have no workflow type: such a rule would never allow these calls, or deny all of them.
+func validateWorkflowTypes(rule *PolicyRule) error {
+	if len(rule.WorkflowTypes) == 0 {
+		return nil
+	}
+	if len(rule.APIs) == 0 {
+		return errors.New("workflowTypes requires apis")
+	}
+	for _, methodName := range untypedWorkflowAPIs {
+		if matchAny(rule.APIs, methodName) || matchAny(rule.APIs, api.WorkflowServicePrefix+methodName) {
+			return fmt.Errorf("workflowTypes can't be used with API %s, its requests have no workflow type", methodName)
+		}
+	}
+	return nil
+}
+
+func workflowAPIsWithoutWorkflowType() []string {
+	workflowType := (&commonpb.WorkflowType{}).ProtoReflect().Descriptor().FullName()
+	methods := workflowservice.File_temporal_api_workflowservice_v1_service_proto.Services().ByName("WorkflowService").Methods()
+	var methodNames []string
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.Get(i)
+		field := method.Input().Fields().ByName("workflow_type")
+		if field == nil || field.Message() == nil || field.Message().FullName() != workflowType {
+			methodNames = append(methodNames, string(method.Name()))
+		}
+	}
+	return methodNames
+}
+
+// policyCall contains the attributes of a call that rules are matched against. Optional attributes are nil if
+// the request doesn't contain them.
+type policyCall struct {
+	subject      string
+	namespace    string
+	apiName      string
+	methodName   string
+	workflowID   *string
+	workflowType *string
+	taskQueue    *string
+}
+
+func newPolicyCall(claims *Claims, target *CallTarget) policyCall {
+	call := policyCall{
+		namespace:  target.Namespace,
+		apiName:    target.APIName,
+		methodName: api.MethodName(target.APIName),
+	}
+	if claims != nil {
+		call.subject = claims.Subject
+	}
+	switch req := target.Request.(type) {
+	case hasWorkflowExecution:
+		if execution := req.GetWorkflowExecution(); execution != nil {
+			call.workflowID = &execution.WorkflowId
+		}
+	case hasWorkflowID:
+		workflowID := req.GetWorkflowId()
+		call.workflowID = &workflowID
+	}
+	if req, ok := target.Request.(hasWorkflowType); ok {
+		if workflowType := req.GetWorkflowType(); workflowType != nil {
+			call.workflowType = &workflowType.Name
+		}
+	}
+	if req, ok := target.Request.(hasTaskQueue); ok {
+		if taskQueue := req.GetTaskQueue(); taskQueue != nil {
+			call.taskQueue = &taskQueue.Name
+		}
+	}
+	return call
+}
+
+func (r *PolicyRule) matches(call policyCall) bool {
+	// A deny rule matches unknown values to fail closed.
+	matchMissing := r.Effect == policyEffectDeny
+	return matchAny(r.Subjects, call.subject) &&
+		matchAny(r.Namespaces, call.namespace) &&
+		(matchAny(r.APIs, call.methodName) || matchAny(r.APIs, call.apiName)) &&
+		matchOptional(r.WorkflowIDs, call.workflowID, matchMissing) &&
+		matchOptional(r.WorkflowTypes, call.workflowType, matchMissing) &&
+		matchOptional(r.TaskQueues, call.taskQueue, matchMissing)
+}
+
+// matchAny returns true if patterns is empty or any of the patterns matches value.
+func matchAny(patterns []string, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		// Patterns were validated when the policy was loaded.
+		if ok, _ := path.Match(pattern, value); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// matchOptional works like matchAny, but a non-empty list of patterns matches a missing value only if
+// matchMissing is true.
+func matchOptional(patterns []string, value *string, matchMissing bool) bool {
+	if value == nil {
+		return len(patterns) == 0 || matchMissing
+	}
+	return matchAny(patterns, *value)
+}
@@ -0,0 +1,316 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	testPolicy = `
+default: roles
+rules:
+  - name: payments-start
+    effect: allow
+    subjects: ["team-payments"]
+    namespaces: ["payments"]
+    apis: ["StartWorkflowExecution", "SignalWithStartWorkflowExecution"]
+    workflowTypes: ["Payment*"]
+  - name: payments-signal
+    effect: allow
+    subjects: ["team-payments"]
+    namespaces: ["payments"]
+    apis: ["/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution"]
+    workflowIds: ["payment-*"]
+  - name: ci-start
+    effect: allow
+    subjects: ["ci"]
+    apis: ["StartWorkflowExecution"]
+    taskQueues: ["ci-*"]
+  - name: ci-no-terminate
+    effect: deny
+    subjects: ["ci"]
+    apis: ["Terminate*"]
+  - name: ci-no-cancel-prod
+    effect: deny
+    subjects: ["ci"]
+    apis: ["RequestCancelWorkflowExecution"]
+    workflowIds: ["prod-*"]
+`
+	startWorkflowAPI     = "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"
+	signalWorkflowAPI    = "/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution"
+	terminateWorkflowAPI = "/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution"
+	cancelWorkflowAPI    = "/temporal.api.workflowservice.v1.WorkflowService/RequestCancelWorkflowExecution"
+)
+
+func writePolicyFile(t *testing.T, path string, contents string, modTime time.Time) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestPolicyAuthorizer_Rules(t *testing.T) {
+	policyPath := filepath.Join(t.TempDir(), "policy.yaml")
+	writePolicyFile(t, policyPath, testPolicy, time.Now())
+	authorizer, err := NewPolicyAuthorizer(&config.AuthorizationPolicy{Filepath: policyPath}, log.NewNoopLogger())
+	require.NoError(t, err)
+
+	payments := &Claims{Subject: "team-payments"}
+	ci := &Claims{Subject: "ci", Namespaces: map[string]Role{"builds": RoleAdmin}}
+	testCases := []struct {
+		name     string
+		claims   *Claims
+		target   *CallTarget
+		decision Decision
+	}{
+		{
+			name:   "allow start of matching workflow type",
+			claims: payments,
+			target: &CallTarget{
+				APIName:   startWorkflowAPI,
+				Namespace: "payments",
+				Request: &workflowservice.StartWorkflowExecutionRequest{
+					WorkflowType: &commonpb.WorkflowType{Name: "PaymentRefund"},
+				},
+			},
+			decision: DecisionAllow,
+		},
+		{
+			name:   "deny start of other workflow type",
+			claims: payments,
+			target: &CallTarget{
+				APIName:   startWorkflowAPI,
+				Namespace: "payments",
+				Request: &workflowservice.StartWorkflowExecutionRequest{
+					WorkflowType: &commonpb.WorkflowType{Name: "Invoice"},
+				},
+			},
+			decision: DecisionDeny,
+		},
+		{
+			name:   "deny start in other namespace",
+			claims: payments,
+			target: &CallTarget{
+				APIName:   startWorkflowAPI,
+				Namespace: "billing",
+				Request: &workflowservice.StartWorkflowExecutionRequest{
+					WorkflowType: &commonpb.WorkflowType{Name: "PaymentRefund"},
+				},
+			},
+			decision: DecisionDeny,
+		},
+		{
+			name:   "allow signal of matching workflow id by full API name",
+			claims: payments,
+			target: &CallTarget{
+				APIName:   signalWorkflowAPI,
+				Namespace: "payments",
+				Request: &workflowservice.SignalWorkflowExecutionRequest{
+					WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "payment-42"},
+				},
+			},
+			decision: DecisionAllow,
+		},
+		{
+			name:   "deny signal without workflow execution",
+			claims: payments,
+			target: &CallTarget{
+				APIName:   signalWorkflowAPI,
+				Namespace: "payments",
+				Request:   &workflowservice.SignalWorkflowExecutionRequest{},
+			},
+			decision: DecisionDeny,
+		},
+		{
+			name:   "allow start on matching task queue",
+			claims: &Claims{Subject: "ci"},
+			target: &CallTarget{
+				APIName:   startWorkflowAPI,
+				Namespace: "builds",
+				Request: &workflowservice.StartWorkflowExecutionRequest{
+					TaskQueue: &taskqueuepb.TaskQueue{Name: "ci-linux"},
+				},
+			},
+			decision: DecisionAllow,
+		},
+		{
+			name:   "deny rule overrides roles",
+			claims: ci,
+			target: &CallTarget{
+				APIName:   terminateWorkflowAPI,
+				Namespace: "builds",
+				Request:   &workflowservice.TerminateWorkflowExecutionRequest{},
+			},
+			decision: DecisionDeny,
+		},
+		{
+			name:   "deny rule matches request without workflow execution",
+			claims: ci,
+			target: &CallTarget{
+				APIName:   cancelWorkflowAPI,
+				Namespace: "builds",
+				Request:   &workflowservice.RequestCancelWorkflowExecutionRequest{},
+			},
+			decision: DecisionDeny,
+		},
+		{
+			name:   "deny rule doesn't match other workflow id",
+			claims: ci,
+			target: &CallTarget{
+				APIName:   cancelWorkflowAPI,
+				Namespace: "builds",
+				Request: &workflowservice.RequestCancelWorkflowExecutionRequest{
+					WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "test-42"},
+				},
+			},
+			decision: DecisionAllow,
+		},
+		{
+			name:   "allow system caller regardless of rules",
+			claims: &Claims{Subject: "ci", System: RoleAdmin},
+			target: &CallTarget{
+				APIName:   terminateWorkflowAPI,
+				Namespace: "builds",
+				Request:   &workflowservice.TerminateWorkflowExecutionRequest{},
+			},
+			decision: DecisionAllow,
+		},
+		{
+			name:   "fall back to roles when no rule matches",
+			claims: ci,
+			target: &CallTarget{
+				APIName:   signalWorkflowAPI,
+				Namespace: "builds",
+				Request:   &workflowservice.SignalWorkflowExecutionRequest{},
+			},
+			decision: DecisionAllow,
+		},
+		{
+			name:   "allow health check",
+			claims: nil,
+			target: &CallTarget{
+				APIName: "/grpc.health.v1.Health/Check",
+			},
+			decision: DecisionAllow,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := authorizer.Authorize(context.Background(), tc.claims, tc.target)
+			require.NoError(t, err)
+			require.Equal(t, tc.decision, result.Decision)
+		})
+	}
+
+	result, err := authorizer.Authorize(context.Background(), ci, &CallTarget{APIName: terminateWorkflowAPI})
+	require.NoError(t, err)
+	require.Equal(t, `denied by policy rule "ci-no-terminate"`, result.Reason)
+}
+
+func TestPolicyAuthorizer_Reload(t *testing.T) {
+	policyPath := filepath.Join(t.TempDir(), "policy.yaml")
+	modTime := time.Now().Add(-time.Hour)
+	writePolicyFile(t, policyPath, "default: deny", modTime)
+
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	authorizer, err := newPolicyAuthorizer(
+		&config.AuthorizationPolicy{Filepath: policyPath, PollInterval: time.Minute},
+		log.NewNoopLogger(),
+		timeSource,
+	)
+	require.NoError(t, err)
+
+	assertDecision := func(decision Decision) {
+		t.Helper()
+		result, err := authorizer.Authorize(context.Background(), nil, &CallTarget{APIName: startWorkflowAPI})
+		require.NoError(t, err)
+		require.Equal(t, decision, result.Decision)
+	}
+	assertDecision(DecisionDeny)
+
+	modTime = modTime.Add(time.Minute)
+	writePolicyFile(t, policyPath, "default: allow", modTime)
+	assertDecision(DecisionDeny)
+
+	timeSource.Advance(time.Minute)
+	assertDecision(DecisionAllow)
+
+	// An invalid policy file is ignored.
+	modTime = modTime.Add(time.Minute)
+	writePolicyFile(t, policyPath, "default: maybe", modTime)
+	timeSource.Advance(time.Minute)
+	assertDecision(DecisionAllow)
+
+	modTime = modTime.Add(time.Minute)
+	writePolicyFile(t, policyPath, "default: deny", modTime)
+	timeSource.Advance(time.Minute)
+	assertDecision(DecisionDeny)
+}
+
+func TestParsePolicy_Invalid(t *testing.T) {
+	for name, contents := range map[string]string{
+		"invalid yaml":                "rules: {",
+		"invalid default":             "default: maybe",
+		"invalid effect":              "rules: [{name: r, effect: perhaps}]",
+		"invalid pattern":             "rules: [{name: r, effect: allow, apis: ['[']}]",
+		"workflow types without apis": "rules: [{name: r, effect: allow, workflowTypes: ['Payment*']}]",
+		"workflow types with signal":  "rules: [{name: r, effect: allow, apis: ['Signal*'], workflowTypes: ['Payment*']}]",
+		"workflow types with full signal api name": "rules: [{name: r, effect: deny, " +
+			"apis: ['/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution'], workflowTypes: ['Payment*']}]",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := parsePolicy([]byte(contents))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestWorkflowAPIsWithoutWorkflowType(t *testing.T) {
+	require.Contains(t, untypedWorkflowAPIs, "SignalWorkflowExecution")
+	require.Contains(t, untypedWorkflowAPIs, "QueryWorkflow")
+	require.NotContains(t, untypedWorkflowAPIs, "StartWorkflowExecution")
+	require.NotContains(t, untypedWorkflowAPIs, "SignalWithStartWorkflowExecution")
+}
+
+func TestNewPolicyAuthorizer_MissingFile(t *testing.T) {
+	_, err := NewPolicyAuthorizer(&config.AuthorizationPolicy{}, log.NewNoopLogger())
+	require.Error(t, err)
+
+	_, err = NewPolicyAuthorizer(
+		&config.AuthorizationPolicy{Filepath: filepath.Join(t.TempDir(), "missing.yaml")},
+		log.NewNoopLogger(),
+	)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
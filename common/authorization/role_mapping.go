@@ -0,0 +1,174 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	roleMappingNamespacePlaceholder = "{namespace}"
+	roleMappingRolePlaceholder      = "{role}"
+)
+
+// roleMapping grants roles for the values of a claim that match a template. See config.RoleMapping.
+type roleMapping struct {
+	claimPath   []string
+	template    *regexp.Regexp
+	namespace   string
+	role        Role
+	allowSystem bool
+}
+
+func newRoleMappings(cfgs []config.RoleMapping) ([]roleMapping, error) {
+	mappings := make([]roleMapping, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		mapping, err := newRoleMapping(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("role mapping for claim %q with template %q: %w", cfg.Claim, cfg.Template, err)
+		}
+		mappings = append(mappings, mapping)
+	}
+	return mappings, nil
+}
+
+func newRoleMapping(cfg config.RoleMapping) (roleMapping, error) {
+	return buildRoleMapping(cfg, false)
+}
+
+// newWildcardRoleMapping works like newRoleMapping, but "*" in the template matches any sequence of characters
+// instead of a literal "*". It is used by the certificate claim mapper.
+func newWildcardRoleMapping(cfg config.RoleMapping) (roleMapping, error) {
+	return buildRoleMapping(cfg, true)
+}
+
+func buildRoleMapping(cfg config.RoleMapping, wildcards bool) (roleMapping, error) {
+	if cfg.Claim == "" {
+		return roleMapping{}, errors.New("claim is not set")
+	}
+	if cfg.Template == "" {
+		return roleMapping{}, errors.New("template is not set")
+	}
+	mapping := roleMapping{
+		claimPath:   strings.Split(cfg.Claim, "."),
+		allowSystem: cfg.AllowSystemNamespace,
+	}
+
+	// Placeholders and wildcards are escaped by QuoteMeta like all other special characters.
+	pattern := regexp.QuoteMeta(cfg.Template)
+	if wildcards {
+		pattern = strings.ReplaceAll(pattern, regexp.QuoteMeta("*"), ".*")
+	}
+	for placeholder, group := range map[string]string{
+		roleMappingNamespacePlaceholder: "(?P<namespace>.+)",
+		roleMappingRolePlaceholder:      "(?P<role>(?i:read|write|worker|admin))",
+	} {
+		quoted := regexp.QuoteMeta(placeholder)
+		switch strings.Count(pattern, quoted) {
+		case 0:
+		case 1:
+			pattern = strings.Replace(pattern, quoted, group, 1)
+		default:
+			return roleMapping{}, fmt.Errorf("template contains %s more than once", placeholder)
+		}
+	}
+	template, err := regexp.Compile("^" + pattern + "$")
+	if err != nil {
+		return roleMapping{}, err
+	}
+	mapping.template = template
+
+	if template.SubexpIndex("namespace") < 0 {
+		if cfg.Namespace == "" {
+			return roleMapping{}, fmt.Errorf("namespace is required if template doesn't contain %s", roleMappingNamespacePlaceholder)
+		}
+		mapping.namespace = cfg.Namespace
+	}
+	if template.SubexpIndex("role") < 0 {
+		mapping.role = permissionToRole(cfg.Role)
+		if mapping.role == RoleUndefined {
+			return roleMapping{}, fmt.Errorf("invalid role %q", cfg.Role)
+		}
+	}
+	return mapping, nil
+}
+
+// apply adds the roles granted by the values of the mapped claim in jwtClaims to claims.
+func (m *roleMapping) apply(jwtClaims jwt.MapClaims, claims *Claims) {
+	m.applyValues(claimValues(jwtClaims, m.claimPath), claims)
+}
+
+// applyValues adds the roles granted by the values that match the template to claims.
+func (m *roleMapping) applyValues(values []string, claims *Claims) {
+	for _, value := range values {
+		match := m.template.FindStringSubmatch(value)
+		if match == nil {
+			continue
+		}
+		namespace := m.namespace
+		if i := m.template.SubexpIndex("namespace"); i >= 0 {
+			namespace = match[i]
+			if namespace == permissionScopeSystem && !m.allowSystem {
+				// Cluster wide roles must not be granted by accident, e.g. by a group named after the template.
+				continue
+			}
+		}
+		role := m.role
+		if i := m.template.SubexpIndex("role"); i >= 0 {
+			role = permissionToRole(match[i])
+		}
+		AddRole(claims, namespace, role)
+	}
+}
+
+// claimValues returns the string values of the claim at path. Values that aren't strings are ignored.
+func claimValues(jwtClaims jwt.MapClaims, path []string) []string {
+	var value interface{} = map[string]interface{}(jwtClaims)
+	for _, name := range path {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[name]
+	}
+
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
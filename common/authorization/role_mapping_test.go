@@ -0,0 +1,110 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/config"
+)
+
+func TestNewRoleMapping_Invalid(t *testing.T) {
+	for name, cfg := range map[string]config.RoleMapping{
+		"no claim":            {Template: "{namespace}:{role}"},
+		"no template":         {Claim: "groups"},
+		"no namespace":        {Claim: "groups", Template: "temporal-{role}"},
+		"no role":             {Claim: "groups", Template: "temporal-{namespace}"},
+		"invalid role":        {Claim: "groups", Template: "temporal-{namespace}", Role: "owner"},
+		"duplicate namespace": {Claim: "groups", Template: "{namespace}-{namespace}-{role}"},
+		"duplicate role":      {Claim: "groups", Template: "{namespace}-{role}-{role}"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := newRoleMapping(cfg)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestRoleMapping_Apply(t *testing.T) {
+	mapping, err := newRoleMapping(config.RoleMapping{Claim: "roles", Template: "ns.{namespace}.[{role}]"})
+	require.NoError(t, err)
+
+	claims := &Claims{}
+	mapping.apply(jwt.MapClaims{"roles": []interface{}{
+		"ns.default.[ADMIN]",
+		"ns.my.namespace.[worker]",
+		"ns.default.[owner]",
+		"nsXdefaultX[read]",
+	}}, claims)
+	require.Equal(t, RoleUndefined, claims.System)
+	require.Equal(t, map[string]Role{
+		"default":      RoleAdmin,
+		"my.namespace": RoleWorker,
+	}, claims.Namespaces)
+
+	// Missing claims and claims of other types are ignored.
+	claims = &Claims{}
+	mapping.apply(jwt.MapClaims{}, claims)
+	mapping.apply(jwt.MapClaims{"roles": map[string]interface{}{"default": "admin"}}, claims)
+	require.Equal(t, &Claims{}, claims)
+}
+
+func TestRoleMapping_SystemNamespace(t *testing.T) {
+	mapping, err := newRoleMapping(config.RoleMapping{Claim: "groups", Template: "temporal-{namespace}-{role}"})
+	require.NoError(t, err)
+
+	claims := &Claims{}
+	mapping.applyValues([]string{"temporal-temporal-system-admin", "temporal-default-read"}, claims)
+	require.Equal(t, &Claims{Namespaces: map[string]Role{"default": RoleReader}}, claims)
+
+	mapping, err = newRoleMapping(config.RoleMapping{
+		Claim:                "groups",
+		Template:             "temporal-{namespace}-{role}",
+		AllowSystemNamespace: true,
+	})
+	require.NoError(t, err)
+
+	claims = &Claims{}
+	mapping.applyValues([]string{"temporal-temporal-system-admin"}, claims)
+	require.Equal(t, &Claims{System: RoleAdmin}, claims)
+}
+
+func TestRoleMapping_Wildcard(t *testing.T) {
+	cfg := config.RoleMapping{Claim: "groups", Template: "*/temporal-{role}", Namespace: "default"}
+	mapping, err := newWildcardRoleMapping(cfg)
+	require.NoError(t, err)
+
+	claims := &Claims{}
+	mapping.applyValues([]string{"eu/team-a/temporal-write", "temporal-admin", "eu/temporal-read.x"}, claims)
+	require.Equal(t, map[string]Role{"default": RoleWriter}, claims.Namespaces)
+
+	// Without wildcards, "*" only matches itself.
+	mapping, err = newRoleMapping(cfg)
+	require.NoError(t, err)
+
+	claims = &Claims{}
+	mapping.applyValues([]string{"eu/team-a/temporal-write", "*/temporal-read"}, claims)
+	require.Equal(t, map[string]Role{"default": RoleReader}, claims.Namespaces)
+}
@@ -58,3 +58,20 @@ type Claims struct {
 }
 
 // @@@SNIPEND
+
+// AddRole grants role in namespace to claims, or for the whole cluster if namespace is "temporal-system".
+func AddRole(claims *Claims, namespace string, role Role) {
+	if namespace == permissionScopeSystem {
+		claims.System |= role
+		return
+	}
+	if claims.Namespaces == nil {
+		claims.Namespaces = make(map[string]Role)
+	}
+	claims.Namespaces[namespace] |= role
+}
+
+// ParseRole returns the role for "read", "write", "worker" or "admin", ignoring case, and RoleUndefined otherwise.
+func ParseRole(role string) Role {
+	return permissionToRole(role)
+}
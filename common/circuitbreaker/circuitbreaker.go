@@ -23,6 +23,7 @@
 package circuitbreaker
 
 import (
+	"fmt"
 	"sync"
 	"sync/atomic"
 
@@ -42,6 +43,7 @@ type (
 	// that calls the settingsFn everytime the Allow function is called and replaces the circuit
 	// breaker if there is a change in the settings object. Note that in this case, the previous
 	// state of the circuit breaker is lost.
+	// The circuit breaker can also be forced open or closed, see Force.
 	TwoStepCircuitBreakerWithDynamicSettings struct {
 		name          string
 		readyToTrip   func(counts gobreaker.Counts) bool
@@ -50,8 +52,12 @@ type (
 		cb       atomic.Pointer[gobreaker.TwoStepCircuitBreaker]
 		cbLock   sync.Mutex
 		settings dynamicconfig.CircuitBreakerSettings
+		forced   atomic.Int32
 	}
 
+	// ForcedState overrides the state of a circuit breaker regardless of the outcome of requests.
+	ForcedState int32
+
 	Settings struct {
 		// For the following options, check gobreaker docs for details.
 		Name          string
@@ -60,6 +66,14 @@ type (
 	}
 )
 
+const (
+	ForcedStateNone ForcedState = iota
+	// ForcedStateOpen rejects all requests.
+	ForcedStateOpen
+	// ForcedStateClosed allows all requests. Outcomes of requests are not recorded.
+	ForcedStateClosed
+)
+
 var _ TwoStepCircuitBreaker = (*TwoStepCircuitBreakerWithDynamicSettings)(nil)
 
 // Caller must call UpdateSettings once before using this object.
@@ -82,16 +96,39 @@ func (c *TwoStepCircuitBreakerWithDynamicSettings) UpdateSettings(
 		return // no change
 	}
 	c.settings = ds
+	c.resetLocked()
+}
+
+// Reset clears a forced state and replaces the circuit breaker with a new closed one, discarding its counts.
+func (c *TwoStepCircuitBreakerWithDynamicSettings) Reset() {
+	c.cbLock.Lock()
+	defer c.cbLock.Unlock()
+	c.forced.Store(int32(ForcedStateNone))
+	c.resetLocked()
+}
+
+func (c *TwoStepCircuitBreakerWithDynamicSettings) resetLocked() {
 	c.cb.Store(gobreaker.NewTwoStepCircuitBreaker(gobreaker.Settings{
 		Name:          c.name,
-		MaxRequests:   uint32(ds.MaxRequests),
-		Interval:      ds.Interval,
-		Timeout:       ds.Timeout,
+		MaxRequests:   uint32(c.settings.MaxRequests),
+		Interval:      c.settings.Interval,
+		Timeout:       c.settings.Timeout,
 		ReadyToTrip:   c.readyToTrip,
 		OnStateChange: c.onStateChange,
 	}))
 }
 
+// Force overrides the state of the circuit breaker until it's reset or forced to a different state.
+// The underlying state and counts are kept as is and take effect again once the forced state is cleared
+// with ForcedStateNone.
+func (c *TwoStepCircuitBreakerWithDynamicSettings) Force(state ForcedState) {
+	c.forced.Store(int32(state))
+}
+
+func (c *TwoStepCircuitBreakerWithDynamicSettings) ForcedState() ForcedState {
+	return ForcedState(c.forced.Load())
+}
+
 func (c *TwoStepCircuitBreakerWithDynamicSettings) Name() string {
 	return c.cb.Load().Name()
 }
@@ -105,5 +142,25 @@ func (c *TwoStepCircuitBreakerWithDynamicSettings) Counts() gobreaker.Counts {
 }
 
 func (c *TwoStepCircuitBreakerWithDynamicSettings) Allow() (done func(success bool), err error) {
-	return c.cb.Load().Allow()
+	switch c.ForcedState() {
+	case ForcedStateOpen:
+		return nil, gobreaker.ErrOpenState
+	case ForcedStateClosed:
+		return func(bool) {}, nil
+	default:
+		return c.cb.Load().Allow()
+	}
+}
+
+func (s ForcedState) String() string {
+	switch s {
+	case ForcedStateNone:
+		return "none"
+	case ForcedStateOpen:
+		return "open"
+	case ForcedStateClosed:
+		return "closed"
+	default:
+		return fmt.Sprintf("unknown forced state %d", int32(s))
+	}
 }
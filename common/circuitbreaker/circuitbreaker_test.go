@@ -26,6 +26,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/sony/gobreaker"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -73,3 +74,50 @@ func TestDynamicSettings(t *testing.T) {
 	cb3 := tscb.cb.Load()
 	s.NotEqual(cb3, cb2)
 }
+
+func TestForce(t *testing.T) {
+	s := assert.New(t)
+
+	tscb := NewTwoStepCircuitBreakerWithDynamicSettings(Settings{
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return counts.ConsecutiveFailures > 0
+		},
+	})
+	tscb.UpdateSettings(dynamicconfig.CircuitBreakerSettings{Timeout: time.Hour})
+
+	tscb.Force(ForcedStateOpen)
+	s.Equal(ForcedStateOpen, tscb.ForcedState())
+	_, err := tscb.Allow()
+	s.ErrorIs(err, gobreaker.ErrOpenState)
+	// forced state is not reflected in the underlying state
+	s.Equal(gobreaker.StateClosed, tscb.State())
+
+	// trip the underlying circuit breaker
+	tscb.Force(ForcedStateNone)
+	doneFn, err := tscb.Allow()
+	s.NoError(err)
+	doneFn(false)
+	s.Equal(gobreaker.StateOpen, tscb.State())
+
+	tscb.Force(ForcedStateClosed)
+	doneFn, err = tscb.Allow()
+	s.NoError(err)
+	doneFn(true)
+	// outcomes are not recorded while forced
+	s.Equal(gobreaker.StateOpen, tscb.State())
+
+	// forced state survives settings changes
+	tscb.UpdateSettings(dynamicconfig.CircuitBreakerSettings{Timeout: 2 * time.Hour})
+	s.Equal(ForcedStateClosed, tscb.ForcedState())
+
+	tscb.Force(ForcedStateNone)
+	doneFn, err = tscb.Allow()
+	s.NoError(err)
+	doneFn(false)
+	s.Equal(gobreaker.StateOpen, tscb.State())
+
+	tscb.Reset()
+	s.Equal(ForcedStateNone, tscb.ForcedState())
+	s.Equal(gobreaker.StateClosed, tscb.State())
+	s.Equal(gobreaker.Counts{}, tscb.Counts())
+}
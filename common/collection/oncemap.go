@@ -58,6 +58,18 @@ func (m *OnceMap[K, T]) Get(key K) T {
 	return value
 }
 
+// Range calls fn for each constructed key and value until fn returns false.
+// fn is called while holding a read lock on the map and must not call Get.
+func (m *OnceMap[K, T]) Range(fn func(key K, value T) bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for key, value := range m.inner {
+		if !fn(key, value) {
+			return
+		}
+	}
+}
+
 // FallibleOnceMap is a concurrent map which lazily constructs its values. Map values are initialized on-the-fly, using
 // a provided construction function only when a key is accessed for the first time.
 // If the construct function returns an error, the value is not cached.
@@ -47,4 +47,11 @@ func TestOnceMap(t *testing.T) {
 
 	require.Equal(t, 2, bar1)
 	require.Equal(t, 2, bar2)
+
+	values := make(map[string]int)
+	m.Range(func(k string, v int) bool {
+		values[k] = v
+		return true
+	})
+	require.Equal(t, map[string]int{"foo": 1, "bar": 2}, values)
 }
@@ -121,6 +121,8 @@ type (
 		Metrics *metrics.Config `yaml:"metrics"`
 		// Settings for authentication and authorization
 		Authorization Authorization `yaml:"authorization"`
+		// Audit log of authorization decisions and mutating API calls
+		Audit Audit `yaml:"audit"`
 	}
 
 	// RootTLS contains all TLS settings for the Temporal server
@@ -251,6 +253,34 @@ type (
 		DataStores map[string]DataStore `yaml:"datastores"`
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// PayloadOffload contains the config for the blob store large history event payloads are offloaded to.
+		// Offloading is disabled if this is not set.
+		PayloadOffload *PayloadOffload `yaml:"payloadOffload"`
+		// PayloadOffloadThreshold is the payload size above which payloads are offloaded to the blob store
+		PayloadOffloadThreshold dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+	}
+
+	// PayloadOffload contains the config for the payload offloading blob store. Exactly one store must be set.
+	PayloadOffload struct {
+		Filestore *FilestoreBlobStore `yaml:"filestore"`
+		S3        *S3BlobStore        `yaml:"s3"`
+	}
+
+	// FilestoreBlobStore contains the config for a blob store on a local or mounted file system
+	FilestoreBlobStore struct {
+		Path     string `yaml:"path"`
+		FileMode string `yaml:"fileMode"`
+		DirMode  string `yaml:"dirMode"`
+	}
+
+	// S3BlobStore contains the config for a blob store on S3 or any S3-compatible object storage
+	S3BlobStore struct {
+		Bucket           string  `yaml:"bucket"`
+		KeyPrefix        string  `yaml:"keyPrefix"`
+		Region           string  `yaml:"region"`
+		Endpoint         *string `yaml:"endpoint"`
+		S3ForcePathStyle bool    `yaml:"s3ForcePathStyle"`
+		LogLevel         uint    `yaml:"logLevel"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -562,14 +592,137 @@ type (
 		// Signing key provider for validating JWT tokens
 		JWTKeyProvider       JWTKeyProvider `yaml:"jwtKeyProvider"`
 		PermissionsClaimName string         `yaml:"permissionsClaimName"`
-		// Empty string for noopAuthorizer or "default" for defaultAuthorizer
+		// Empty string for noopAuthorizer, "default" for defaultAuthorizer or "policy" for the policy file
+		// based authorizer configured in Policy
 		Authorizer string `yaml:"authorizer"`
-		// Empty string for noopClaimMapper or "default" for defaultJWTClaimMapper
+		// Policy file for the "policy" authorizer
+		Policy AuthorizationPolicy `yaml:"policy"`
+		// Empty string for noopClaimMapper, "default" for defaultJWTClaimMapper or "certificate" for the client
+		// certificate claim mapper configured in CertificateClaimMapper
 		ClaimMapper string `yaml:"claimMapper"`
+		// Rules and revocation checks of the "certificate" claim mapper
+		CertificateClaimMapper CertificateClaimMapper `yaml:"certificateClaimMapper"`
 		// Name of main auth header to pass to ClaimMapper (as `AuthToken`). Defaults to `authorization`.
 		AuthHeaderName string `yaml:"authHeaderName"`
 		// Name of extra auth header to pass to ClaimMapper (as `ExtraData`). Defaults to `authorization-extras`.
 		AuthExtraHeaderName string `yaml:"authExtraHeaderName"`
+		// Issuers accepted by the default claim mapper in the "iss" claim of tokens. Defaults to the issuer URL
+		// of the JWTKeyProvider if one is set, otherwise the issuer isn't validated.
+		Issuers []string `yaml:"issuers"`
+		// Audiences accepted by the default claim mapper. If set, the "aud" claim of tokens must contain at
+		// least one of them.
+		Audiences []string `yaml:"audiences"`
+		// RoleMappings map values of standard claims, such as groups or roles, to Temporal roles in the default
+		// claim mapper. They are applied in addition to the permissions claim.
+		RoleMappings []RoleMapping `yaml:"roleMappings"`
+		// Built-in API keys
+		APIKeys APIKeys `yaml:"apiKeys"`
+	}
+
+	// APIKeys contains the config for the built-in API keys, which are managed with the admin API.
+	APIKeys struct {
+		// Enabled makes the frontend accept API keys as bearer tokens in the auth header. Calls without an API
+		// key are passed to the configured claim mapper. If no claim mapper is configured, calls without an API
+		// key get no roles, except for callers with a verified client certificate (internode mTLS), which keep
+		// the system admin role. Without internode mTLS, the worker service must connect through the
+		// internal-frontend, which doesn't accept API keys. Keys can be created before they are enabled, e.g. to
+		// bootstrap the first admin key.
+		Enabled bool `yaml:"enabled"`
+	}
+
+	// RoleMapping maps the values of a claim to Temporal roles. For example, with claim "groups" and template
+	// "temporal-{namespace}-{role}", the group "temporal-payments-write" grants the writer role in the
+	// "payments" namespace.
+	RoleMapping struct {
+		// Name of the claim. Nested claims are separated by dots, e.g. "realm_access.roles". The claim must be a
+		// string or a list of strings.
+		Claim string `yaml:"claim"`
+		// Template the claim values are matched against. It may contain the {namespace} and {role} placeholders;
+		// a role is one of read, write, worker or admin. All other characters, including "*", match literally.
+		Template string `yaml:"template"`
+		// Namespace in which the role is granted if Template doesn't contain {namespace}.
+		// "temporal-system" grants the role for the whole cluster.
+		Namespace string `yaml:"namespace"`
+		// Role that is granted if Template doesn't contain {role}
+		Role string `yaml:"role"`
+		// AllowSystemNamespace allows {namespace} to match "temporal-system", which grants the role for the whole
+		// cluster. By default, such values are ignored.
+		AllowSystemNamespace bool `yaml:"allowSystemNamespace"`
+	}
+
+	// CertificateClaimMapper contains the config for the claim mapper that grants roles based on the verified
+	// client certificate of mTLS connections.
+	CertificateClaimMapper struct {
+		// Rules map fields of the client certificate to roles. The roles of all matching rules are granted.
+		Rules []CertificateMapping `yaml:"rules"`
+		// Revocation checks of client certificates against local files
+		Revocation CertificateRevocation `yaml:"revocation"`
+	}
+
+	// CertificateMapping maps the values of a client certificate field to Temporal roles. For example, with field
+	// "san-uri" and template "spiffe://example.org/temporal/{namespace}/{role}", the certificate of
+	// "spiffe://example.org/temporal/payments/worker" grants the worker role in the "payments" namespace.
+	CertificateMapping struct {
+		// Field of the certificate: "cn" (subject common name), "ou" (subject organizational units), "san-dns"
+		// or "san-uri" (DNS names or URIs of the subject alternative name)
+		Field string `yaml:"field"`
+		// Template the field values are matched against, see RoleMapping.Template. Unlike in RoleMapping, "*"
+		// matches any sequence of characters.
+		Template string `yaml:"template"`
+		// Namespace in which the role is granted if Template doesn't contain {namespace}.
+		// "temporal-system" grants the role for the whole cluster.
+		Namespace string `yaml:"namespace"`
+		// Role that is granted if Template doesn't contain {role}
+		Role string `yaml:"role"`
+		// AllowSystemNamespace allows {namespace} to match "temporal-system", see RoleMapping.AllowSystemNamespace
+		AllowSystemNamespace bool `yaml:"allowSystemNamespace"`
+	}
+
+	// CertificateRevocation contains the local revocation sources of client certificates. Calls with a revoked
+	// certificate are rejected. The files are usually kept up to date by a job that downloads them from the CA.
+	CertificateRevocation struct {
+		// Paths of PEM or DER encoded certificate revocation lists
+		CRLFiles []string `yaml:"crlFiles"`
+		// Paths of DER encoded OCSP responses
+		OCSPResponseFiles []string `yaml:"ocspResponseFiles"`
+		// How often the files are checked for changes. Defaults to 1 minute.
+		PollInterval time.Duration `yaml:"pollInterval"`
+	}
+
+	// AuthorizationPolicy contains the config for the policy file based authorizer.
+	AuthorizationPolicy struct {
+		// Path of the YAML policy file
+		Filepath string `yaml:"filepath"`
+		// How often the policy file is checked for changes. Defaults to 10 seconds.
+		PollInterval time.Duration `yaml:"pollInterval"`
+	}
+
+	// Audit contains the config for the audit log of the frontend service.
+	Audit struct {
+		// Enabled turns on writing audit records for mutating workflow, namespace and admin API calls
+		Enabled bool `yaml:"enabled"`
+		// IncludeRequest adds the request to audit records. Payloads in the request are redacted.
+		IncludeRequest bool `yaml:"includeRequest"`
+		// Sinks the audit records are written to
+		Sinks []AuditSink `yaml:"sinks"`
+	}
+
+	// AuditSink contains the config for a single audit log sink.
+	AuditSink struct {
+		// Type is one of "file", "stdout" or "http"
+		Type string `yaml:"type"`
+		// Path of the audit log file for the "file" sink
+		Filepath string `yaml:"filepath"`
+		// Size in megabytes at which the audit log file is rotated. Defaults to 100.
+		MaxSizeMB int `yaml:"maxSizeMB"`
+		// Number of rotated audit log files to keep. Defaults to 5.
+		MaxBackups int `yaml:"maxBackups"`
+		// URL that the "http" sink posts batches of newline delimited JSON records to
+		URL string `yaml:"url"`
+		// Timeout of requests sent by the "http" sink. Defaults to 10 seconds.
+		Timeout time.Duration `yaml:"timeout"`
+		// Number of records buffered by the "http" sink before records are dropped. Defaults to 1000.
+		BufferSize int `yaml:"bufferSize"`
 	}
 
 	// @@@SNIPSTART temporal-common-service-config-jwtkeyprovider
@@ -577,6 +730,9 @@ type (
 	JWTKeyProvider struct {
 		KeySourceURIs   []string      `yaml:"keySourceURIs"`
 		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// IssuerURL of an OpenID Connect provider. Keys are also loaded from the JWKS URI in the provider's
+		// discovery document at {IssuerURL}/.well-known/openid-configuration.
+		IssuerURL string `yaml:"issuerUrl"`
 	}
 	// @@@SNIPEND
 )
@@ -652,3 +808,8 @@ func (p *JWTKeyProvider) HasSourceURIsConfigured() bool {
 	}
 	return false
 }
+
+// HasKeySourcesConfigured returns true if keys are loaded from source URIs or from an OpenID Connect issuer.
+func (p *JWTKeyProvider) HasKeySourcesConfigured() bool {
+	return p.HasSourceURIsConfigured() || strings.TrimSpace(p.IssuerURL) != ""
+}
@@ -33,6 +33,7 @@ import (
 	sdkworker "go.temporal.io/sdk/worker"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/redaction"
 	"go.temporal.io/server/common/retrypolicy"
 )
 
@@ -152,6 +153,16 @@ for signal / start / signal with start API if namespace is not active`,
 		primitives.DefaultTransactionSizeLimit,
 		`TransactionSizeLimit is the largest allowed transaction size to persistence`,
 	)
+	PayloadOffloadThreshold = NewGlobalIntSetting(
+		"system.payloadOffloadThreshold",
+		256*1024,
+		`PayloadOffloadThreshold is the size in bytes above which history event payloads are offloaded to the
+blob store configured in persistence.payloadOffload. Offloading only happens if a blob store is configured,
+and a value of 0 or less disables offloading of new payloads. References are resolved whenever history events
+are read, including raw history blobs sent to remote clusters and to clients with frontend.sendRawWorkflowHistory,
+so neither needs access to the blob store. Raw history appended from replication is offloaded again with the
+threshold of the receiving cluster.`,
+	)
 	DisallowQuery = NewNamespaceBoolSetting(
 		"system.disallowQuery",
 		false,
@@ -939,6 +950,15 @@ to allow waiting on the "Accepted" lifecycle stage.`,
 		false,
 		`FrontendEnableWorkerVersioningRuleAPIs enables worker versioning in workflow progress APIs.`,
 	)
+	FrontendFieldRedaction = NewNamespaceTypedSetting(
+		"frontend.fieldRedaction",
+		redaction.Config{},
+		`FrontendFieldRedaction contains the rules for redacting memo keys, search attributes and payload fields
+in DescribeWorkflowExecution, list and history responses, based on the role of the caller in the namespace.
+Workers and internal callers always see unredacted values, and nothing is redacted if no authorizer or claim
+mapper is configured.
+Example: {MemoKeys: ["email"], SearchAttributes: ["CustomerId"], PayloadFields: ["input"], ExemptRoles: ["write", "admin"]}`,
+	)
 
 	DeleteNamespaceDeleteActivityRPS = NewGlobalIntSetting(
 		"frontend.deleteNamespaceDeleteActivityRPS",
@@ -1529,6 +1549,15 @@ If value less or equal to 0, will fall back to HistoryPersistenceNamespaceMaxQPS
 		nil, // actual default is in service/history/configs package
 		`TimerProcessorSchedulerStandbyRoundRobinWeights is the priority round robin weights used by timer task scheduler for standby namespaces`,
 	)
+	TimerProcessorSchedulerNamespaceWeight = NewNamespaceIntSetting(
+		"history.timerProcessorSchedulerNamespaceWeight",
+		1,
+		`TimerProcessorSchedulerNamespaceWeight is the weight of a namespace in the timer task scheduler. It's multiplied
+with the priority round robin weights of the namespace, so that when the host is saturated, a namespace with a higher
+weight gets a proportionally larger share of timer task processing capacity. Values less than 1 are treated as 1.
+The weight is read when the task channel of a namespace is created, and all weights are only read again when a
+namespace is added, deleted, changes state or fails over, so changes don't take effect on running hosts until then.`,
+	)
 	TimerProcessorUpdateAckInterval = NewGlobalDurationSetting(
 		"history.timerProcessorUpdateAckInterval",
 		30*time.Second,
@@ -1616,6 +1645,15 @@ If value less or equal to 0, will fall back to HistoryPersistenceNamespaceMaxQPS
 		nil, // actual default is in service/history/configs package
 		`TransferProcessorSchedulerStandbyRoundRobinWeights is the priority round robin weights used by transfer task scheduler for standby namespaces`,
 	)
+	TransferProcessorSchedulerNamespaceWeight = NewNamespaceIntSetting(
+		"history.transferProcessorSchedulerNamespaceWeight",
+		1,
+		`TransferProcessorSchedulerNamespaceWeight is the weight of a namespace in the transfer task scheduler. It's multiplied
+with the priority round robin weights of the namespace, so that when the host is saturated, a namespace with a higher
+weight gets a proportionally larger share of transfer task processing capacity. Values less than 1 are treated as 1.
+The weight is read when the task channel of a namespace is created, and all weights are only read again when a
+namespace is added, deleted, changes state or fails over, so changes don't take effect on running hosts until then.`,
+	)
 	TransferProcessorMaxPollInterval = NewGlobalDurationSetting(
 		"history.transferProcessorMaxPollInterval",
 		1*time.Minute,
@@ -1906,6 +1944,28 @@ archivalQueueProcessor`,
 		2000,
 		`WorkflowExecutionMaxTotalUpdates is the max number of updates that any given workflow execution can receive.`,
 	)
+	EnableDurableUpdateAdmission = NewNamespaceBoolSetting(
+		"history.enableDurableUpdateAdmission",
+		false,
+		`EnableDurableUpdateAdmission stores the requests of admitted updates in mutable state, so that they survive
+shard movement and mutable state reloads and are delivered to the worker in the order they were admitted. Updates
+admitted this way do not count towards WorkflowExecutionMaxInFlightUpdates but towards
+WorkflowExecutionMaxDurablyAdmittedUpdates and WorkflowExecutionMaxDurablyAdmittedUpdatesSizeBytes. The requests are not
+written to history and therefore not replicated: updates to workflows in multi-cluster namespaces are always admitted
+the regular way.`,
+	)
+	WorkflowExecutionMaxDurablyAdmittedUpdates = NewNamespaceIntSetting(
+		"history.maxDurablyAdmittedUpdates",
+		100,
+		`WorkflowExecutionMaxDurablyAdmittedUpdates is the max number of durably admitted updates that can wait to be accepted
+or rejected for any given workflow execution.`,
+	)
+	WorkflowExecutionMaxDurablyAdmittedUpdatesSizeBytes = NewNamespaceIntSetting(
+		"history.maxDurablyAdmittedUpdatesSizeBytes",
+		512*1024,
+		`WorkflowExecutionMaxDurablyAdmittedUpdatesSizeBytes is the max total size in bytes of the requests of durably
+admitted updates that are stored in the mutable state of any given workflow execution.`,
+	)
 
 	ReplicatorTaskBatchSize = NewGlobalIntSetting(
 		"history.replicatorTaskBatchSize",
@@ -1943,6 +2003,12 @@ state. The total size is determined by the sum of the size, in bytes, of each Hi
 		10000,
 		`MaximumSignalsPerExecution is max number of signals supported by single execution`,
 	)
+	MaximumPendingDelayedSignalsPerExecution = NewNamespaceIntSetting(
+		"history.maximumPendingDelayedSignalsPerExecution",
+		1000,
+		`MaximumPendingDelayedSignalsPerExecution is max number of delayed signals that have not been delivered or
+canceled yet that a single execution can host`,
+	)
 	ShardUpdateMinInterval = NewGlobalDurationSetting(
 		"history.shardUpdateMinInterval",
 		5*time.Minute,
@@ -1993,6 +2059,16 @@ where the user has set an explicit RetryPolicy, but not specified all the fields
 		primitives.DefaultHistoryMaxAutoResetPoints,
 		`HistoryMaxAutoResetPoints is the key for max number of auto reset points stored in mutableState`,
 	)
+	StripHistoryPayloadsEventThreshold = NewNamespaceIntSetting(
+		"history.stripHistoryPayloadsEventThreshold",
+		0,
+		`StripHistoryPayloadsEventThreshold enables stripping of activity payloads from workflow histories when positive.
+Activity inputs and results of events that are more than this number of events behind the end of history are replaced by
+a tombstone payload, while the events themselves and inputs of activities which are still pending are kept. Stripping runs
+in the background after a workflow task completes. Only enable for namespaces whose workers can replay histories
+containing tombstones. Stripping is best effort and only done for events of the current branch which are not shared
+with other branches.`,
+	)
 	EnableParentClosePolicy = NewNamespaceBoolSetting(
 		"history.enableParentClosePolicy",
 		true,
@@ -2412,6 +2488,44 @@ Should be at least WorkerESProcessorFlushInterval+<time to process request>.`,
 		false,
 		`ExecutionsScannerEnabled indicates if executions scanner should be started as part of worker.Scanner`,
 	)
+	VisibilityScannerEnabled = NewGlobalBoolSetting(
+		"worker.visibilityScannerEnabled",
+		false,
+		`VisibilityScannerEnabled indicates if visibility scanner should be started as part of worker.Scanner`,
+	)
+	VisibilityScannerSampleRate = NewGlobalFloatSetting(
+		"worker.visibilityScannerSampleRate",
+		0.01,
+		`VisibilityScannerSampleRate is the fraction of executions the visibility scanner compares with their visibility record`,
+	)
+	VisibilityScannerPerHostQPS = NewGlobalIntSetting(
+		"worker.visibilityScannerPerHostQPS",
+		10,
+		`VisibilityScannerPerHostQPS is the maximum rate of calls per host from visibility.Scanner`,
+	)
+	VisibilityScannerPerShardQPS = NewGlobalIntSetting(
+		"worker.visibilityScannerPerShardQPS",
+		1,
+		`VisibilityScannerPerShardQPS is the maximum rate of calls per shard from visibility.Scanner`,
+	)
+	VisibilityScannerWorkerCount = NewGlobalIntSetting(
+		"worker.visibilityScannerWorkerCount",
+		8,
+		`VisibilityScannerWorkerCount is the visibility scavenger worker count`,
+	)
+	VisibilityScannerExecutionMinAge = NewGlobalDurationSetting(
+		"worker.visibilityScannerExecutionMinAge",
+		time.Hour,
+		`VisibilityScannerExecutionMinAge is the minimum duration since the last update of an execution for the visibility
+scanner to compare it with its visibility record. Executions updated more recently are skipped since their visibility
+tasks may not have been processed yet.`,
+	)
+	VisibilityScannerRepairEnabled = NewGlobalBoolSetting(
+		"worker.visibilityScannerRepairEnabled",
+		false,
+		`VisibilityScannerRepairEnabled indicates if visibility scanner should regenerate the visibility tasks of the
+executions whose visibility record doesn't match their mutable state`,
+	)
 	HistoryScannerDataMinAge = NewGlobalDurationSetting(
 		"worker.historyScannerDataMinAge",
 		60*24*time.Hour,
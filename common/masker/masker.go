@@ -27,14 +27,20 @@ package masker
 import (
 	"reflect"
 
+	commonpb "go.temporal.io/api/common/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"gopkg.in/yaml.v3"
 )
 
 const passwordMask = "******"
 
 var (
-	DefaultFieldNames     = []string{"Password", "KeyData"}
-	DefaultYAMLFieldNames = []string{"password", "keyData"}
+	DefaultFieldNames      = []string{"Password", "KeyData"}
+	DefaultYAMLFieldNames  = []string{"password", "keyData"}
+	DefaultProtoFieldNames = []string{"password", "key_data"}
+
+	payloadDescriptor = (&commonpb.Payload{}).ProtoReflect().Descriptor()
 )
 
 // MaskYaml replace password values with mask and returns copy of the string.
@@ -88,6 +94,56 @@ func MaskStruct(strct interface{}, fieldNamesToMask []string) interface{} {
 	return strctCopyPV.Interface()
 }
 
+// MaskProto replaces the data of all payloads and the values of string fields named in fieldNamesToMask with
+// a mask and returns copy of the msg. Original msg value is not modified. Does recursive replacement for the
+// entire msg. Payload metadata (e.g. the encoding) is kept.
+func MaskProto(msg proto.Message, fieldNamesToMask []string) proto.Message {
+	if msg == nil || !msg.ProtoReflect().IsValid() {
+		return msg
+	}
+	fns := make(map[protoreflect.Name]struct{}, len(fieldNamesToMask))
+	for _, fieldName := range fieldNamesToMask {
+		fns[protoreflect.Name(fieldName)] = struct{}{}
+	}
+
+	msgCopy := proto.Clone(msg)
+	maskMessage(msgCopy.ProtoReflect(), fns)
+	return msgCopy
+}
+
+func maskMessage(m protoreflect.Message, fns map[protoreflect.Name]struct{}) {
+	if m.Descriptor() == payloadDescriptor {
+		m.Set(payloadDescriptor.Fields().ByName("data"), protoreflect.ValueOfBytes([]byte(passwordMask)))
+		return
+	}
+
+	m.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				m.Mutable(fd).Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					maskMessage(v.Message(), fns)
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				list := m.Mutable(fd).List()
+				for i := 0; i < list.Len(); i++ {
+					maskMessage(list.Get(i).Message(), fns)
+				}
+			}
+		case fd.Message() != nil:
+			maskMessage(m.Mutable(fd).Message(), fns)
+		case fd.Kind() == protoreflect.StringKind:
+			if _, ok := fns[fd.Name()]; ok {
+				m.Set(fd, protoreflect.ValueOfString(passwordMask))
+			}
+		}
+		return true
+	})
+}
+
 func pointerTo(val interface{}) reflect.Value {
 	valPtr := reflect.New(reflect.TypeOf(val))
 	valPtr.Elem().Set(reflect.ValueOf(val))
@@ -30,6 +30,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
 )
 
 func TestMaskStruct(t *testing.T) {
@@ -98,3 +100,37 @@ func TestMaskYaml(t *testing.T) {
 
 	fmt.Println(maskedYaml)
 }
+
+func TestMaskProto(t *testing.T) {
+	assert := assert.New(t)
+
+	payload := func(data string) *commonpb.Payload {
+		return &commonpb.Payload{Metadata: map[string][]byte{"encoding": []byte("json/plain")}, Data: []byte(data)}
+	}
+	request := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:  "namespace",
+		WorkflowId: "workflow-id",
+		Identity:   "identity",
+		Input:      &commonpb.Payloads{Payloads: []*commonpb.Payload{payload(`"secret-input"`)}},
+		Memo:       &commonpb.Memo{Fields: map[string]*commonpb.Payload{"key": payload(`"secret-memo"`)}},
+	}
+	masked := MaskProto(request, []string{"identity"}).(*workflowservice.StartWorkflowExecutionRequest)
+
+	assert.Equal("******", masked.Identity)
+	assert.Equal("workflow-id", masked.WorkflowId)
+	assert.Equal([]byte("******"), masked.Input.Payloads[0].Data)
+	assert.Equal([]byte("json/plain"), masked.Input.Payloads[0].Metadata["encoding"])
+	assert.Equal([]byte("******"), masked.Memo.Fields["key"].Data)
+
+	// Original request is not modified.
+	assert.Equal("identity", request.Identity)
+	assert.Equal([]byte(`"secret-input"`), request.Input.Payloads[0].Data)
+	assert.Equal([]byte(`"secret-memo"`), request.Memo.Fields["key"].Data)
+}
+
+func TestMaskProto_Nil(t *testing.T) {
+	assert.Nil(t, MaskProto(nil, DefaultProtoFieldNames))
+
+	var nilRequest *workflowservice.StartWorkflowExecutionRequest
+	assert.Equal(t, nilRequest, MaskProto(nilRequest, DefaultProtoFieldNames))
+}
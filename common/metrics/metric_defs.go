@@ -100,6 +100,8 @@ const (
 	TaskQueueScavengerScope = "TaskQueueScavenger"
 	// ExecutionsScavengerScope is scope used by all metrics emitted by worker.executions.Scavenger module
 	ExecutionsScavengerScope = "ExecutionsScavenger"
+	// VisibilityScavengerScope is scope used by all metrics emitted by worker.visibility.Scavenger module
+	VisibilityScavengerScope = "VisibilityScavenger"
 )
 
 const (
@@ -300,6 +302,8 @@ const (
 	VisibilityPersistenceScanWorkflowExecutionsScope = "ScanWorkflowExecutions"
 	// VisibilityPersistenceCountWorkflowExecutionsScope tracks CountWorkflowExecutions calls made by service to visibility persistence layer
 	VisibilityPersistenceCountWorkflowExecutionsScope = "CountWorkflowExecutions"
+	// VisibilityPersistenceAggregateWorkflowExecutionsScope tracks AggregateWorkflowExecutions calls made by service to visibility persistence layer
+	VisibilityPersistenceAggregateWorkflowExecutionsScope = "AggregateWorkflowExecutions"
 	// VisibilityPersistenceGetWorkflowExecutionScope tracks GetWorkflowExecution calls made by service to visibility persistence layer
 	VisibilityPersistenceGetWorkflowExecutionScope = "GetWorkflowExecution"
 )
@@ -699,6 +703,10 @@ var (
 		"nexus_completion_request_preprocess_errors",
 		WithDescription("The number of Nexus completion requests for which pre-processing failed."),
 	)
+	NexusEndpointAccessDenied = NewCounterDef(
+		"nexus_endpoint_access_denied",
+		WithDescription("The number of Nexus requests denied by an endpoint's caller namespace access policy."),
+	)
 	HostRPSLimit          = NewGaugeDef("host_rps_limit")
 	NamespaceHostRPSLimit = NewGaugeDef("namespace_host_rps_limit")
 
@@ -1067,6 +1075,10 @@ var (
 	ScavengerValidationRequestsCount                = NewCounterDef("scavenger_validation_requests")
 	ScavengerValidationFailuresCount                = NewCounterDef("scavenger_validation_failures")
 	ScavengerValidationSkipsCount                   = NewCounterDef("scavenger_validation_skips")
+	VisibilityScannerChecksCount                    = NewCounterDef("visibility_scanner_checks")
+	VisibilityScannerMismatchesCount                = NewCounterDef("visibility_scanner_mismatches")
+	VisibilityScannerRepairsCount                   = NewCounterDef("visibility_scanner_repairs")
+	VisibilityScannerCheckFailuresCount             = NewCounterDef("visibility_scanner_check_failures")
 	AddSearchAttributesFailuresCount                = NewCounterDef("add_search_attributes_failures")
 	DeleteNamespaceSuccessCount                     = NewCounterDef("delete_namespace_success")
 	RenameNamespaceSuccessCount                     = NewCounterDef("rename_namespace_success")
@@ -0,0 +1,97 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexus
+
+import (
+	"fmt"
+	"path"
+	"slices"
+
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
+)
+
+const (
+	// Header key for the ID of the namespace a request to an endpoint is made on behalf of. Set by the history service
+	// when calling endpoints with a worker target and checked against the endpoint's access policy by the frontend.
+	// The frontend only trusts the header on connections with system admin claims and strips it from other requests.
+	CallerNamespaceIDHeader = "Temporal-Nexus-Caller-Namespace-Id"
+)
+
+// CheckEndpointAccess evaluates an endpoint access policy for the given caller namespace, which may be nil if the
+// caller is unknown. An unknown caller is only allowed if the policy is empty.
+// Returns a PermissionDenied error if the caller is not allowed to access the endpoint.
+func CheckEndpointAccess(policy *persistencespb.NexusEndpointAccessPolicy, caller *namespace.Namespace) error {
+	if len(policy.GetAllow()) == 0 && len(policy.GetDeny()) == 0 {
+		return nil
+	}
+	if caller == nil {
+		return serviceerror.NewPermissionDenied("caller namespace is not allowed to access endpoint", "unknown caller namespace")
+	}
+	for i, rule := range policy.GetDeny() {
+		if accessRuleMatches(rule, caller) {
+			return serviceerror.NewPermissionDenied(
+				"caller namespace is not allowed to access endpoint",
+				fmt.Sprintf("matched deny rule %d", i),
+			)
+		}
+	}
+	if len(policy.GetAllow()) == 0 {
+		return nil
+	}
+	for _, rule := range policy.GetAllow() {
+		if accessRuleMatches(rule, caller) {
+			return nil
+		}
+	}
+	return serviceerror.NewPermissionDenied("caller namespace is not allowed to access endpoint", "no matching allow rule")
+}
+
+// ValidateEndpointAccessPolicy returns an error if the policy has malformed rules.
+func ValidateEndpointAccessPolicy(policy *persistencespb.NexusEndpointAccessPolicy) error {
+	for _, rule := range slices.Concat(policy.GetAllow(), policy.GetDeny()) {
+		if rule.GetNamespaceNamePattern() == "" && len(rule.GetNamespaceTags()) == 0 {
+			return fmt.Errorf("access rule must set a namespace name pattern or namespace tags") // nolint:goerr113
+		}
+		if _, err := path.Match(rule.GetNamespaceNamePattern(), ""); err != nil {
+			return fmt.Errorf("invalid namespace name pattern %q: %w", rule.GetNamespaceNamePattern(), err)
+		}
+	}
+	return nil
+}
+
+func accessRuleMatches(rule *persistencespb.NexusEndpointAccessPolicy_Rule, caller *namespace.Namespace) bool {
+	if pattern := rule.GetNamespaceNamePattern(); pattern != "" {
+		// Patterns are validated when the policy is set, a malformed pattern never matches.
+		if ok, err := path.Match(pattern, caller.Name().String()); err != nil || !ok {
+			return false
+		}
+	}
+	for k, v := range rule.GetNamespaceTags() {
+		if caller.GetCustomData(k) != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,137 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
+)
+
+func TestCheckEndpointAccess(t *testing.T) {
+	caller := namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{
+		Id:   "ns-id",
+		Name: "payments-prod",
+		Data: map[string]string{"team": "payments", "env": "prod"},
+	}, nil, "active")
+
+	cases := []struct {
+		name    string
+		policy  *persistencespb.NexusEndpointAccessPolicy
+		caller  *namespace.Namespace
+		allowed bool
+	}{
+		{
+			name:    "nil policy",
+			caller:  caller,
+			allowed: true,
+		},
+		{
+			name:    "empty policy allows unknown caller",
+			policy:  &persistencespb.NexusEndpointAccessPolicy{},
+			allowed: true,
+		},
+		{
+			name: "unknown caller",
+			policy: &persistencespb.NexusEndpointAccessPolicy{
+				Deny: []*persistencespb.NexusEndpointAccessPolicy_Rule{{NamespaceNamePattern: "test-*"}},
+			},
+			allowed: false,
+		},
+		{
+			name: "allow by name pattern",
+			policy: &persistencespb.NexusEndpointAccessPolicy{
+				Allow: []*persistencespb.NexusEndpointAccessPolicy_Rule{{NamespaceNamePattern: "payments-*"}},
+			},
+			caller:  caller,
+			allowed: true,
+		},
+		{
+			name: "no matching allow rule",
+			policy: &persistencespb.NexusEndpointAccessPolicy{
+				Allow: []*persistencespb.NexusEndpointAccessPolicy_Rule{{NamespaceNamePattern: "orders-*"}},
+			},
+			caller:  caller,
+			allowed: false,
+		},
+		{
+			name: "allow by tags",
+			policy: &persistencespb.NexusEndpointAccessPolicy{
+				Allow: []*persistencespb.NexusEndpointAccessPolicy_Rule{{NamespaceTags: map[string]string{"team": "payments"}}},
+			},
+			caller:  caller,
+			allowed: true,
+		},
+		{
+			name: "all tags must match",
+			policy: &persistencespb.NexusEndpointAccessPolicy{
+				Allow: []*persistencespb.NexusEndpointAccessPolicy_Rule{{NamespaceTags: map[string]string{"team": "payments", "env": "dev"}}},
+			},
+			caller:  caller,
+			allowed: false,
+		},
+		{
+			name: "deny takes precedence",
+			policy: &persistencespb.NexusEndpointAccessPolicy{
+				Allow: []*persistencespb.NexusEndpointAccessPolicy_Rule{{NamespaceNamePattern: "*"}},
+				Deny:  []*persistencespb.NexusEndpointAccessPolicy_Rule{{NamespaceTags: map[string]string{"env": "prod"}}},
+			},
+			caller:  caller,
+			allowed: false,
+		},
+		{
+			name: "deny only",
+			policy: &persistencespb.NexusEndpointAccessPolicy{
+				Deny: []*persistencespb.NexusEndpointAccessPolicy_Rule{{NamespaceNamePattern: "*-dev"}},
+			},
+			caller:  caller,
+			allowed: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CheckEndpointAccess(tc.policy, tc.caller)
+			if tc.allowed {
+				require.NoError(t, err)
+			} else {
+				require.ErrorAs(t, err, new(*serviceerror.PermissionDenied))
+			}
+		})
+	}
+}
+
+func TestValidateEndpointAccessPolicy(t *testing.T) {
+	require.NoError(t, ValidateEndpointAccessPolicy(nil))
+	require.NoError(t, ValidateEndpointAccessPolicy(&persistencespb.NexusEndpointAccessPolicy{
+		Allow: []*persistencespb.NexusEndpointAccessPolicy_Rule{{NamespaceNamePattern: "team-?-*"}},
+	}))
+	require.ErrorContains(t, ValidateEndpointAccessPolicy(&persistencespb.NexusEndpointAccessPolicy{
+		Deny: []*persistencespb.NexusEndpointAccessPolicy_Rule{{NamespaceNamePattern: "[a-"}},
+	}), "invalid namespace name pattern")
+	require.ErrorContains(t, ValidateEndpointAccessPolicy(&persistencespb.NexusEndpointAccessPolicy{
+		Allow: []*persistencespb.NexusEndpointAccessPolicy_Rule{{}},
+	}), "must set a namespace name pattern or namespace tags")
+}
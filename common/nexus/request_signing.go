@@ -0,0 +1,166 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexus
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// Header key for the HMAC signature of a request, see [SignRequest].
+	SignatureHeader = "Temporal-Nexus-Signature"
+)
+
+var (
+	ErrMissingSignature = errors.New("missing request signature")
+	ErrInvalidSignature = errors.New("invalid request signature")
+	ErrExpiredSignature = errors.New("request signature timestamp is outside of the allowed tolerance")
+	ErrBodyTooLarge     = errors.New("request body exceeds the maximum size allowed for signature verification")
+)
+
+// SignRequest signs a request with HMAC-SHA256 and sets the [SignatureHeader] to
+// "t=<unix seconds>,kid=<key ID>,v1=<hex encoded signature>", kid is omitted if keyID is empty.
+//
+// The signature covers "<t>\n<method>\n<request URI>\n<hex encoded SHA-256 of the body>". The receiver is expected to
+// recompute it and to reject requests with a timestamp too far off its own clock to prevent replays.
+// The body is buffered in memory to compute the signature.
+func SignRequest(r *http.Request, key []byte, keyID string, now time.Time) error {
+	var body []byte
+	if r.Body != nil && r.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(r.Body)
+		if err != nil {
+			return err
+		}
+		if err := r.Body.Close(); err != nil {
+			return err
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		r.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	var header strings.Builder
+	header.WriteString("t=" + timestamp)
+	if keyID != "" {
+		header.WriteString(",kid=" + keyID)
+	}
+	header.WriteString(",v1=" + requestSignature(key, timestamp, r.Method, r.URL.RequestURI(), body))
+	r.Header.Set(SignatureHeader, header.String())
+	return nil
+}
+
+// VerifyRequest verifies the [SignatureHeader] of a request signed with [SignRequest] using the given keys, indexed by
+// key ID. If the header specifies a key ID, only the matching key is tried, otherwise the signature is checked against
+// all keys. Passing both the current and the previous keys allows rotating keys without rejecting in-flight requests.
+// Requests with a timestamp more than tolerance away from now are rejected.
+// The body is buffered in memory and restored so it can be read again after verification, bodies larger than
+// maxBodyBytes are rejected with [ErrBodyTooLarge] without being read in full.
+func VerifyRequest(r *http.Request, keys map[string][]byte, now time.Time, tolerance time.Duration, maxBodyBytes int64) error {
+	header := r.Header.Get(SignatureHeader)
+	if header == "" {
+		return ErrMissingSignature
+	}
+	var timestamp, keyID, signature string
+	for _, part := range strings.Split(header, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return ErrInvalidSignature
+		}
+		switch k {
+		case "t":
+			timestamp = v
+		case "kid":
+			keyID = v
+		case "v1":
+			signature = v
+		}
+	}
+	if timestamp == "" || signature == "" {
+		return ErrInvalidSignature
+	}
+	unixSeconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if skew := now.Sub(time.Unix(unixSeconds, 0)); skew > tolerance || skew < -tolerance {
+		return ErrExpiredSignature
+	}
+	decodedSignature, err := hex.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	var body []byte
+	if r.Body != nil && r.Body != http.NoBody {
+		if r.ContentLength > maxBodyBytes {
+			return ErrBodyTooLarge
+		}
+		body, err = io.ReadAll(io.LimitReader(r.Body, maxBodyBytes+1))
+		if err != nil {
+			return err
+		}
+		if int64(len(body)) > maxBodyBytes {
+			return ErrBodyTooLarge
+		}
+		if err := r.Body.Close(); err != nil {
+			return err
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	matches := func(key []byte) bool {
+		expected, _ := hex.DecodeString(requestSignature(key, timestamp, r.Method, r.URL.RequestURI(), body))
+		return hmac.Equal(expected, decodedSignature)
+	}
+	if keyID != "" {
+		if key, ok := keys[keyID]; ok && matches(key) {
+			return nil
+		}
+		return ErrInvalidSignature
+	}
+	for _, key := range keys {
+		if matches(key) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+func requestSignature(key []byte, timestamp, method, requestURI string, body []byte) string {
+	bodyHash := sha256.Sum256(body)
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(timestamp + "\n" + method + "\n" + requestURI + "\n" + hex.EncodeToString(bodyHash[:])))
+	return hex.EncodeToString(mac.Sum(nil))
+}
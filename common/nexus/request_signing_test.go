@@ -0,0 +1,120 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexus
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignRequest(t *testing.T) {
+	request, err := http.NewRequest(http.MethodPost, "https://example.com/service/operation?x=1", strings.NewReader("body"))
+	require.NoError(t, err)
+
+	now := time.Unix(1700000000, 0)
+	require.NoError(t, SignRequest(request, []byte("key"), "key-1", now))
+
+	bodyHash := sha256.Sum256([]byte("body"))
+	mac := hmac.New(sha256.New, []byte("key"))
+	mac.Write([]byte("1700000000\nPOST\n/service/operation?x=1\n" + hex.EncodeToString(bodyHash[:])))
+	require.Equal(t, "t=1700000000,kid=key-1,v1="+hex.EncodeToString(mac.Sum(nil)), request.Header.Get(SignatureHeader))
+
+	// body is still readable after signing
+	body, err := io.ReadAll(request.Body)
+	require.NoError(t, err)
+	require.Equal(t, "body", string(body))
+}
+
+func TestSignRequest_NoBodyNoKeyID(t *testing.T) {
+	request, err := http.NewRequest(http.MethodGet, "https://example.com/path", nil)
+	require.NoError(t, err)
+
+	require.NoError(t, SignRequest(request, []byte("key"), "", time.Unix(1, 0)))
+	require.Equal(t, "t=1,v1="+requestSignature([]byte("key"), "1", http.MethodGet, "/path", nil), request.Header.Get(SignatureHeader))
+	require.NotContains(t, request.Header.Get(SignatureHeader), "kid=")
+}
+
+func TestVerifyRequest(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	keys := map[string][]byte{"old": []byte("old-key"), "new": []byte("new-key")}
+
+	newSignedRequest := func(t *testing.T, key []byte, keyID string, signedAt time.Time) *http.Request {
+		request, err := http.NewRequest(http.MethodPost, "https://example.com/callback?token=x", strings.NewReader("body"))
+		require.NoError(t, err)
+		require.NoError(t, SignRequest(request, key, keyID, signedAt))
+		return request
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		request := newSignedRequest(t, []byte("new-key"), "new", now)
+		require.NoError(t, VerifyRequest(request, keys, now.Add(time.Second), time.Minute, 1024))
+		// body is still readable after verification
+		body, err := io.ReadAll(request.Body)
+		require.NoError(t, err)
+		require.Equal(t, "body", string(body))
+	})
+	t.Run("previous key without key ID", func(t *testing.T) {
+		request := newSignedRequest(t, []byte("old-key"), "", now)
+		require.NoError(t, VerifyRequest(request, keys, now, time.Minute, 1024))
+	})
+	t.Run("missing", func(t *testing.T) {
+		request, err := http.NewRequest(http.MethodPost, "https://example.com/callback", nil)
+		require.NoError(t, err)
+		require.ErrorIs(t, VerifyRequest(request, keys, now, time.Minute, 1024), ErrMissingSignature)
+	})
+	t.Run("key ID mismatch", func(t *testing.T) {
+		request := newSignedRequest(t, []byte("old-key"), "new", now)
+		require.ErrorIs(t, VerifyRequest(request, keys, now, time.Minute, 1024), ErrInvalidSignature)
+	})
+	t.Run("unknown key", func(t *testing.T) {
+		request := newSignedRequest(t, []byte("other-key"), "", now)
+		require.ErrorIs(t, VerifyRequest(request, keys, now, time.Minute, 1024), ErrInvalidSignature)
+	})
+	t.Run("tampered body", func(t *testing.T) {
+		request := newSignedRequest(t, []byte("new-key"), "new", now)
+		request.Body = io.NopCloser(strings.NewReader("tampered"))
+		require.ErrorIs(t, VerifyRequest(request, keys, now, time.Minute, 1024), ErrInvalidSignature)
+	})
+	t.Run("expired", func(t *testing.T) {
+		request := newSignedRequest(t, []byte("new-key"), "new", now.Add(-2*time.Minute))
+		require.ErrorIs(t, VerifyRequest(request, keys, now, time.Minute, 1024), ErrExpiredSignature)
+	})
+	t.Run("body too large", func(t *testing.T) {
+		request := newSignedRequest(t, []byte("new-key"), "new", now)
+		request.ContentLength = -1
+		require.ErrorIs(t, VerifyRequest(request, keys, now, time.Minute, 3), ErrBodyTooLarge)
+	})
+	t.Run("malformed", func(t *testing.T) {
+		request := newSignedRequest(t, []byte("new-key"), "new", now)
+		request.Header.Set(SignatureHeader, "t=abc,v1=zz")
+		require.ErrorIs(t, VerifyRequest(request, keys, now, time.Minute, 1024), ErrInvalidSignature)
+	})
+}
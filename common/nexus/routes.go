@@ -22,7 +22,12 @@
 
 package nexus
 
-import "go.temporal.io/server/common/routing"
+import (
+	"net/url"
+	"strings"
+
+	"go.temporal.io/server/common/routing"
+)
 
 type NamespaceAndTaskQueue struct {
 	Namespace string
@@ -49,3 +54,22 @@ var RouteCompletionCallback = routing.NewBuilder[string]().
 	StringVariable("namespace", func(namespace *string) *string { return namespace }).
 	Constant("nexus", "callback").
 	Build()
+
+// CompletionCallbackNamespace extracts the target namespace name from a callback URL that ends with the
+// [RouteCompletionCallback] path. Returns false for URLs that aren't addressed to a Temporal completion callback route.
+func CompletionCallbackNamespace(callbackURL string) (string, bool) {
+	u, err := url.Parse(callbackURL)
+	if err != nil {
+		return "", false
+	}
+	segments := strings.Split(strings.TrimSuffix(u.EscapedPath(), "/"), "/")
+	n := len(segments)
+	if n < 4 || segments[n-4] != "namespaces" || segments[n-2] != "nexus" || segments[n-1] != "callback" {
+		return "", false
+	}
+	nsName, err := url.PathUnescape(segments[n-3])
+	if err != nil || nsName == "" {
+		return "", false
+	}
+	return nsName, true
+}
@@ -44,3 +44,12 @@ func ExampleRouteDispatchNexusTaskByEndpoint() {
 	fmt.Println(path)
 	// Output: nexus/endpoints/TEST-ENDPOINT/services
 }
+
+func ExampleCompletionCallbackNamespace() {
+	nsName, ok := nexus.CompletionCallbackNamespace("https://frontend:7243/namespaces/TEST-NAMESPACE/nexus/callback")
+	fmt.Println(nsName, ok)
+	_, ok = nexus.CompletionCallbackNamespace("https://example.com/callback")
+	fmt.Println(ok)
+	// Output: TEST-NAMESPACE true
+	// false
+}
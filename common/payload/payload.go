@@ -27,12 +27,20 @@ package payload
 import (
 	"bytes"
 	"maps"
+	"strconv"
 
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/sdk/converter"
 	"go.temporal.io/server/common/util"
 )
 
+const (
+	// StrippedEncoding is the encoding of tombstone payloads which replace payloads stripped from history events.
+	StrippedEncoding = "binary/stripped"
+	// StrippedSizeMetadataKey is the tombstone payload metadata key holding the size of the stripped payload.
+	StrippedSizeMetadataKey = "temporal.io/stripped-size"
+)
+
 var (
 	defaultDataConverter = converter.GetDefaultDataConverter()
 
@@ -64,6 +72,21 @@ func ToString(p *commonpb.Payload) string {
 	return defaultDataConverter.ToString(p)
 }
 
+// NewStrippedTombstone returns a tombstone payload which replaces a stripped payload of the given size.
+func NewStrippedTombstone(strippedSize int) *commonpb.Payload {
+	return &commonpb.Payload{
+		Metadata: map[string][]byte{
+			converter.MetadataEncoding: []byte(StrippedEncoding),
+			StrippedSizeMetadataKey:    []byte(strconv.Itoa(strippedSize)),
+		},
+	}
+}
+
+// IsStrippedTombstone returns true if the payload is a tombstone created by NewStrippedTombstone.
+func IsStrippedTombstone(p *commonpb.Payload) bool {
+	return string(p.GetMetadata()[converter.MetadataEncoding]) == StrippedEncoding
+}
+
 // MergeMapOfPayload returns a new map resulting from merging map `src` into `dst`.
 // If a key in `src` already exists in `dst`, then the value in `src` replaces
 // the value in `dst`.
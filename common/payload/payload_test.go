@@ -178,3 +178,15 @@ func TestIsEqual(t *testing.T) {
 	b, _ = Encode("foo")
 	s.False(isEqual(a, b))
 }
+
+func TestStrippedTombstone(t *testing.T) {
+	s := assert.New(t)
+
+	tombstone := NewStrippedTombstone(42)
+	s.True(IsStrippedTombstone(tombstone))
+	s.Empty(tombstone.GetData())
+	s.Equal("42", string(tombstone.GetMetadata()[StrippedSizeMetadataKey]))
+
+	s.False(IsStrippedTombstone(EncodeString("str")))
+	s.False(IsStrippedTombstone(nil))
+}
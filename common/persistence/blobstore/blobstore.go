@@ -0,0 +1,46 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"context"
+	"errors"
+)
+
+type (
+	// Store is a minimal key/value blob storage used to hold data that is too large to be kept inline in
+	// primary persistence. Keys are slash separated paths.
+	Store interface {
+		// Put writes the blob under the given key, overwriting any existing blob.
+		Put(ctx context.Context, key string, data []byte) error
+		// Get returns the blob stored under the given key, or ErrBlobNotFound.
+		Get(ctx context.Context, key string) ([]byte, error)
+		// Delete removes the blob stored under the given key. Deleting a missing key is not an error.
+		Delete(ctx context.Context, key string) error
+		// List returns all keys starting with the given prefix.
+		List(ctx context.Context, prefix string) ([]string, error)
+	}
+)
+
+// ErrBlobNotFound is returned by Store.Get when no blob exists for a key.
+var ErrBlobNotFound = errors.New("blob not found")
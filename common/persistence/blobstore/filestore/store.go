@@ -0,0 +1,167 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence/blobstore"
+)
+
+const (
+	defaultFileMode = os.FileMode(0o666)
+	defaultDirMode  = os.FileMode(0o766)
+)
+
+var errEmptyDirectoryPath = errors.New("directory path is empty")
+
+type (
+	fileStore struct {
+		root     string
+		fileMode os.FileMode
+		dirMode  os.FileMode
+	}
+)
+
+var _ blobstore.Store = (*fileStore)(nil)
+
+// NewStore creates a blobstore.Store which keeps blobs as files under a local (or mounted) directory.
+func NewStore(cfg *config.FilestoreBlobStore) (blobstore.Store, error) {
+	if len(cfg.Path) == 0 {
+		return nil, errEmptyDirectoryPath
+	}
+	fileMode, err := parseMode(cfg.FileMode, defaultFileMode)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file mode: %w", err)
+	}
+	dirMode, err := parseMode(cfg.DirMode, defaultDirMode)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dir mode: %w", err)
+	}
+	return &fileStore{
+		root:     filepath.Clean(cfg.Path),
+		fileMode: fileMode,
+		dirMode:  dirMode,
+	}, nil
+}
+
+func (s *fileStore) Put(_ context.Context, key string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), s.dirMode); err != nil {
+		return err
+	}
+	// Write to a temporary file first so that readers never observe a partially written blob.
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, s.fileMode); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (s *fileStore) Get(_ context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	// #nosec
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, blobstore.ErrBlobNotFound
+	}
+	return data, err
+}
+
+func (s *fileStore) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *fileStore) List(_ context.Context, prefix string) ([]string, error) {
+	// Only walk the deepest directory fully covered by the prefix.
+	dir := s.root
+	if idx := strings.LastIndex(prefix, "/"); idx >= 0 {
+		var err error
+		if dir, err = s.path(prefix[:idx]); err != nil {
+			return nil, err
+		}
+	}
+
+	var keys []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.HasSuffix(path, ".tmp") {
+			return nil
+		}
+		rel, err := filepath.Rel(s.root, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	return keys, err
+}
+
+func (s *fileStore) path(key string) (string, error) {
+	path := filepath.Join(s.root, filepath.FromSlash(key))
+	if path != s.root && !strings.HasPrefix(path, s.root+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return path, nil
+}
+
+func parseMode(mode string, defaultMode os.FileMode) (os.FileMode, error) {
+	if len(mode) == 0 {
+		return defaultMode, nil
+	}
+	m, err := strconv.ParseUint(mode, 0, 32)
+	if err != nil {
+		return 0, err
+	}
+	return os.FileMode(m), nil
+}
@@ -0,0 +1,79 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence/blobstore"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewStore(&config.FilestoreBlobStore{Path: t.TempDir()})
+	require.NoError(t, err)
+
+	_, err = store.Get(ctx, "tree/branch/1/1/0")
+	require.ErrorIs(t, err, blobstore.ErrBlobNotFound)
+
+	require.NoError(t, store.Put(ctx, "tree/branch/1/1/0", []byte("first")))
+	require.NoError(t, store.Put(ctx, "tree/branch/1/1/1", []byte("second")))
+	require.NoError(t, store.Put(ctx, "tree/other/3/2/0", []byte("third")))
+	require.NoError(t, store.Put(ctx, "tree/branch/1/1/0", []byte("overwritten")))
+
+	data, err := store.Get(ctx, "tree/branch/1/1/0")
+	require.NoError(t, err)
+	require.Equal(t, []byte("overwritten"), data)
+
+	keys, err := store.List(ctx, "tree/branch/")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"tree/branch/1/1/0", "tree/branch/1/1/1"}, keys)
+
+	keys, err = store.List(ctx, "missing/")
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	require.NoError(t, store.Delete(ctx, "tree/branch/1/1/0"))
+	require.NoError(t, store.Delete(ctx, "tree/branch/1/1/0"))
+	_, err = store.Get(ctx, "tree/branch/1/1/0")
+	require.ErrorIs(t, err, blobstore.ErrBlobNotFound)
+}
+
+func TestStore_InvalidKey(t *testing.T) {
+	store, err := NewStore(&config.FilestoreBlobStore{Path: t.TempDir()})
+	require.NoError(t, err)
+
+	err = store.Put(context.Background(), "../escape", []byte("data"))
+	require.Error(t, err)
+}
+
+func TestNewStore_InvalidConfig(t *testing.T) {
+	_, err := NewStore(&config.FilestoreBlobStore{})
+	require.Error(t, err)
+
+	_, err = NewStore(&config.FilestoreBlobStore{Path: t.TempDir(), FileMode: "not-a-mode"})
+	require.Error(t, err)
+}
@@ -0,0 +1,147 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence/blobstore"
+)
+
+var (
+	errNoBucketSpecified = errors.New("no bucket specified")
+	errEmptyAwsRegion    = errors.New("empty aws region")
+)
+
+type (
+	s3Store struct {
+		s3cli     s3iface.S3API
+		bucket    string
+		keyPrefix string
+	}
+)
+
+var _ blobstore.Store = (*s3Store)(nil)
+
+// NewStore creates a blobstore.Store backed by S3 or any S3-compatible object storage.
+func NewStore(cfg *config.S3BlobStore) (blobstore.Store, error) {
+	if len(cfg.Bucket) == 0 {
+		return nil, errNoBucketSpecified
+	}
+	if len(cfg.Region) == 0 {
+		return nil, errEmptyAwsRegion
+	}
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:         cfg.Endpoint,
+		Region:           aws.String(cfg.Region),
+		S3ForcePathStyle: aws.Bool(cfg.S3ForcePathStyle),
+		LogLevel:         (*aws.LogLevelType)(&cfg.LogLevel),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newS3Store(s3.New(sess), cfg.Bucket, cfg.KeyPrefix), nil
+}
+
+func newS3Store(s3cli s3iface.S3API, bucket string, keyPrefix string) *s3Store {
+	return &s3Store{
+		s3cli:     s3cli,
+		bucket:    bucket,
+		keyPrefix: keyPrefix,
+	}
+}
+
+func (s *s3Store) Put(ctx context.Context, key string, data []byte) error {
+	_, err := s.s3cli.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.objectKey(key)),
+		Body:   bytes.NewReader(data),
+	})
+	return err
+}
+
+func (s *s3Store) Get(ctx context.Context, key string) ([]byte, error) {
+	result, err := s.s3cli.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.objectKey(key)),
+	})
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, blobstore.ErrBlobNotFound
+		}
+		return nil, err
+	}
+	defer func() { _ = result.Body.Close() }()
+	return io.ReadAll(result.Body)
+}
+
+func (s *s3Store) Delete(ctx context.Context, key string) error {
+	_, err := s.s3cli.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.objectKey(key)),
+	})
+	if err != nil && !isNotFoundError(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *s3Store) List(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	err := s.s3cli.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(s.objectKey(prefix)),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, object := range page.Contents {
+			keys = append(keys, aws.StringValue(object.Key)[len(s.objectKey("")):])
+		}
+		return true
+	})
+	return keys, err
+}
+
+func (s *s3Store) objectKey(key string) string {
+	if len(s.keyPrefix) == 0 {
+		return key
+	}
+	// path.Join would strip a trailing slash which is significant for prefixes.
+	return path.Clean(s.keyPrefix) + "/" + key
+}
+
+func isNotFoundError(err error) bool {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code() == s3.ErrCodeNoSuchKey || awsErr.Code() == "NotFound"
+	}
+	return false
+}
@@ -0,0 +1,144 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/archiver/s3store/mocks"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence/blobstore"
+	"go.uber.org/mock/gomock"
+)
+
+const testBucket = "test-bucket"
+
+// newEmulatedS3 returns an S3API mock which keeps objects in the given map, keyed by object key.
+func newEmulatedS3(t *testing.T, objects map[string][]byte) *mocks.MockS3API {
+	s3cli := mocks.NewMockS3API(gomock.NewController(t))
+	s3cli.EXPECT().PutObjectWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *s3.PutObjectInput, _ ...request.Option) (*s3.PutObjectOutput, error) {
+			require.Equal(t, testBucket, aws.StringValue(input.Bucket))
+			data, err := io.ReadAll(input.Body)
+			require.NoError(t, err)
+			objects[aws.StringValue(input.Key)] = data
+			return &s3.PutObjectOutput{}, nil
+		}).AnyTimes()
+	s3cli.EXPECT().GetObjectWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *s3.GetObjectInput, _ ...request.Option) (*s3.GetObjectOutput, error) {
+			data, ok := objects[aws.StringValue(input.Key)]
+			if !ok {
+				return nil, awserr.New(s3.ErrCodeNoSuchKey, "no such key", nil)
+			}
+			return &s3.GetObjectOutput{Body: io.NopCloser(strings.NewReader(string(data)))}, nil
+		}).AnyTimes()
+	s3cli.EXPECT().DeleteObjectWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *s3.DeleteObjectInput, _ ...request.Option) (*s3.DeleteObjectOutput, error) {
+			delete(objects, aws.StringValue(input.Key))
+			return &s3.DeleteObjectOutput{}, nil
+		}).AnyTimes()
+	s3cli.EXPECT().ListObjectsV2PagesWithContext(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *s3.ListObjectsV2Input, fn func(*s3.ListObjectsV2Output, bool) bool, _ ...request.Option) error {
+			var keys []string
+			for key := range objects {
+				if strings.HasPrefix(key, aws.StringValue(input.Prefix)) {
+					keys = append(keys, key)
+				}
+			}
+			sort.Strings(keys)
+			// Return one object per page to exercise pagination.
+			for i, key := range keys {
+				page := &s3.ListObjectsV2Output{Contents: []*s3.Object{{Key: aws.String(key)}}}
+				if !fn(page, i == len(keys)-1) {
+					break
+				}
+			}
+			return nil
+		}).AnyTimes()
+	return s3cli
+}
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	objects := make(map[string][]byte)
+	store := newS3Store(newEmulatedS3(t, objects), testBucket, "")
+
+	_, err := store.Get(ctx, "tree/branch/1/1/0")
+	require.ErrorIs(t, err, blobstore.ErrBlobNotFound)
+
+	require.NoError(t, store.Put(ctx, "tree/branch/1/1/0", []byte("first")))
+	require.NoError(t, store.Put(ctx, "tree/branch/1/1/1", []byte("second")))
+	require.NoError(t, store.Put(ctx, "tree/other/3/2/0", []byte("third")))
+	require.NoError(t, store.Put(ctx, "tree/branch/1/1/0", []byte("overwritten")))
+
+	data, err := store.Get(ctx, "tree/branch/1/1/0")
+	require.NoError(t, err)
+	require.Equal(t, []byte("overwritten"), data)
+
+	keys, err := store.List(ctx, "tree/branch/")
+	require.NoError(t, err)
+	require.Equal(t, []string{"tree/branch/1/1/0", "tree/branch/1/1/1"}, keys)
+
+	keys, err = store.List(ctx, "missing/")
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	require.NoError(t, store.Delete(ctx, "tree/branch/1/1/0"))
+	require.NoError(t, store.Delete(ctx, "tree/branch/1/1/0"))
+	_, err = store.Get(ctx, "tree/branch/1/1/0")
+	require.ErrorIs(t, err, blobstore.ErrBlobNotFound)
+}
+
+func TestStore_KeyPrefix(t *testing.T) {
+	ctx := context.Background()
+	objects := make(map[string][]byte)
+	store := newS3Store(newEmulatedS3(t, objects), testBucket, "offload/")
+
+	require.NoError(t, store.Put(ctx, "tree/branch/1/1/0", []byte("data")))
+	require.Contains(t, objects, "offload/tree/branch/1/1/0")
+
+	keys, err := store.List(ctx, "tree/")
+	require.NoError(t, err)
+	require.Equal(t, []string{"tree/branch/1/1/0"}, keys)
+
+	data, err := store.Get(ctx, "tree/branch/1/1/0")
+	require.NoError(t, err)
+	require.Equal(t, []byte("data"), data)
+}
+
+func TestNewStore_InvalidConfig(t *testing.T) {
+	_, err := NewStore(&config.S3BlobStore{Region: "us-east-1"})
+	require.ErrorIs(t, err, errNoBucketSpecified)
+
+	_, err = NewStore(&config.S3BlobStore{Bucket: testBucket})
+	require.ErrorIs(t, err, errEmptyAwsRegion)
+}
@@ -25,20 +25,27 @@
 package client
 
 import (
+	"errors"
 	"time"
 
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/blobstore"
+	"go.temporal.io/server/common/persistence/blobstore/filestore"
+	"go.temporal.io/server/common/persistence/blobstore/s3store"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/quotas"
 )
 
 var (
+	errInvalidPayloadOffloadConfig = errors.New("exactly one of filestore or s3 must be configured for payload offloading")
+
 	retryPolicy               = common.CreatePersistenceClientRetryPolicy()
 	namespaceQueueRetryPolicy = backoff.NewConstantDelayRetryPolicy(time.Millisecond * 50).WithMaximumAttempts(10)
 )
@@ -199,7 +206,12 @@ func (f *factoryImpl) NewExecutionManager() (persistence.ExecutionManager, error
 		return nil, err
 	}
 
-	result := persistence.NewExecutionManager(store, f.serializer, f.eventBlobCache, f.logger, f.config.TransactionSizeLimit)
+	payloadOffloader, err := f.newPayloadOffloader()
+	if err != nil {
+		return nil, err
+	}
+
+	result := persistence.NewExecutionManager(store, f.serializer, f.eventBlobCache, f.logger, f.config.TransactionSizeLimit, payloadOffloader)
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
 		result = persistence.NewExecutionPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
 	}
@@ -251,6 +263,32 @@ func (f *factoryImpl) NewNexusEndpointManager() (persistence.NexusEndpointManage
 	return result, nil
 }
 
+func (f *factoryImpl) newPayloadOffloader() (serialization.PayloadOffloader, error) {
+	if f.config.PayloadOffload == nil {
+		return nil, nil
+	}
+
+	var store blobstore.Store
+	var err error
+	switch cfg := f.config.PayloadOffload; {
+	case cfg.Filestore != nil && cfg.S3 == nil:
+		store, err = filestore.NewStore(cfg.Filestore)
+	case cfg.S3 != nil && cfg.Filestore == nil:
+		store, err = s3store.NewStore(cfg.S3)
+	default:
+		err = errInvalidPayloadOffloadConfig
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	threshold := f.config.PayloadOffloadThreshold
+	if threshold == nil {
+		threshold = dynamicconfig.PayloadOffloadThreshold.Get(dynamicconfig.NewNoopCollection())
+	}
+	return serialization.NewPayloadOffloader(store, threshold), nil
+}
+
 // Close closes this factory
 func (f *factoryImpl) Close() {
 	f.dataStoreFactory.Close()
@@ -774,6 +774,10 @@ type (
 		TransactionID int64
 		// NodeID is the first event id.
 		NodeID int64
+		// KeepOffloadedPayloads is set when rewriting an existing node read with KeepOffloadedPayloads. The history
+		// may reference payloads offloaded for the node being overwritten, so payloads are not offloaded again and
+		// no offloaded payload is deleted if the append fails.
+		KeepOffloadedPayloads bool
 	}
 
 	// ReadHistoryBranchRequest is used to read a history branch
@@ -791,6 +795,9 @@ type (
 		PageSize int
 		// Token to continue reading next page of history append transactions.  Pass in empty slice for first page
 		NextPageToken []byte
+		// KeepOffloadedPayloads is only used by ReadRawHistoryBranch. If set, references to offloaded payloads
+		// are returned as is instead of being resolved.
+		KeepOffloadedPayloads bool
 	}
 
 	// ReadHistoryBranchResponse is the response to ReadHistoryBranchRequest
@@ -820,6 +827,9 @@ type (
 		LastFirstTransactionID int64
 		// Token to continue reading next page of history append transactions.  Pass in empty slice for first page
 		NextPageToken []byte
+		// KeepOffloadedPayloads is only used by ReadRawHistoryBranch. If set, references to offloaded payloads
+		// are returned as is instead of being resolved.
+		KeepOffloadedPayloads bool
 	}
 
 	// ReadHistoryBranchResponse is the response to ReadHistoryBranchRequest
@@ -854,6 +864,10 @@ type (
 		HistoryEventBlobs []*commonpb.DataBlob
 		// NodeIDs is the first event id of each history blob
 		NodeIDs []int64
+		// TransactionIDs is the transaction id of each history blob
+		TransactionIDs []int64
+		// PrevTransactionIDs is the transaction id of the history blob preceding each history blob
+		PrevTransactionIDs []int64
 		// Token to read next page if there are more events beyond page size.
 		// Use this to set NextPageToken on ReadHistoryBranchRequest to read the next page.
 		// Empty means we have reached the last page, not need to continue
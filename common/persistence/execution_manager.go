@@ -55,6 +55,7 @@ type (
 		logger                log.Logger
 		pagingTokenSerializer *jsonHistoryTokenSerializer
 		transactionSizeLimit  dynamicconfig.IntPropertyFn
+		payloadOffloader      serialization.PayloadOffloader
 	}
 )
 
@@ -67,6 +68,7 @@ func NewExecutionManager(
 	eventBlobCache XDCCache,
 	logger log.Logger,
 	transactionSizeLimit dynamicconfig.IntPropertyFn,
+	payloadOffloader serialization.PayloadOffloader,
 ) ExecutionManager {
 	return &executionManagerImpl{
 		serializer:            serializer,
@@ -75,6 +77,7 @@ func NewExecutionManager(
 		logger:                logger,
 		pagingTokenSerializer: newJSONHistoryTokenSerializer(),
 		transactionSizeLimit:  transactionSizeLimit,
+		payloadOffloader:      payloadOffloader,
 	}
 }
 
@@ -91,7 +94,7 @@ func (m *executionManagerImpl) GetHistoryBranchUtil() HistoryBranchUtil {
 func (m *executionManagerImpl) CreateWorkflowExecution(
 	ctx context.Context,
 	request *CreateWorkflowExecutionRequest,
-) (*CreateWorkflowExecutionResponse, error) {
+) (_ *CreateWorkflowExecutionResponse, retErr error) {
 
 	newSnapshot := request.NewWorkflowSnapshot
 	newWorkflowXDCKVs, newWorkflowNewEvents, newHistoryDiff, err := m.serializeWorkflowEventBatches(
@@ -103,6 +106,12 @@ func (m *executionManagerImpl) CreateWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	writeAttempted := false
+	defer func() {
+		if retErr != nil && (!writeAttempted || isDefiniteWriteFailure(retErr)) {
+			m.deleteNodeOffloadedPayloads(ctx, newWorkflowNewEvents...)
+		}
+	}()
 
 	newSnapshot.ExecutionInfo.ExecutionStats.HistorySize += int64(newHistoryDiff.SizeDiff)
 
@@ -134,6 +143,7 @@ func (m *executionManagerImpl) CreateWorkflowExecution(
 		NewWorkflowNewEvents:     newWorkflowNewEvents,
 	}
 
+	writeAttempted = true
 	if _, err := m.persistence.CreateWorkflowExecution(ctx, newRequest); err != nil {
 		return nil, err
 	}
@@ -149,7 +159,7 @@ func (m *executionManagerImpl) CreateWorkflowExecution(
 func (m *executionManagerImpl) UpdateWorkflowExecution(
 	ctx context.Context,
 	request *UpdateWorkflowExecutionRequest,
-) (*UpdateWorkflowExecutionResponse, error) {
+) (_ *UpdateWorkflowExecutionResponse, retErr error) {
 
 	updateMutation := request.UpdateWorkflowMutation
 	newSnapshot := request.NewWorkflowSnapshot
@@ -163,10 +173,17 @@ func (m *executionManagerImpl) UpdateWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	var newWorkflowNewEvents []*InternalAppendHistoryNodesRequest
+	writeAttempted := false
+	defer func() {
+		if retErr != nil && (!writeAttempted || isDefiniteWriteFailure(retErr)) {
+			m.deleteNodeOffloadedPayloads(ctx, updateWorkflowNewEvents...)
+			m.deleteNodeOffloadedPayloads(ctx, newWorkflowNewEvents...)
+		}
+	}()
 	updateMutation.ExecutionInfo.ExecutionStats.HistorySize += int64(updateWorkflowHistoryDiff.SizeDiff)
 
 	var newWorkflowXDCKVs map[XDCCacheKey]XDCCacheValue
-	var newWorkflowNewEvents []*InternalAppendHistoryNodesRequest
 	var newWorkflowHistoryDiff *HistoryStatistics
 	if newSnapshot != nil {
 		newWorkflowXDCKVs, newWorkflowNewEvents, newWorkflowHistoryDiff, err = m.serializeWorkflowEventBatches(
@@ -219,6 +236,7 @@ func (m *executionManagerImpl) UpdateWorkflowExecution(
 		NewWorkflowNewEvents:    newWorkflowNewEvents,
 	}
 
+	writeAttempted = true
 	err = m.persistence.UpdateWorkflowExecution(ctx, newRequest)
 	switch err.(type) {
 	case nil:
@@ -253,7 +271,7 @@ func (m *executionManagerImpl) UpdateWorkflowExecution(
 func (m *executionManagerImpl) ConflictResolveWorkflowExecution(
 	ctx context.Context,
 	request *ConflictResolveWorkflowExecutionRequest,
-) (*ConflictResolveWorkflowExecutionResponse, error) {
+) (_ *ConflictResolveWorkflowExecutionResponse, retErr error) {
 
 	resetSnapshot := request.ResetWorkflowSnapshot
 	newSnapshot := request.NewWorkflowSnapshot
@@ -268,10 +286,19 @@ func (m *executionManagerImpl) ConflictResolveWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	var newWorkflowEvents []*InternalAppendHistoryNodesRequest
+	var currentWorkflowEvents []*InternalAppendHistoryNodesRequest
+	writeAttempted := false
+	defer func() {
+		if retErr != nil && (!writeAttempted || isDefiniteWriteFailure(retErr)) {
+			m.deleteNodeOffloadedPayloads(ctx, resetWorkflowEvents...)
+			m.deleteNodeOffloadedPayloads(ctx, newWorkflowEvents...)
+			m.deleteNodeOffloadedPayloads(ctx, currentWorkflowEvents...)
+		}
+	}()
 	resetSnapshot.ExecutionInfo.ExecutionStats.HistorySize += int64(resetWorkflowHistoryDiff.SizeDiff)
 
 	var newWorkflowXDCKVs map[XDCCacheKey]XDCCacheValue
-	var newWorkflowEvents []*InternalAppendHistoryNodesRequest
 	var newWorkflowHistoryDiff *HistoryStatistics
 	if newSnapshot != nil {
 		newWorkflowXDCKVs, newWorkflowEvents, newWorkflowHistoryDiff, err = m.serializeWorkflowEventBatches(
@@ -287,7 +314,6 @@ func (m *executionManagerImpl) ConflictResolveWorkflowExecution(
 	}
 
 	var currentWorkflowXDCKVs map[XDCCacheKey]XDCCacheValue
-	var currentWorkflowEvents []*InternalAppendHistoryNodesRequest
 	var currentWorkflowHistoryDiff *HistoryStatistics
 	if currentMutation != nil {
 		currentWorkflowXDCKVs, currentWorkflowEvents, currentWorkflowHistoryDiff, err = m.serializeWorkflowEventBatches(
@@ -346,6 +372,7 @@ func (m *executionManagerImpl) ConflictResolveWorkflowExecution(
 		CurrentWorkflowEventsNewEvents: currentWorkflowEvents,
 	}
 
+	writeAttempted = true
 	err = m.persistence.ConflictResolveWorkflowExecution(ctx, newRequest)
 	switch err.(type) {
 	case nil:
@@ -462,8 +489,9 @@ func (m *executionManagerImpl) serializeWorkflowEventBatches(
 	xdcKVs := make(map[XDCCacheKey]XDCCacheValue, len(eventBatches))
 	workflowNewEvents := make([]*InternalAppendHistoryNodesRequest, 0, len(eventBatches))
 	for _, workflowEvents := range eventBatches {
-		newEvents, err := m.serializeWorkflowEvents(shardID, workflowEvents)
+		newEvents, err := m.serializeWorkflowEvents(ctx, shardID, workflowEvents)
 		if err != nil {
+			m.deleteNodeOffloadedPayloads(ctx, workflowNewEvents...)
 			return nil, nil, nil, err
 		}
 		versionHistoryItems, _, baseWorkflowInfo, err := GetXDCCacheValue(
@@ -472,6 +500,7 @@ func (m *executionManagerImpl) serializeWorkflowEventBatches(
 			workflowEvents.Events[0].Version,
 		)
 		if err != nil {
+			m.deleteNodeOffloadedPayloads(ctx, append(workflowNewEvents, newEvents)...)
 			return nil, nil, nil, err
 		}
 		xdcKVs[NewXDCCacheKey(
@@ -525,6 +554,7 @@ func (m *executionManagerImpl) DeserializeBufferedEvents( // unexport
 }
 
 func (m *executionManagerImpl) serializeWorkflowEvents(
+	ctx context.Context,
 	shardID int32,
 	workflowEvents *WorkflowEvents,
 ) (*InternalAppendHistoryNodesRequest, error) {
@@ -545,7 +575,7 @@ func (m *executionManagerImpl) serializeWorkflowEvents(
 		request.Info = BuildHistoryGarbageCleanupInfo(workflowEvents.NamespaceID, workflowEvents.WorkflowID, workflowEvents.RunID)
 	}
 
-	return m.serializeAppendHistoryNodesRequest(request)
+	return m.serializeAppendHistoryNodesRequest(ctx, request)
 }
 
 func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
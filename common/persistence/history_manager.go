@@ -36,6 +36,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
@@ -225,7 +226,20 @@ findDeleteRanges:
 		ShardID:      request.ShardID,
 		BranchRanges: deleteRanges,
 	}
-	return m.persistence.DeleteHistoryBranch(ctx, req)
+	if err := m.persistence.DeleteHistoryBranch(ctx, req); err != nil {
+		return err
+	}
+
+	// Offloaded payloads are owned by the history nodes they were written with, so they go away
+	// with exactly the ranges which are no longer referenced by any other branch.
+	if m.payloadOffloader != nil {
+		for _, br := range deleteRanges {
+			if err := m.payloadOffloader.DeleteHistoryBranchRange(ctx, branch.TreeId, br.BranchId, br.BeginNodeId); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // TrimHistoryBranch trims a branch
@@ -321,6 +335,16 @@ func (m *executionManagerImpl) TrimHistoryBranch(
 		}); err != nil {
 			return nil, fmt.Errorf("unable to delete history nodes: %w", err)
 		}
+		if m.payloadOffloader != nil {
+			if err := m.payloadOffloader.DeleteHistoryNode(ctx, serialization.HistoryNodeKey{
+				TreeID:        node.branchInfo.TreeId,
+				BranchID:      node.branchInfo.BranchId,
+				NodeID:        node.nodeID,
+				TransactionID: node.transactionID,
+			}); err != nil {
+				return nil, fmt.Errorf("unable to delete offloaded payloads: %w", err)
+			}
+		}
 	}
 
 	return &TrimHistoryBranchResponse{}, nil
@@ -341,8 +365,9 @@ func (m *executionManagerImpl) deserializeBranchInfos(
 }
 
 func (m *executionManagerImpl) serializeAppendHistoryNodesRequest(
+	ctx context.Context,
 	request *AppendHistoryNodesRequest,
-) (*InternalAppendHistoryNodesRequest, error) {
+) (_ *InternalAppendHistoryNodesRequest, retErr error) {
 	branch, err := m.GetHistoryBranchUtil().ParseHistoryBranchInfo(request.BranchToken)
 	if err != nil {
 		return nil, err
@@ -378,8 +403,27 @@ func (m *executionManagerImpl) serializeAppendHistoryNodesRequest(
 		lastID++
 	}
 
+	events := request.Events
+	if m.payloadOffloader != nil {
+		nodeKey := serialization.HistoryNodeKey{
+			TreeID:        branch.TreeId,
+			BranchID:      branch.BranchId,
+			NodeID:        nodeID,
+			TransactionID: request.TransactionID,
+		}
+		events, err = m.payloadOffloader.OffloadEvents(ctx, nodeKey, events)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if retErr != nil {
+				m.deleteOffloadedPayloads(ctx, nodeKey)
+			}
+		}()
+	}
+
 	// nodeID will be the first eventID
-	blob, err := m.serializer.SerializeEvents(request.Events, enumspb.ENCODING_TYPE_PROTO3)
+	blob, err := m.serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
 	if err != nil {
 		return nil, err
 	}
@@ -431,7 +475,7 @@ func (m *executionManagerImpl) serializeAppendHistoryNodesRequest(
 func (m *executionManagerImpl) serializeAppendRawHistoryNodesRequest(
 	ctx context.Context,
 	request *AppendRawHistoryNodesRequest,
-) (*InternalAppendHistoryNodesRequest, error) {
+) (_ *InternalAppendHistoryNodesRequest, retErr error) {
 	branch, err := m.GetHistoryBranchUtil().ParseHistoryBranchInfo(request.BranchToken)
 	if err != nil {
 		return nil, err
@@ -450,8 +494,30 @@ func (m *executionManagerImpl) serializeAppendRawHistoryNodesRequest(
 			Msg: "eventID cannot be less than 1",
 		}
 	}
+	history := request.History
+	if m.payloadOffloader != nil && !request.KeepOffloadedPayloads {
+		// raw history is received with all payloads resolved, see ReadRawHistoryBranch
+		nodeKey := serialization.HistoryNodeKey{
+			TreeID:        branch.TreeId,
+			BranchID:      branch.BranchId,
+			NodeID:        nodeID,
+			TransactionID: request.TransactionID,
+		}
+		history, err = m.payloadOffloader.OffloadEventsBlob(ctx, nodeKey, history)
+		if err != nil {
+			return nil, err
+		}
+		if history != request.History {
+			defer func() {
+				if retErr != nil {
+					m.deleteOffloadedPayloads(ctx, nodeKey)
+				}
+			}()
+		}
+	}
+
 	// nodeID will be the first eventID
-	size := len(request.History.Data)
+	size := len(history.Data)
 	sizeLimit := m.transactionSizeLimit()
 	if size > sizeLimit {
 		return nil, &TransactionSizeLimitError{
@@ -466,7 +532,7 @@ func (m *executionManagerImpl) serializeAppendRawHistoryNodesRequest(
 		BranchInfo:  branch,
 		Node: InternalHistoryNode{
 			NodeID:            nodeID,
-			Events:            request.History,
+			Events:            history,
 			PrevTransactionID: request.PrevTransactionID,
 			TransactionID:     request.TransactionID,
 		},
@@ -502,13 +568,16 @@ func (m *executionManagerImpl) AppendHistoryNodes(
 	request *AppendHistoryNodesRequest,
 ) (*AppendHistoryNodesResponse, error) {
 
-	req, err := m.serializeAppendHistoryNodesRequest(request)
+	req, err := m.serializeAppendHistoryNodesRequest(ctx, request)
 
 	if err != nil {
 		return nil, err
 	}
 
 	err = m.persistence.AppendHistoryNodes(ctx, req)
+	if isDefiniteWriteFailure(err) {
+		m.deleteNodeOffloadedPayloads(ctx, req)
+	}
 
 	return &AppendHistoryNodesResponse{
 		Size: len(req.Node.Events.Data),
@@ -527,8 +596,11 @@ func (m *executionManagerImpl) AppendRawHistoryNodes(
 	}
 
 	err = m.persistence.AppendHistoryNodes(ctx, req)
+	if isDefiniteWriteFailure(err) && !request.KeepOffloadedPayloads {
+		m.deleteNodeOffloadedPayloads(ctx, req)
+	}
 	return &AppendHistoryNodesResponse{
-		Size: len(request.History.Data),
+		Size: len(req.Node.Events.Data),
 	}, err
 }
 
@@ -566,21 +638,39 @@ func (m *executionManagerImpl) ReadRawHistoryBranch(
 	request *ReadHistoryBranchRequest,
 ) (*ReadRawHistoryBranchResponse, error) {
 
-	dataBlobs, _, nodeIDs, token, dataSize, err := m.readRawHistoryBranchAndFilter(ctx, request)
+	dataBlobs, transactionIDs, prevTransactionIDs, nodeIDs, token, dataSize, err := m.readRawHistoryBranchAndFilter(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 
+	// Raw history leaves this cluster through replication and the raw history APIs, so references to
+	// offloaded payloads have to be resolved here as the receiving side can't access the blob store.
+	if m.payloadOffloader != nil && !request.KeepOffloadedPayloads {
+		for i, blob := range dataBlobs {
+			if !serialization.HasOffloadedPayloads(blob) {
+				continue
+			}
+			resolved, err := m.resolveRawEvents(ctx, blob)
+			if err != nil {
+				return nil, err
+			}
+			dataSize += len(resolved.Data) - len(blob.Data)
+			dataBlobs[i] = resolved
+		}
+	}
+
 	nextPageToken, err := m.serializeToken(token, false)
 	if err != nil {
 		return nil, err
 	}
 
 	return &ReadRawHistoryBranchResponse{
-		HistoryEventBlobs: dataBlobs,
-		NodeIDs:           nodeIDs,
-		NextPageToken:     nextPageToken,
-		Size:              dataSize,
+		HistoryEventBlobs:  dataBlobs,
+		NodeIDs:            nodeIDs,
+		TransactionIDs:     transactionIDs,
+		PrevTransactionIDs: prevTransactionIDs,
+		NextPageToken:      nextPageToken,
+		Size:               dataSize,
 	}, nil
 }
 
@@ -746,7 +836,7 @@ func (m *executionManagerImpl) readRawHistoryBranchReverse(
 func (m *executionManagerImpl) readRawHistoryBranchAndFilter(
 	ctx context.Context,
 	request *ReadHistoryBranchRequest,
-) ([]*commonpb.DataBlob, []int64, []int64, *historyPagingToken, int, error) {
+) ([]*commonpb.DataBlob, []int64, []int64, []int64, *historyPagingToken, int, error) {
 
 	shardID := request.ShardID
 	branchToken := request.BranchToken
@@ -755,7 +845,7 @@ func (m *executionManagerImpl) readRawHistoryBranchAndFilter(
 
 	branch, err := m.GetHistoryBranchUtil().ParseHistoryBranchInfo(branchToken)
 	if err != nil {
-		return nil, nil, nil, nil, 0, err
+		return nil, nil, nil, nil, nil, 0, err
 	}
 	branchID := branch.BranchId
 	branchAncestors := branch.Ancestors
@@ -777,7 +867,7 @@ func (m *executionManagerImpl) readRawHistoryBranchAndFilter(
 		defaultLastTransactionID,
 	)
 	if err != nil {
-		return nil, nil, nil, nil, 0, err
+		return nil, nil, nil, nil, nil, 0, err
 	}
 
 	nodes, token, err := m.readRawHistoryBranch(
@@ -792,10 +882,10 @@ func (m *executionManagerImpl) readRawHistoryBranchAndFilter(
 		false,
 	)
 	if err != nil {
-		return nil, nil, nil, nil, 0, err
+		return nil, nil, nil, nil, nil, 0, err
 	}
 	if len(nodes) == 0 && len(request.NextPageToken) == 0 {
-		return nil, nil, nil, nil, 0, serviceerror.NewNotFound("Workflow execution history not found.")
+		return nil, nil, nil, nil, nil, 0, serviceerror.NewNotFound("Workflow execution history not found.")
 	}
 
 	nodes, err = m.filterHistoryNodes(
@@ -804,11 +894,12 @@ func (m *executionManagerImpl) readRawHistoryBranchAndFilter(
 		nodes,
 	)
 	if err != nil {
-		return nil, nil, nil, nil, 0, err
+		return nil, nil, nil, nil, nil, 0, err
 	}
 
 	var dataBlobs []*commonpb.DataBlob
 	transactionIDs := make([]int64, 0, len(nodes))
+	prevTransactionIDs := make([]int64, 0, len(nodes))
 	nodeIDs := make([]int64, 0, len(nodes))
 	dataSize := 0
 	if len(nodes) > 0 {
@@ -817,6 +908,7 @@ func (m *executionManagerImpl) readRawHistoryBranchAndFilter(
 			dataBlobs[index] = node.Events
 			dataSize += len(node.Events.Data)
 			transactionIDs = append(transactionIDs, node.TransactionID)
+			prevTransactionIDs = append(prevTransactionIDs, node.PrevTransactionID)
 			nodeIDs = append(nodeIDs, node.NodeID)
 		}
 		lastNode := nodes[len(nodes)-1]
@@ -824,7 +916,7 @@ func (m *executionManagerImpl) readRawHistoryBranchAndFilter(
 		token.LastTransactionID = lastNode.TransactionID
 	}
 
-	return dataBlobs, transactionIDs, nodeIDs, token, dataSize, nil
+	return dataBlobs, transactionIDs, prevTransactionIDs, nodeIDs, token, dataSize, nil
 }
 
 func (m *executionManagerImpl) readRawHistoryBranchReverseAndFilter(
@@ -922,7 +1014,7 @@ func (m *executionManagerImpl) readHistoryBranch(
 	request *ReadHistoryBranchRequest,
 ) ([]*historypb.HistoryEvent, []*historypb.History, []int64, []byte, int, error) {
 
-	dataBlobs, transactionIDs, _, token, dataSize, err := m.readRawHistoryBranchAndFilter(ctx, request)
+	dataBlobs, transactionIDs, _, _, token, dataSize, err := m.readRawHistoryBranchAndFilter(ctx, request)
 	if err != nil {
 		return nil, nil, nil, nil, 0, err
 	}
@@ -931,7 +1023,7 @@ func (m *executionManagerImpl) readHistoryBranch(
 	historyEventBatches := make([]*historypb.History, 0, request.PageSize)
 
 	for _, batch := range dataBlobs {
-		events, err := m.serializer.DeserializeEvents(batch)
+		events, err := m.deserializeEvents(ctx, batch)
 		if err != nil {
 			return nil, nil, nil, nil, dataSize, err
 		}
@@ -991,7 +1083,7 @@ func (m *executionManagerImpl) readHistoryBranchReverse(
 	historyEvents := make([]*historypb.HistoryEvent, 0, request.PageSize)
 
 	for _, batch := range dataBlobs {
-		events, err := m.serializer.DeserializeEvents(batch)
+		events, err := m.deserializeEvents(ctx, batch)
 		if err != nil {
 			return nil, nil, nil, dataSize, err
 		}
@@ -1034,6 +1126,84 @@ func (m *executionManagerImpl) readHistoryBranchReverse(
 	return historyEvents, transactionIDs, nextPageToken, dataSize, nil
 }
 
+// deserializeEvents deserializes a batch of events and resolves payloads which were offloaded when it was appended.
+func (m *executionManagerImpl) deserializeEvents(
+	ctx context.Context,
+	batch *commonpb.DataBlob,
+) ([]*historypb.HistoryEvent, error) {
+	events, err := m.serializer.DeserializeEvents(batch)
+	if err != nil {
+		return nil, err
+	}
+	if m.payloadOffloader != nil {
+		if err := m.payloadOffloader.ResolveEvents(ctx, events); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// resolveRawEvents returns a copy of the serialized batch of events with all offloaded payloads resolved.
+func (m *executionManagerImpl) resolveRawEvents(
+	ctx context.Context,
+	blob *commonpb.DataBlob,
+) (*commonpb.DataBlob, error) {
+	events, err := m.deserializeEvents(ctx, blob)
+	if err != nil {
+		return nil, err
+	}
+	return m.serializer.SerializeEvents(events, blob.EncodingType)
+}
+
+// deleteNodeOffloadedPayloads deletes the payloads offloaded for history nodes which failed to be appended.
+func (m *executionManagerImpl) deleteNodeOffloadedPayloads(
+	ctx context.Context,
+	nodes ...*InternalAppendHistoryNodesRequest,
+) {
+	if m.payloadOffloader == nil {
+		return
+	}
+	for _, node := range nodes {
+		if node == nil || !serialization.HasOffloadedPayloads(node.Node.Events) {
+			continue
+		}
+		m.deleteOffloadedPayloads(ctx, serialization.HistoryNodeKey{
+			TreeID:        node.BranchInfo.TreeId,
+			BranchID:      node.BranchInfo.BranchId,
+			NodeID:        node.Node.NodeID,
+			TransactionID: node.Node.TransactionID,
+		})
+	}
+}
+
+func (m *executionManagerImpl) deleteOffloadedPayloads(
+	ctx context.Context,
+	nodeKey serialization.HistoryNodeKey,
+) {
+	// best effort, the blobs are unreferenced and only waste space if they remain
+	if err := m.payloadOffloader.DeleteHistoryNode(ctx, nodeKey); err != nil {
+		m.logger.Warn("unable to delete offloaded payloads of failed history append",
+			tag.Value(nodeKey),
+			tag.Error(err),
+		)
+	}
+}
+
+// isDefiniteWriteFailure returns true if err guarantees that the history nodes written with the failed request
+// are never going to be read. Timeouts and unknown errors are ambiguous as the write may have succeeded.
+func isDefiniteWriteFailure(err error) bool {
+	if IsConflictErr(err) {
+		return true
+	}
+	switch err.(type) {
+	case *ShardOwnershipLostError,
+		*InvalidPersistenceRequestError,
+		*TransactionSizeLimitError:
+		return true
+	}
+	return false
+}
+
 func (m *executionManagerImpl) reverseSlice(events []*historypb.HistoryEvent) []*historypb.HistoryEvent {
 	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
 		events[i], events[j] = events[j], events[i]
@@ -0,0 +1,186 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/blobstore"
+	"go.temporal.io/server/common/persistence/blobstore/filestore"
+	"go.temporal.io/server/common/persistence/mock"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.uber.org/mock/gomock"
+)
+
+type offloadTestEnv struct {
+	store       *mock.MockExecutionStore
+	blobStore   blobstore.Store
+	manager     persistence.ExecutionManager
+	branchToken []byte
+}
+
+func newOffloadTestEnv(t *testing.T) *offloadTestEnv {
+	blobStore, err := filestore.NewStore(&config.FilestoreBlobStore{Path: t.TempDir()})
+	require.NoError(t, err)
+	store := mock.NewMockExecutionStore(gomock.NewController(t))
+	branchUtil := &persistence.HistoryBranchUtilImpl{}
+	store.EXPECT().GetHistoryBranchUtil().Return(branchUtil).AnyTimes()
+	branchToken, err := branchUtil.NewHistoryBranch("", "", "", "tree", nil, nil, 0, 0, 0)
+	require.NoError(t, err)
+	return &offloadTestEnv{
+		store:     store,
+		blobStore: blobStore,
+		manager: persistence.NewExecutionManager(
+			store,
+			serialization.NewSerializer(),
+			nil,
+			log.NewNoopLogger(),
+			dynamicconfig.GetIntPropertyFn(1024*1024),
+			serialization.NewPayloadOffloader(blobStore, dynamicconfig.GetIntPropertyFn(100)),
+		),
+		branchToken: branchToken,
+	}
+}
+
+func (e *offloadTestEnv) blobKeys(t *testing.T) []string {
+	keys, err := e.blobStore.List(context.Background(), "")
+	require.NoError(t, err)
+	return keys
+}
+
+func largeInputEvents() []*historypb.HistoryEvent {
+	return []*historypb.HistoryEvent{{
+		EventId:   1,
+		Version:   1,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+			WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+				Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{{Data: []byte(strings.Repeat("a", 1000))}}},
+			},
+		},
+	}}
+}
+
+func TestAppendHistoryNodes_DeletesOffloadedPayloadsOnDefiniteFailure(t *testing.T) {
+	env := newOffloadTestEnv(t)
+	env.store.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).Return(&persistence.ShardOwnershipLostError{})
+
+	_, err := env.manager.AppendHistoryNodes(context.Background(), &persistence.AppendHistoryNodesRequest{
+		IsNewBranch:   true,
+		BranchToken:   env.branchToken,
+		Events:        largeInputEvents(),
+		TransactionID: 1,
+	})
+	require.Error(t, err)
+	require.Empty(t, env.blobKeys(t))
+}
+
+func TestAppendHistoryNodes_KeepsOffloadedPayloadsOnAmbiguousFailure(t *testing.T) {
+	env := newOffloadTestEnv(t)
+	env.store.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).Return(&persistence.TimeoutError{})
+
+	_, err := env.manager.AppendHistoryNodes(context.Background(), &persistence.AppendHistoryNodesRequest{
+		IsNewBranch:   true,
+		BranchToken:   env.branchToken,
+		Events:        largeInputEvents(),
+		TransactionID: 1,
+	})
+	require.Error(t, err)
+	// the node may have been written, so its payloads must still be resolvable
+	require.Len(t, env.blobKeys(t), 1)
+}
+
+func TestReadRawHistoryBranch_ResolvesOffloadedPayloads(t *testing.T) {
+	env := newOffloadTestEnv(t)
+	var appended *persistence.InternalAppendHistoryNodesRequest
+	env.store.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.InternalAppendHistoryNodesRequest) error {
+			appended = request
+			return nil
+		})
+	_, err := env.manager.AppendHistoryNodes(context.Background(), &persistence.AppendHistoryNodesRequest{
+		IsNewBranch:   true,
+		BranchToken:   env.branchToken,
+		Events:        largeInputEvents(),
+		TransactionID: 1,
+	})
+	require.NoError(t, err)
+	require.True(t, serialization.HasOffloadedPayloads(appended.Node.Events))
+
+	env.store.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).Return(&persistence.InternalReadHistoryBranchResponse{
+		Nodes: []persistence.InternalHistoryNode{appended.Node},
+	}, nil)
+	resp, err := env.manager.ReadRawHistoryBranch(context.Background(), &persistence.ReadHistoryBranchRequest{
+		BranchToken: env.branchToken,
+		MinEventID:  1,
+		MaxEventID:  2,
+		PageSize:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.HistoryEventBlobs, 1)
+	require.False(t, serialization.HasOffloadedPayloads(resp.HistoryEventBlobs[0]))
+
+	events, err := serialization.NewSerializer().DeserializeEvents(resp.HistoryEventBlobs[0])
+	require.NoError(t, err)
+	require.Equal(t,
+		largeInputEvents()[0].GetWorkflowExecutionStartedEventAttributes().GetInput().GetPayloads()[0].GetData(),
+		events[0].GetWorkflowExecutionStartedEventAttributes().GetInput().GetPayloads()[0].GetData(),
+	)
+	require.Equal(t, len(resp.HistoryEventBlobs[0].Data), resp.Size)
+}
+
+func TestAppendRawHistoryNodes_OffloadsPayloads(t *testing.T) {
+	env := newOffloadTestEnv(t)
+	var appended *persistence.InternalAppendHistoryNodesRequest
+	env.store.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.InternalAppendHistoryNodesRequest) error {
+			appended = request
+			return nil
+		})
+	blob, err := serialization.NewSerializer().SerializeEvents(largeInputEvents(), enumspb.ENCODING_TYPE_PROTO3)
+	require.NoError(t, err)
+
+	resp, err := env.manager.AppendRawHistoryNodes(context.Background(), &persistence.AppendRawHistoryNodesRequest{
+		IsNewBranch:   true,
+		BranchToken:   env.branchToken,
+		History:       blob,
+		NodeID:        1,
+		TransactionID: 1,
+	})
+	require.NoError(t, err)
+	require.True(t, serialization.HasOffloadedPayloads(appended.Node.Events))
+	require.Equal(t, len(appended.Node.Events.Data), resp.Size)
+	require.Len(t, env.blobKeys(t), 1)
+}
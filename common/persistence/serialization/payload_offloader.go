@@ -0,0 +1,331 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence/blobstore"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// OffloadedPayloadMetadataKey is the payload metadata key holding the blob store key of an offloaded payload.
+	// A payload carrying this key has no data of its own.
+	OffloadedPayloadMetadataKey = "temporal.io/offloaded-payload"
+)
+
+var (
+	payloadsFullName                 = (&commonpb.Payloads{}).ProtoReflect().Descriptor().FullName()
+	offloadedPayloadMetadataKeyBytes = []byte(OffloadedPayloadMetadataKey)
+)
+
+type (
+	// PayloadOffloader swaps large payloads in history events for references to a blob store and resolves
+	// those references again when events are read back.
+	//
+	// Offloaded blobs are owned by the history node they were written with, so they are deleted together
+	// with the node. Nodes shared between branches after a fork are only deleted once no branch references
+	// them anymore, see ExecutionManager.DeleteHistoryBranch.
+	PayloadOffloader interface {
+		// OffloadEvents returns events in which every payload larger than the threshold is replaced by a
+		// reference. Events containing offloaded payloads are copied, the given events are never modified.
+		// Blobs are written before the node is appended, so callers must delete them with DeleteHistoryNode
+		// if the append fails. If offloading itself fails, the blobs already written are deleted.
+		OffloadEvents(ctx context.Context, node HistoryNodeKey, events []*historypb.HistoryEvent) ([]*historypb.HistoryEvent, error)
+		// OffloadEventsBlob is OffloadEvents for serialized events. The given blob is returned as is if no payload
+		// is offloaded.
+		OffloadEventsBlob(ctx context.Context, node HistoryNodeKey, blob *commonpb.DataBlob) (*commonpb.DataBlob, error)
+		// ResolveEvents replaces all payload references in the given events with the original payloads in place.
+		ResolveEvents(ctx context.Context, events []*historypb.HistoryEvent) error
+		// DeleteHistoryNode deletes the blobs offloaded for a single history node transaction.
+		DeleteHistoryNode(ctx context.Context, node HistoryNodeKey) error
+		// DeleteHistoryBranchRange deletes the blobs offloaded for all nodes of a branch starting at beginNodeID.
+		DeleteHistoryBranchRange(ctx context.Context, treeID string, branchID string, beginNodeID int64) error
+	}
+
+	// HistoryNodeKey identifies the history node a batch of events is written to.
+	HistoryNodeKey struct {
+		TreeID        string
+		BranchID      string
+		NodeID        int64
+		TransactionID int64
+	}
+
+	payloadOffloaderImpl struct {
+		store      blobstore.Store
+		threshold  dynamicconfig.IntPropertyFn
+		serializer Serializer
+	}
+)
+
+// NewPayloadOffloader creates a PayloadOffloader which offloads payloads to the given blob store.
+func NewPayloadOffloader(
+	store blobstore.Store,
+	threshold dynamicconfig.IntPropertyFn,
+) PayloadOffloader {
+	return &payloadOffloaderImpl{
+		store:      store,
+		threshold:  threshold,
+		serializer: NewSerializer(),
+	}
+}
+
+// IsOffloadedPayload returns true if the payload is a reference to an offloaded payload.
+func IsOffloadedPayload(payload *commonpb.Payload) bool {
+	_, ok := payload.GetMetadata()[OffloadedPayloadMetadataKey]
+	return ok
+}
+
+// HasOffloadedPayloads returns true if the serialized events in blob may contain references to offloaded payloads.
+// It is a cheap check on the raw bytes which can be used to skip deserializing blobs without any references.
+func HasOffloadedPayloads(blob *commonpb.DataBlob) bool {
+	return bytes.Contains(blob.GetData(), offloadedPayloadMetadataKeyBytes)
+}
+
+func (o *payloadOffloaderImpl) OffloadEvents(
+	ctx context.Context,
+	node HistoryNodeKey,
+	events []*historypb.HistoryEvent,
+) ([]*historypb.HistoryEvent, error) {
+	threshold := o.threshold()
+	if threshold <= 0 {
+		return events, nil
+	}
+	result, _, err := o.offloadEvents(ctx, node, events, threshold)
+	return result, err
+}
+
+func (o *payloadOffloaderImpl) OffloadEventsBlob(
+	ctx context.Context,
+	node HistoryNodeKey,
+	blob *commonpb.DataBlob,
+) (*commonpb.DataBlob, error) {
+	threshold := o.threshold()
+	if threshold <= 0 || len(blob.Data) <= threshold {
+		// no single payload can exceed the threshold
+		return blob, nil
+	}
+	events, err := o.serializer.DeserializeEvents(blob)
+	if err != nil {
+		return nil, err
+	}
+	events, offloaded, err := o.offloadEvents(ctx, node, events, threshold)
+	if err != nil || !offloaded {
+		return blob, err
+	}
+	result, err := o.serializer.SerializeEvents(events, blob.EncodingType)
+	if err != nil {
+		_ = o.DeleteHistoryNode(ctx, node)
+		return nil, err
+	}
+	return result, nil
+}
+
+// offloadEvents implements OffloadEvents and additionally returns whether any payload was offloaded.
+func (o *payloadOffloaderImpl) offloadEvents(
+	ctx context.Context,
+	node HistoryNodeKey,
+	events []*historypb.HistoryEvent,
+	threshold int,
+) ([]*historypb.HistoryEvent, bool, error) {
+
+	var result []*historypb.HistoryEvent
+	blobIndex := 0
+	for i, event := range events {
+		if !hasPayloadLargerThan(event, threshold) {
+			continue
+		}
+		if result == nil {
+			result = make([]*historypb.HistoryEvent, len(events))
+			copy(result, events)
+		}
+		event = proto.Clone(event).(*historypb.HistoryEvent)
+		err := visitPayloads(event.ProtoReflect(), func(payloads *commonpb.Payloads) error {
+			for j, payload := range payloads.Payloads {
+				if proto.Size(payload) <= threshold {
+					continue
+				}
+				data, err := proto.Marshal(payload)
+				if err != nil {
+					return NewSerializationError(0, err)
+				}
+				key := node.blobKey(blobIndex)
+				blobIndex++
+				if err := o.store.Put(ctx, key, data); err != nil {
+					return fmt.Errorf("unable to offload payload: %w", err)
+				}
+				payloads.Payloads[j] = &commonpb.Payload{
+					Metadata: map[string][]byte{OffloadedPayloadMetadataKey: []byte(key)},
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			// Blobs written before the failure are not referenced by any node.
+			_ = o.DeleteHistoryNode(ctx, node)
+			return nil, false, err
+		}
+		result[i] = event
+	}
+	if result == nil {
+		return events, false, nil
+	}
+	return result, true, nil
+}
+
+func (o *payloadOffloaderImpl) ResolveEvents(
+	ctx context.Context,
+	events []*historypb.HistoryEvent,
+) error {
+	for _, event := range events {
+		err := visitPayloads(event.ProtoReflect(), func(payloads *commonpb.Payloads) error {
+			for j, payload := range payloads.Payloads {
+				if !IsOffloadedPayload(payload) {
+					continue
+				}
+				key := string(payload.Metadata[OffloadedPayloadMetadataKey])
+				data, err := o.store.Get(ctx, key)
+				if errors.Is(err, blobstore.ErrBlobNotFound) {
+					return serviceerror.NewDataLoss(fmt.Sprintf("offloaded payload %v not found", key))
+				} else if err != nil {
+					return fmt.Errorf("unable to resolve offloaded payload: %w", err)
+				}
+				resolved := &commonpb.Payload{}
+				if err := proto.Unmarshal(data, resolved); err != nil {
+					return NewDeserializationError(0, err)
+				}
+				payloads.Payloads[j] = resolved
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *payloadOffloaderImpl) DeleteHistoryNode(
+	ctx context.Context,
+	node HistoryNodeKey,
+) error {
+	return o.deleteByPrefix(ctx, node.blobKeyPrefix(), func(string) bool { return true })
+}
+
+func (o *payloadOffloaderImpl) DeleteHistoryBranchRange(
+	ctx context.Context,
+	treeID string,
+	branchID string,
+	beginNodeID int64,
+) error {
+	prefix := treeID + "/" + branchID + "/"
+	return o.deleteByPrefix(ctx, prefix, func(key string) bool {
+		nodeID, err := strconv.ParseInt(strings.SplitN(strings.TrimPrefix(key, prefix), "/", 2)[0], 10, 64)
+		return err == nil && nodeID >= beginNodeID
+	})
+}
+
+func (o *payloadOffloaderImpl) deleteByPrefix(
+	ctx context.Context,
+	prefix string,
+	filter func(key string) bool,
+) error {
+	keys, err := o.store.List(ctx, prefix)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if !filter(key) {
+			continue
+		}
+		if err := o.store.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k HistoryNodeKey) blobKeyPrefix() string {
+	return fmt.Sprintf("%s/%s/%d/%d/", k.TreeID, k.BranchID, k.NodeID, k.TransactionID)
+}
+
+func (k HistoryNodeKey) blobKey(index int) string {
+	return k.blobKeyPrefix() + strconv.Itoa(index)
+}
+
+func hasPayloadLargerThan(event *historypb.HistoryEvent, threshold int) bool {
+	errFound := errors.New("found")
+	err := visitPayloads(event.ProtoReflect(), func(payloads *commonpb.Payloads) error {
+		for _, payload := range payloads.Payloads {
+			if proto.Size(payload) > threshold {
+				return errFound
+			}
+		}
+		return nil
+	})
+	return err != nil
+}
+
+// visitPayloads calls fn for every Payloads message nested in msg. Only payloads held in Payloads messages
+// (inputs, results, details, ...) are visited; single payloads in headers, memos and search attributes are not.
+func visitPayloads(msg protoreflect.Message, fn func(*commonpb.Payloads) error) error {
+	if msg.Descriptor().FullName() == payloadsFullName {
+		return fn(msg.Interface().(*commonpb.Payloads))
+	}
+	var err error
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Message() == nil {
+			return true
+		}
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = visitPayloads(list.Get(i).Message(), fn)
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() == nil {
+				return true
+			}
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				err = visitPayloads(mv.Message(), fn)
+				return err == nil
+			})
+		default:
+			err = visitPayloads(v.Message(), fn)
+		}
+		return err == nil
+	})
+	return err
+}
@@ -0,0 +1,240 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence/blobstore"
+	"go.temporal.io/server/common/testing/protorequire"
+	"google.golang.org/protobuf/proto"
+)
+
+type memoryBlobStore struct {
+	sync.Mutex
+	blobs map[string][]byte
+}
+
+func newMemoryBlobStore() *memoryBlobStore {
+	return &memoryBlobStore{blobs: make(map[string][]byte)}
+}
+
+func (s *memoryBlobStore) Put(_ context.Context, key string, data []byte) error {
+	s.Lock()
+	defer s.Unlock()
+	s.blobs[key] = data
+	return nil
+}
+
+func (s *memoryBlobStore) Get(_ context.Context, key string) ([]byte, error) {
+	s.Lock()
+	defer s.Unlock()
+	data, ok := s.blobs[key]
+	if !ok {
+		return nil, blobstore.ErrBlobNotFound
+	}
+	return data, nil
+}
+
+func (s *memoryBlobStore) Delete(_ context.Context, key string) error {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.blobs, key)
+	return nil
+}
+
+func (s *memoryBlobStore) List(_ context.Context, prefix string) ([]string, error) {
+	s.Lock()
+	defer s.Unlock()
+	var keys []string
+	for key := range s.blobs {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func payloadOfSize(size int) *commonpb.Payload {
+	return &commonpb.Payload{
+		Metadata: map[string][]byte{"encoding": []byte("binary/plain")},
+		Data:     []byte(strings.Repeat("a", size)),
+	}
+}
+
+func TestPayloadOffloader_OffloadAndResolve(t *testing.T) {
+	store := newMemoryBlobStore()
+	offloader := NewPayloadOffloader(store, dynamicconfig.GetIntPropertyFn(100))
+	node := HistoryNodeKey{TreeID: "tree", BranchID: "branch", NodeID: 5, TransactionID: 42}
+
+	events := []*historypb.HistoryEvent{
+		{
+			EventId:   5,
+			EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED,
+			Attributes: &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{
+				ActivityTaskCompletedEventAttributes: &historypb.ActivityTaskCompletedEventAttributes{
+					Result: &commonpb.Payloads{Payloads: []*commonpb.Payload{payloadOfSize(10), payloadOfSize(1000)}},
+				},
+			},
+		},
+		{
+			EventId:   6,
+			EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_FAILED,
+			Attributes: &historypb.HistoryEvent_ActivityTaskFailedEventAttributes{
+				ActivityTaskFailedEventAttributes: &historypb.ActivityTaskFailedEventAttributes{
+					Failure: &failurepb.Failure{
+						Cause: &failurepb.Failure{
+							FailureInfo: &failurepb.Failure_ApplicationFailureInfo{
+								ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{
+									Details: &commonpb.Payloads{Payloads: []*commonpb.Payload{payloadOfSize(1000)}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			EventId:   7,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+				WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+					Header: &commonpb.Header{Fields: map[string]*commonpb.Payload{"large": payloadOfSize(1000)}},
+				},
+			},
+		},
+	}
+	original := make([]*historypb.HistoryEvent, len(events))
+	for i, event := range events {
+		original[i] = proto.Clone(event).(*historypb.HistoryEvent)
+	}
+
+	offloaded, err := offloader.OffloadEvents(context.Background(), node, events)
+	require.NoError(t, err)
+	require.Len(t, store.blobs, 2)
+	for i := range events {
+		// input must not be modified
+		protorequire.ProtoEqual(t, original[i], events[i])
+	}
+	// headers are never offloaded so the event is passed through as is
+	require.Same(t, events[2], offloaded[2])
+
+	result := offloaded[0].GetActivityTaskCompletedEventAttributes().GetResult().GetPayloads()
+	protorequire.ProtoEqual(t, payloadOfSize(10), result[0])
+	require.True(t, IsOffloadedPayload(result[1]))
+	require.Empty(t, result[1].Data)
+	require.True(t, IsOffloadedPayload(
+		offloaded[1].GetActivityTaskFailedEventAttributes().GetFailure().GetCause().GetApplicationFailureInfo().GetDetails().GetPayloads()[0],
+	))
+
+	// round trip through the serializer like the history manager does
+	serializer := NewSerializer()
+	blob, err := serializer.SerializeEvents(offloaded, enumspb.ENCODING_TYPE_PROTO3)
+	require.NoError(t, err)
+	require.True(t, HasOffloadedPayloads(blob))
+	originalBlob, err := serializer.SerializeEvents(original, enumspb.ENCODING_TYPE_PROTO3)
+	require.NoError(t, err)
+	require.False(t, HasOffloadedPayloads(originalBlob))
+	deserialized, err := serializer.DeserializeEvents(blob)
+	require.NoError(t, err)
+	require.NoError(t, offloader.ResolveEvents(context.Background(), deserialized))
+	for i := range original {
+		protorequire.ProtoEqual(t, original[i], deserialized[i])
+	}
+}
+
+func TestPayloadOffloader_Disabled(t *testing.T) {
+	store := newMemoryBlobStore()
+	offloader := NewPayloadOffloader(store, dynamicconfig.GetIntPropertyFn(0))
+
+	events := []*historypb.HistoryEvent{{
+		EventId: 1,
+		Attributes: &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{
+			ActivityTaskCompletedEventAttributes: &historypb.ActivityTaskCompletedEventAttributes{
+				Result: &commonpb.Payloads{Payloads: []*commonpb.Payload{payloadOfSize(1000)}},
+			},
+		},
+	}}
+	offloaded, err := offloader.OffloadEvents(context.Background(), HistoryNodeKey{}, events)
+	require.NoError(t, err)
+	require.Same(t, events[0], offloaded[0])
+	require.Empty(t, store.blobs)
+}
+
+func TestPayloadOffloader_ResolveMissingBlob(t *testing.T) {
+	offloader := NewPayloadOffloader(newMemoryBlobStore(), dynamicconfig.GetIntPropertyFn(100))
+
+	events := []*historypb.HistoryEvent{{
+		EventId: 1,
+		Attributes: &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{
+			ActivityTaskCompletedEventAttributes: &historypb.ActivityTaskCompletedEventAttributes{
+				Result: &commonpb.Payloads{Payloads: []*commonpb.Payload{{
+					Metadata: map[string][]byte{OffloadedPayloadMetadataKey: []byte("tree/branch/1/1/0")},
+				}}},
+			},
+		},
+	}}
+	err := offloader.ResolveEvents(context.Background(), events)
+	var dataLoss *serviceerror.DataLoss
+	require.ErrorAs(t, err, &dataLoss)
+}
+
+func TestPayloadOffloader_Delete(t *testing.T) {
+	store := newMemoryBlobStore()
+	offloader := NewPayloadOffloader(store, dynamicconfig.GetIntPropertyFn(100))
+	ctx := context.Background()
+
+	for _, key := range []string{
+		"tree/branch-a/1/10/0",
+		"tree/branch-a/5/11/0",
+		"tree/branch-a/5/12/0",
+		"tree/branch-a/9/13/0",
+		"tree/branch-a/10/14/0",
+		"tree/branch-b/9/15/0",
+	} {
+		require.NoError(t, store.Put(ctx, key, nil))
+	}
+
+	require.NoError(t, offloader.DeleteHistoryNode(ctx, HistoryNodeKey{TreeID: "tree", BranchID: "branch-a", NodeID: 5, TransactionID: 12}))
+	require.NotContains(t, store.blobs, "tree/branch-a/5/12/0")
+	require.Contains(t, store.blobs, "tree/branch-a/5/11/0")
+
+	require.NoError(t, offloader.DeleteHistoryBranchRange(ctx, "tree", "branch-a", 9))
+	keys, err := store.List(ctx, "")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{
+		"tree/branch-a/1/10/0",
+		"tree/branch-a/5/11/0",
+		"tree/branch-b/9/15/0",
+	}, keys)
+}
@@ -104,6 +104,8 @@ type (
 	VisibilityCountRow struct {
 		GroupValues []any
 		Count       int64
+		// Values of the columns selected after the count, if any.
+		Values []any
 	}
 
 	Visibility interface {
@@ -159,8 +161,14 @@ func (vsa VisibilitySearchAttributes) Value() (driver.Value, error) {
 }
 
 func ParseCountGroupByRows(rows *sql.Rows, groupBy []string) ([]VisibilityCountRow, error) {
-	// Number of columns is number of group by fields plus the count column.
-	rowValues := make([]any, len(groupBy)+1)
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	// Number of columns is number of group by fields plus the count column,
+	// plus the number of values selected after the count.
+	numValues := len(columns) - len(groupBy) - 1
+	rowValues := make([]any, len(columns))
 	for i := range rowValues {
 		rowValues[i] = new(any)
 	}
@@ -178,10 +186,18 @@ func ParseCountGroupByRows(rows *sql.Rows, groupBy []string) ([]VisibilityCountR
 				return nil, err
 			}
 		}
-		count := *(rowValues[len(rowValues)-1].(*any))
+		count := *(rowValues[len(groupBy)].(*any))
+		var values []any
+		if numValues > 0 {
+			values = make([]any, numValues)
+			for i := range values {
+				values[i] = *(rowValues[len(groupBy)+1+i].(*any))
+			}
+		}
 		res = append(res, VisibilityCountRow{
 			GroupValues: groupValues,
 			Count:       count.(int64),
+			Values:      values,
 		})
 	}
 	return res, nil
@@ -94,6 +94,7 @@ func NewExecutionMutableStateSuite(
 			nil,
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			nil,
 		),
 		historyBranchUtil: historyBranchUtil,
 		Logger:            logger,
@@ -27,12 +27,14 @@ package tests
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/common/debug"
@@ -943,6 +945,16 @@ func (s *VisibilityPersistenceSuite) TestGetWorkflowExecution() {
 		s.NoError(err)
 		s.assertClosedExecutionEquals(req, resp.Execution)
 	}
+
+	_, err := s.VisibilityMgr.GetWorkflowExecution(
+		s.ctx,
+		&manager.GetWorkflowExecutionRequest{
+			NamespaceID: testNamespaceUUID,
+			RunID:       uuid.New(),
+		},
+	)
+	var notFoundErr *serviceerror.NotFound
+	s.ErrorAs(err, &notFoundErr)
 }
 
 // TestAdvancedVisibilityPagination test
@@ -970,7 +982,7 @@ func (s *VisibilityPersistenceSuite) TestAdvancedVisibilityPagination() {
 
 	for pageSize := 1; pageSize <= 5; pageSize++ {
 		executions := make(map[string]*workflowpb.WorkflowExecutionInfo)
-		for _, e := range s.listWithPagination(testNamespaceUUID, 5) {
+		for _, e := range s.listWithPagination(testNamespaceUUID, 5, "") {
 			executions[e.GetExecution().GetWorkflowId()] = e
 		}
 
@@ -993,6 +1005,51 @@ func (s *VisibilityPersistenceSuite) TestAdvancedVisibilityPagination() {
 	}
 }
 
+func (s *VisibilityPersistenceSuite) TestAdvancedVisibilityOrderBy() {
+	testNamespaceUUID := namespace.ID(uuid.New())
+	startTime := time.Now().UTC().Truncate(time.Millisecond)
+
+	// Generate 6 workflow records with pairs of equal start times, and close
+	// every other one so half of them have no history length.
+	var closedIDs, openIDs []string
+	for i := 0; i < 6; i++ {
+		startReq := s.createOpenWorkflowRecord(
+			testNamespaceUUID,
+			fmt.Sprintf("order-by-%v", i),
+			"visibility-workflow",
+			startTime.Add(time.Duration(i/2)*time.Second),
+			"test-queue",
+		)
+		if i%2 == 0 {
+			s.createClosedWorkflowRecord(startReq, startTime.Add(time.Minute))
+			closedIDs = append(closedIDs, startReq.Execution.GetWorkflowId())
+		} else {
+			openIDs = append(openIDs, startReq.Execution.GetWorkflowId())
+		}
+	}
+
+	for pageSize := 1; pageSize <= 6; pageSize++ {
+		executions := s.listWithPagination(testNamespaceUUID, pageSize, "ORDER BY StartTime")
+		s.Len(executions, 6)
+		for i := 1; i < len(executions); i++ {
+			s.False(executions[i].GetStartTime().AsTime().Before(executions[i-1].GetStartTime().AsTime()))
+		}
+
+		executions = s.listWithPagination(testNamespaceUUID, pageSize, "ORDER BY HistoryLength DESC, WorkflowId")
+		s.Len(executions, 6)
+		var workflowIDs []string
+		for _, e := range executions {
+			workflowIDs = append(workflowIDs, e.GetExecution().GetWorkflowId())
+		}
+		// NULL values order is database specific.
+		if executions[0].GetHistoryLength() == 0 {
+			s.Equal(append(slices.Clone(openIDs), closedIDs...), workflowIDs)
+		} else {
+			s.Equal(append(slices.Clone(closedIDs), openIDs...), workflowIDs)
+		}
+	}
+}
+
 func (s *VisibilityPersistenceSuite) TestCountWorkflowExecutions() {
 	testNamespaceUUID := namespace.ID(uuid.New())
 	closeTime := time.Now().UTC()
@@ -1077,12 +1134,116 @@ func (s *VisibilityPersistenceSuite) TestCountGroupByWorkflowExecutions() {
 	s.Equal(int64(5), resp.Count)
 }
 
-func (s *VisibilityPersistenceSuite) listWithPagination(namespaceID namespace.ID, pageSize int) []*workflowpb.WorkflowExecutionInfo {
+func (s *VisibilityPersistenceSuite) TestAggregateWorkflowExecutions() {
+	testNamespaceUUID := namespace.ID(uuid.New())
+	startTime := time.Now().UTC().Truncate(time.Second)
+
+	// Generate 2 closed records of workflow type "aggregate-closed" and 2 open
+	// records of workflow type "aggregate-open", started one second apart.
+	for i := 0; i < 4; i++ {
+		workflowType := "aggregate-open"
+		if i < 2 {
+			workflowType = "aggregate-closed"
+		}
+		startReq := s.createOpenWorkflowRecord(
+			testNamespaceUUID,
+			fmt.Sprintf("aggregate-%v", i),
+			workflowType,
+			startTime.Add(time.Duration(i)*time.Second),
+			"test-queue",
+		)
+		if i < 2 {
+			s.createClosedWorkflowRecord(startReq, startTime.Add(time.Minute))
+		}
+	}
+
+	aggregations := []*manager.Aggregation{
+		{Type: manager.AggregationTypeMin, SearchAttribute: searchattribute.StartTime},
+		{Type: manager.AggregationTypeAvg, SearchAttribute: searchattribute.HistoryLength},
+		{Type: manager.AggregationTypeHistogram, SearchAttribute: searchattribute.HistoryLength, Interval: 2},
+	}
+	resp, err := s.VisibilityMgr.AggregateWorkflowExecutions(
+		s.ctx,
+		&manager.AggregateWorkflowExecutionsRequest{
+			NamespaceID:  testNamespaceUUID,
+			Query:        "",
+			Aggregations: aggregations,
+		},
+	)
+	s.NoError(err)
+	s.Len(resp.Groups, 1)
+	s.Equal(int64(4), resp.Groups[0].Count)
+	minStartTime, err := searchattribute.DecodeValue(resp.Groups[0].Results[0].Value, enumspb.INDEXED_VALUE_TYPE_DATETIME, false)
+	s.NoError(err)
+	s.Equal(startTime, minStartTime)
+	avgHistoryLength, err := searchattribute.DecodeValue(resp.Groups[0].Results[1].Value, enumspb.INDEXED_VALUE_TYPE_DOUBLE, false)
+	s.NoError(err)
+	s.InDelta(5.0, avgHistoryLength, 0.001)
+	s.Len(resp.Groups[0].Results[2].Buckets, 1)
+	s.Equal(int64(2), resp.Groups[0].Results[2].Buckets[0].Count)
+	bucketKey, err := searchattribute.DecodeValue(resp.Groups[0].Results[2].Buckets[0].Key, enumspb.INDEXED_VALUE_TYPE_DOUBLE, false)
+	s.NoError(err)
+	s.InDelta(4.0, bucketKey, 0.001)
+
+	resp, err = s.VisibilityMgr.AggregateWorkflowExecutions(
+		s.ctx,
+		&manager.AggregateWorkflowExecutionsRequest{
+			NamespaceID:  testNamespaceUUID,
+			Query:        "GROUP BY WorkflowType",
+			Aggregations: aggregations,
+		},
+	)
+	s.NoError(err)
+	s.Len(resp.Groups, 2)
+	groups := make(map[string]*manager.AggregationGroup, len(resp.Groups))
+	for _, group := range resp.Groups {
+		s.Len(group.GroupValues, 1)
+		workflowType, err := searchattribute.DecodeValue(group.GroupValues[0], enumspb.INDEXED_VALUE_TYPE_KEYWORD, false)
+		s.NoError(err)
+		groups[workflowType.(string)] = group
+	}
+
+	closedGroup := groups["aggregate-closed"]
+	s.NotNil(closedGroup)
+	s.Equal(int64(2), closedGroup.Count)
+	minStartTime, err = searchattribute.DecodeValue(closedGroup.Results[0].Value, enumspb.INDEXED_VALUE_TYPE_DATETIME, false)
+	s.NoError(err)
+	s.Equal(startTime, minStartTime)
+	s.Len(closedGroup.Results[2].Buckets, 1)
+
+	openGroup := groups["aggregate-open"]
+	s.NotNil(openGroup)
+	s.Equal(int64(2), openGroup.Count)
+	minStartTime, err = searchattribute.DecodeValue(openGroup.Results[0].Value, enumspb.INDEXED_VALUE_TYPE_DATETIME, false)
+	s.NoError(err)
+	s.Equal(startTime.Add(2*time.Second), minStartTime)
+	s.Nil(openGroup.Results[1].Value)
+	s.Empty(openGroup.Results[2].Buckets)
+
+	_, err = s.VisibilityMgr.AggregateWorkflowExecutions(
+		s.ctx,
+		&manager.AggregateWorkflowExecutionsRequest{
+			NamespaceID: testNamespaceUUID,
+			Query:       "",
+			Aggregations: []*manager.Aggregation{
+				{Type: manager.AggregationTypeMax, SearchAttribute: searchattribute.WorkflowType},
+			},
+		},
+	)
+	var invalidArgErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgErr)
+}
+
+func (s *VisibilityPersistenceSuite) listWithPagination(
+	namespaceID namespace.ID,
+	pageSize int,
+	query string,
+) []*workflowpb.WorkflowExecutionInfo {
 	var executions []*workflowpb.WorkflowExecutionInfo
 	resp, err := s.VisibilityMgr.ListWorkflowExecutions(s.ctx, &manager.ListWorkflowExecutionsRequestV2{
 		NamespaceID: namespaceID,
 		PageSize:    pageSize,
-		Query:       "",
+		Query:       query,
 	})
 	s.Nil(err)
 	executions = append(executions, resp.Executions...)
@@ -1091,7 +1252,7 @@ func (s *VisibilityPersistenceSuite) listWithPagination(namespaceID namespace.ID
 		resp, err = s.VisibilityMgr.ListWorkflowExecutions(s.ctx, &manager.ListWorkflowExecutionsRequestV2{
 			NamespaceID:   namespaceID,
 			PageSize:      pageSize,
-			Query:         "",
+			Query:         query,
 			NextPageToken: resp.NextPageToken,
 		})
 		s.Nil(err)
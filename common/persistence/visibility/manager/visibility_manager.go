@@ -29,6 +29,7 @@ package manager
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -59,6 +60,7 @@ type (
 		ListWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error)
 		ScanWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error)
 		CountWorkflowExecutions(ctx context.Context, request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
+		AggregateWorkflowExecutions(ctx context.Context, request *AggregateWorkflowExecutionsRequest) (*AggregateWorkflowExecutionsResponse, error)
 		GetWorkflowExecution(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error)
 	}
 
@@ -145,6 +147,63 @@ type (
 		Groups []*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup
 	}
 
+	// AggregationType is the type of an aggregation computed by AggregateWorkflowExecutions.
+	AggregationType int
+
+	// Aggregation is a single aggregation over a search attribute.
+	Aggregation struct {
+		Type AggregationType
+		// Name (or alias) of the search attribute to aggregate.
+		// It must be of Datetime, Int or Double type.
+		SearchAttribute string
+		// Width of the histogram buckets. Required for histogram aggregations
+		// and ignored otherwise. For Datetime search attributes, it's in seconds.
+		Interval float64
+	}
+
+	// AggregateWorkflowExecutionsRequest is request from AggregateWorkflowExecutions
+	AggregateWorkflowExecutionsRequest struct {
+		NamespaceID namespace.ID
+		Namespace   namespace.Name // namespace.Name is not persisted.
+		// Query filters the executions to aggregate. It can have a 'group by' clause
+		// with a single Keyword search attribute to aggregate each group separately.
+		Query        string
+		Aggregations []*Aggregation
+	}
+
+	// AggregateWorkflowExecutionsResponse is response to AggregateWorkflowExecutions
+	AggregateWorkflowExecutionsResponse struct {
+		// Groups has a single group with no group values if the query doesn't
+		// have a 'group by' clause.
+		Groups []*AggregationGroup
+	}
+
+	// AggregationGroup is a group of executions with the same 'group by' values.
+	AggregationGroup struct {
+		GroupValues []*commonpb.Payload
+		Count       int64
+		// Results of the aggregations, in the same order as in the request.
+		Results []*AggregationResult
+	}
+
+	// AggregationResult is the result of a single aggregation.
+	AggregationResult struct {
+		// Value of min, max and avg aggregations, encoded with the search attribute
+		// type, except the avg of Int search attributes which is a Double.
+		// It's nil if the search attribute isn't set in any execution of the group.
+		Value *commonpb.Payload
+		// Non-empty buckets of histogram aggregations, sorted by key.
+		Buckets []*HistogramBucket
+	}
+
+	// HistogramBucket is a bucket of a histogram aggregation.
+	HistogramBucket struct {
+		// Lower bound of the bucket: a Datetime for Datetime search attributes,
+		// and a Double otherwise.
+		Key   *commonpb.Payload
+		Count int64
+	}
+
 	// VisibilityDeleteWorkflowExecutionRequest contains the request params for DeleteWorkflowExecution call
 	VisibilityDeleteWorkflowExecutionRequest struct {
 		NamespaceID namespace.ID
@@ -168,6 +227,28 @@ type (
 	}
 )
 
+const (
+	AggregationTypeMin AggregationType = iota + 1
+	AggregationTypeMax
+	AggregationTypeAvg
+	AggregationTypeHistogram
+)
+
+func (t AggregationType) String() string {
+	switch t {
+	case AggregationTypeMin:
+		return "Min"
+	case AggregationTypeMax:
+		return "Max"
+	case AggregationTypeAvg:
+		return "Avg"
+	case AggregationTypeHistogram:
+		return "Histogram"
+	default:
+		return fmt.Sprintf("AggregationType(%d)", int(t))
+	}
+}
+
 func (r *ListWorkflowExecutionsRequest) OverrideToken(token []byte) {
 	r.NextPageToken = token
 }
@@ -0,0 +1,134 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package store
+
+import (
+	"math"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+// ValidateAggregation checks that the aggregation can be computed on a search attribute of the
+// given type. It returns a query.ConverterError otherwise.
+func ValidateAggregation(agg *manager.Aggregation, saType enumspb.IndexedValueType) error {
+	switch agg.Type {
+	case manager.AggregationTypeMin,
+		manager.AggregationTypeMax,
+		manager.AggregationTypeAvg,
+		manager.AggregationTypeHistogram:
+	default:
+		return query.NewConverterError("%s: aggregation type %v", query.NotSupportedErrMessage, agg.Type)
+	}
+	switch saType {
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME,
+		enumspb.INDEXED_VALUE_TYPE_INT,
+		enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+	default:
+		return query.NewConverterError(
+			"%s: cannot aggregate search attribute '%s' of type %s",
+			query.NotSupportedErrMessage,
+			agg.SearchAttribute,
+			saType.String(),
+		)
+	}
+	if agg.Type == manager.AggregationTypeHistogram && !(agg.Interval > 0) {
+		return query.NewConverterError(
+			"%s: histogram interval of search attribute '%s' must be positive",
+			query.InvalidExpressionErrMessage,
+			agg.SearchAttribute,
+		)
+	}
+	return nil
+}
+
+// ValidateAggregationGroupBy checks that the executions can be aggregated by the 'group by'
+// fields. Only a single Keyword search attribute is supported.
+func ValidateAggregationGroupBy(groupBy []string, saTypeMap searchattribute.NameTypeMap) error {
+	if len(groupBy) > 1 {
+		return query.NewConverterError(
+			"%s: 'group by' clause supports only a single field",
+			query.NotSupportedErrMessage,
+		)
+	}
+	for _, fieldName := range groupBy {
+		saType, err := saTypeMap.GetType(fieldName)
+		if err != nil {
+			return err
+		}
+		if saType != enumspb.INDEXED_VALUE_TYPE_KEYWORD {
+			return query.NewConverterError(
+				"%s: 'group by' clause is only supported for search attributes of type %s",
+				query.NotSupportedErrMessage,
+				enumspb.INDEXED_VALUE_TYPE_KEYWORD.String(),
+			)
+		}
+	}
+	return nil
+}
+
+// NewAggregationValue encodes the value of a min, max or avg aggregation. Stores compute all
+// aggregations as float64, with Datetime values as seconds since epoch.
+func NewAggregationValue(
+	aggType manager.AggregationType,
+	saType enumspb.IndexedValueType,
+	value float64,
+) (*commonpb.Payload, error) {
+	switch saType {
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		return searchattribute.EncodeValue(epochSecondsToTime(value), saType)
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		if aggType != manager.AggregationTypeAvg {
+			return searchattribute.EncodeValue(int64(math.Round(value)), saType)
+		}
+	}
+	return searchattribute.EncodeValue(value, enumspb.INDEXED_VALUE_TYPE_DOUBLE)
+}
+
+// NewHistogramBucket returns a histogram bucket with the given lower bound, with Datetime keys
+// as seconds since epoch.
+func NewHistogramBucket(
+	saType enumspb.IndexedValueType,
+	key float64,
+	count int64,
+) (*manager.HistogramBucket, error) {
+	var keyPayload *commonpb.Payload
+	var err error
+	if saType == enumspb.INDEXED_VALUE_TYPE_DATETIME {
+		keyPayload, err = searchattribute.EncodeValue(epochSecondsToTime(key), saType)
+	} else {
+		keyPayload, err = searchattribute.EncodeValue(key, enumspb.INDEXED_VALUE_TYPE_DOUBLE)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &manager.HistogramBucket{Key: keyPayload, Count: count}, nil
+}
+
+func epochSecondsToTime(seconds float64) time.Time {
+	return time.UnixMicro(int64(math.Round(seconds * 1e6))).UTC()
+}
@@ -42,6 +42,9 @@ type (
 		searchAttributesTypeMap        searchattribute.NameTypeMap
 		searchAttributesMapperProvider searchattribute.MapperProvider
 		seenNamespaceDivision          bool
+		// Set when converting aggregation queries, which validate the 'group by'
+		// fields with store.ValidateAggregationGroupBy instead.
+		aggregation bool
 	}
 
 	valuesInterceptor struct {
@@ -119,7 +122,7 @@ func (ni *nameInterceptor) Name(name string, usage query.FieldNameUsage) (string
 			)
 		}
 	case query.FieldNameGroupBy:
-		if fieldName != searchattribute.ExecutionStatus {
+		if !ni.aggregation && fieldName != searchattribute.ExecutionStatus {
 			return "", query.NewConverterError(
 				"'group by' clause is only supported for %s search attribute",
 				searchattribute.ExecutionStatus,
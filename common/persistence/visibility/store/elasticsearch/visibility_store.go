@@ -60,6 +60,9 @@ const (
 	delimiter                    = "~"
 	scrollKeepAliveInterval      = "1m"
 	pointInTimeKeepAliveInterval = "1m"
+
+	// Maximum number of groups returned by AggregateWorkflowExecutions.
+	aggregationGroupsLimit = 1000
 )
 
 type (
@@ -544,6 +547,90 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 	return s.parseCountGroupByResponse(esResponse, groupByFields)
 }
 
+func (s *VisibilityStore) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	queryParams, err := s.convertQueryInternal(request.Namespace, request.NamespaceID, request.Query, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(queryParams.Sorter) > 0 {
+		return nil, serviceerror.NewInvalidArgument("ORDER BY clause is not supported")
+	}
+
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("Unable to read search attribute types: %v", err))
+	}
+	if err := store.ValidateAggregationGroupBy(queryParams.GroupBy, saTypeMap); err != nil {
+		return nil, convertConverterError(err)
+	}
+
+	nameInterceptor := NewNameInterceptor(request.Namespace, saTypeMap, s.searchAttributesMapperProvider)
+	saTypes := make([]enumspb.IndexedValueType, len(request.Aggregations))
+	esAggs := make(map[string]elastic.Aggregation, len(request.Aggregations))
+	for i, agg := range request.Aggregations {
+		fieldName, err := nameInterceptor.Name(agg.SearchAttribute, query.FieldNameFilter)
+		if err != nil {
+			return nil, convertConverterError(err)
+		}
+		saTypes[i], err = saTypeMap.GetType(fieldName)
+		if err != nil {
+			return nil, err
+		}
+		if err := store.ValidateAggregation(agg, saTypes[i]); err != nil {
+			return nil, convertConverterError(err)
+		}
+		esAggs[strconv.Itoa(i)] = newAggregation(agg, fieldName, saTypes[i])
+	}
+
+	if len(queryParams.GroupBy) > 0 {
+		termsAgg := elastic.NewTermsAggregation().
+			Field(queryParams.GroupBy[0]).
+			Size(aggregationGroupsLimit)
+		for name, agg := range esAggs {
+			termsAgg.SubAggregation(name, agg)
+		}
+		esAggs = map[string]elastic.Aggregation{queryParams.GroupBy[0]: termsAgg}
+	}
+
+	esResponse, err := s.esClient.Aggregate(ctx, s.index, queryParams.Query, esAggs)
+	if err != nil {
+		return nil, ConvertElasticsearchClientError("AggregateWorkflowExecutions failed", err)
+	}
+
+	response := &manager.AggregateWorkflowExecutionsResponse{}
+	if len(queryParams.GroupBy) == 0 {
+		group, err := parseAggregationGroup(esResponse.Aggregations, request.Aggregations, saTypes)
+		if err != nil {
+			return nil, err
+		}
+		group.Count = esResponse.TotalHits()
+		response.Groups = append(response.Groups, group)
+		return response, nil
+	}
+
+	terms, ok := esResponse.Aggregations.Terms(queryParams.GroupBy[0])
+	if !ok {
+		return nil, serviceerror.NewInternal("AggregateWorkflowExecutions failed: missing 'group by' aggregation in response")
+	}
+	for _, bucket := range terms.Buckets {
+		group, err := parseAggregationGroup(bucket.Aggregations, request.Aggregations, saTypes)
+		if err != nil {
+			return nil, err
+		}
+		groupValue, err := searchattribute.EncodeValue(bucket.Key, enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+		if err != nil {
+			return nil, err
+		}
+		group.GroupValues = []*commonpb.Payload{groupValue}
+		group.Count = bucket.DocCount
+		response.Groups = append(response.Groups, group)
+	}
+	return response, nil
+}
+
 func (s *VisibilityStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -551,6 +638,11 @@ func (s *VisibilityStore) GetWorkflowExecution(
 	docID := GetDocID(request.WorkflowID, request.RunID)
 	result, err := s.esClient.Get(ctx, s.index, docID)
 	if err != nil {
+		if elastic.IsNotFound(err) {
+			return nil, serviceerror.NewNotFound(
+				fmt.Sprintf("Workflow execution with run id %s not found.", request.RunID),
+			)
+		}
 		return nil, ConvertElasticsearchClientError("GetWorkflowExecution failed", err)
 	}
 
@@ -748,12 +840,22 @@ func (s *VisibilityStore) convertQuery(
 	namespace namespace.Name,
 	namespaceID namespace.ID,
 	requestQueryStr string,
+) (*query.QueryParams, error) {
+	return s.convertQueryInternal(namespace, namespaceID, requestQueryStr, false)
+}
+
+func (s *VisibilityStore) convertQueryInternal(
+	namespace namespace.Name,
+	namespaceID namespace.ID,
+	requestQueryStr string,
+	aggregation bool,
 ) (*query.QueryParams, error) {
 	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(fmt.Sprintf("Unable to read search attribute types: %v", err))
 	}
 	nameInterceptor := NewNameInterceptor(namespace, saTypeMap, s.searchAttributesMapperProvider)
+	nameInterceptor.aggregation = aggregation
 	queryConverter := NewQueryConverter(
 		nameInterceptor,
 		NewValuesInterceptor(namespace, saTypeMap),
@@ -1113,6 +1215,98 @@ func (s *VisibilityStore) ParseESDoc(
 	return record, nil
 }
 
+func newAggregation(
+	agg *manager.Aggregation,
+	fieldName string,
+	saType enumspb.IndexedValueType,
+) elastic.Aggregation {
+	switch agg.Type {
+	case manager.AggregationTypeMin:
+		return elastic.NewMinAggregation().Field(fieldName)
+	case manager.AggregationTypeMax:
+		return elastic.NewMaxAggregation().Field(fieldName)
+	case manager.AggregationTypeAvg:
+		return elastic.NewAvgAggregation().Field(fieldName)
+	default:
+		if saType == enumspb.INDEXED_VALUE_TYPE_DATETIME {
+			intervalMillis := max(int64(math.Round(agg.Interval*1000)), 1)
+			return elastic.NewDateHistogramAggregation().
+				Field(fieldName).
+				FixedInterval(fmt.Sprintf("%dms", intervalMillis)).
+				MinDocCount(1)
+		}
+		return elastic.NewHistogramAggregation().
+			Field(fieldName).
+			Interval(agg.Interval).
+			MinDocCount(1)
+	}
+}
+
+// parseAggregationGroup parses the aggregations built by newAggregation. Elasticsearch returns
+// Datetime values as milliseconds since epoch.
+func parseAggregationGroup(
+	esAggs elastic.Aggregations,
+	aggregations []*manager.Aggregation,
+	saTypes []enumspb.IndexedValueType,
+) (*manager.AggregationGroup, error) {
+	group := &manager.AggregationGroup{
+		Results: make([]*manager.AggregationResult, len(aggregations)),
+	}
+	for i, agg := range aggregations {
+		name := strconv.Itoa(i)
+		scale := 1.0
+		if saTypes[i] == enumspb.INDEXED_VALUE_TYPE_DATETIME {
+			scale = 1000
+		}
+		result := &manager.AggregationResult{}
+		group.Results[i] = result
+
+		var metric *elastic.AggregationValueMetric
+		var found bool
+		switch agg.Type {
+		case manager.AggregationTypeMin:
+			metric, found = esAggs.Min(name)
+		case manager.AggregationTypeMax:
+			metric, found = esAggs.Max(name)
+		case manager.AggregationTypeAvg:
+			metric, found = esAggs.Avg(name)
+		default:
+			histogram, found := esAggs.Histogram(name)
+			if !found {
+				return nil, serviceerror.NewInternal(fmt.Sprintf("AggregateWorkflowExecutions failed: missing aggregation %s in response", name))
+			}
+			for _, bucket := range histogram.Buckets {
+				histogramBucket, err := store.NewHistogramBucket(saTypes[i], bucket.Key/scale, bucket.DocCount)
+				if err != nil {
+					return nil, err
+				}
+				result.Buckets = append(result.Buckets, histogramBucket)
+			}
+			continue
+		}
+		if !found {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("AggregateWorkflowExecutions failed: missing aggregation %s in response", name))
+		}
+		if metric.Value != nil {
+			var err error
+			result.Value, err = store.NewAggregationValue(agg.Type, saTypes[i], *metric.Value/scale)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return group, nil
+}
+
+func convertConverterError(err error) error {
+	// Convert ConverterError to InvalidArgument and pass through all other errors (which should be only mapper errors).
+	var converterErr *query.ConverterError
+	if errors.As(err, &converterErr) {
+		return converterErr.ToInvalidArgument()
+	}
+	return err
+}
+
 // Elasticsearch aggregation groups are returned as nested object.
 // This function flattens the response into rows.
 //
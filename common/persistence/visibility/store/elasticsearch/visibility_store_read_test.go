@@ -1309,6 +1309,111 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
 	s.Nil(resp)
 }
 
+func (s *ESVisibilitySuite) TestAggregateWorkflowExecutions() {
+	request := &manager.AggregateWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       "",
+		Aggregations: []*manager.Aggregation{
+			{Type: manager.AggregationTypeAvg, SearchAttribute: "CustomIntField"},
+			{Type: manager.AggregationTypeMax, SearchAttribute: searchattribute.StartTime},
+			{Type: manager.AggregationTypeHistogram, SearchAttribute: "CustomIntField", Interval: 10},
+		},
+	}
+	s.mockESClient.EXPECT().
+		Aggregate(
+			gomock.Any(),
+			testIndex,
+			elastic.NewBoolQuery().
+				Filter(elastic.NewTermQuery(searchattribute.NamespaceID, testNamespaceID.String())).
+				MustNot(namespaceDivisionExists),
+			map[string]elastic.Aggregation{
+				"0": elastic.NewAvgAggregation().Field("CustomIntField"),
+				"1": elastic.NewMaxAggregation().Field(searchattribute.StartTime),
+				"2": elastic.NewHistogramAggregation().Field("CustomIntField").Interval(10).MinDocCount(1),
+			},
+		).
+		Return(
+			&elastic.SearchResult{
+				Hits: &elastic.SearchHits{TotalHits: &elastic.TotalHits{Value: 3}},
+				Aggregations: map[string]json.RawMessage{
+					"0": json.RawMessage(`{"value":12.5}`),
+					"1": json.RawMessage(`{"value":1700000000000,"value_as_string":"2023-11-14T22:13:20.000Z"}`),
+					"2": json.RawMessage(`{"buckets":[{"key":0,"doc_count":1},{"key":10,"doc_count":2}]}`),
+				},
+			},
+			nil,
+		)
+	resp, err := s.visibilityStore.AggregateWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Len(resp.Groups, 1)
+	group := resp.Groups[0]
+	s.Empty(group.GroupValues)
+	s.Equal(int64(3), group.Count)
+	s.Len(group.Results, 3)
+
+	avgPayload, _ := searchattribute.EncodeValue(12.5, enumspb.INDEXED_VALUE_TYPE_DOUBLE)
+	s.True(temporalproto.DeepEqual(avgPayload, group.Results[0].Value))
+	maxPayload, _ := searchattribute.EncodeValue(time.Unix(1700000000, 0).UTC(), enumspb.INDEXED_VALUE_TYPE_DATETIME)
+	s.True(temporalproto.DeepEqual(maxPayload, group.Results[1].Value))
+	s.Nil(group.Results[2].Value)
+	s.Len(group.Results[2].Buckets, 2)
+	bucketKey, _ := searchattribute.EncodeValue(10.0, enumspb.INDEXED_VALUE_TYPE_DOUBLE)
+	s.True(temporalproto.DeepEqual(bucketKey, group.Results[2].Buckets[1].Key))
+	s.Equal(int64(2), group.Results[2].Buckets[1].Count)
+
+	// test group by a Keyword search attribute
+	request.Query = "GROUP BY WorkflowType"
+	request.Aggregations = request.Aggregations[:1]
+	s.mockESClient.EXPECT().
+		Aggregate(
+			gomock.Any(),
+			testIndex,
+			gomock.Any(),
+			map[string]elastic.Aggregation{
+				searchattribute.WorkflowType: elastic.NewTermsAggregation().
+					Field(searchattribute.WorkflowType).
+					Size(aggregationGroupsLimit).
+					SubAggregation("0", elastic.NewAvgAggregation().Field("CustomIntField")),
+			},
+		).
+		Return(
+			&elastic.SearchResult{
+				Aggregations: map[string]json.RawMessage{
+					searchattribute.WorkflowType: json.RawMessage(
+						`{"buckets":[{"key":"wf-type-1","doc_count":4,"0":{"value":2}},{"key":"wf-type-2","doc_count":1,"0":{"value":null}}]}`,
+					),
+				},
+			},
+			nil,
+		)
+	resp, err = s.visibilityStore.AggregateWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Len(resp.Groups, 2)
+	wfType1Payload, _ := searchattribute.EncodeValue("wf-type-1", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	s.True(temporalproto.DeepEqual([]*commonpb.Payload{wfType1Payload}, resp.Groups[0].GroupValues))
+	s.Equal(int64(4), resp.Groups[0].Count)
+	avgPayload, _ = searchattribute.EncodeValue(2.0, enumspb.INDEXED_VALUE_TYPE_DOUBLE)
+	s.True(temporalproto.DeepEqual(avgPayload, resp.Groups[0].Results[0].Value))
+	s.Equal(int64(1), resp.Groups[1].Count)
+	s.Nil(resp.Groups[1].Results[0].Value)
+
+	// test only allowed to group by Keyword search attributes
+	request.Query = "GROUP BY CustomIntField"
+	_, err = s.visibilityStore.AggregateWorkflowExecutions(context.Background(), request)
+	s.Error(err)
+	s.Contains(err.Error(), "'group by' clause is only supported for search attributes of type")
+
+	// test only allowed to aggregate numeric and Datetime search attributes
+	request.Query = ""
+	request.Aggregations = []*manager.Aggregation{
+		{Type: manager.AggregationTypeMin, SearchAttribute: "CustomKeywordField"},
+	}
+	_, err = s.visibilityStore.AggregateWorkflowExecutions(context.Background(), request)
+	var invalidArgErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgErr)
+}
+
 func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 	statusCompletedPayload, _ := searchattribute.EncodeValue(
 		enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
@@ -1633,6 +1738,14 @@ func (s *ESVisibilitySuite) TestGetWorkflowExecution() {
 	_, ok := err.(*serviceerror.Unavailable)
 	s.True(ok)
 	s.Contains(err.Error(), "GetWorkflowExecution failed")
+
+	// test not found error
+	s.mockESClient.EXPECT().Get(gomock.Any(), testIndex, gomock.Any()).Return(nil, &elastic.Error{Status: 404})
+
+	_, err = s.visibilityStore.GetWorkflowExecution(context.Background(), request)
+	s.Error(err)
+	var notFound *serviceerror.NotFound
+	s.ErrorAs(err, &notFound)
 }
 
 func (s *ESVisibilitySuite) Test_detailedErrorMessage() {
@@ -25,8 +25,15 @@
 package sql
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type (
@@ -34,15 +41,41 @@ type (
 		CloseTime time.Time
 		StartTime time.Time
 		RunID     string
+		// Values of the 'order by' fields of the last row, in the same order as
+		// the fields in the query. Only set if the query has 'order by' clause.
+		SortValues []any `json:",omitempty"`
 	}
 )
 
+func newPageToken(row *sqlplugin.VisibilityRow, orderBy []orderByField) *pageToken {
+	closeTime := maxTime
+	if row.CloseTime != nil {
+		closeTime = *row.CloseTime
+	}
+	token := &pageToken{
+		CloseTime: closeTime,
+		StartTime: row.StartTime,
+		RunID:     row.RunID,
+	}
+	if len(orderBy) > 0 {
+		token.SortValues = make([]any, len(orderBy))
+		for i, field := range orderBy {
+			token.SortValues[i] = getSortValue(row, field)
+		}
+	}
+	return token
+}
+
 func deserializePageToken(data []byte) (*pageToken, error) {
 	if len(data) == 0 {
 		return nil, nil
 	}
 	var token *pageToken
-	err := json.Unmarshal(data, &token)
+	// Sort values are parsed later by parseSortValues based on the field types.
+	// UseNumber prevents int values from losing precision.
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	err := decoder.Decode(&token)
 	return token, err
 }
 
@@ -50,3 +83,127 @@ func serializePageToken(token *pageToken) ([]byte, error) {
 	data, err := json.Marshal(token)
 	return data, err
 }
+
+// getSortValue returns the value of the 'order by' field in the row. CloseTime
+// is sorted by the coalesce expression, so it returns maxTime if it's not set.
+func getSortValue(row *sqlplugin.VisibilityRow, field orderByField) any {
+	switch field.fieldName {
+	case searchattribute.WorkflowID:
+		return row.WorkflowID
+	case searchattribute.RunID:
+		return row.RunID
+	case searchattribute.WorkflowType:
+		return row.WorkflowTypeName
+	case searchattribute.StartTime:
+		return row.StartTime
+	case searchattribute.ExecutionTime:
+		return row.ExecutionTime
+	case searchattribute.CloseTime:
+		if row.CloseTime == nil {
+			return maxTime
+		}
+		return *row.CloseTime
+	case searchattribute.ExecutionStatus:
+		return row.Status
+	case searchattribute.ExecutionDuration:
+		if row.ExecutionDuration == nil {
+			return nil
+		}
+		return row.ExecutionDuration.Nanoseconds()
+	case searchattribute.HistoryLength:
+		return derefOrNil(row.HistoryLength)
+	case searchattribute.HistorySizeBytes:
+		return derefOrNil(row.HistorySizeBytes)
+	case searchattribute.StateTransitionCount:
+		return derefOrNil(row.StateTransitionCount)
+	case searchattribute.TaskQueue:
+		return row.TaskQueue
+	case searchattribute.ParentWorkflowID:
+		return derefOrNil(row.ParentWorkflowID)
+	case searchattribute.ParentRunID:
+		return derefOrNil(row.ParentRunID)
+	case searchattribute.RootWorkflowID:
+		return row.RootWorkflowID
+	case searchattribute.RootRunID:
+		return row.RootRunID
+	}
+
+	if row.SearchAttributes == nil {
+		return nil
+	}
+	value := (*row.SearchAttributes)[field.fieldName]
+	switch v := value.(type) {
+	case float64:
+		// Search attributes are stored as JSON, so numbers are decoded as float64.
+		if field.valueType == enumspb.INDEXED_VALUE_TYPE_INT {
+			return int64(v)
+		}
+	case string:
+		if field.valueType == enumspb.INDEXED_VALUE_TYPE_DATETIME {
+			if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+				return t
+			}
+		}
+	}
+	return value
+}
+
+// parseSortValues converts the sort values decoded from the page token to the
+// types of the 'order by' fields.
+func parseSortValues(orderBy []orderByField, values []any) ([]any, error) {
+	if len(orderBy) != len(values) {
+		return nil, serviceerror.NewInvalidArgument("invalid page token: sort values don't match 'order by' clause")
+	}
+	res := make([]any, len(values))
+	for i, field := range orderBy {
+		if values[i] == nil {
+			continue
+		}
+		var err error
+		res[i], err = parseSortValue(field, values[i])
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(
+				fmt.Sprintf("invalid page token: unable to parse sort value of %s: %v", field.fieldName, err),
+			)
+		}
+	}
+	return res, nil
+}
+
+func parseSortValue(field orderByField, value any) (any, error) {
+	// ExecutionStatus is a keyword search attribute stored as int in the database.
+	valueType := field.valueType
+	if field.fieldName == searchattribute.ExecutionStatus {
+		valueType = enumspb.INDEXED_VALUE_TYPE_INT
+	}
+	switch valueType {
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		if v, ok := value.(bool); ok {
+			return v, nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		if v, ok := value.(string); ok {
+			return time.Parse(time.RFC3339Nano, v)
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		if v, ok := value.(json.Number); ok {
+			return v.Float64()
+		}
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		if v, ok := value.(json.Number); ok {
+			return v.Int64()
+		}
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD:
+		if v, ok := value.(string); ok {
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected value type %T", value)
+}
+
+func derefOrNil[T any](v *T) any {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
@@ -25,10 +25,14 @@
 package sql
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 func TestSerializePageToken(t *testing.T) {
@@ -72,3 +76,59 @@ func TestDeserializePageToken(t *testing.T) {
 		*token,
 	)
 }
+
+func TestPageTokenSortValues(t *testing.T) {
+	s := assert.New(t)
+
+	orderBy := []orderByField{
+		{fieldName: searchattribute.StartTime, valueType: enumspb.INDEXED_VALUE_TYPE_DATETIME},
+		{fieldName: searchattribute.ExecutionStatus, valueType: enumspb.INDEXED_VALUE_TYPE_KEYWORD},
+		{fieldName: "Int01", valueType: enumspb.INDEXED_VALUE_TYPE_INT},
+		{fieldName: "Double01", valueType: enumspb.INDEXED_VALUE_TYPE_DOUBLE},
+		{fieldName: "Datetime01", valueType: enumspb.INDEXED_VALUE_TYPE_DATETIME},
+		{fieldName: "Keyword01", valueType: enumspb.INDEXED_VALUE_TYPE_KEYWORD},
+		{fieldName: "Bool01", valueType: enumspb.INDEXED_VALUE_TYPE_BOOL},
+	}
+	startTime := time.Date(2023, 3, 21, 14, 10, 32, 0, time.UTC)
+	row := &sqlplugin.VisibilityRow{
+		RunID:     "test-run-id",
+		StartTime: startTime,
+		Status:    int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+		SearchAttributes: &sqlplugin.VisibilitySearchAttributes{
+			"Int01":      float64(123),
+			"Double01":   1.5,
+			"Datetime01": "2023-03-21T14:20:32.123456Z",
+			"Bool01":     true,
+		},
+	}
+
+	token := newPageToken(row, orderBy)
+	s.Equal(maxTime, token.CloseTime)
+	s.Equal(startTime, token.StartTime)
+	s.Equal("test-run-id", token.RunID)
+	s.Len(token.SortValues, len(orderBy))
+
+	data, err := serializePageToken(token)
+	s.NoError(err)
+	token, err = deserializePageToken(data)
+	s.NoError(err)
+	values, err := parseSortValues(orderBy, token.SortValues)
+	s.NoError(err)
+	s.Equal(
+		[]any{
+			startTime,
+			int64(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+			int64(123),
+			1.5,
+			time.Date(2023, 3, 21, 14, 20, 32, 123456000, time.UTC),
+			nil,
+			true,
+		},
+		values,
+	)
+
+	_, err = parseSortValues(orderBy[:1], nil)
+	s.Error(err)
+	_, err = parseSortValues(orderBy[:1], []any{json.Number("1")})
+	s.Error(err)
+}
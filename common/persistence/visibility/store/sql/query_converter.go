@@ -27,6 +27,7 @@ package sql
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -35,6 +36,8 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store"
 	"go.temporal.io/server/common/persistence/visibility/store/query"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/searchattribute"
@@ -51,12 +54,24 @@ type (
 			queryString string,
 			pageSize int,
 			token *pageToken,
+			orderBy []orderByField,
 		) (string, []any)
 
-		buildCountStmt(namespaceID namespace.ID, queryString string, groupBy []string) (string, []any)
+		// buildCountStmt selects the group by values, the count and the values,
+		// grouped by the group by values.
+		buildCountStmt(
+			namespaceID namespace.ID,
+			queryString string,
+			groupBy []string,
+			values []string,
+		) (string, []any)
 
 		getDatetimeFormat() string
 
+		// getEpochSecondsExpr returns an expression converting a datetime
+		// expression to seconds since epoch.
+		getEpochSecondsExpr(expr string) string
+
 		getCoalesceCloseTimeExpr() sqlparser.Expr
 	}
 
@@ -69,12 +84,41 @@ type (
 		queryString   string
 
 		seenNamespaceDivision bool
+		// Set when building aggregation statements, which validate the 'group by'
+		// fields with store.ValidateAggregationGroupBy instead.
+		aggregation bool
+		// Sort fields of the last statement built by BuildSelectStmt. Used to
+		// build the page token from the last row of the page.
+		orderBy []orderByField
 	}
 
 	queryParams struct {
 		queryString string
 		// List of search attributes to group by (field name, not db name).
 		groupBy []string
+		// List of fields to order by, in the order they appear in the query.
+		orderBy []orderByField
+	}
+
+	// AggregateStmts are the statements computing the aggregations of a query.
+	AggregateStmts struct {
+		// Selects the group by values, the count and the value of each min, max
+		// and avg aggregation, in the same order as in the request.
+		Metrics *sqlplugin.VisibilitySelectFilter
+		// Selects the group by values, the bucket index and the count of each
+		// histogram aggregation, indexed by position in the request. The bucket
+		// index is the last group by value.
+		Histograms map[int]*sqlplugin.VisibilitySelectFilter
+		// Types of the aggregated search attributes.
+		Types []enumspb.IndexedValueType
+	}
+
+	orderByField struct {
+		// SQL expression to sort by, ie., db column name or coalesce expression.
+		expr      string
+		fieldName string
+		valueType enumspb.IndexedValueType
+		desc      bool
 	}
 )
 
@@ -131,6 +175,14 @@ var (
 		enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 	}
 
+	supportedTypesOrderBy = []enumspb.IndexedValueType{
+		enumspb.INDEXED_VALUE_TYPE_BOOL,
+		enumspb.INDEXED_VALUE_TYPE_DATETIME,
+		enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+		enumspb.INDEXED_VALUE_TYPE_INT,
+		enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	}
+
 	defaultLikeEscapeExpr = newUnsafeSQLString(string(defaultLikeEscapeChar))
 )
 
@@ -169,11 +221,19 @@ func (c *QueryConverter) BuildSelectStmt(
 	if len(qp.groupBy) > 0 {
 		return nil, query.NewConverterError("%s: 'group by' clause", query.NotSupportedErrMessage)
 	}
+	if token != nil {
+		token.SortValues, err = parseSortValues(qp.orderBy, token.SortValues)
+		if err != nil {
+			return nil, err
+		}
+	}
+	c.orderBy = qp.orderBy
 	queryString, queryArgs := c.buildSelectStmt(
 		c.namespaceID,
 		qp.queryString,
 		pageSize,
 		token,
+		qp.orderBy,
 	)
 	return &sqlplugin.VisibilitySelectFilter{Query: queryString, QueryArgs: queryArgs}, nil
 }
@@ -187,7 +247,7 @@ func (c *QueryConverter) BuildCountStmt() (*sqlplugin.VisibilitySelectFilter, er
 	for i, fieldName := range qp.groupBy {
 		groupByDbNames[i] = searchattribute.GetSqlDbColName(fieldName)
 	}
-	queryString, queryArgs := c.buildCountStmt(c.namespaceID, qp.queryString, groupByDbNames)
+	queryString, queryArgs := c.buildCountStmt(c.namespaceID, qp.queryString, groupByDbNames, nil)
 	return &sqlplugin.VisibilitySelectFilter{
 		Query:     queryString,
 		QueryArgs: queryArgs,
@@ -195,6 +255,88 @@ func (c *QueryConverter) BuildCountStmt() (*sqlplugin.VisibilitySelectFilter, er
 	}, nil
 }
 
+func (c *QueryConverter) BuildAggregateStmts(
+	aggregations []*manager.Aggregation,
+) (*AggregateStmts, error) {
+	c.aggregation = true
+	qp, err := c.convertWhereString(c.queryString)
+	if err != nil {
+		return nil, err
+	}
+	if len(qp.orderBy) > 0 {
+		return nil, query.NewConverterError("%s: 'order by' clause", query.NotSupportedErrMessage)
+	}
+	if err := store.ValidateAggregationGroupBy(qp.groupBy, c.saTypeMap); err != nil {
+		return nil, err
+	}
+	groupByDbNames := make([]string, len(qp.groupBy))
+	for i, fieldName := range qp.groupBy {
+		groupByDbNames[i] = searchattribute.GetSqlDbColName(fieldName)
+	}
+
+	res := &AggregateStmts{
+		Histograms: make(map[int]*sqlplugin.VisibilitySelectFilter),
+		Types:      make([]enumspb.IndexedValueType, len(aggregations)),
+	}
+	var metricValues []string
+	for i, agg := range aggregations {
+		var expr sqlparser.Expr = &sqlparser.ColName{Name: sqlparser.NewColIdent(agg.SearchAttribute)}
+		colName, err := c.convertColName(&expr)
+		if err != nil {
+			return nil, err
+		}
+		if err := store.ValidateAggregation(agg, colName.valueType); err != nil {
+			return nil, err
+		}
+		res.Types[i] = colName.valueType
+
+		// Use the column name instead of the converted expression since
+		// CloseTime is converted to a coalesce expression.
+		dbColName := searchattribute.GetSqlDbColName(colName.fieldName)
+		valueExpr := dbColName
+		if colName.valueType == enumspb.INDEXED_VALUE_TYPE_DATETIME {
+			valueExpr = c.getEpochSecondsExpr(dbColName)
+		}
+		switch agg.Type {
+		case manager.AggregationTypeMin:
+			metricValues = append(metricValues, fmt.Sprintf("MIN(%s)", valueExpr))
+		case manager.AggregationTypeMax:
+			metricValues = append(metricValues, fmt.Sprintf("MAX(%s)", valueExpr))
+		case manager.AggregationTypeAvg:
+			metricValues = append(metricValues, fmt.Sprintf("AVG(%s)", valueExpr))
+		case manager.AggregationTypeHistogram:
+			// The interval always has a decimal point to avoid integer division.
+			interval := strconv.FormatFloat(agg.Interval, 'f', -1, 64)
+			if !strings.Contains(interval, ".") {
+				interval += ".0"
+			}
+			queryString := fmt.Sprintf("%s IS NOT NULL", dbColName)
+			if qp.queryString != "" {
+				queryString = fmt.Sprintf("%s and %s", qp.queryString, queryString)
+			}
+			stmt, args := c.buildCountStmt(
+				c.namespaceID,
+				queryString,
+				append(slices.Clone(groupByDbNames), fmt.Sprintf("FLOOR(%s / %s)", valueExpr, interval)),
+				nil,
+			)
+			res.Histograms[i] = &sqlplugin.VisibilitySelectFilter{
+				Query:     stmt,
+				QueryArgs: args,
+				GroupBy:   append(slices.Clone(qp.groupBy), colName.fieldName),
+			}
+		}
+	}
+
+	stmt, args := c.buildCountStmt(c.namespaceID, qp.queryString, groupByDbNames, metricValues)
+	res.Metrics = &sqlplugin.VisibilitySelectFilter{
+		Query:     stmt,
+		QueryArgs: args,
+		GroupBy:   qp.groupBy,
+	}
+	return res, nil
+}
+
 func (c *QueryConverter) convertWhereString(queryString string) (*queryParams, error) {
 	where := strings.TrimSpace(queryString)
 	if where != "" &&
@@ -224,14 +366,24 @@ func (c *QueryConverter) convertWhereString(queryString string) (*queryParams, e
 		colName := groupByExpr.(*saColName)
 		res.groupBy = append(res.groupBy, colName.fieldName)
 	}
+	for _, orderByExpr := range selectStmt.OrderBy {
+		// The parser already ensures the type is saColName.
+		colName := orderByExpr.Expr.(*saColName)
+		expr := sqlparser.String(colName)
+		if colName.fieldName == searchattribute.CloseTime {
+			expr = sqlparser.String(c.getCoalesceCloseTimeExpr())
+		}
+		res.orderBy = append(res.orderBy, orderByField{
+			expr:      expr,
+			fieldName: colName.fieldName,
+			valueType: colName.valueType,
+			desc:      orderByExpr.Direction == sqlparser.DescScr,
+		})
+	}
 	return res, nil
 }
 
 func (c *QueryConverter) convertSelectStmt(sel *sqlparser.Select) error {
-	if sel.OrderBy != nil {
-		return query.NewConverterError("%s: 'order by' clause", query.NotSupportedErrMessage)
-	}
-
 	if sel.Limit != nil {
 		return query.NewConverterError("%s: 'limit' clause", query.NotSupportedErrMessage)
 	}
@@ -292,7 +444,7 @@ func (c *QueryConverter) convertSelectStmt(sel *sqlparser.Select) error {
 		if err != nil {
 			return err
 		}
-		if colName.fieldName != searchattribute.ExecutionStatus {
+		if !c.aggregation && colName.fieldName != searchattribute.ExecutionStatus {
 			return query.NewConverterError(
 				"%s: 'group by' clause is only supported for %s search attribute",
 				query.NotSupportedErrMessage,
@@ -301,6 +453,30 @@ func (c *QueryConverter) convertSelectStmt(sel *sqlparser.Select) error {
 		}
 	}
 
+	if len(sel.GroupBy) > 0 && len(sel.OrderBy) > 0 {
+		return query.NewConverterError(
+			"%s: 'order by' clause is not supported with 'group by' clause",
+			query.NotSupportedErrMessage,
+		)
+	}
+	for _, orderByExpr := range sel.OrderBy {
+		colName, err := c.convertColName(&orderByExpr.Expr)
+		if err != nil {
+			return err
+		}
+		if !isSupportedTypeOrderBy(colName.valueType) {
+			return query.NewConverterError(
+				"%s: cannot order by search attribute '%s' of type %s",
+				query.NotSupportedErrMessage,
+				colName.alias,
+				colName.valueType.String(),
+			)
+		}
+		// convertColName replaces CloseTime with the coalesce expression.
+		// Keep the column name so convertWhereString can resolve the field.
+		orderByExpr.Expr = colName
+	}
+
 	return nil
 }
 
@@ -719,6 +895,15 @@ func isSupportedTextOperator(operator string) bool {
 	return isSupportedOperator(supportedTextOperators, operator)
 }
 
+func isSupportedTypeOrderBy(saType enumspb.IndexedValueType) bool {
+	for _, tp := range supportedTypesOrderBy {
+		if saType == tp {
+			return true
+		}
+	}
+	return false
+}
+
 func isSupportedTypeRangeCond(saType enumspb.IndexedValueType) bool {
 	for _, tp := range supportedTypesRangeCond {
 		if saType == tp {
@@ -27,6 +27,7 @@ package sql
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/temporalio/sqlparser"
@@ -102,6 +103,11 @@ func (c *mysqlQueryConverter) getDatetimeFormat() string {
 	return "2006-01-02 15:04:05.999999"
 }
 
+func (c *mysqlQueryConverter) getEpochSecondsExpr(expr string) string {
+	// TIMESTAMPDIFF doesn't depend on the session time zone unlike UNIX_TIMESTAMP.
+	return fmt.Sprintf("TIMESTAMPDIFF(MICROSECOND, '1970-01-01 00:00:00', %s) / 1000000", expr)
+}
+
 func (c *mysqlQueryConverter) getCoalesceCloseTimeExpr() sqlparser.Expr {
 	return newFuncExpr(
 		coalesceFuncName,
@@ -214,6 +220,7 @@ func (c *mysqlQueryConverter) buildSelectStmt(
 	queryString string,
 	pageSize int,
 	token *pageToken,
+	orderBy []orderByField,
 ) (string, []any) {
 	var whereClauses []string
 	var queryArgs []any
@@ -228,7 +235,21 @@ func (c *mysqlQueryConverter) buildSelectStmt(
 		whereClauses = append(whereClauses, queryString)
 	}
 
-	if token != nil {
+	orderByClause := fmt.Sprintf(
+		"%s DESC, %s DESC, %s",
+		sqlparser.String(c.getCoalesceCloseTimeExpr()),
+		searchattribute.GetSqlDbColName(searchattribute.StartTime),
+		searchattribute.GetSqlDbColName(searchattribute.RunID),
+	)
+	if len(orderBy) > 0 {
+		var tokenClause string
+		var tokenArgs []any
+		orderByClause, tokenClause, tokenArgs = buildOrderByClause(orderBy, token, false)
+		if tokenClause != "" {
+			whereClauses = append(whereClauses, tokenClause)
+			queryArgs = append(queryArgs, tokenArgs...)
+		}
+	} else if token != nil {
 		whereClauses = append(
 			whereClauses,
 			fmt.Sprintf(
@@ -260,15 +281,13 @@ func (c *mysqlQueryConverter) buildSelectStmt(
 		LEFT JOIN custom_search_attributes
 		USING (%s, %s)
 		WHERE %s
-		ORDER BY %s DESC, %s DESC, %s
+		ORDER BY %s
 		LIMIT ?`,
 		strings.Join(addPrefix("ev.", sqlplugin.DbFields), ", "),
 		searchattribute.GetSqlDbColName(searchattribute.NamespaceID),
 		searchattribute.GetSqlDbColName(searchattribute.RunID),
 		strings.Join(whereClauses, " AND "),
-		sqlparser.String(c.getCoalesceCloseTimeExpr()),
-		searchattribute.GetSqlDbColName(searchattribute.StartTime),
-		searchattribute.GetSqlDbColName(searchattribute.RunID),
+		orderByClause,
 	), queryArgs
 }
 
@@ -276,6 +295,7 @@ func (c *mysqlQueryConverter) buildCountStmt(
 	namespaceID namespace.ID,
 	queryString string,
 	groupBy []string,
+	values []string,
 ) (string, []any) {
 	var whereClauses []string
 	var queryArgs []any
@@ -302,7 +322,7 @@ func (c *mysqlQueryConverter) buildCountStmt(
 		USING (%s, %s)
 		WHERE %s
 		%s`,
-		strings.Join(append(groupBy, "COUNT(*)"), ", "),
+		strings.Join(append(append(slices.Clone(groupBy), "COUNT(*)"), values...), ", "),
 		searchattribute.GetSqlDbColName(searchattribute.NamespaceID),
 		searchattribute.GetSqlDbColName(searchattribute.RunID),
 		strings.Join(whereClauses, " AND "),
@@ -26,6 +26,7 @@ package sql
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/temporalio/sqlparser"
@@ -83,6 +84,10 @@ func (c *pgQueryConverter) getDatetimeFormat() string {
 	return "2006-01-02 15:04:05.999999"
 }
 
+func (c *pgQueryConverter) getEpochSecondsExpr(expr string) string {
+	return fmt.Sprintf("EXTRACT(EPOCH FROM %s)", expr)
+}
+
 func (c *pgQueryConverter) getCoalesceCloseTimeExpr() sqlparser.Expr {
 	return newFuncExpr(
 		coalesceFuncName,
@@ -221,6 +226,7 @@ func (c *pgQueryConverter) buildSelectStmt(
 	queryString string,
 	pageSize int,
 	token *pageToken,
+	orderBy []orderByField,
 ) (string, []any) {
 	var whereClauses []string
 	var queryArgs []any
@@ -235,7 +241,21 @@ func (c *pgQueryConverter) buildSelectStmt(
 		whereClauses = append(whereClauses, queryString)
 	}
 
-	if token != nil {
+	orderByClause := fmt.Sprintf(
+		"%s DESC, %s DESC, %s",
+		sqlparser.String(c.getCoalesceCloseTimeExpr()),
+		searchattribute.GetSqlDbColName(searchattribute.StartTime),
+		searchattribute.GetSqlDbColName(searchattribute.RunID),
+	)
+	if len(orderBy) > 0 {
+		var tokenClause string
+		var tokenArgs []any
+		orderByClause, tokenClause, tokenArgs = buildOrderByClause(orderBy, token, true)
+		if tokenClause != "" {
+			whereClauses = append(whereClauses, tokenClause)
+			queryArgs = append(queryArgs, tokenArgs...)
+		}
+	} else if token != nil {
 		whereClauses = append(
 			whereClauses,
 			fmt.Sprintf(
@@ -265,13 +285,11 @@ func (c *pgQueryConverter) buildSelectStmt(
 		`SELECT %s
 		FROM executions_visibility
 		WHERE %s
-		ORDER BY %s DESC, %s DESC, %s
+		ORDER BY %s
 		LIMIT ?`,
 		strings.Join(sqlplugin.DbFields, ", "),
 		strings.Join(whereClauses, " AND "),
-		sqlparser.String(c.getCoalesceCloseTimeExpr()),
-		searchattribute.GetSqlDbColName(searchattribute.StartTime),
-		searchattribute.GetSqlDbColName(searchattribute.RunID),
+		orderByClause,
 	), queryArgs
 }
 
@@ -279,6 +297,7 @@ func (c *pgQueryConverter) buildCountStmt(
 	namespaceID namespace.ID,
 	queryString string,
 	groupBy []string,
+	values []string,
 ) (string, []any) {
 	var whereClauses []string
 	var queryArgs []any
@@ -300,7 +319,7 @@ func (c *pgQueryConverter) buildCountStmt(
 
 	return fmt.Sprintf(
 		"SELECT %s FROM executions_visibility WHERE %s %s",
-		strings.Join(append(groupBy, "COUNT(*)"), ", "),
+		strings.Join(append(append(slices.Clone(groupBy), "COUNT(*)"), values...), ", "),
 		strings.Join(whereClauses, " AND "),
 		groupByClause,
 	), queryArgs
@@ -26,6 +26,7 @@ package sql
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/temporalio/sqlparser"
@@ -67,6 +68,11 @@ func (c *sqliteQueryConverter) getDatetimeFormat() string {
 	return "2006-01-02 15:04:05.999999-07:00"
 }
 
+func (c *sqliteQueryConverter) getEpochSecondsExpr(expr string) string {
+	// SQLite date and time functions have millisecond precision.
+	return fmt.Sprintf("UNIXEPOCH(%s, 'subsec')", expr)
+}
+
 func (c *sqliteQueryConverter) getCoalesceCloseTimeExpr() sqlparser.Expr {
 	return newFuncExpr(
 		coalesceFuncName,
@@ -230,6 +236,7 @@ func (c *sqliteQueryConverter) buildSelectStmt(
 	queryString string,
 	pageSize int,
 	token *pageToken,
+	orderBy []orderByField,
 ) (string, []any) {
 	var whereClauses []string
 	var queryArgs []any
@@ -244,7 +251,21 @@ func (c *sqliteQueryConverter) buildSelectStmt(
 		whereClauses = append(whereClauses, queryString)
 	}
 
-	if token != nil {
+	orderByClause := fmt.Sprintf(
+		"%s DESC, %s DESC, %s",
+		sqlparser.String(c.getCoalesceCloseTimeExpr()),
+		searchattribute.GetSqlDbColName(searchattribute.StartTime),
+		searchattribute.GetSqlDbColName(searchattribute.RunID),
+	)
+	if len(orderBy) > 0 {
+		var tokenClause string
+		var tokenArgs []any
+		orderByClause, tokenClause, tokenArgs = buildOrderByClause(orderBy, token, false)
+		if tokenClause != "" {
+			whereClauses = append(whereClauses, tokenClause)
+			queryArgs = append(queryArgs, tokenArgs...)
+		}
+	} else if token != nil {
 		whereClauses = append(
 			whereClauses,
 			fmt.Sprintf(
@@ -274,13 +295,11 @@ func (c *sqliteQueryConverter) buildSelectStmt(
 		`SELECT %s
 		FROM executions_visibility
 		WHERE %s
-		ORDER BY %s DESC, %s DESC, %s
+		ORDER BY %s
 		LIMIT ?`,
 		strings.Join(sqlplugin.DbFields, ", "),
 		strings.Join(whereClauses, " AND "),
-		sqlparser.String(c.getCoalesceCloseTimeExpr()),
-		searchattribute.GetSqlDbColName(searchattribute.StartTime),
-		searchattribute.GetSqlDbColName(searchattribute.RunID),
+		orderByClause,
 	), queryArgs
 }
 
@@ -319,6 +338,7 @@ func (c *sqliteQueryConverter) buildCountStmt(
 	namespaceID namespace.ID,
 	queryString string,
 	groupBy []string,
+	values []string,
 ) (string, []any) {
 	var whereClauses []string
 	var queryArgs []any
@@ -340,7 +360,7 @@ func (c *sqliteQueryConverter) buildCountStmt(
 
 	return fmt.Sprintf(
 		"SELECT %s FROM executions_visibility WHERE %s %s",
-		strings.Join(append(groupBy, "COUNT(*)"), ", "),
+		strings.Join(append(append(slices.Clone(groupBy), "COUNT(*)"), values...), ", "),
 		strings.Join(whereClauses, " AND "),
 		groupByClause,
 	), queryArgs
@@ -36,6 +36,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/query"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/searchattribute"
@@ -147,16 +148,70 @@ func (s *queryConverterSuite) TestConvertWhereString() {
 			),
 		},
 		{
-			name:   "order by not supported",
-			input:  "ORDER BY StartTime",
+			name:  "order by system search attribute",
+			input: "ORDER BY StartTime",
+			output: &queryParams{
+				queryString: "TemporalNamespaceDivision is null",
+				orderBy: []orderByField{
+					{
+						expr:      "start_time",
+						fieldName: searchattribute.StartTime,
+						valueType: enumspb.INDEXED_VALUE_TYPE_DATETIME,
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name:  "order by multiple fields",
+			input: "AliasForInt01 = 1 ORDER BY AliasForKeyword01 DESC, CloseTime",
+			output: &queryParams{
+				queryString: "(Int01 = 1) and TemporalNamespaceDivision is null",
+				orderBy: []orderByField{
+					{
+						expr:      "Keyword01",
+						fieldName: "Keyword01",
+						valueType: enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+						desc:      true,
+					},
+					{
+						expr:      sqlparser.String(s.pqc.getCoalesceCloseTimeExpr()),
+						fieldName: searchattribute.CloseTime,
+						valueType: enumspb.INDEXED_VALUE_TYPE_DATETIME,
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name:   "order by text not supported",
+			input:  "ORDER BY AliasForText01",
 			output: nil,
-			err:    query.NewConverterError("%s: 'order by' clause", query.NotSupportedErrMessage),
+			err: query.NewConverterError(
+				"%s: cannot order by search attribute '%s' of type %s",
+				query.NotSupportedErrMessage,
+				"AliasForText01",
+				enumspb.INDEXED_VALUE_TYPE_TEXT.String(),
+			),
+		},
+		{
+			name:   "order by invalid search attribute",
+			input:  "ORDER BY InvalidName",
+			output: nil,
+			err: query.NewConverterError(
+				"%s: column name '%s' is not a valid search attribute",
+				query.InvalidExpressionErrMessage,
+				"InvalidName",
+			),
 		},
 		{
 			name:   "group by with order by not supported",
 			input:  "GROUP BY ExecutionStatus ORDER BY StartTime",
 			output: nil,
-			err:    query.NewConverterError("%s: 'order by' clause", query.NotSupportedErrMessage),
+			err: query.NewConverterError(
+				"%s: 'order by' clause is not supported with 'group by' clause",
+				query.NotSupportedErrMessage,
+			),
 		},
 	}
 
@@ -182,6 +237,101 @@ func (s *queryConverterSuite) TestConvertWhereString() {
 	}
 }
 
+func (s *queryConverterSuite) TestBuildSelectStmtOrderBy() {
+	qc := newQueryConverterInternal(
+		s.pqc,
+		testNamespaceName,
+		testNamespaceID,
+		searchattribute.TestNameTypeMap,
+		&searchattribute.TestMapper{},
+		"ORDER BY AliasForInt01 DESC",
+	)
+
+	filter, err := qc.BuildSelectStmt(10, nil)
+	s.NoError(err)
+	s.Contains(filter.Query, "ORDER BY Int01 DESC, run_id DESC")
+	s.Equal([]any{testNamespaceID.String(), 10}, filter.QueryArgs)
+	s.Len(qc.orderBy, 1)
+
+	token, err := serializePageToken(&pageToken{RunID: "test-run-id", SortValues: []any{int64(5)}})
+	s.NoError(err)
+	filter, err = qc.BuildSelectStmt(10, token)
+	s.NoError(err)
+	s.Equal(testNamespaceID.String(), filter.QueryArgs[0])
+	s.Contains(filter.QueryArgs, int64(5))
+	s.Contains(filter.QueryArgs, "test-run-id")
+	s.Equal(10, filter.QueryArgs[len(filter.QueryArgs)-1])
+
+	// Page token from a query without 'order by' clause.
+	token, err = serializePageToken(&pageToken{RunID: "test-run-id"})
+	s.NoError(err)
+	_, err = qc.BuildSelectStmt(10, token)
+	var invalidArgErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgErr)
+}
+
+func (s *queryConverterSuite) TestBuildAggregateStmts() {
+	qc := newQueryConverterInternal(
+		s.pqc,
+		testNamespaceName,
+		testNamespaceID,
+		searchattribute.TestNameTypeMap,
+		&searchattribute.TestMapper{},
+		"AliasForInt01 > 1 GROUP BY AliasForKeyword01",
+	)
+
+	stmts, err := qc.BuildAggregateStmts([]*manager.Aggregation{
+		{Type: manager.AggregationTypeMin, SearchAttribute: "AliasForInt01"},
+		{Type: manager.AggregationTypeAvg, SearchAttribute: "AliasForDouble01"},
+		{Type: manager.AggregationTypeHistogram, SearchAttribute: "AliasForInt01", Interval: 10},
+	})
+	s.NoError(err)
+	s.Equal(
+		[]enumspb.IndexedValueType{
+			enumspb.INDEXED_VALUE_TYPE_INT,
+			enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+			enumspb.INDEXED_VALUE_TYPE_INT,
+		},
+		stmts.Types,
+	)
+	s.Contains(stmts.Metrics.Query, "MIN(Int01)")
+	s.Contains(stmts.Metrics.Query, "AVG(Double01)")
+	s.Contains(stmts.Metrics.Query, "GROUP BY Keyword01")
+	s.Equal([]string{"Keyword01"}, stmts.Metrics.GroupBy)
+
+	s.Len(stmts.Histograms, 1)
+	histogram := stmts.Histograms[2]
+	s.NotNil(histogram)
+	s.Contains(histogram.Query, "Int01 IS NOT NULL")
+	s.Contains(histogram.Query, "FLOOR(Int01 / 10.0)")
+	s.Equal([]string{"Keyword01", "Int01"}, histogram.GroupBy)
+
+	// Invalid aggregations.
+	for _, aggs := range [][]*manager.Aggregation{
+		{{Type: manager.AggregationTypeMax, SearchAttribute: "AliasForKeyword01"}},
+		{{Type: manager.AggregationTypeHistogram, SearchAttribute: "AliasForInt01"}},
+		{{Type: manager.AggregationTypeMin, SearchAttribute: "UnknownField"}},
+	} {
+		_, err = qc.BuildAggregateStmts(aggs)
+		s.Error(err)
+	}
+
+	// Only a single Keyword field is supported in 'group by' clause.
+	qc = newQueryConverterInternal(
+		s.pqc,
+		testNamespaceName,
+		testNamespaceID,
+		searchattribute.TestNameTypeMap,
+		&searchattribute.TestMapper{},
+		"GROUP BY AliasForInt01",
+	)
+	_, err = qc.BuildAggregateStmts([]*manager.Aggregation{
+		{Type: manager.AggregationTypeMin, SearchAttribute: "AliasForInt01"},
+	})
+	var converterErr *query.ConverterError
+	s.ErrorAs(err, &converterErr)
+}
+
 func (s *queryConverterSuite) TestConvertAndExpr() {
 	var tests = []testCase{
 		{
@@ -1087,6 +1237,96 @@ func TestSupportedTypeRangeCond(t *testing.T) {
 	}
 }
 
+func TestSupportedTypeOrderBy(t *testing.T) {
+	s := assert.New(t)
+	msg := "If you're changing the supported types for order by, " +
+		"remember to check they work correctly with MySQL, PostgreSQL and SQLite, " +
+		"and that page token values are handled in getSortValue and parseSortValue."
+	for tpCode := range enumspb.IndexedValueType_name {
+		tp := enumspb.IndexedValueType(tpCode)
+		switch tp {
+		case enumspb.INDEXED_VALUE_TYPE_BOOL,
+			enumspb.INDEXED_VALUE_TYPE_DATETIME,
+			enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+			enumspb.INDEXED_VALUE_TYPE_INT,
+			enumspb.INDEXED_VALUE_TYPE_KEYWORD:
+			s.True(isSupportedTypeOrderBy(tp), msg)
+		default:
+			s.False(isSupportedTypeOrderBy(tp), msg)
+		}
+	}
+}
+
+func TestBuildOrderByClause(t *testing.T) {
+	s := assert.New(t)
+	startTime := time.Date(2023, 3, 21, 14, 10, 32, 0, time.UTC)
+	intField := orderByField{
+		expr:      "Int01",
+		fieldName: "Int01",
+		valueType: enumspb.INDEXED_VALUE_TYPE_INT,
+		desc:      true,
+	}
+	keywordField := orderByField{
+		expr:      "Keyword01",
+		fieldName: "Keyword01",
+		valueType: enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	}
+	startTimeField := orderByField{
+		expr:      "start_time",
+		fieldName: searchattribute.StartTime,
+		valueType: enumspb.INDEXED_VALUE_TYPE_DATETIME,
+		desc:      true,
+	}
+
+	orderByClause, tokenClause, tokenArgs := buildOrderByClause([]orderByField{keywordField}, nil, false)
+	s.Equal("Keyword01 ASC, run_id ASC", orderByClause)
+	s.Empty(tokenClause)
+	s.Nil(tokenArgs)
+
+	// NULL values sort last in descending order.
+	token := &pageToken{RunID: "test-run-id", SortValues: []any{int64(5)}}
+	orderByClause, tokenClause, tokenArgs = buildOrderByClause([]orderByField{intField}, token, false)
+	s.Equal("Int01 DESC, run_id DESC", orderByClause)
+	s.Equal("((Int01 < ? OR Int01 IS NULL) OR Int01 = ? AND run_id < ?)", tokenClause)
+	s.Equal([]any{int64(5), int64(5), "test-run-id"}, tokenArgs)
+
+	// NULL values sort first in descending order.
+	orderByClause, tokenClause, tokenArgs = buildOrderByClause([]orderByField{intField}, token, true)
+	s.Equal("Int01 DESC, run_id DESC", orderByClause)
+	s.Equal("(Int01 < ? OR Int01 = ? AND run_id < ?)", tokenClause)
+	s.Equal([]any{int64(5), int64(5), "test-run-id"}, tokenArgs)
+
+	// NULL token value sorting first.
+	token = &pageToken{RunID: "test-run-id", SortValues: []any{nil, startTime}}
+	orderByClause, tokenClause, tokenArgs = buildOrderByClause(
+		[]orderByField{keywordField, startTimeField},
+		token,
+		false,
+	)
+	s.Equal("Keyword01 ASC, start_time DESC, run_id DESC", orderByClause)
+	s.Equal(
+		"(Keyword01 IS NOT NULL"+
+			" OR Keyword01 IS NULL AND (start_time < ? OR start_time IS NULL)"+
+			" OR Keyword01 IS NULL AND start_time = ? AND run_id < ?)",
+		tokenClause,
+	)
+	s.Equal([]any{startTime, startTime, "test-run-id"}, tokenArgs)
+
+	// NULL token value sorting last.
+	orderByClause, tokenClause, tokenArgs = buildOrderByClause(
+		[]orderByField{keywordField, startTimeField},
+		token,
+		true,
+	)
+	s.Equal("Keyword01 ASC, start_time DESC, run_id DESC", orderByClause)
+	s.Equal(
+		"(Keyword01 IS NULL AND start_time < ?"+
+			" OR Keyword01 IS NULL AND start_time = ? AND run_id < ?)",
+		tokenClause,
+	)
+	s.Equal([]any{startTime, startTime, "test-run-id"}, tokenArgs)
+}
+
 func newMapper(
 	getAlias func(fieldName, ns string) (string, error),
 	getFieldName func(alias, ns string) (string, error),
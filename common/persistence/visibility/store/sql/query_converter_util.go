@@ -25,6 +25,8 @@
 package sql
 
 import (
+	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -172,3 +174,89 @@ func getUnsafeStringTupleValues(valTuple sqlparser.ValTuple) ([]string, error) {
 	}
 	return values, nil
 }
+
+// buildOrderByClause returns the ORDER BY clause for a query with custom sort fields and, when
+// token is not nil, the condition that selects the rows after the token together with its
+// arguments. Ties are broken by run_id in the direction of the last sort field, so single field
+// sorts can use the (namespace_id, field) indexes in either direction.
+// Each database keeps its native NULL ordering for the same reason: nullsLargest must be true if
+// NULL values sort after all other values in ascending order (PostgreSQL), and false if they sort
+// before them (MySQL and SQLite).
+func buildOrderByClause(
+	orderBy []orderByField,
+	token *pageToken,
+	nullsLargest bool,
+) (string, string, []any) {
+	runIDColName := searchattribute.GetSqlDbColName(searchattribute.RunID)
+	runIDDesc := orderBy[len(orderBy)-1].desc
+
+	orderByItems := make([]string, 0, len(orderBy)+1)
+	for _, field := range orderBy {
+		orderByItems = append(orderByItems, field.expr+getOrderDirection(field.desc))
+	}
+	orderByItems = append(orderByItems, runIDColName+getOrderDirection(runIDDesc))
+	orderByClause := strings.Join(orderByItems, ", ")
+	if token == nil {
+		return orderByClause, "", nil
+	}
+
+	// Rows after the token are the ones where the first i sort fields are equal to the token
+	// values and the next one comes after the token value, for any i.
+	var orClauses []string
+	var tokenArgs []any
+	var equalClauses []string
+	var equalArgs []any
+	for i, field := range orderBy {
+		value := token.SortValues[i]
+		nullsLast := nullsLargest != field.desc
+		afterClause := ""
+		var afterArgs []any
+		if value == nil {
+			if !nullsLast {
+				afterClause = field.expr + " IS NOT NULL"
+			}
+		} else {
+			afterClause = fmt.Sprintf("%s %s ?", field.expr, getAfterOperator(field.desc))
+			afterArgs = []any{value}
+			if nullsLast {
+				afterClause = fmt.Sprintf("(%s OR %s IS NULL)", afterClause, field.expr)
+			}
+		}
+		if afterClause != "" {
+			orClauses = append(orClauses, strings.Join(append(slices.Clone(equalClauses), afterClause), " AND "))
+			tokenArgs = append(tokenArgs, equalArgs...)
+			tokenArgs = append(tokenArgs, afterArgs...)
+		}
+		if value == nil {
+			equalClauses = append(equalClauses, field.expr+" IS NULL")
+		} else {
+			equalClauses = append(equalClauses, field.expr+" = ?")
+			equalArgs = append(equalArgs, value)
+		}
+	}
+	orClauses = append(
+		orClauses,
+		strings.Join(
+			append(equalClauses, fmt.Sprintf("%s %s ?", runIDColName, getAfterOperator(runIDDesc))),
+			" AND ",
+		),
+	)
+	tokenArgs = append(tokenArgs, equalArgs...)
+	tokenArgs = append(tokenArgs, token.RunID)
+
+	return orderByClause, "(" + strings.Join(orClauses, " OR ") + ")", tokenArgs
+}
+
+func getOrderDirection(desc bool) string {
+	if desc {
+		return " DESC"
+	}
+	return " ASC"
+}
+
+func getAfterOperator(desc bool) string {
+	if desc {
+		return sqlparser.LessThanStr
+	}
+	return sqlparser.GreaterThanStr
+}
@@ -25,9 +25,13 @@
 package sql
 
 import (
+	"cmp"
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 	"time"
 
 	"go.temporal.io/api/common/v1"
@@ -232,16 +236,7 @@ func (s *VisibilityStore) ListWorkflowExecutions(
 
 	var nextPageToken []byte
 	if len(rows) == request.PageSize {
-		lastRow := rows[len(rows)-1]
-		closeTime := maxTime
-		if lastRow.CloseTime != nil {
-			closeTime = *lastRow.CloseTime
-		}
-		nextPageToken, err = serializePageToken(&pageToken{
-			CloseTime: closeTime,
-			StartTime: lastRow.StartTime,
-			RunID:     lastRow.RunID,
-		})
+		nextPageToken, err = serializePageToken(newPageToken(&rows[len(rows)-1], converter.orderBy))
 		if err != nil {
 			return nil, err
 		}
@@ -347,6 +342,121 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 	return resp, nil
 }
 
+func (s *VisibilityStore) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.GetIndexName(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	saMapper, err := s.searchAttributesMapperProvider.GetMapper(request.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	converter := NewQueryConverter(
+		s.GetName(),
+		request.Namespace,
+		request.NamespaceID,
+		saTypeMap,
+		saMapper,
+		request.Query,
+	)
+	stmts, err := converter.BuildAggregateStmts(request.Aggregations)
+	if err != nil {
+		// Convert ConverterError to InvalidArgument and pass through all other errors (which should be only mapper errors).
+		var converterErr *query.ConverterError
+		if errors.As(err, &converterErr) {
+			return nil, converterErr.ToInvalidArgument()
+		}
+		return nil, err
+	}
+
+	rows, err := s.sqlStore.Db.CountGroupByFromVisibility(ctx, *stmts.Metrics)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(
+			fmt.Sprintf("AggregateWorkflowExecutions operation failed. Query failed: %v", err))
+	}
+
+	resp := &manager.AggregateWorkflowExecutionsResponse{
+		Groups: make([]*manager.AggregationGroup, 0, len(rows)),
+	}
+	groups := make(map[string]*manager.AggregationGroup, len(rows))
+	for _, row := range rows {
+		// Without 'group by', there's a single row, even if the count is zero.
+		group := &manager.AggregationGroup{
+			GroupValues: make([]*common.Payload, len(row.GroupValues)),
+			Count:       row.Count,
+			Results:     make([]*manager.AggregationResult, len(request.Aggregations)),
+		}
+		for i, val := range row.GroupValues {
+			group.GroupValues[i], err = searchattribute.EncodeValue(val, enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+			if err != nil {
+				return nil, err
+			}
+		}
+		valueIdx := 0
+		for i, agg := range request.Aggregations {
+			group.Results[i] = &manager.AggregationResult{}
+			if agg.Type == manager.AggregationTypeHistogram {
+				continue
+			}
+			value, ok, err := parseAggregateValue(row.Values[valueIdx])
+			valueIdx++
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				group.Results[i].Value, err = store.NewAggregationValue(agg.Type, stmts.Types[i], value)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		groups[fmt.Sprint(row.GroupValues)] = group
+		resp.Groups = append(resp.Groups, group)
+	}
+
+	for i, histogramStmt := range stmts.Histograms {
+		rows, err := s.sqlStore.Db.CountGroupByFromVisibility(ctx, *histogramStmt)
+		if err != nil {
+			return nil, serviceerror.NewUnavailable(
+				fmt.Sprintf("AggregateWorkflowExecutions operation failed. Query failed: %v", err))
+		}
+		// The query groups by bucket index, so each bucket is in a single row.
+		slices.SortFunc(rows, func(a, b sqlplugin.VisibilityCountRow) int {
+			return cmp.Compare(bucketIndexOf(a), bucketIndexOf(b))
+		})
+		for _, row := range rows {
+			// The bucket index is the last group value.
+			group, ok := groups[fmt.Sprint(row.GroupValues[:len(row.GroupValues)-1])]
+			if !ok {
+				// The group didn't exist when the metrics query ran.
+				continue
+			}
+			bucketIndex, ok, err := parseAggregateValue(row.GroupValues[len(row.GroupValues)-1])
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			bucket, err := store.NewHistogramBucket(
+				stmts.Types[i],
+				bucketIndex*request.Aggregations[i].Interval,
+				row.Count,
+			)
+			if err != nil {
+				return nil, err
+			}
+			group.Results[i].Buckets = append(group.Results[i].Buckets, bucket)
+		}
+	}
+	return resp, nil
+}
+
 func (s *VisibilityStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -356,6 +466,10 @@ func (s *VisibilityStore) GetWorkflowExecution(
 		RunID:       request.RunID,
 	})
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, serviceerror.NewNotFound(
+				fmt.Sprintf("Workflow execution with run id %s not found.", request.RunID))
+		}
 		return nil, serviceerror.NewUnavailable(
 			fmt.Sprintf("GetWorkflowExecution operation failed. Select failed: %v", err))
 	}
@@ -539,3 +653,37 @@ func (s *VisibilityStore) processRowSearchAttributes(
 	}
 	return aliasedSas, nil
 }
+
+func bucketIndexOf(row sqlplugin.VisibilityCountRow) float64 {
+	index, _, _ := parseAggregateValue(row.GroupValues[len(row.GroupValues)-1])
+	return index
+}
+
+// parseAggregateValue converts a numeric value returned by the database to float64.
+// It returns false if the value is NULL.
+func parseAggregateValue(value any) (float64, bool, error) {
+	switch v := value.(type) {
+	case nil:
+		return 0, false, nil
+	case int64:
+		return float64(v), true, nil
+	case int32:
+		return float64(v), true, nil
+	case int:
+		return float64(v), true, nil
+	case float64:
+		return v, true, nil
+	case float32:
+		return float64(v), true, nil
+	case []byte:
+		// MySQL returns DECIMAL values as bytes.
+		f, err := strconv.ParseFloat(string(v), 64)
+		return f, err == nil, err
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil, err
+	default:
+		return 0, false, serviceerror.NewInternal(
+			fmt.Sprintf("Unexpected aggregation value type from DB: %T", value))
+	}
+}
@@ -0,0 +1,200 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package redaction removes sensitive memo keys, search attributes and payloads from API responses.
+package redaction
+
+import (
+	"slices"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/common/payload"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// RedactedValue replaces the values of redacted memo keys and payloads.
+	RedactedValue = "[REDACTED]"
+	// Wildcard matches all memo keys, search attributes or payload fields.
+	Wildcard = "*"
+)
+
+type (
+	// Config contains the field-level redaction rules of a namespace. The zero value redacts nothing.
+	Config struct {
+		// MemoKeys are the memo keys whose values are replaced with RedactedValue
+		MemoKeys []string
+		// SearchAttributes are the search attributes that are removed. They are removed instead of replaced,
+		// because a replacement value wouldn't match the type of the search attribute.
+		SearchAttributes []string
+		// PayloadFields are the names of proto fields, e.g. "input", "result" or "heartbeat_details", in which
+		// the data of all payloads is replaced with RedactedValue.
+		PayloadFields []string
+		// ExemptRoles are the roles that see unredacted values: "read", "write", "worker" or "admin". Defaults to
+		// write, worker and admin, which means that only callers with the read role or without any role in the
+		// namespace get redacted values. The worker role is always exempt, because workers need unredacted
+		// payloads to replay workflows.
+		ExemptRoles []string
+		// ExemptSubjects are the subjects of callers that see unredacted values
+		ExemptSubjects []string
+	}
+
+	// Redactor redacts messages according to a Config.
+	Redactor struct {
+		memoKeys         matcher
+		searchAttributes matcher
+		payloadFields    matcher
+	}
+
+	matcher []string
+)
+
+var (
+	memoDescriptor             = (&commonpb.Memo{}).ProtoReflect().Descriptor()
+	searchAttributesDescriptor = (&commonpb.SearchAttributes{}).ProtoReflect().Descriptor()
+	payloadDescriptor          = (&commonpb.Payload{}).ProtoReflect().Descriptor()
+)
+
+// IsEmpty returns true if cfg doesn't redact anything.
+func (cfg Config) IsEmpty() bool {
+	return len(cfg.MemoKeys) == 0 && len(cfg.SearchAttributes) == 0 && len(cfg.PayloadFields) == 0
+}
+
+// NewRedactor creates a Redactor for cfg.
+func NewRedactor(cfg Config) *Redactor {
+	return &Redactor{
+		memoKeys:         cfg.MemoKeys,
+		searchAttributes: cfg.SearchAttributes,
+		payloadFields:    cfg.PayloadFields,
+	}
+}
+
+// Redact redacts msg in place. Memos and search attributes are redacted wherever they occur in msg, e.g. in the
+// workflow execution info of DescribeWorkflowExecution and in the events of GetWorkflowExecutionHistory.
+func (r *Redactor) Redact(msg proto.Message) {
+	if msg == nil || !msg.ProtoReflect().IsValid() {
+		return
+	}
+	r.redactMessage(msg.ProtoReflect())
+}
+
+func (r *Redactor) redactMessage(m protoreflect.Message) {
+	switch m.Descriptor() {
+	case memoDescriptor:
+		r.redactMap(m, func(key string, value protoreflect.Value) bool {
+			if r.memoKeys.matches(key) {
+				redactPayloads(value.Message())
+			}
+			return false
+		})
+		return
+	case searchAttributesDescriptor:
+		r.redactMap(m, func(key string, _ protoreflect.Value) bool {
+			return r.searchAttributes.matches(key)
+		})
+		return
+	}
+
+	m.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if fd.Message() == nil || (fd.IsMap() && fd.MapValue().Message() == nil) {
+			return true
+		}
+		redact := r.redactMessage
+		if r.payloadFields.matches(string(fd.Name())) {
+			redact = redactPayloads
+		}
+		switch {
+		case fd.IsMap():
+			value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				redact(v.Message())
+				return true
+			})
+		case fd.IsList():
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				redact(list.Get(i).Message())
+			}
+		default:
+			redact(value.Message())
+		}
+		return true
+	})
+}
+
+// redactMap calls fn for every entry of the map field of m, which is either a Memo or SearchAttributes, and
+// removes the entries for which fn returns true.
+func (r *Redactor) redactMap(m protoreflect.Message, fn func(key string, value protoreflect.Value) bool) {
+	fd := m.Descriptor().Fields().ByNumber(1)
+	if !m.Has(fd) {
+		return
+	}
+	fields := m.Mutable(fd).Map()
+	var remove []protoreflect.MapKey
+	fields.Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+		if fn(key.String(), value) {
+			remove = append(remove, key)
+		}
+		return true
+	})
+	for _, key := range remove {
+		fields.Clear(key)
+	}
+}
+
+// redactPayloads replaces all payloads in m with RedactedValue.
+func redactPayloads(m protoreflect.Message) {
+	if m.Descriptor() == payloadDescriptor {
+		redacted := payload.EncodeString(RedactedValue).ProtoReflect()
+		proto.Reset(m.Interface())
+		redacted.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+			m.Set(fd, value)
+			return true
+		})
+		return
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					redactPayloads(v.Message())
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				list := value.List()
+				for i := 0; i < list.Len(); i++ {
+					redactPayloads(list.Get(i).Message())
+				}
+			}
+		case fd.Message() != nil:
+			redactPayloads(value.Message())
+		}
+		return true
+	})
+}
+
+func (m matcher) matches(name string) bool {
+	return len(m) > 0 && (slices.Contains(m, name) || slices.Contains(m, Wildcard))
+}
@@ -0,0 +1,118 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package redaction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
+)
+
+func decodeString(t *testing.T, p *commonpb.Payload) string {
+	t.Helper()
+	var value string
+	require.NoError(t, payload.Decode(p, &value))
+	return value
+}
+
+func TestRedactor_Describe(t *testing.T) {
+	response := &workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Memo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+				"email":  payload.EncodeString("jane@example.org"),
+				"region": payload.EncodeString("eu"),
+			}},
+			SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+				"CustomerId": payload.EncodeString("42"),
+				"Priority":   payload.EncodeString("high"),
+			}},
+		},
+		PendingActivities: []*workflowpb.PendingActivityInfo{
+			{HeartbeatDetails: payloads.EncodeString("card 4111")},
+		},
+	}
+	NewRedactor(Config{
+		MemoKeys:         []string{"email"},
+		SearchAttributes: []string{"CustomerId"},
+		PayloadFields:    []string{"heartbeat_details"},
+	}).Redact(response)
+
+	info := response.GetWorkflowExecutionInfo()
+	require.Equal(t, RedactedValue, decodeString(t, info.GetMemo().GetFields()["email"]))
+	require.Equal(t, "eu", decodeString(t, info.GetMemo().GetFields()["region"]))
+	require.NotContains(t, info.GetSearchAttributes().GetIndexedFields(), "CustomerId")
+	require.Contains(t, info.GetSearchAttributes().GetIndexedFields(), "Priority")
+	require.Equal(t, RedactedValue, decodeString(t, response.GetPendingActivities()[0].GetHeartbeatDetails().GetPayloads()[0]))
+}
+
+func TestRedactor_History(t *testing.T) {
+	history := &historypb.History{Events: []*historypb.HistoryEvent{
+		{Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+			WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+				Input: payloads.EncodeString("ssn 123"),
+				Memo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+					"email": payload.EncodeString("jane@example.org"),
+				}},
+				Header: &commonpb.Header{Fields: map[string]*commonpb.Payload{
+					"tracing": payload.EncodeString("span"),
+				}},
+			},
+		}},
+		{Attributes: &historypb.HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes{
+			UpsertWorkflowSearchAttributesEventAttributes: &historypb.UpsertWorkflowSearchAttributesEventAttributes{
+				SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+					"CustomerId": payload.EncodeString("42"),
+				}},
+			},
+		}},
+		{Attributes: &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{
+			ActivityTaskCompletedEventAttributes: &historypb.ActivityTaskCompletedEventAttributes{
+				Result: payloads.EncodeString("result"),
+			},
+		}},
+	}}
+	NewRedactor(Config{
+		MemoKeys:         []string{Wildcard},
+		SearchAttributes: []string{Wildcard},
+		PayloadFields:    []string{"input"},
+	}).Redact(history)
+
+	started := history.Events[0].GetWorkflowExecutionStartedEventAttributes()
+	require.Equal(t, RedactedValue, decodeString(t, started.GetInput().GetPayloads()[0]))
+	require.Equal(t, RedactedValue, decodeString(t, started.GetMemo().GetFields()["email"]))
+	require.Equal(t, "span", decodeString(t, started.GetHeader().GetFields()["tracing"]))
+	require.Empty(t, history.Events[1].GetUpsertWorkflowSearchAttributesEventAttributes().GetSearchAttributes().GetIndexedFields())
+	require.Equal(t, "result", decodeString(t, history.Events[2].GetActivityTaskCompletedEventAttributes().GetResult().GetPayloads()[0]))
+}
+
+func TestConfig_IsEmpty(t *testing.T) {
+	require.True(t, Config{}.IsEmpty())
+	require.True(t, Config{ExemptSubjects: []string{"admin"}}.IsEmpty())
+	require.False(t, Config{MemoKeys: []string{"email"}}.IsEmpty())
+}
@@ -340,6 +340,7 @@ func MatchingClientProvider(matchingRawClient MatchingRawClient) MatchingClient
 
 func PersistenceConfigProvider(persistenceConfig config.Persistence, dc *dynamicconfig.Collection) *config.Persistence {
 	persistenceConfig.TransactionSizeLimit = dynamicconfig.TransactionSizeLimit.Get(dc)
+	persistenceConfig.PayloadOffloadThreshold = dynamicconfig.PayloadOffloadThreshold.Get(dc)
 	return &persistenceConfig
 }
 
@@ -64,23 +64,27 @@ var (
 	}
 
 	historyAPIExcluded = map[string]struct{}{
-		"CloseShard":                {},
-		"GetShard":                  {},
-		"GetDLQMessages":            {},
-		"GetDLQReplicationMessages": {},
-		"GetReplicationMessages":    {},
-		"MergeDLQMessages":          {},
-		"PurgeDLQMessages":          {},
-		"RemoveTask":                {},
-		"SyncShardStatus":           {},
-		"GetReplicationStatus":      {},
-		"GetDLQTasks":               {},
-		"DeleteDLQTasks":            {},
-		"AddTasks":                  {},
-		"ListQueues":                {},
-		"ListTasks":                 {},
-		"CompleteNexusOperation":    {}, // NamespaceId is in the completion token for this request.
-		"DeepHealthCheck":           {},
+		"CloseShard":                        {},
+		"GetShard":                          {},
+		"GetDLQMessages":                    {},
+		"GetDLQReplicationMessages":         {},
+		"GetReplicationMessages":            {},
+		"MergeDLQMessages":                  {},
+		"PurgeDLQMessages":                  {},
+		"RemoveTask":                        {},
+		"SyncShardStatus":                   {},
+		"GetReplicationStatus":              {},
+		"GetDLQTasks":                       {},
+		"DeleteDLQTasks":                    {},
+		"AddTasks":                          {},
+		"ListQueues":                        {},
+		"ListTasks":                         {},
+		"DescribeQueue":                     {},
+		"MitigateQueue":                     {},
+		"DescribeOutboundQueue":             {},
+		"UpdateOutboundQueueCircuitBreaker": {},
+		"CompleteNexusOperation":            {}, // NamespaceId is in the completion token for this request.
+		"DeepHealthCheck":                   {},
 	}
 )
 
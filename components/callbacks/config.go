@@ -23,6 +23,7 @@
 package callbacks
 
 import (
+	"errors"
 	"regexp"
 	"strings"
 	"time"
@@ -49,9 +50,35 @@ var RetryPolicyMaximumInterval = dynamicconfig.NewGlobalDurationSetting(
 	`The maximum backoff interval between every callback request attempt for a given callback.`,
 )
 
+var SigningKeys = dynamicconfig.NewNamespaceTypedSettingWithConverter(
+	"component.callbacks.signingKeys",
+	signingKeysConverter,
+	[]SigningKey(nil),
+	`The per-namespace list of HMAC keys used to sign and verify Nexus completion callback requests. Keys are owned by the
+namespace receiving the completion: callbacks addressed to a /namespaces/{namespace}/nexus/callback URL are signed with
+the keys of {namespace}, regardless of the namespace sending them, and verified with the same keys by the frontend.
+Callbacks to other URLs are sent unsigned. Default is no keys, meaning callbacks are sent unsigned. Any invalid entries
+are ignored.
+Each entry is a map with possible values:
+	 - "ID":string (required) the key ID, sent along with the signature so receivers can select the matching key.
+	 - "Key":string (required) the secret key.
+	 - "VerifyOnly":bool (optional, default=false) indicates that the key is only accepted for verification.
+Requests are signed with the first key that is not VerifyOnly while all keys are accepted for verification.
+To rotate keys, add the new key as VerifyOnly on the receiving side, then move it to the front of the list and remove
+the old key once in-flight callbacks have been delivered.`)
+
+// SigningKey is an HMAC key used to sign and verify completion callback requests.
+type SigningKey struct {
+	ID         string
+	Key        []byte
+	VerifyOnly bool
+}
+
 type Config struct {
-	RequestTimeout dynamicconfig.DurationPropertyFnWithDestinationFilter
-	RetryPolicy    func() backoff.RetryPolicy
+	RequestTimeout   dynamicconfig.DurationPropertyFnWithDestinationFilter
+	RetryPolicy      func() backoff.RetryPolicy
+	SigningKeys      dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]SigningKey]
+	AllowedAddresses dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]AddressMatchRule]
 }
 
 func ConfigProvider(dc *dynamicconfig.Collection) *Config {
@@ -66,6 +93,8 @@ func ConfigProvider(dc *dynamicconfig.Collection) *Config {
 				backoff.NoInterval,
 			)
 		},
+		SigningKeys:      SigningKeys.Get(dc),
+		AllowedAddresses: AllowedAddresses.Get(dc),
 	}
 }
 
@@ -75,6 +104,7 @@ var AllowedAddresses = dynamicconfig.NewNamespaceTypedSettingWithConverter(
 	[]AddressMatchRule(nil),
 	`The per-namespace list of addresses that are allowed for callbacks and whether secure connections (https) are required.
 URLs are checked against each in order when starting a workflow with attached callbacks and only need to match one to pass validation.
+The addresses of gRPC callbacks are also checked before every invocation, secure gRPC connections use TLS with host verification.
 Default is no address rules, meaning all callbacks will be rejected. Any invalid entries are ignored. Each entry is a map with possible values:
 	 - "Pattern":string (required) the host:port pattern to which this config applies.
 		Wildcards, '*', are supported and can match any number of characters (e.g. '*' matches everything, 'prefix.*.domain' matches 'prefix.a.domain' as well as 'prefix.a.b.domain').
@@ -85,6 +115,25 @@ type AddressMatchRule struct {
 	AllowInsecure bool
 }
 
+// ErrAddressNotAllowed is returned by [CheckAddress] when an address doesn't match any rule.
+var ErrAddressNotAllowed = errors.New("address does not match any configured callback address")
+
+// ErrInsecureAddress is returned by [CheckAddress] when an address only matches a rule that requires secure connections.
+var ErrInsecureAddress = errors.New("callback address does not allow insecure connections")
+
+// CheckAddress checks a host:port address against the rules, the first matching rule applies.
+func CheckAddress(rules []AddressMatchRule, hostPort string, secure bool) error {
+	for _, rule := range rules {
+		if rule.Regexp.MatchString(hostPort) {
+			if !secure && !rule.AllowInsecure {
+				return ErrInsecureAddress
+			}
+			return nil
+		}
+	}
+	return ErrAddressNotAllowed
+}
+
 func allowedAddressConverter(val any) ([]AddressMatchRule, error) {
 	type entry struct {
 		Pattern       string
@@ -114,6 +163,51 @@ func allowedAddressConverter(val any) ([]AddressMatchRule, error) {
 	return configs, nil
 }
 
+func signingKeysConverter(val any) ([]SigningKey, error) {
+	type entry struct {
+		ID         string
+		Key        string
+		VerifyOnly bool
+	}
+	intermediate, err := dynamicconfig.ConvertStructure([]entry{})(val)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []SigningKey
+	for _, e := range intermediate {
+		if e.ID == "" || e.Key == "" {
+			// Skip keys with missing ID or secret
+			continue
+		}
+		keys = append(keys, SigningKey{
+			ID:         e.ID,
+			Key:        []byte(e.Key),
+			VerifyOnly: e.VerifyOnly,
+		})
+	}
+	return keys, nil
+}
+
+// ActiveSigningKey returns the key that should be used to sign requests, if any.
+func ActiveSigningKey(keys []SigningKey) (SigningKey, bool) {
+	for _, key := range keys {
+		if !key.VerifyOnly {
+			return key, true
+		}
+	}
+	return SigningKey{}, false
+}
+
+// VerificationKeys returns all keys accepted for verifying requests, indexed by key ID.
+func VerificationKeys(keys []SigningKey) map[string][]byte {
+	result := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		result[key.ID] = key.Key
+	}
+	return result
+}
+
 func addressPatternToRegexp(pattern string) string {
 	var result strings.Builder
 	result.WriteString("^")
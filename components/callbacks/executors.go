@@ -67,6 +67,7 @@ type (
 		MetricsHandler     metrics.Handler
 		Logger             log.Logger
 		HTTPCallerProvider HTTPCallerProvider
+		GRPCConnProvider   GRPCConnProvider
 		HistoryClient      resource.HistoryClient
 	}
 
@@ -162,6 +163,34 @@ func (e taskExecutor) loadInvocationArgs(
 			if err != nil {
 				return err
 			}
+		case *persistencespb.Callback_Grpc:
+			target, err := hsm.MachineData[CanGetHSMCompletionCallbackArg](node.Parent)
+			if err != nil {
+				return err
+			}
+			callbackArg, err := target.GetHSMCompletionCallbackArg(ctx)
+			if err != nil {
+				return err
+			}
+			// variant struct is immutable and ok to reference without copying
+			invokable = grpcInvocation{grpc: variant.Grpc, callbackArg: callbackArg}
+		case *persistencespb.Callback_Webhook_:
+			target, err := hsm.MachineData[CanGetHSMCompletionCallbackArg](node.Parent)
+			if err != nil {
+				return err
+			}
+			callbackArg, err := target.GetHSMCompletionCallbackArg(ctx)
+			if err != nil {
+				return err
+			}
+			completion, err := newWebhookCompletion(callbackArg)
+			if err != nil {
+				return queues.NewUnprocessableTaskError(
+					fmt.Sprintf("failed to construct webhook completion: %v", err),
+				)
+			}
+			// variant struct is immutable and ok to reference without copying
+			invokable = webhookInvocation{webhook: variant.Webhook, completion: completion}
 		default:
 			return queues.NewUnprocessableTaskError(
 				fmt.Sprintf("unprocessable callback variant: %v", variant),
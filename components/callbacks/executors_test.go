@@ -24,9 +24,12 @@ package callbacks_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"regexp"
 	"testing"
 	"time"
 
@@ -44,6 +47,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	commonnexus "go.temporal.io/server/common/nexus"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/components/callbacks"
 	"go.temporal.io/server/service/history/hsm"
@@ -54,6 +58,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
@@ -89,6 +94,8 @@ func TestProcessInvocationTaskNexus_Outcomes(t *testing.T) {
 	cases := []struct {
 		name                  string
 		caller                callbacks.HTTPCaller
+		url                   string
+		signingKeys           map[string][]callbacks.SigningKey
 		destinationDown       bool
 		expectedMetricOutcome string
 		assertOutcome         func(*testing.T, callbacks.Callback)
@@ -104,6 +111,32 @@ func TestProcessInvocationTaskNexus_Outcomes(t *testing.T) {
 				require.Equal(t, enumsspb.CALLBACK_STATE_SUCCEEDED, cb.State())
 			},
 		},
+		{
+			name: "signed",
+			caller: func(r *http.Request) (*http.Response, error) {
+				if err := commonnexus.VerifyRequest(r, map[string][]byte{"new": []byte("new-key")}, time.Now(), time.Minute, 1024); err != nil {
+					return &http.Response{StatusCode: 401, Body: http.NoBody}, nil
+				}
+				return &http.Response{StatusCode: 200, Body: http.NoBody}, nil
+			},
+			// Signed with the keys of the namespace in the callback URL, not the keys of the sending namespace.
+			url: "http://localhost/namespaces/target-namespace/nexus/callback",
+			signingKeys: map[string][]callbacks.SigningKey{
+				"target-namespace": {
+					{ID: "next", Key: []byte("next-key"), VerifyOnly: true},
+					{ID: "new", Key: []byte("new-key")},
+					{ID: "old", Key: []byte("old-key")},
+				},
+				"namespace-name": {
+					{ID: "sender", Key: []byte("sender-key")},
+				},
+			},
+			destinationDown:       false,
+			expectedMetricOutcome: "status:200",
+			assertOutcome: func(t *testing.T, cb callbacks.Callback) {
+				require.Equal(t, enumsspb.CALLBACK_STATE_SUCCEEDED, cb.State())
+			},
+		},
 		{
 			name: "failed",
 			caller: func(r *http.Request) (*http.Response, error) {
@@ -169,13 +202,17 @@ func TestProcessInvocationTaskNexus_Outcomes(t *testing.T) {
 				metrics.DestinationTag("http://localhost"),
 				metrics.OutcomeTag(tc.expectedMetricOutcome))
 
+			callbackURL := "http://localhost"
+			if tc.url != "" {
+				callbackURL = tc.url
+			}
 			root := newRoot(t)
 			cb := callbacks.Callback{
 				CallbackInfo: &persistencespb.CallbackInfo{
 					Callback: &persistencespb.Callback{
 						Variant: &persistencespb.Callback_Nexus_{
 							Nexus: &persistencespb.Callback_Nexus{
-								Url: "http://localhost",
+								Url: callbackURL,
 							},
 						},
 					},
@@ -203,6 +240,9 @@ func TestProcessInvocationTaskNexus_Outcomes(t *testing.T) {
 						RetryPolicy: func() backoff.RetryPolicy {
 							return backoff.NewExponentialRetryPolicy(time.Second)
 						},
+						SigningKeys: func(nsName string) []callbacks.SigningKey {
+							return tc.signingKeys[nsName]
+						},
 					},
 				},
 			))
@@ -394,6 +434,381 @@ func TestProcessInvocationTaskHsm_Outcomes(t *testing.T) {
 	}
 }
 
+func TestProcessInvocationTaskWebhook_Outcomes(t *testing.T) {
+	cases := []struct {
+		name                  string
+		bodyTemplate          string
+		caller                callbacks.HTTPCaller
+		destinationDown       bool
+		expectedMetricOutcome string
+		assertOutcome         func(*testing.T, callbacks.Callback)
+	}{
+		{
+			name: "success",
+			caller: func(r *http.Request) (*http.Response, error) {
+				if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" || r.Header.Get("x-token") != "secret" {
+					return &http.Response{Status: "400 Bad Request", StatusCode: 400, Body: http.NoBody}, nil
+				}
+				var completion callbacks.WebhookCompletion
+				if err := json.NewDecoder(r.Body).Decode(&completion); err != nil {
+					return nil, err
+				}
+				if completion.NamespaceID != "mynsid" || completion.WorkflowID != "mywid" || completion.RunID != "myrid" || completion.Status != "Completed" {
+					return &http.Response{Status: "400 Bad Request", StatusCode: 400, Body: http.NoBody}, nil
+				}
+				return &http.Response{StatusCode: 200, Body: http.NoBody}, nil
+			},
+			expectedMetricOutcome: "status:200",
+			assertOutcome: func(t *testing.T, cb callbacks.Callback) {
+				require.Equal(t, enumsspb.CALLBACK_STATE_SUCCEEDED, cb.State())
+			},
+		},
+		{
+			name:         "templated",
+			bodyTemplate: `{"text": "workflow {{.WorkflowID}} {{.Status}}"}`,
+			caller: func(r *http.Request) (*http.Response, error) {
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					return nil, err
+				}
+				if string(body) != `{"text": "workflow mywid Completed"}` || r.Header.Get("Content-Type") != "application/json" {
+					return &http.Response{Status: "400 Bad Request", StatusCode: 400, Body: http.NoBody}, nil
+				}
+				return &http.Response{StatusCode: 200, Body: http.NoBody}, nil
+			},
+			expectedMetricOutcome: "status:200",
+			assertOutcome: func(t *testing.T, cb callbacks.Callback) {
+				require.Equal(t, enumsspb.CALLBACK_STATE_SUCCEEDED, cb.State())
+			},
+		},
+		{
+			name: "retryable-error",
+			caller: func(r *http.Request) (*http.Response, error) {
+				return &http.Response{Status: "503 Service Unavailable", StatusCode: 503, Body: http.NoBody}, nil
+			},
+			destinationDown:       true,
+			expectedMetricOutcome: "status:503",
+			assertOutcome: func(t *testing.T, cb callbacks.Callback) {
+				require.Equal(t, enumsspb.CALLBACK_STATE_BACKING_OFF, cb.State())
+			},
+		},
+		{
+			name: "non-retryable-error",
+			caller: func(r *http.Request) (*http.Response, error) {
+				return &http.Response{Status: "400 Bad Request", StatusCode: 400, Body: http.NoBody}, nil
+			},
+			expectedMetricOutcome: "status:400",
+			assertOutcome: func(t *testing.T, cb callbacks.Callback) {
+				require.Equal(t, enumsspb.CALLBACK_STATE_FAILED, cb.State())
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			namespaceRegistryMock := namespace.NewMockRegistry(ctrl)
+			namespaceRegistryMock.EXPECT().GetNamespaceByID(namespace.ID("namespace-id")).Return(
+				namespace.FromPersistentState(&persistence.GetNamespaceResponse{
+					Namespace: &persistencespb.NamespaceDetail{
+						Info: &persistencespb.NamespaceInfo{
+							Id:   "namespace-id",
+							Name: "namespace-name",
+						},
+						Config: &persistencespb.NamespaceConfig{},
+					},
+				}),
+				nil,
+			)
+			metricsHandler := metrics.NewMockHandler(ctrl)
+			counter := metrics.NewMockCounterIface(ctrl)
+			timer := metrics.NewMockTimerIface(ctrl)
+			metricsHandler.EXPECT().Counter(callbacks.RequestCounter.Name()).Return(counter)
+			counter.EXPECT().Record(int64(1),
+				metrics.NamespaceTag("namespace-name"),
+				metrics.DestinationTag("http://localhost"),
+				metrics.OutcomeTag(tc.expectedMetricOutcome))
+			metricsHandler.EXPECT().Timer(callbacks.RequestLatencyHistogram.Name()).Return(timer)
+			timer.EXPECT().Record(gomock.Any(),
+				metrics.NamespaceTag("namespace-name"),
+				metrics.DestinationTag("http://localhost"),
+				metrics.OutcomeTag(tc.expectedMetricOutcome))
+
+			root := newRoot(t)
+			cb := callbacks.Callback{
+				CallbackInfo: &persistencespb.CallbackInfo{
+					Callback: &persistencespb.Callback{
+						Variant: &persistencespb.Callback_Webhook_{
+							Webhook: &persistencespb.Callback_Webhook{
+								Url:          "http://localhost/hook",
+								Header:       map[string]string{"x-token": "secret"},
+								BodyTemplate: tc.bodyTemplate,
+							},
+						},
+					},
+					State: enumsspb.CALLBACK_STATE_SCHEDULED,
+				},
+			}
+			coll := callbacks.MachineCollection(root)
+			node, err := coll.Add("ID", cb)
+			require.NoError(t, err)
+			env := fakeEnv{node}
+
+			key := definition.NewWorkflowKey("namespace-id", "", "")
+			reg := hsm.NewRegistry()
+			require.NoError(t, callbacks.RegisterExecutor(
+				reg,
+				callbacks.TaskExecutorOptions{
+					NamespaceRegistry: namespaceRegistryMock,
+					MetricsHandler:    metricsHandler,
+					HTTPCallerProvider: func(nid queues.NamespaceIDAndDestination) callbacks.HTTPCaller {
+						return tc.caller
+					},
+					Logger: log.NewNoopLogger(),
+					Config: &callbacks.Config{
+						RequestTimeout: dynamicconfig.GetDurationPropertyFnFilteredByDestination(time.Second),
+						RetryPolicy: func() backoff.RetryPolicy {
+							return backoff.NewExponentialRetryPolicy(time.Second)
+						},
+						SigningKeys: func(string) []callbacks.SigningKey {
+							return nil
+						},
+					},
+				},
+			))
+
+			err = reg.ExecuteImmediateTask(
+				context.Background(),
+				env,
+				hsm.Ref{
+					WorkflowKey: key,
+					StateMachineRef: &persistencespb.StateMachineRef{
+						Path: []*persistencespb.StateMachineKey{
+							{
+								Type: callbacks.StateMachineType,
+								Id:   "ID",
+							},
+						},
+					},
+				},
+				callbacks.InvocationTask{Destination: "http://localhost"},
+			)
+
+			if tc.destinationDown {
+				var destinationDownErr *queues.DestinationDownError
+				require.ErrorAs(t, err, &destinationDownErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			cb, err = coll.Data("ID")
+			require.NoError(t, err)
+			tc.assertOutcome(t, cb)
+		})
+	}
+}
+
+type fakeGRPCConn struct {
+	invoke func(ctx context.Context, method string, args any, reply any) error
+}
+
+func (c fakeGRPCConn) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	return c.invoke(ctx, method, args, reply)
+}
+
+func (fakeGRPCConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestProcessInvocationTaskGRPC_Outcomes(t *testing.T) {
+	cases := []struct {
+		name                  string
+		callErr               error
+		addressNotAllowed     bool
+		destinationDown       bool
+		expectedMetricOutcome codes.Code
+		assertOutcome         func(*testing.T, callbacks.Callback)
+	}{
+		{
+			name:                  "success",
+			expectedMetricOutcome: codes.OK,
+			assertOutcome: func(t *testing.T, cb callbacks.Callback) {
+				require.Equal(t, enumsspb.CALLBACK_STATE_SUCCEEDED, cb.State())
+			},
+		},
+		{
+			name:                  "retryable-error",
+			callErr:               status.Error(codes.Unavailable, "fake error"),
+			destinationDown:       true,
+			expectedMetricOutcome: codes.Unavailable,
+			assertOutcome: func(t *testing.T, cb callbacks.Callback) {
+				require.Equal(t, enumsspb.CALLBACK_STATE_BACKING_OFF, cb.State())
+			},
+		},
+		{
+			name:              "address-not-allowed",
+			addressNotAllowed: true,
+			assertOutcome: func(t *testing.T, cb callbacks.Callback) {
+				require.Equal(t, enumsspb.CALLBACK_STATE_FAILED, cb.State())
+				require.Contains(t, cb.LastAttemptFailure.GetMessage(), callbacks.ErrAddressNotAllowed.Error())
+			},
+		},
+		{
+			name:                  "non-retryable-error",
+			callErr:               status.Error(codes.InvalidArgument, "fake error"),
+			expectedMetricOutcome: codes.InvalidArgument,
+			assertOutcome: func(t *testing.T, cb callbacks.Callback) {
+				require.Equal(t, enumsspb.CALLBACK_STATE_FAILED, cb.State())
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			namespaceRegistryMock := namespace.NewMockRegistry(ctrl)
+			namespaceRegistryMock.EXPECT().GetNamespaceByID(namespace.ID("namespace-id")).Return(
+				namespace.FromPersistentState(&persistence.GetNamespaceResponse{
+					Namespace: &persistencespb.NamespaceDetail{
+						Info: &persistencespb.NamespaceInfo{
+							Id:   "namespace-id",
+							Name: "namespace-name",
+						},
+						Config: &persistencespb.NamespaceConfig{},
+					},
+				}),
+				nil,
+			)
+			metricsHandler := metrics.NewMockHandler(ctrl)
+			if !tc.addressNotAllowed {
+				counter := metrics.NewMockCounterIface(ctrl)
+				timer := metrics.NewMockTimerIface(ctrl)
+				metricsHandler.EXPECT().Counter(callbacks.RequestCounter.Name()).Return(counter)
+				counter.EXPECT().Record(int64(1),
+					metrics.NamespaceTag("namespace-name"),
+					metrics.DestinationTag("grpc://localhost:7777"),
+					metrics.OutcomeTag(fmt.Sprintf("status:%d", tc.expectedMetricOutcome)))
+				metricsHandler.EXPECT().Timer(callbacks.RequestLatencyHistogram.Name()).Return(timer)
+				timer.EXPECT().Record(gomock.Any(),
+					metrics.NamespaceTag("namespace-name"),
+					metrics.DestinationTag("grpc://localhost:7777"),
+					metrics.OutcomeTag(fmt.Sprintf("status:%d", tc.expectedMetricOutcome)))
+			}
+			allowedAddresses := []callbacks.AddressMatchRule{{Regexp: regexp.MustCompile(`^localhost:7777$`)}}
+			if tc.addressNotAllowed {
+				allowedAddresses = []callbacks.AddressMatchRule{{Regexp: regexp.MustCompile(`^other:7777$`)}}
+			}
+
+			root := newRoot(t)
+			cb := callbacks.Callback{
+				CallbackInfo: &persistencespb.CallbackInfo{
+					Callback: &persistencespb.Callback{
+						Variant: &persistencespb.Callback_Grpc{
+							Grpc: &persistencespb.Callback_GRPC{
+								Address: "localhost:7777",
+								Service: "my.package.CompletionService",
+								Method:  "Complete",
+								Header:  map[string]string{"x-token": "secret"},
+								Tls: &persistencespb.Callback_GRPC_TLS{
+									ServerName: "completions.local",
+								},
+							},
+						},
+					},
+					State: enumsspb.CALLBACK_STATE_SCHEDULED,
+				},
+			}
+			coll := callbacks.MachineCollection(root)
+			node, err := coll.Add("ID", cb)
+			require.NoError(t, err)
+			env := fakeEnv{node}
+
+			conn := fakeGRPCConn{
+				invoke: func(ctx context.Context, method string, args any, reply any) error {
+					require.Equal(t, "/my.package.CompletionService/Complete", method)
+					md, _ := metadata.FromOutgoingContext(ctx)
+					require.Equal(t, []string{"secret"}, md.Get("x-token"))
+					arg, ok := args.(*persistencespb.HSMCompletionCallbackArg)
+					require.True(t, ok)
+					require.Equal(t, "mywid", arg.WorkflowId)
+					require.Equal(t, int64(42), arg.LastEvent.EventId)
+					return tc.callErr
+				},
+			}
+
+			key := definition.NewWorkflowKey("namespace-id", "", "")
+			reg := hsm.NewRegistry()
+			require.NoError(t, callbacks.RegisterExecutor(
+				reg,
+				callbacks.TaskExecutorOptions{
+					NamespaceRegistry: namespaceRegistryMock,
+					MetricsHandler:    metricsHandler,
+					GRPCConnProvider: func(target callbacks.GRPCTarget) (grpc.ClientConnInterface, error) {
+						require.Equal(t, callbacks.GRPCTarget{
+							Address:    "localhost:7777",
+							TLS:        true,
+							ServerName: "completions.local",
+						}, target)
+						return conn, nil
+					},
+					Logger: log.NewNoopLogger(),
+					Config: &callbacks.Config{
+						RequestTimeout: dynamicconfig.GetDurationPropertyFnFilteredByDestination(time.Second),
+						RetryPolicy: func() backoff.RetryPolicy {
+							return backoff.NewExponentialRetryPolicy(time.Second)
+						},
+						AllowedAddresses: func(string) []callbacks.AddressMatchRule {
+							return allowedAddresses
+						},
+					},
+				},
+			))
+
+			err = reg.ExecuteImmediateTask(
+				context.Background(),
+				env,
+				hsm.Ref{
+					WorkflowKey: key,
+					StateMachineRef: &persistencespb.StateMachineRef{
+						Path: []*persistencespb.StateMachineKey{
+							{
+								Type: callbacks.StateMachineType,
+								Id:   "ID",
+							},
+						},
+					},
+				},
+				callbacks.InvocationTask{Destination: "grpc://localhost:7777"},
+			)
+
+			if tc.destinationDown {
+				var destinationDownErr *queues.DestinationDownError
+				require.ErrorAs(t, err, &destinationDownErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			cb, err = coll.Data("ID")
+			require.NoError(t, err)
+			tc.assertOutcome(t, cb)
+		})
+	}
+}
+
+func TestGRPCConnProvider_InvalidRootCA(t *testing.T) {
+	provider := callbacks.GRPCConnProviderProvider()
+	_, err := provider(callbacks.GRPCTarget{
+		Address:    "localhost:7777",
+		TLS:        true,
+		RootCAData: "not a certificate",
+	})
+	require.ErrorContains(t, err, "failed to parse root CA data")
+
+	conn, err := provider(callbacks.GRPCTarget{Address: "localhost:7777"})
+	require.NoError(t, err)
+	require.NotNil(t, conn)
+}
+
 func TestProcessBackoffTask(t *testing.T) {
 	root := newRoot(t)
 	cb := callbacks.Callback{
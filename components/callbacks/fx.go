@@ -25,6 +25,7 @@ package callbacks
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
@@ -33,6 +34,8 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/service/history/queues"
 	"go.uber.org/fx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
 )
 
 // Header key used to identify callbacks that originate from and target the same cluster.
@@ -42,6 +45,7 @@ var Module = fx.Module(
 	"component.callbacks",
 	fx.Provide(ConfigProvider),
 	fx.Provide(HTTPCallerProviderProvider),
+	fx.Provide(GRPCConnProviderProvider),
 	fx.Invoke(RegisterTaskSerializers),
 	fx.Invoke(RegisterStateMachine),
 	fx.Invoke(RegisterExecutor),
@@ -99,3 +103,34 @@ func HTTPCallerProviderProvider(
 	})
 	return m.Get, nil
 }
+
+func GRPCConnProviderProvider() GRPCConnProvider {
+	type connOrErr struct {
+		conn *grpc.ClientConn
+		err  error
+	}
+	m := collection.NewOnceMap(func(target GRPCTarget) connOrErr {
+		creds, err := target.transportCredentials()
+		if err != nil {
+			return connOrErr{err: err}
+		}
+		// Connections are created lazily and reused for all callbacks to the same target for the lifetime of the
+		// process, gRPC reconnects on its own if the connection is lost.
+		conn, err := grpc.NewClient(
+			target.Address,
+			grpc.WithTransportCredentials(creds),
+			grpc.WithConnectParams(grpc.ConnectParams{
+				Backoff:           backoff.DefaultConfig,
+				MinConnectTimeout: 2 * time.Second,
+			}),
+		)
+		return connOrErr{conn: conn, err: err}
+	})
+	return func(target GRPCTarget) (grpc.ClientConnInterface, error) {
+		c := m.Get(target)
+		if c.err != nil {
+			return nil, c.err
+		}
+		return c.conn, nil
+	}
+}
@@ -0,0 +1,168 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package callbacks
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/queues"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GRPCTarget identifies a gRPC connection, connections are shared between callbacks with the same target.
+type GRPCTarget struct {
+	Address string
+	// TLS is disabled when false, the rest of the fields are ignored.
+	TLS                     bool
+	ServerName              string
+	RootCAData              string
+	DisableHostVerification bool
+}
+
+// GRPCConnProvider returns a connection that can be used to invoke gRPC callbacks on the given target.
+type GRPCConnProvider func(GRPCTarget) (grpc.ClientConnInterface, error)
+
+func newGRPCTarget(cb *persistencespb.Callback_GRPC) GRPCTarget {
+	target := GRPCTarget{Address: cb.GetAddress()}
+	if t := cb.GetTls(); t != nil {
+		target.TLS = true
+		target.ServerName = t.GetServerName()
+		target.RootCAData = string(t.GetRootCaData())
+		target.DisableHostVerification = t.GetDisableHostVerification()
+	}
+	return target
+}
+
+// transportCredentials builds the credentials used to dial the target.
+func (t GRPCTarget) transportCredentials() (credentials.TransportCredentials, error) {
+	if !t.TLS {
+		return insecure.NewCredentials(), nil
+	}
+	serverName := t.ServerName
+	if serverName == "" {
+		host, _, err := net.SplitHostPort(t.Address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q: %w", t.Address, err)
+		}
+		serverName = host
+	}
+	tlsConfig := &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: t.DisableHostVerification, // nolint:gosec
+		MinVersion:         tls.VersionTLS12,
+	}
+	if t.RootCAData != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(t.RootCAData)) {
+			return nil, errors.New("failed to parse root CA data") // nolint:goerr113
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+type grpcInvocation struct {
+	grpc        *persistencespb.Callback_GRPC
+	callbackArg *persistencespb.HSMCompletionCallbackArg
+}
+
+func (g grpcInvocation) WrapError(result invocationResult, err error) error {
+	// If the request permanently failed there is no need to raise the error
+	if result == failed {
+		return nil
+	}
+	if err != nil {
+		return queues.NewDestinationDownError(err.Error(), err)
+	}
+	return nil
+}
+
+// NewGRPCCallback wraps a gRPC callback in an API callback that can be attached to StartWorkflowExecution requests.
+// The frontend validates the address against the namespace's allowed addresses, see [CheckGRPCAddress].
+func NewGRPCCallback(cb *persistencespb.Callback_GRPC) (*commonpb.Callback, error) {
+	return newInternalCallback(&persistencespb.Callback{
+		Variant: &persistencespb.Callback_Grpc{Grpc: cb},
+	})
+}
+
+// CheckGRPCAddress checks the address of a gRPC callback against the allowed address rules. Plain text connections and
+// TLS connections without host verification are considered insecure.
+func CheckGRPCAddress(rules []AddressMatchRule, cb *persistencespb.Callback_GRPC) error {
+	secure := cb.GetTls() != nil && !cb.GetTls().GetDisableHostVerification()
+	return CheckAddress(rules, cb.GetAddress(), secure)
+}
+
+func (g grpcInvocation) Invoke(ctx context.Context, ns *namespace.Namespace, e taskExecutor, task InvocationTask) (invocationResult, error) {
+	// The allowed addresses may have changed since the callback was attached.
+	if err := CheckGRPCAddress(e.Config.AllowedAddresses(ns.Name().String()), g.grpc); err != nil {
+		err = fmt.Errorf("%w: %v", err, g.grpc.GetAddress())
+		e.Logger.Error("gRPC callback address is not allowed", tag.Error(err))
+		return failed, err
+	}
+	conn, err := e.GRPCConnProvider(newGRPCTarget(g.grpc))
+	if err != nil {
+		return failed, queues.NewUnprocessableTaskError(
+			fmt.Sprintf("failed to create gRPC connection: %v", err),
+		)
+	}
+	if len(g.grpc.Header) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.New(g.grpc.Header))
+	}
+	method := "/" + g.grpc.Service + "/" + g.grpc.Method
+
+	// Make the call and record metrics. The response is not needed, any message can be decoded into Empty.
+	startTime := time.Now()
+	err = conn.Invoke(ctx, method, g.callbackArg, &emptypb.Empty{})
+
+	namespaceTag := metrics.NamespaceTag(ns.Name().String())
+	destTag := metrics.DestinationTag(task.Destination)
+	statusCodeTag := metrics.OutcomeTag(fmt.Sprintf("status:%d", status.Code(err)))
+	e.MetricsHandler.Counter(RequestCounter.Name()).Record(1, namespaceTag, destTag, statusCodeTag)
+	e.MetricsHandler.Timer(RequestLatencyHistogram.Name()).Record(time.Since(startTime), namespaceTag, destTag, statusCodeTag)
+
+	if err != nil {
+		retryable := isRetryableRpcResponse(err)
+		e.Logger.Error("gRPC callback request failed", tag.Error(err), tag.NewBoolTag("retryable", retryable))
+		if retryable {
+			return retry, err
+		}
+		return failed, err
+	}
+	return ok, nil
+}
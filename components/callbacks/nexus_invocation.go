@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	commonnexus "go.temporal.io/server/common/nexus"
 	"go.temporal.io/server/service/history/queues"
 )
 
@@ -77,6 +78,14 @@ func (n nexusInvocation) WrapError(result invocationResult, err error) error {
 	return nil
 }
 
+func (n nexusInvocation) signRequest(request *http.Request, keys []SigningKey) error {
+	key, ok := ActiveSigningKey(keys)
+	if !ok {
+		return nil
+	}
+	return commonnexus.SignRequest(request, key.Key, key.ID, time.Now())
+}
+
 func (n nexusInvocation) Invoke(ctx context.Context, ns *namespace.Namespace, e taskExecutor, task InvocationTask) (invocationResult, error) {
 	request, err := nexus.NewCompletionHTTPRequest(ctx, n.nexus.Url, n.completion)
 	if err != nil {
@@ -90,6 +99,15 @@ func (n nexusInvocation) Invoke(ctx context.Context, ns *namespace.Namespace, e
 	for k, v := range n.nexus.Header {
 		request.Header.Set(k, v)
 	}
+	// Signing keys are owned by the namespace receiving the completion, which is the only namespace the receiving
+	// frontend can derive from the request.
+	if targetNamespace, ok := commonnexus.CompletionCallbackNamespace(n.nexus.Url); ok {
+		if err := n.signRequest(request, e.Config.SigningKeys(targetNamespace)); err != nil {
+			return failed, queues.NewUnprocessableTaskError(
+				fmt.Sprintf("failed to sign Nexus request: %v", err),
+			)
+		}
+	}
 
 	caller := e.HTTPCallerProvider(queues.NamespaceIDAndDestination{
 		NamespaceID: ns.ID().String(),
@@ -104,8 +104,16 @@ func (c Callback) RegenerateTasks(*hsm.Node) ([]hsm.Task, error) {
 			}
 			baseURL = u.Scheme + "://" + u.Host
 			return []hsm.Task{InvocationTask{Destination: baseURL}}, nil
+		case *persistencespb.Callback_Webhook_:
+			u, err := url.Parse(c.Callback.GetWebhook().Url)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse URL: %v: %w", &c, err)
+			}
+			return []hsm.Task{InvocationTask{Destination: u.Scheme + "://" + u.Host}}, nil
 		case *persistencespb.Callback_Hsm:
 			return []hsm.Task{InvocationTask{Destination: ""}}, nil
+		case *persistencespb.Callback_Grpc:
+			return []hsm.Task{InvocationTask{Destination: "grpc://" + c.Callback.GetGrpc().Address}}, nil
 
 		default:
 			return nil, fmt.Errorf("unsupported callback variant %v", v) // nolint:goerr113
@@ -0,0 +1,264 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package callbacks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"text/template"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	commonnexus "go.temporal.io/server/common/nexus"
+	"go.temporal.io/server/service/history/queues"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// WebhookCompletion is the representation of a workflow completion delivered to webhook callbacks.
+// It is sent as the JSON request body unless the callback specifies a body template, in which case it is the data the
+// template is executed with.
+type WebhookCompletion struct {
+	NamespaceID string    `json:"namespaceId"`
+	WorkflowID  string    `json:"workflowId"`
+	RunID       string    `json:"runId"`
+	Status      string    `json:"status"`
+	CloseTime   time.Time `json:"closeTime"`
+	// Result of a completed workflow. Payloads encoded as "json/plain" are inlined, others are rendered in their proto
+	// JSON form.
+	Result json.RawMessage `json:"result,omitempty"`
+	// Failure of a workflow that did not complete successfully, rendered in its proto JSON form.
+	Failure json.RawMessage `json:"failure,omitempty"`
+}
+
+var webhookTemplateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// NewWebhookCallback wraps a webhook in an API callback that can be attached to StartWorkflowExecution requests.
+// The frontend validates the webhook like Nexus callbacks: the URL must match one of the namespace's allowed addresses
+// and the header must fit in the maximum callback header size.
+func NewWebhookCallback(webhook *persistencespb.Callback_Webhook) (*commonpb.Callback, error) {
+	return newInternalCallback(&persistencespb.Callback{
+		Variant: &persistencespb.Callback_Webhook_{Webhook: webhook},
+	})
+}
+
+// newInternalCallback serializes a persistence callback into an internal API callback.
+func newInternalCallback(cb *persistencespb.Callback) (*commonpb.Callback, error) {
+	data, err := proto.Marshal(cb)
+	if err != nil {
+		return nil, err
+	}
+	return &commonpb.Callback{
+		Variant: &commonpb.Callback_Internal_{
+			Internal: &commonpb.Callback_Internal{Data: data},
+		},
+	}, nil
+}
+
+// ValidateWebhookBodyTemplate returns an error if the given webhook body template cannot be parsed.
+func ValidateWebhookBodyTemplate(bodyTemplate string) error {
+	_, err := parseWebhookBodyTemplate(bodyTemplate)
+	return err
+}
+
+func parseWebhookBodyTemplate(bodyTemplate string) (*template.Template, error) {
+	return template.New("body").Funcs(webhookTemplateFuncs).Parse(bodyTemplate)
+}
+
+type webhookInvocation struct {
+	webhook    *persistencespb.Callback_Webhook
+	completion WebhookCompletion
+}
+
+// newWebhookCompletion converts a workflow completion event into a [WebhookCompletion].
+func newWebhookCompletion(arg *persistencespb.HSMCompletionCallbackArg) (WebhookCompletion, error) {
+	event := arg.GetLastEvent()
+	completion := WebhookCompletion{
+		NamespaceID: arg.GetNamespaceId(),
+		WorkflowID:  arg.GetWorkflowId(),
+		RunID:       arg.GetRunId(),
+		CloseTime:   event.GetEventTime().AsTime(),
+	}
+
+	var status enumspb.WorkflowExecutionStatus
+	var failure *failurepb.Failure
+	switch event.GetEventType() {
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
+		status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+		payloads := event.GetWorkflowExecutionCompletedEventAttributes().GetResult().GetPayloads()
+		if len(payloads) > 0 {
+			// All of our SDKs support returning a single value from workflows, we can safely ignore the rest of the
+			// payloads.
+			result, err := payloadToJSON(payloads[0])
+			if err != nil {
+				return completion, err
+			}
+			completion.Result = result
+		}
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+		status = enumspb.WORKFLOW_EXECUTION_STATUS_FAILED
+		failure = event.GetWorkflowExecutionFailedEventAttributes().GetFailure()
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
+		status = enumspb.WORKFLOW_EXECUTION_STATUS_CANCELED
+		failure = &failurepb.Failure{Message: "workflow canceled"}
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED:
+		status = enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED
+		failure = &failurepb.Failure{Message: "workflow terminated"}
+		if reason := event.GetWorkflowExecutionTerminatedEventAttributes().GetReason(); reason != "" {
+			failure.Message += ": " + reason
+		}
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:
+		status = enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT
+		failure = &failurepb.Failure{Message: "workflow timed out"}
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+		status = enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW
+	default:
+		return completion, fmt.Errorf("invalid workflow completion event type: %v", event.GetEventType()) // nolint:goerr113
+	}
+	completion.Status = status.String()
+
+	if failure != nil {
+		b, err := protojson.Marshal(failure)
+		if err != nil {
+			return completion, err
+		}
+		completion.Failure = b
+	}
+	return completion, nil
+}
+
+func payloadToJSON(p *commonpb.Payload) (json.RawMessage, error) {
+	if string(p.GetMetadata()["encoding"]) == "json/plain" && json.Valid(p.GetData()) {
+		return p.GetData(), nil
+	}
+	return protojson.Marshal(p)
+}
+
+func (w webhookInvocation) body() ([]byte, string, error) {
+	if w.webhook.BodyTemplate == "" {
+		b, err := json.Marshal(w.completion)
+		return b, "application/json", err
+	}
+	tmpl, err := parseWebhookBodyTemplate(w.webhook.BodyTemplate)
+	if err != nil {
+		return nil, "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, w.completion); err != nil {
+		return nil, "", err
+	}
+	contentType := "text/plain; charset=utf-8"
+	if json.Valid(buf.Bytes()) {
+		contentType = "application/json"
+	}
+	return buf.Bytes(), contentType, nil
+}
+
+func (w webhookInvocation) WrapError(result invocationResult, err error) error {
+	// If the request permanently failed there is no need to raise the error
+	if result == failed {
+		return nil
+	}
+	if err != nil {
+		return queues.NewDestinationDownError(err.Error(), err)
+	}
+	return nil
+}
+
+func (w webhookInvocation) Invoke(ctx context.Context, ns *namespace.Namespace, e taskExecutor, task InvocationTask) (invocationResult, error) {
+	body, contentType, err := w.body()
+	if err != nil {
+		return failed, queues.NewUnprocessableTaskError(
+			fmt.Sprintf("failed to render webhook request body: %v", err),
+		)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, w.webhook.Url, bytes.NewReader(body))
+	if err != nil {
+		return failed, queues.NewUnprocessableTaskError(
+			fmt.Sprintf("failed to construct webhook request: %v", err),
+		)
+	}
+	request.Header.Set("Content-Type", contentType)
+	for k, v := range w.webhook.Header {
+		request.Header.Set(k, v)
+	}
+	if key, ok := ActiveSigningKey(e.Config.SigningKeys(ns.Name().String())); ok {
+		if err := commonnexus.SignRequest(request, key.Key, key.ID, time.Now()); err != nil {
+			return failed, queues.NewUnprocessableTaskError(
+				fmt.Sprintf("failed to sign webhook request: %v", err),
+			)
+		}
+	}
+
+	caller := e.HTTPCallerProvider(queues.NamespaceIDAndDestination{
+		NamespaceID: ns.ID().String(),
+		Destination: task.Destination,
+	})
+	// Make the call and record metrics.
+	startTime := time.Now()
+	response, err := caller(request)
+
+	namespaceTag := metrics.NamespaceTag(ns.Name().String())
+	destTag := metrics.DestinationTag(task.Destination)
+	statusCodeTag := metrics.OutcomeTag(outcomeTag(ctx, response, err))
+	e.MetricsHandler.Counter(RequestCounter.Name()).Record(1, namespaceTag, destTag, statusCodeTag)
+	e.MetricsHandler.Timer(RequestLatencyHistogram.Name()).Record(time.Since(startTime), namespaceTag, destTag, statusCodeTag)
+
+	if err == nil {
+		// Body is not read but should be discarded to keep the underlying TCP connection alive.
+		if _, err = io.Copy(io.Discard, response.Body); err == nil {
+			err = response.Body.Close()
+		}
+	}
+
+	if err != nil {
+		e.Logger.Error("Webhook callback request failed with error", tag.Error(err))
+		return retry, err
+	}
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return ok, nil
+	}
+
+	retryable := isRetryableHTTPResponse(response)
+	err = fmt.Errorf("request failed with: %v", response.Status) // nolint:goerr113
+	e.Logger.Error("Webhook callback request failed", tag.Error(err), tag.NewStringTag("status", response.Status), tag.NewBoolTag("retryable", retryable))
+	if retryable {
+		return retry, err
+	}
+	return failed, err
+}
@@ -0,0 +1,68 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package delayedsignals
+
+import (
+	"time"
+
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+var RequestTimeout = dynamicconfig.NewNamespaceDurationSetting(
+	"component.delayedsignals.request.timeout",
+	time.Second*10,
+	`RequestTimeout is the timeout for a single attempt to deliver a delayed signal.`,
+)
+
+var RetryPolicyInitialInterval = dynamicconfig.NewGlobalDurationSetting(
+	"component.delayedsignals.retryPolicy.initialInterval",
+	time.Second,
+	`The initial backoff interval between every delivery attempt for a given delayed signal.`,
+)
+
+var RetryPolicyMaximumInterval = dynamicconfig.NewGlobalDurationSetting(
+	"component.delayedsignals.retryPolicy.maxInterval",
+	time.Hour,
+	`The maximum backoff interval between every delivery attempt for a given delayed signal.`,
+)
+
+type Config struct {
+	RequestTimeout dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	RetryPolicy    func() backoff.RetryPolicy
+}
+
+func ConfigProvider(dc *dynamicconfig.Collection) *Config {
+	return &Config{
+		RequestTimeout: RequestTimeout.Get(dc),
+		RetryPolicy: func() backoff.RetryPolicy {
+			return backoff.NewExponentialRetryPolicy(
+				RetryPolicyInitialInterval.Get(dc)(),
+			).WithMaximumInterval(
+				RetryPolicyMaximumInterval.Get(dc)(),
+			).WithExpirationInterval(
+				backoff.NoInterval,
+			)
+		},
+	}
+}
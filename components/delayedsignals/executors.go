@@ -0,0 +1,220 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package delayedsignals
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/service/history/hsm"
+	"go.uber.org/fx"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func RegisterExecutor(
+	registry *hsm.Registry,
+	executorOptions TaskExecutorOptions,
+) error {
+	exec := taskExecutor{executorOptions}
+	if err := hsm.RegisterTimerExecutor(
+		registry,
+		exec.executeFireTask,
+	); err != nil {
+		return err
+	}
+	if err := hsm.RegisterImmediateExecutor(
+		registry,
+		exec.executeDeliveryTask,
+	); err != nil {
+		return err
+	}
+	return hsm.RegisterTimerExecutor(
+		registry,
+		exec.executeBackoffTask,
+	)
+}
+
+type (
+	TaskExecutorOptions struct {
+		fx.In
+
+		Config            *Config
+		NamespaceRegistry namespace.Registry
+		MetricsHandler    metrics.Handler
+		Logger            log.Logger
+		HistoryClient     resource.HistoryClient
+	}
+
+	taskExecutor struct {
+		TaskExecutorOptions
+	}
+)
+
+func (e taskExecutor) executeFireTask(
+	env hsm.Environment,
+	node *hsm.Node,
+	task FireTask,
+) error {
+	return hsm.MachineTransition(node, func(ds DelayedSignal) (hsm.TransitionOutput, error) {
+		return TransitionFired.Apply(ds, EventFired{})
+	})
+}
+
+func (e taskExecutor) executeBackoffTask(
+	env hsm.Environment,
+	node *hsm.Node,
+	task BackoffTask,
+) error {
+	return hsm.MachineTransition(node, func(ds DelayedSignal) (hsm.TransitionOutput, error) {
+		return TransitionRescheduled.Apply(ds, EventRescheduled{})
+	})
+}
+
+func (e taskExecutor) executeDeliveryTask(
+	ctx context.Context,
+	env hsm.Environment,
+	ref hsm.Ref,
+	task DeliveryTask,
+) error {
+	ns, err := e.NamespaceRegistry.GetNamespaceByID(namespace.ID(ref.WorkflowKey.NamespaceID))
+	if err != nil {
+		return fmt.Errorf("failed to get namespace by ID: %w", err)
+	}
+
+	info, err := e.loadDeliveryArgs(ctx, env, ref)
+	if err != nil {
+		return err
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, e.Config.RequestTimeout(ns.Name().String()))
+	defer cancel()
+
+	startTime := time.Now()
+	_, callErr := e.HistoryClient.SignalWorkflowExecution(callCtx, &historyservice.SignalWorkflowExecutionRequest{
+		NamespaceId: ns.ID().String(),
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			Namespace:         ns.Name().String(),
+			WorkflowExecution: info.GetTarget(),
+			SignalName:        info.GetSignalName(),
+			Input:             info.GetInput(),
+			Header:            info.GetHeader(),
+			Identity:          info.GetIdentity(),
+			// Deduplicates the signal in case a previous attempt was applied but its result was not recorded.
+			RequestId: info.GetId(),
+		},
+	})
+
+	namespaceTag := metrics.NamespaceTag(ns.Name().String())
+	outcomeTag := metrics.OutcomeTag(fmt.Sprintf("status:%d", status.Code(callErr)))
+	RequestCounter.With(e.MetricsHandler).Record(1, namespaceTag, outcomeTag)
+	RequestLatencyHistogram.With(e.MetricsHandler).Record(time.Since(startTime), namespaceTag, outcomeTag)
+
+	if callErr != nil {
+		e.Logger.Warn("Delayed signal delivery failed",
+			tag.WorkflowNamespace(ns.Name().String()),
+			tag.WorkflowID(ref.WorkflowKey.WorkflowID),
+			tag.WorkflowRunID(ref.WorkflowKey.RunID),
+			tag.NewStringTag("delayed-signal-id", info.GetId()),
+			tag.Error(callErr),
+		)
+	}
+
+	// Retries are driven by the backoff timer, there's no need to return the call error to the queue.
+	return e.saveResult(ctx, env, ref, callErr)
+}
+
+func (e taskExecutor) loadDeliveryArgs(
+	ctx context.Context,
+	env hsm.Environment,
+	ref hsm.Ref,
+) (info *persistencespb.DelayedSignalInfo, err error) {
+	err = env.Access(ctx, ref, hsm.AccessRead, func(node *hsm.Node) error {
+		ds, err := hsm.MachineData[DelayedSignal](node)
+		if err != nil {
+			return err
+		}
+		info = proto.Clone(ds.DelayedSignalInfo).(*persistencespb.DelayedSignalInfo)
+		return nil
+	})
+	return
+}
+
+func (e taskExecutor) saveResult(
+	ctx context.Context,
+	env hsm.Environment,
+	ref hsm.Ref,
+	callErr error,
+) error {
+	return env.Access(ctx, ref, hsm.AccessWrite, func(node *hsm.Node) error {
+		ds, err := hsm.MachineData[DelayedSignal](node)
+		if err != nil {
+			return err
+		}
+		if ds.State() == enumsspb.DELAYED_SIGNAL_STATE_CANCELED {
+			// Canceled while the attempt was in flight, keep the canceled state.
+			return nil
+		}
+		return hsm.MachineTransition(node, func(ds DelayedSignal) (hsm.TransitionOutput, error) {
+			if callErr == nil {
+				return TransitionSucceeded.Apply(ds, EventSucceeded{Time: env.Now()})
+			}
+			if isRetryableSignalError(callErr) {
+				return TransitionAttemptFailed.Apply(ds, EventAttemptFailed{
+					Time:        env.Now(),
+					Err:         callErr,
+					RetryPolicy: e.Config.RetryPolicy(),
+				})
+			}
+			return TransitionFailed.Apply(ds, EventFailed{
+				Time: env.Now(),
+				Err:  callErr,
+			})
+		})
+	})
+}
+
+// isRetryableSignalError returns false for errors that would fail any subsequent attempt as well, e.g. when the
+// target workflow does not exist or is already closed.
+func isRetryableSignalError(err error) bool {
+	switch err.(type) {
+	case *serviceerror.NotFound,
+		*serviceerror.NamespaceNotFound,
+		*serviceerror.InvalidArgument,
+		*serviceerror.FailedPrecondition,
+		*serviceerror.PermissionDenied:
+		return false
+	}
+	return true
+}
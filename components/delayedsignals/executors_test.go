@@ -0,0 +1,229 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package delayedsignals_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/components/delayedsignals"
+	"go.temporal.io/server/service/history/hsm"
+	"go.temporal.io/server/service/history/hsm/hsmtest"
+	"go.temporal.io/server/service/history/workflow"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type fakeEnv struct {
+	node *hsm.Node
+}
+
+func (s fakeEnv) Access(ctx context.Context, ref hsm.Ref, accessType hsm.AccessType, accessor func(*hsm.Node) error) error {
+	return accessor(s.node)
+}
+
+func (fakeEnv) Now() time.Time {
+	return time.Now()
+}
+
+var _ hsm.Environment = fakeEnv{}
+
+func TestProcessDeliveryTask_Outcomes(t *testing.T) {
+	cases := []struct {
+		name          string
+		callErr       error
+		expectedState enumsspb.DelayedSignalState
+	}{
+		{
+			name:          "success",
+			expectedState: enumsspb.DELAYED_SIGNAL_STATE_SUCCEEDED,
+		},
+		{
+			name:          "retryable-error",
+			callErr:       serviceerror.NewUnavailable("fake error"),
+			expectedState: enumsspb.DELAYED_SIGNAL_STATE_BACKING_OFF,
+		},
+		{
+			name:          "non-retryable-error",
+			callErr:       serviceerror.NewNotFound("workflow not found"),
+			expectedState: enumsspb.DELAYED_SIGNAL_STATE_FAILED,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			node := newDelayedSignalNode(t, enumsspb.DELAYED_SIGNAL_STATE_SCHEDULED)
+
+			historyClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+			historyClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, in *historyservice.SignalWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.SignalWorkflowExecutionResponse, error) {
+					require.Equal(t, "namespace-id", in.NamespaceId)
+					require.Equal(t, "namespace-name", in.SignalRequest.Namespace)
+					require.Equal(t, "target-workflow-id", in.SignalRequest.WorkflowExecution.WorkflowId)
+					require.Equal(t, "signal", in.SignalRequest.SignalName)
+					require.Equal(t, "signal-id", in.SignalRequest.RequestId)
+					require.Equal(t, "identity", in.SignalRequest.Identity)
+					return &historyservice.SignalWorkflowExecutionResponse{}, tc.callErr
+				})
+
+			reg := newRegistry(t, ctrl, historyClient)
+			err := reg.ExecuteImmediateTask(context.Background(), fakeEnv{node}, newRef(), delayedsignals.DeliveryTask{})
+			require.NoError(t, err)
+
+			ds, err := hsm.MachineData[delayedsignals.DelayedSignal](node)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedState, ds.State())
+			require.Equal(t, int32(1), ds.Attempt)
+		})
+	}
+}
+
+func TestProcessDeliveryTask_CanceledWhileInFlight(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	node := newDelayedSignalNode(t, enumsspb.DELAYED_SIGNAL_STATE_SCHEDULED)
+
+	historyClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+	historyClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *historyservice.SignalWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.SignalWorkflowExecutionResponse, error) {
+			require.NoError(t, hsm.MachineTransition(node, func(ds delayedsignals.DelayedSignal) (hsm.TransitionOutput, error) {
+				return delayedsignals.TransitionCanceled.Apply(ds, delayedsignals.EventCanceled{})
+			}))
+			return &historyservice.SignalWorkflowExecutionResponse{}, nil
+		})
+
+	reg := newRegistry(t, ctrl, historyClient)
+	err := reg.ExecuteImmediateTask(context.Background(), fakeEnv{node}, newRef(), delayedsignals.DeliveryTask{})
+	require.NoError(t, err)
+
+	ds, err := hsm.MachineData[delayedsignals.DelayedSignal](node)
+	require.NoError(t, err)
+	require.Equal(t, enumsspb.DELAYED_SIGNAL_STATE_CANCELED, ds.State())
+}
+
+func TestProcessTimerTasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	reg := newRegistry(t, ctrl, nil)
+
+	node := newDelayedSignalNode(t, enumsspb.DELAYED_SIGNAL_STATE_WAITING)
+	require.NoError(t, reg.ExecuteTimerTask(fakeEnv{node}, node, delayedsignals.FireTask{}))
+	ds, err := hsm.MachineData[delayedsignals.DelayedSignal](node)
+	require.NoError(t, err)
+	require.Equal(t, enumsspb.DELAYED_SIGNAL_STATE_SCHEDULED, ds.State())
+
+	node = newDelayedSignalNode(t, enumsspb.DELAYED_SIGNAL_STATE_BACKING_OFF)
+	require.NoError(t, reg.ExecuteTimerTask(fakeEnv{node}, node, delayedsignals.BackoffTask{}))
+	ds, err = hsm.MachineData[delayedsignals.DelayedSignal](node)
+	require.NoError(t, err)
+	require.Equal(t, enumsspb.DELAYED_SIGNAL_STATE_SCHEDULED, ds.State())
+}
+
+func newRegistry(t *testing.T, ctrl *gomock.Controller, historyClient historyservice.HistoryServiceClient) *hsm.Registry {
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID("namespace-id")).Return(
+		namespace.FromPersistentState(&persistence.GetNamespaceResponse{
+			Namespace: &persistencespb.NamespaceDetail{
+				Info: &persistencespb.NamespaceInfo{
+					Id:   "namespace-id",
+					Name: "namespace-name",
+				},
+				Config: &persistencespb.NamespaceConfig{},
+			},
+		}),
+		nil,
+	).AnyTimes()
+
+	reg := hsm.NewRegistry()
+	require.NoError(t, delayedsignals.RegisterExecutor(
+		reg,
+		delayedsignals.TaskExecutorOptions{
+			NamespaceRegistry: namespaceRegistry,
+			MetricsHandler:    metrics.NoopMetricsHandler,
+			HistoryClient:     historyClient,
+			Logger:            log.NewNoopLogger(),
+			Config: &delayedsignals.Config{
+				RequestTimeout: dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Second),
+				RetryPolicy: func() backoff.RetryPolicy {
+					return backoff.NewExponentialRetryPolicy(time.Second)
+				},
+			},
+		},
+	))
+	return reg
+}
+
+func newRef() hsm.Ref {
+	return hsm.Ref{
+		WorkflowKey: definition.NewWorkflowKey("namespace-id", "workflow-id", "run-id"),
+		StateMachineRef: &persistencespb.StateMachineRef{
+			Path: []*persistencespb.StateMachineKey{
+				{
+					Type: delayedsignals.StateMachineType,
+					Id:   "signal-id",
+				},
+			},
+		},
+	}
+}
+
+func newDelayedSignalNode(t *testing.T, state enumsspb.DelayedSignalState) *hsm.Node {
+	root := newRoot(t)
+	node, err := delayedsignals.MachineCollection(root).Add("signal-id", delayedsignals.DelayedSignal{
+		DelayedSignalInfo: &persistencespb.DelayedSignalInfo{
+			Id:                      "signal-id",
+			Target:                  &commonpb.WorkflowExecution{WorkflowId: "target-workflow-id"},
+			SignalName:              "signal",
+			Identity:                "identity",
+			FireTime:                timestamppb.Now(),
+			NextAttemptScheduleTime: timestamppb.Now(),
+			State:                   state,
+		},
+	})
+	require.NoError(t, err)
+	return node
+}
+
+func newRoot(t *testing.T) *hsm.Node {
+	reg := hsm.NewRegistry()
+	require.NoError(t, workflow.RegisterStateMachine(reg))
+	require.NoError(t, delayedsignals.RegisterStateMachine(reg))
+	root, err := hsm.NewRoot(reg, workflow.StateMachineType, nil, make(map[string]*persistencespb.StateMachineMap), &hsmtest.NodeBackend{})
+	require.NoError(t, err)
+	return root
+}
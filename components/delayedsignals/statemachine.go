@@ -0,0 +1,310 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package delayedsignals
+
+import (
+	"fmt"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/service/history/hsm"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// StateMachineType is a unique type identifier for this state machine.
+	StateMachineType = "delayedsignals.DelayedSignal"
+
+	// A marker for the first return value from a progress() that indicates the machine is in a terminal state.
+	// TODO: Remove this once transition history is fully implemented.
+	terminalStage = 3
+)
+
+// MachineCollection creates a new typed [statemachines.Collection] for delayed signals.
+func MachineCollection(tree *hsm.Node) hsm.Collection[DelayedSignal] {
+	return hsm.NewCollection[DelayedSignal](tree, StateMachineType)
+}
+
+// DelayedSignal state machine.
+// A delayed signal is a durable timer hosted on a workflow execution that signals a workflow, either the hosting
+// execution itself or another workflow in the same namespace, when it fires.
+type DelayedSignal struct {
+	*persistencespb.DelayedSignalInfo
+}
+
+// AddChild adds a new delayed signal child machine to the given node and transitions it to the WAITING state.
+// The info's ID is used as the machine ID.
+func AddChild(node *hsm.Node, info *persistencespb.DelayedSignalInfo) (*hsm.Node, error) {
+	info.State = enumsspb.DELAYED_SIGNAL_STATE_UNSPECIFIED
+	child, err := node.AddChild(hsm.Key{Type: StateMachineType, ID: info.Id}, DelayedSignal{info})
+	if err != nil {
+		return nil, err
+	}
+	return child, hsm.MachineTransition(child, func(ds DelayedSignal) (hsm.TransitionOutput, error) {
+		return TransitionCreated.Apply(ds, EventCreated{})
+	})
+}
+
+func (d DelayedSignal) State() enumsspb.DelayedSignalState {
+	return d.DelayedSignalInfo.State
+}
+
+func (d DelayedSignal) SetState(state enumsspb.DelayedSignalState) {
+	d.DelayedSignalInfo.State = state
+}
+
+// Pending returns true if the delayed signal has not been delivered, canceled or permanently failed yet.
+func (d DelayedSignal) Pending() bool {
+	switch d.State() { // nolint:exhaustive
+	case enumsspb.DELAYED_SIGNAL_STATE_FAILED,
+		enumsspb.DELAYED_SIGNAL_STATE_SUCCEEDED,
+		enumsspb.DELAYED_SIGNAL_STATE_CANCELED:
+		return false
+	}
+	return true
+}
+
+func (d DelayedSignal) recordAttempt(ts time.Time) {
+	d.DelayedSignalInfo.Attempt++
+	d.DelayedSignalInfo.LastAttemptCompleteTime = timestamppb.New(ts)
+}
+
+func (d DelayedSignal) RegenerateTasks(*hsm.Node) ([]hsm.Task, error) {
+	switch d.DelayedSignalInfo.State { // nolint:exhaustive
+	case enumsspb.DELAYED_SIGNAL_STATE_WAITING:
+		return []hsm.Task{FireTask{Deadline: d.FireTime.AsTime()}}, nil
+	case enumsspb.DELAYED_SIGNAL_STATE_SCHEDULED:
+		return []hsm.Task{DeliveryTask{}}, nil
+	case enumsspb.DELAYED_SIGNAL_STATE_BACKING_OFF:
+		return []hsm.Task{BackoffTask{Deadline: d.NextAttemptScheduleTime.AsTime()}}, nil
+	}
+	return nil, nil
+}
+
+func (d DelayedSignal) output() (hsm.TransitionOutput, error) {
+	// Task logic is the same when regenerating tasks for a given state and when transitioning to that state.
+	// Node is ignored here.
+	tasks, err := d.RegenerateTasks(nil)
+	return hsm.TransitionOutput{Tasks: tasks}, err
+}
+
+// TODO: Remove this implementation once transition history is fully implemented.
+func (d DelayedSignal) progress() (int, int32, error) {
+	switch d.State() {
+	case enumsspb.DELAYED_SIGNAL_STATE_UNSPECIFIED:
+		return 0, 0, serviceerror.NewInvalidArgument("uninitialized delayed signal state")
+	case enumsspb.DELAYED_SIGNAL_STATE_WAITING:
+		return 1, 0, nil
+	case enumsspb.DELAYED_SIGNAL_STATE_BACKING_OFF:
+		return 2, d.GetAttempt() * 2, nil
+	case enumsspb.DELAYED_SIGNAL_STATE_SCHEDULED:
+		// We've made slightly more progress if we transitioned from backing off to scheduled.
+		return 2, d.GetAttempt()*2 + 1, nil
+	case enumsspb.DELAYED_SIGNAL_STATE_FAILED,
+		enumsspb.DELAYED_SIGNAL_STATE_SUCCEEDED,
+		enumsspb.DELAYED_SIGNAL_STATE_CANCELED:
+		// Consider any terminal state as "max progress", we'll rely on last update namespace failover version to break
+		// the tie when comparing two states.
+		return terminalStage, 0, nil
+	default:
+		return 0, 0, serviceerror.NewInvalidArgument("unknown delayed signal state")
+	}
+}
+
+type stateMachineDefinition struct{}
+
+func (stateMachineDefinition) Type() string {
+	return StateMachineType
+}
+
+func (stateMachineDefinition) Deserialize(d []byte) (any, error) {
+	info := &persistencespb.DelayedSignalInfo{}
+	if err := proto.Unmarshal(d, info); err != nil {
+		return nil, serialization.NewDeserializationError(enumspb.ENCODING_TYPE_PROTO3, err)
+	}
+	return DelayedSignal{info}, nil
+}
+
+func (stateMachineDefinition) Serialize(state any) ([]byte, error) {
+	if state, ok := state.(DelayedSignal); ok {
+		return proto.Marshal(state.DelayedSignalInfo)
+	}
+	return nil, fmt.Errorf("invalid delayed signal provided: %v", state) // nolint:goerr113
+}
+
+// CompareState compares the progress of two DelayedSignal state machines to determine whether to sync machine state
+// while processing a replication task.
+// TODO: Remove this implementation once transition history is fully implemented.
+func (stateMachineDefinition) CompareState(state1, state2 any) (int, error) {
+	ds1, ok := state1.(DelayedSignal)
+	if !ok {
+		return 0, fmt.Errorf("%w: expected state1 to be a DelayedSignal instance, got %v", hsm.ErrIncompatibleType, state1)
+	}
+	ds2, ok := state2.(DelayedSignal)
+	if !ok {
+		return 0, fmt.Errorf("%w: expected state2 to be a DelayedSignal instance, got %v", hsm.ErrIncompatibleType, state2)
+	}
+
+	stage1, attempts1, err := ds1.progress()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get progress for state1: %w", err)
+	}
+	stage2, attempts2, err := ds2.progress()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get progress for state2: %w", err)
+	}
+	if stage1 != stage2 {
+		return stage1 - stage2, nil
+	}
+	if stage1 == terminalStage && ds1.State() != ds2.State() {
+		return 0, serviceerror.NewInvalidArgument(fmt.Sprintf("cannot compare two distinct terminal states: %v, %v", ds1.State(), ds2.State()))
+	}
+	return int(attempts1 - attempts2), nil
+}
+
+func RegisterStateMachine(r *hsm.Registry) error {
+	return r.RegisterMachine(stateMachineDefinition{})
+}
+
+// EventCreated is triggered when the delayed signal is added to the tree.
+type EventCreated struct{}
+
+var TransitionCreated = hsm.NewTransition(
+	[]enumsspb.DelayedSignalState{enumsspb.DELAYED_SIGNAL_STATE_UNSPECIFIED},
+	enumsspb.DELAYED_SIGNAL_STATE_WAITING,
+	func(ds DelayedSignal, event EventCreated) (hsm.TransitionOutput, error) {
+		return ds.output()
+	},
+)
+
+// EventFired is triggered when the delayed signal's fire time is reached.
+type EventFired struct{}
+
+var TransitionFired = hsm.NewTransition(
+	[]enumsspb.DelayedSignalState{enumsspb.DELAYED_SIGNAL_STATE_WAITING},
+	enumsspb.DELAYED_SIGNAL_STATE_SCHEDULED,
+	func(ds DelayedSignal, event EventFired) (hsm.TransitionOutput, error) {
+		return ds.output()
+	},
+)
+
+// EventRescheduled is triggered when delivery is meant to be rescheduled after backing off from a previous attempt.
+type EventRescheduled struct{}
+
+var TransitionRescheduled = hsm.NewTransition(
+	[]enumsspb.DelayedSignalState{enumsspb.DELAYED_SIGNAL_STATE_BACKING_OFF},
+	enumsspb.DELAYED_SIGNAL_STATE_SCHEDULED,
+	func(ds DelayedSignal, event EventRescheduled) (hsm.TransitionOutput, error) {
+		ds.DelayedSignalInfo.NextAttemptScheduleTime = nil
+		return ds.output()
+	},
+)
+
+// EventAttemptFailed is triggered when a delivery attempt is failed with a retryable error.
+type EventAttemptFailed struct {
+	Time        time.Time
+	Err         error
+	RetryPolicy backoff.RetryPolicy
+}
+
+var TransitionAttemptFailed = hsm.NewTransition(
+	[]enumsspb.DelayedSignalState{enumsspb.DELAYED_SIGNAL_STATE_SCHEDULED},
+	enumsspb.DELAYED_SIGNAL_STATE_BACKING_OFF,
+	func(ds DelayedSignal, event EventAttemptFailed) (hsm.TransitionOutput, error) {
+		ds.recordAttempt(event.Time)
+		// Use 0 for elapsed time as we don't limit the retry by time (for now).
+		nextDelay := event.RetryPolicy.ComputeNextDelay(0, int(ds.Attempt), event.Err)
+		ds.DelayedSignalInfo.NextAttemptScheduleTime = timestamppb.New(event.Time.Add(nextDelay))
+		ds.DelayedSignalInfo.LastAttemptFailure = &failurepb.Failure{
+			Message: event.Err.Error(),
+			FailureInfo: &failurepb.Failure_ApplicationFailureInfo{
+				ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{
+					NonRetryable: false,
+				},
+			},
+		}
+		return ds.output()
+	},
+)
+
+// EventFailed is triggered when a delivery attempt is failed with a non retryable error.
+type EventFailed struct {
+	Time time.Time
+	Err  error
+}
+
+var TransitionFailed = hsm.NewTransition(
+	[]enumsspb.DelayedSignalState{enumsspb.DELAYED_SIGNAL_STATE_SCHEDULED},
+	enumsspb.DELAYED_SIGNAL_STATE_FAILED,
+	func(ds DelayedSignal, event EventFailed) (hsm.TransitionOutput, error) {
+		ds.recordAttempt(event.Time)
+		ds.DelayedSignalInfo.LastAttemptFailure = &failurepb.Failure{
+			Message: event.Err.Error(),
+			FailureInfo: &failurepb.Failure_ApplicationFailureInfo{
+				ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{
+					NonRetryable: true,
+				},
+			},
+		}
+		return ds.output()
+	},
+)
+
+// EventSucceeded is triggered when the signal is delivered.
+type EventSucceeded struct {
+	Time time.Time
+}
+
+var TransitionSucceeded = hsm.NewTransition(
+	[]enumsspb.DelayedSignalState{enumsspb.DELAYED_SIGNAL_STATE_SCHEDULED},
+	enumsspb.DELAYED_SIGNAL_STATE_SUCCEEDED,
+	func(ds DelayedSignal, event EventSucceeded) (hsm.TransitionOutput, error) {
+		ds.recordAttempt(event.Time)
+		ds.DelayedSignalInfo.LastAttemptFailure = nil
+		return ds.output()
+	},
+)
+
+// EventCanceled is triggered when the delayed signal is canceled before it was delivered.
+// Canceling a signal while a delivery attempt is in flight does not prevent that attempt from reaching the target.
+type EventCanceled struct{}
+
+var TransitionCanceled = hsm.NewTransition(
+	[]enumsspb.DelayedSignalState{
+		enumsspb.DELAYED_SIGNAL_STATE_WAITING,
+		enumsspb.DELAYED_SIGNAL_STATE_SCHEDULED,
+		enumsspb.DELAYED_SIGNAL_STATE_BACKING_OFF,
+	},
+	enumsspb.DELAYED_SIGNAL_STATE_CANCELED,
+	func(ds DelayedSignal, event EventCanceled) (hsm.TransitionOutput, error) {
+		ds.DelayedSignalInfo.NextAttemptScheduleTime = nil
+		return ds.output()
+	},
+)
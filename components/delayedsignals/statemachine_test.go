@@ -0,0 +1,196 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package delayedsignals_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/components/delayedsignals"
+	"go.temporal.io/server/service/history/hsm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAddChild(t *testing.T) {
+	root := newRoot(t)
+	fireTime := time.Now().Add(time.Hour).UTC()
+	node, err := delayedsignals.AddChild(root, &persistencespb.DelayedSignalInfo{
+		Id:         "signal-id",
+		SignalName: "signal",
+		FireTime:   timestamppb.New(fireTime),
+	})
+	require.NoError(t, err)
+
+	ds, err := hsm.MachineData[delayedsignals.DelayedSignal](node)
+	require.NoError(t, err)
+	require.Equal(t, enumsspb.DELAYED_SIGNAL_STATE_WAITING, ds.State())
+	require.True(t, ds.Pending())
+
+	outputs := node.Outputs()
+	require.Len(t, outputs, 1)
+	require.Len(t, outputs[0].Outputs, 1)
+	require.Equal(t, []hsm.Task{delayedsignals.FireTask{Deadline: fireTime}}, outputs[0].Outputs[0].Tasks)
+
+	_, err = delayedsignals.AddChild(root, &persistencespb.DelayedSignalInfo{Id: "signal-id"})
+	require.ErrorIs(t, err, hsm.ErrStateMachineAlreadyExists)
+}
+
+func TestValidTransitions(t *testing.T) {
+	currentTime := time.Now().UTC()
+	ds := delayedsignals.DelayedSignal{
+		DelayedSignalInfo: &persistencespb.DelayedSignalInfo{
+			State: enumsspb.DELAYED_SIGNAL_STATE_WAITING,
+		},
+	}
+
+	// Fired
+	out, err := delayedsignals.TransitionFired.Apply(ds, delayedsignals.EventFired{})
+	require.NoError(t, err)
+	require.Equal(t, enumsspb.DELAYED_SIGNAL_STATE_SCHEDULED, ds.State())
+	require.Equal(t, []hsm.Task{delayedsignals.DeliveryTask{}}, out.Tasks)
+
+	// AttemptFailed
+	out, err = delayedsignals.TransitionAttemptFailed.Apply(ds, delayedsignals.EventAttemptFailed{
+		Time:        currentTime,
+		Err:         errors.New("test"), // nolint:goerr113
+		RetryPolicy: backoff.NewExponentialRetryPolicy(time.Second),
+	})
+	require.NoError(t, err)
+	require.Equal(t, enumsspb.DELAYED_SIGNAL_STATE_BACKING_OFF, ds.State())
+	require.Equal(t, int32(1), ds.Attempt)
+	require.Equal(t, "test", ds.LastAttemptFailure.Message)
+	require.False(t, ds.LastAttemptFailure.GetApplicationFailureInfo().NonRetryable)
+	require.Equal(t, currentTime, ds.LastAttemptCompleteTime.AsTime())
+	require.Equal(t, []hsm.Task{delayedsignals.BackoffTask{Deadline: ds.NextAttemptScheduleTime.AsTime()}}, out.Tasks)
+
+	// Rescheduled
+	out, err = delayedsignals.TransitionRescheduled.Apply(ds, delayedsignals.EventRescheduled{})
+	require.NoError(t, err)
+	require.Equal(t, enumsspb.DELAYED_SIGNAL_STATE_SCHEDULED, ds.State())
+	require.Nil(t, ds.NextAttemptScheduleTime)
+	require.Equal(t, []hsm.Task{delayedsignals.DeliveryTask{}}, out.Tasks)
+
+	// Succeeded
+	currentTime = currentTime.Add(time.Second)
+	out, err = delayedsignals.TransitionSucceeded.Apply(ds, delayedsignals.EventSucceeded{Time: currentTime})
+	require.NoError(t, err)
+	require.Equal(t, enumsspb.DELAYED_SIGNAL_STATE_SUCCEEDED, ds.State())
+	require.Equal(t, int32(2), ds.Attempt)
+	require.Nil(t, ds.LastAttemptFailure)
+	require.Equal(t, currentTime, ds.LastAttemptCompleteTime.AsTime())
+	require.Empty(t, out.Tasks)
+	require.False(t, ds.Pending())
+
+	// Terminal states cannot be canceled.
+	_, err = delayedsignals.TransitionCanceled.Apply(ds, delayedsignals.EventCanceled{})
+	require.ErrorIs(t, err, hsm.ErrInvalidTransition)
+}
+
+func TestCancel(t *testing.T) {
+	for _, state := range []enumsspb.DelayedSignalState{
+		enumsspb.DELAYED_SIGNAL_STATE_WAITING,
+		enumsspb.DELAYED_SIGNAL_STATE_SCHEDULED,
+		enumsspb.DELAYED_SIGNAL_STATE_BACKING_OFF,
+	} {
+		t.Run(state.String(), func(t *testing.T) {
+			ds := delayedsignals.DelayedSignal{
+				DelayedSignalInfo: &persistencespb.DelayedSignalInfo{
+					State:                   state,
+					NextAttemptScheduleTime: timestamppb.Now(),
+				},
+			}
+			out, err := delayedsignals.TransitionCanceled.Apply(ds, delayedsignals.EventCanceled{})
+			require.NoError(t, err)
+			require.Equal(t, enumsspb.DELAYED_SIGNAL_STATE_CANCELED, ds.State())
+			require.Nil(t, ds.NextAttemptScheduleTime)
+			require.Empty(t, out.Tasks)
+		})
+	}
+}
+
+func TestCompareState(t *testing.T) {
+	reg := hsm.NewRegistry()
+	require.NoError(t, delayedsignals.RegisterStateMachine(reg))
+	def, ok := reg.Machine(delayedsignals.StateMachineType)
+	require.True(t, ok)
+
+	cases := []struct {
+		name                 string
+		s1, s2               enumsspb.DelayedSignalState
+		attempts1, attempts2 int32
+		sign                 int
+		expectError          bool
+	}{
+		{
+			name: "waiting < scheduled",
+			s1:   enumsspb.DELAYED_SIGNAL_STATE_WAITING,
+			s2:   enumsspb.DELAYED_SIGNAL_STATE_SCHEDULED,
+			sign: -1,
+		},
+		{
+			name:      "backing off > scheduled with fewer attempts",
+			s1:        enumsspb.DELAYED_SIGNAL_STATE_BACKING_OFF,
+			s2:        enumsspb.DELAYED_SIGNAL_STATE_SCHEDULED,
+			attempts1: 2,
+			attempts2: 1,
+			sign:      1,
+		},
+		{
+			name: "canceled > waiting",
+			s1:   enumsspb.DELAYED_SIGNAL_STATE_CANCELED,
+			s2:   enumsspb.DELAYED_SIGNAL_STATE_WAITING,
+			sign: 1,
+		},
+		{
+			name:        "succeeded != canceled",
+			s1:          enumsspb.DELAYED_SIGNAL_STATE_SUCCEEDED,
+			s2:          enumsspb.DELAYED_SIGNAL_STATE_CANCELED,
+			expectError: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s1 := delayedsignals.DelayedSignal{DelayedSignalInfo: &persistencespb.DelayedSignalInfo{State: tc.s1, Attempt: tc.attempts1}}
+			s2 := delayedsignals.DelayedSignal{DelayedSignalInfo: &persistencespb.DelayedSignalInfo{State: tc.s2, Attempt: tc.attempts2}}
+			res, err := def.CompareState(s1, s2)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			switch tc.sign {
+			case -1:
+				require.Negative(t, res)
+			case 1:
+				require.Positive(t, res)
+			default:
+				require.Zero(t, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package delayedsignals
+
+import (
+	"fmt"
+	"time"
+
+	"go.temporal.io/server/service/history/hsm"
+)
+
+const (
+	TaskTypeFire     = "delayedsignals.Fire"
+	TaskTypeDelivery = "delayedsignals.Delivery"
+	TaskTypeBackoff  = "delayedsignals.Backoff"
+)
+
+// FireTask is a timer task that fires at the delayed signal's fire time.
+type FireTask struct {
+	Deadline time.Time
+}
+
+var _ hsm.Task = FireTask{}
+
+func (FireTask) Type() string {
+	return TaskTypeFire
+}
+
+func (t FireTask) Kind() hsm.TaskKind {
+	return hsm.TaskKindTimer{Deadline: t.Deadline}
+}
+
+func (FireTask) Concurrent() bool {
+	return false
+}
+
+type FireTaskSerializer struct{}
+
+func (FireTaskSerializer) Deserialize(data []byte, kind hsm.TaskKind) (hsm.Task, error) {
+	if kind, ok := kind.(hsm.TaskKindTimer); ok {
+		return FireTask{Deadline: kind.Deadline}, nil
+	}
+	return nil, fmt.Errorf("%w: expected timer", hsm.ErrInvalidTaskKind)
+}
+
+func (FireTaskSerializer) Serialize(hsm.Task) ([]byte, error) {
+	return nil, nil
+}
+
+// DeliveryTask is an outbound task that signals the target workflow.
+type DeliveryTask struct {
+	// Delayed signals are delivered through the local history service, the destination is always empty.
+	Destination string
+}
+
+var _ hsm.Task = DeliveryTask{}
+
+func (DeliveryTask) Type() string {
+	return TaskTypeDelivery
+}
+
+func (t DeliveryTask) Kind() hsm.TaskKind {
+	return hsm.TaskKindOutbound{Destination: t.Destination}
+}
+
+func (DeliveryTask) Concurrent() bool {
+	return false
+}
+
+type DeliveryTaskSerializer struct{}
+
+func (DeliveryTaskSerializer) Deserialize(data []byte, kind hsm.TaskKind) (hsm.Task, error) {
+	if kind, ok := kind.(hsm.TaskKindOutbound); ok {
+		return DeliveryTask{Destination: kind.Destination}, nil
+	}
+	return nil, fmt.Errorf("%w: expected outbound", hsm.ErrInvalidTaskKind)
+}
+
+func (DeliveryTaskSerializer) Serialize(hsm.Task) ([]byte, error) {
+	return nil, nil
+}
+
+// BackoffTask is a timer task that reschedules delivery after a failed attempt.
+type BackoffTask struct {
+	Deadline time.Time
+}
+
+var _ hsm.Task = BackoffTask{}
+
+func (BackoffTask) Type() string {
+	return TaskTypeBackoff
+}
+
+func (t BackoffTask) Kind() hsm.TaskKind {
+	return hsm.TaskKindTimer{Deadline: t.Deadline}
+}
+
+func (BackoffTask) Concurrent() bool {
+	return false
+}
+
+type BackoffTaskSerializer struct{}
+
+func (BackoffTaskSerializer) Deserialize(data []byte, kind hsm.TaskKind) (hsm.Task, error) {
+	if kind, ok := kind.(hsm.TaskKindTimer); ok {
+		return BackoffTask{Deadline: kind.Deadline}, nil
+	}
+	return nil, fmt.Errorf("%w: expected timer", hsm.ErrInvalidTaskKind)
+}
+
+func (BackoffTaskSerializer) Serialize(hsm.Task) ([]byte, error) {
+	return nil, nil
+}
+
+func RegisterTaskSerializers(reg *hsm.Registry) error {
+	if err := reg.RegisterTaskSerializer(TaskTypeFire, FireTaskSerializer{}); err != nil {
+		return err
+	}
+	if err := reg.RegisterTaskSerializer(TaskTypeDelivery, DeliveryTaskSerializer{}); err != nil {
+		return err
+	}
+	return reg.RegisterTaskSerializer(TaskTypeBackoff, BackoffTaskSerializer{})
+}
@@ -84,6 +84,38 @@ The template can be used to interpolate the {{.NamepaceName}} and {{.NamespaceID
 Must be set in order to use Nexus Operations.`,
 )
 
+var CallbackRequireSignature = dynamicconfig.NewNamespaceBoolSetting(
+	"component.nexusoperations.callback.requireSignature",
+	false,
+	`Controls whether completion callbacks delivered to the frontend for a namespace must be signed with one of the keys
+configured in component.callbacks.signingKeys for that namespace. Unsigned or invalid requests are rejected.`,
+)
+
+var CallbackSignatureTolerance = dynamicconfig.NewGlobalDurationSetting(
+	"component.nexusoperations.callback.signatureTolerance",
+	5*time.Minute,
+	`The maximum allowed difference between the timestamp of a signed completion callback and the time it is received.
+Protects against replaying captured requests.`,
+)
+
+var SecretRefAllowedPrefixes = dynamicconfig.NewGlobalTypedSetting(
+	"component.nexusoperations.secretRef.allowedPrefixes",
+	[]string(nil),
+	`Secret references in the credentials of external Nexus endpoints are only resolved if they start with one of these
+prefixes, for example "env:NEXUS_SECRET_" or "file:/etc/temporal/nexus-secrets/". File paths are cleaned before they are
+matched, so directory prefixes should end with a slash. No secret is resolved by default, which prevents endpoint
+configurations from reading arbitrary environment variables or files of history hosts.`,
+)
+
+var EndpointLimitsBlockedStateEnabled = dynamicconfig.NewGlobalBoolSetting(
+	"component.nexusoperations.endpointLimits.blockedState.enabled",
+	false,
+	`Controls whether operations held back by endpoint limits are moved to the BLOCKED state. When disabled, operations stay
+in the SCHEDULED state and the outbound queue retries their invocation task with backoff until the endpoint has
+capacity. Servers that predate the BLOCKED state fail to load or replicate operations in that state, only enable this
+once all history hosts of all clusters the namespaces are replicated to have been upgraded.`,
+)
+
 var RetryPolicyInitialInterval = dynamicconfig.NewGlobalDurationSetting(
 	"component.nexusoperations.retryPolicy.initialInterval",
 	time.Second,
@@ -106,6 +138,7 @@ type Config struct {
 	PayloadSizeLimit                   dynamicconfig.IntPropertyFnWithNamespaceFilter
 	CallbackURLTemplate                dynamicconfig.StringPropertyFn
 	EndpointNotFoundAlwaysNonRetryable dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	BlockedStateEnabled                dynamicconfig.BoolPropertyFn
 	RetryPolicy                        func() backoff.RetryPolicy
 }
 
@@ -120,6 +153,7 @@ func ConfigProvider(dc *dynamicconfig.Collection) *Config {
 		PayloadSizeLimit:                   dynamicconfig.BlobSizeLimitError.Get(dc),
 		CallbackURLTemplate:                CallbackURLTemplate.Get(dc),
 		EndpointNotFoundAlwaysNonRetryable: EndpointNotFoundAlwaysNonRetryable.Get(dc),
+		BlockedStateEnabled:                EndpointLimitsBlockedStateEnabled.Get(dc),
 		RetryPolicy: func() backoff.RetryPolicy {
 			return backoff.NewExponentialRetryPolicy(
 				RetryPolicyInitialInterval.Get(dc)(),
@@ -0,0 +1,189 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexusoperations
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	commonnexus "go.temporal.io/server/common/nexus"
+)
+
+// SecretResolver resolves references to secrets used to authenticate against external Nexus endpoints, see
+// [persistencespb.NexusEndpointAuth]. Secrets are resolved on every use so that they can be rotated without updating
+// the endpoint.
+type SecretResolver func(ref string) ([]byte, error)
+
+// DefaultSecretResolverProvider provides a SecretResolver that supports "env:<variable name>" and "file:<path>"
+// references. Only references matching one of the prefixes in SecretRefAllowedPrefixes are resolved.
+func DefaultSecretResolverProvider(dc *dynamicconfig.Collection) SecretResolver {
+	allowedPrefixes := SecretRefAllowedPrefixes.Get(dc)
+	return func(ref string) ([]byte, error) {
+		kind, name, found := strings.Cut(ref, ":")
+		if !found || name == "" {
+			return nil, fmt.Errorf("invalid secret reference %q, expected env:<name> or file:<path>", ref)
+		}
+		if kind == "file" {
+			// prevent escaping an allowed directory with relative path elements
+			name = filepath.Clean(name)
+		}
+		if !slices.ContainsFunc(allowedPrefixes(), func(prefix string) bool {
+			return prefix != "" && strings.HasPrefix(kind+":"+name, prefix)
+		}) {
+			return nil, fmt.Errorf("secret reference %q is not allowed, see %v", ref, SecretRefAllowedPrefixes.Key())
+		}
+		switch kind {
+		case "env":
+			value, ok := os.LookupEnv(name)
+			if !ok {
+				return nil, fmt.Errorf("environment variable %q referenced by secret is not set", name)
+			}
+			return []byte(value), nil
+		case "file":
+			return os.ReadFile(name)
+		default:
+			return nil, fmt.Errorf("unsupported secret reference kind %q", kind)
+		}
+	}
+}
+
+// newEndpointAuthTransport wraps a transport to present the credentials configured for an external endpoint.
+// Mutual TLS requires the given transport to be an [*http.Transport].
+func newEndpointAuthTransport(
+	transport http.RoundTripper,
+	auth *persistencespb.NexusEndpointAuth,
+	resolveSecret SecretResolver,
+) (http.RoundTripper, error) {
+	if auth == nil {
+		return transport, nil
+	}
+
+	if mtls := auth.GetMutualTls(); mtls != nil {
+		httpTransport, ok := transport.(*http.Transport)
+		if !ok {
+			return nil, errors.New("mutual TLS for Nexus endpoints requires an *http.Transport")
+		}
+		tlsConfig, err := newEndpointTLSConfig(httpTransport.TLSClientConfig, mtls, resolveSecret)
+		if err != nil {
+			return nil, err
+		}
+		httpTransport = httpTransport.Clone()
+		httpTransport.TLSClientConfig = tlsConfig
+		transport = httpTransport
+	}
+
+	if auth.GetBearerToken() == nil && auth.GetHmacSigning() == nil {
+		return transport, nil
+	}
+	return endpointAuthRoundTripper{
+		roundTripper:  transport,
+		bearerToken:   auth.GetBearerToken(),
+		hmacSigning:   auth.GetHmacSigning(),
+		resolveSecret: resolveSecret,
+	}, nil
+}
+
+func newEndpointTLSConfig(
+	base *tls.Config,
+	mtls *persistencespb.NexusEndpointAuth_MutualTLS,
+	resolveSecret SecretResolver,
+) (*tls.Config, error) {
+	var tlsConfig *tls.Config
+	if base != nil {
+		tlsConfig = base.Clone()
+	} else {
+		tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	if mtls.GetServerName() != "" {
+		tlsConfig.ServerName = mtls.GetServerName()
+	}
+	if mtls.GetServerCaSecretRef() != "" {
+		caPEM, err := resolveSecret(mtls.GetServerCaSecretRef())
+		if err != nil {
+			return nil, fmt.Errorf("cannot resolve server CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("no valid certificate found in server CA")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	// Resolve the certificate on every handshake to pick up rotated certificates.
+	tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		certPEM, err := resolveSecret(mtls.GetClientCertSecretRef())
+		if err != nil {
+			return nil, fmt.Errorf("cannot resolve client certificate: %w", err)
+		}
+		keyPEM, err := resolveSecret(mtls.GetClientKeySecretRef())
+		if err != nil {
+			return nil, fmt.Errorf("cannot resolve client key: %w", err)
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, err
+		}
+		return &cert, nil
+	}
+	return tlsConfig, nil
+}
+
+// endpointAuthRoundTripper sets the Authorization header and signs requests according to an endpoint's auth
+// configuration.
+type endpointAuthRoundTripper struct {
+	roundTripper  http.RoundTripper
+	bearerToken   *persistencespb.NexusEndpointAuth_BearerToken
+	hmacSigning   *persistencespb.NexusEndpointAuth_HmacSigning
+	resolveSecret SecretResolver
+}
+
+func (r endpointAuthRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the given request.
+	request = request.Clone(request.Context())
+	if r.bearerToken != nil {
+		token, err := r.resolveSecret(r.bearerToken.GetTokenSecretRef())
+		if err != nil {
+			return nil, fmt.Errorf("cannot resolve bearer token: %w", err)
+		}
+		request.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+	if r.hmacSigning != nil {
+		key, err := r.resolveSecret(r.hmacSigning.GetKeySecretRef())
+		if err != nil {
+			return nil, fmt.Errorf("cannot resolve signing key: %w", err)
+		}
+		if err := commonnexus.SignRequest(request, key, r.hmacSigning.GetKeyId(), time.Now()); err != nil {
+			return nil, err
+		}
+	}
+	return r.roundTripper.RoundTrip(request)
+}
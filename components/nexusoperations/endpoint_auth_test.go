@@ -0,0 +1,171 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexusoperations_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nexus-rpc/sdk-go/nexus"
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	commonnexus "go.temporal.io/server/common/nexus"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/components/nexusoperations"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDefaultSecretResolver(t *testing.T) {
+	dir := t.TempDir()
+	dc := dynamicconfig.NewCollection(dynamicconfig.StaticClient(map[dynamicconfig.Key]any{
+		nexusoperations.SecretRefAllowedPrefixes.Key(): []string{"env:NEXUS_TEST_", "file:" + dir + "/", "vault:"},
+	}), log.NewNoopLogger())
+	resolveSecret := nexusoperations.DefaultSecretResolverProvider(dc)
+
+	t.Setenv("NEXUS_TEST_SECRET", "env-secret")
+	secret, err := resolveSecret("env:NEXUS_TEST_SECRET")
+	require.NoError(t, err)
+	require.Equal(t, "env-secret", string(secret))
+
+	path := filepath.Join(dir, "secret")
+	require.NoError(t, os.WriteFile(path, []byte("file-secret"), 0600))
+	secret, err = resolveSecret("file:" + path)
+	require.NoError(t, err)
+	require.Equal(t, "file-secret", string(secret))
+
+	_, err = resolveSecret("env:NEXUS_TEST_SECRET_NOT_SET")
+	require.ErrorContains(t, err, "not set")
+	_, err = resolveSecret("vault:secret")
+	require.ErrorContains(t, err, "unsupported")
+	_, err = resolveSecret("secret")
+	require.ErrorContains(t, err, "invalid secret reference")
+}
+
+func TestDefaultSecretResolver_NotAllowed(t *testing.T) {
+	dir := t.TempDir()
+	outside := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(outside, []byte("server-secret"), 0600))
+	t.Setenv("SERVER_SECRET", "server-secret")
+
+	// nothing is resolvable by default
+	resolveSecret := nexusoperations.DefaultSecretResolverProvider(dynamicconfig.NewNoopCollection())
+	_, err := resolveSecret("env:SERVER_SECRET")
+	require.ErrorContains(t, err, "not allowed")
+
+	dc := dynamicconfig.NewCollection(dynamicconfig.StaticClient(map[dynamicconfig.Key]any{
+		nexusoperations.SecretRefAllowedPrefixes.Key(): []string{"env:NEXUS_TEST_", "file:" + dir + "/"},
+	}), log.NewNoopLogger())
+	resolveSecret = nexusoperations.DefaultSecretResolverProvider(dc)
+	for _, ref := range []string{
+		"env:SERVER_SECRET",
+		"file:" + outside,
+		"file:" + dir + "/../" + filepath.Base(filepath.Dir(outside)) + "/secret",
+	} {
+		_, err = resolveSecret(ref)
+		require.ErrorContains(t, err, "not allowed", ref)
+	}
+}
+
+func TestClientProvider_ExternalEndpointAuth(t *testing.T) {
+	headers := make(chan http.Header, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers <- r.Header.Clone()
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	controller := gomock.NewController(t)
+	rpcFactory := common.NewMockRPCFactory(controller)
+	rpcFactory.EXPECT().CreateLocalFrontendHTTPClient().Return(&common.FrontendHTTPClient{}, nil)
+	clusterMetadata := cluster.NewMockMetadata(controller)
+	clusterMetadata.EXPECT().GetAllClusterInfo().Return(nil).AnyTimes()
+	clusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+
+	secrets := map[string]string{
+		"token": "bearer-token\n",
+		"key":   "signing-key",
+	}
+	clientProvider, err := nexusoperations.ClientProviderFactory(
+		nil,
+		nil,
+		nexusoperations.DefaultNexusTransportProvider(),
+		func(ref string) ([]byte, error) {
+			secret, ok := secrets[ref]
+			if !ok {
+				return nil, fmt.Errorf("secret %q not found", ref)
+			}
+			return []byte(secret), nil
+		},
+		clusterMetadata,
+		rpcFactory,
+	)
+	require.NoError(t, err)
+
+	entry := &persistencespb.NexusEndpointEntry{
+		Id: "endpoint-id",
+		Endpoint: &persistencespb.NexusEndpoint{
+			Spec: &persistencespb.NexusEndpointSpec{
+				Name: "endpoint",
+				Target: &persistencespb.NexusEndpointTarget{
+					Variant: &persistencespb.NexusEndpointTarget_External_{
+						External: &persistencespb.NexusEndpointTarget_External{
+							Url: server.URL,
+							Auth: &persistencespb.NexusEndpointAuth{
+								BearerToken: &persistencespb.NexusEndpointAuth_BearerToken{TokenSecretRef: "token"},
+								HmacSigning: &persistencespb.NexusEndpointAuth_HmacSigning{KeySecretRef: "key", KeyId: "key-1"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	client, err := clientProvider(context.Background(), "namespace-id", entry, "service")
+	require.NoError(t, err)
+	_, err = client.StartOperation(context.Background(), "operation", payload.EncodeString("input"), nexus.StartOperationOptions{})
+	require.Error(t, err)
+
+	header := <-headers
+	require.Equal(t, "Bearer bearer-token", header.Get("Authorization"))
+	require.Contains(t, header.Get(commonnexus.SignatureHeader), "kid=key-1,v1=")
+
+	// Credentials are not sent once removed from the endpoint.
+	entry.Endpoint.Spec.Target.GetExternal().Auth = nil
+	client, err = clientProvider(context.Background(), "namespace-id", entry, "service")
+	require.NoError(t, err)
+	_, err = client.StartOperation(context.Background(), "operation", payload.EncodeString("input"), nexus.StartOperationOptions{})
+	require.Error(t, err)
+
+	header = <-headers
+	require.Empty(t, header.Get("Authorization"))
+	require.Empty(t, header.Get(commonnexus.SignatureHeader))
+}
@@ -0,0 +1,116 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexusoperations
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/quotas"
+)
+
+var (
+	errEndpointConcurrencyLimitExceeded = serviceerror.NewResourceExhausted(
+		enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT,
+		"endpoint concurrent operation limit exceeded",
+	)
+	errEndpointRPSLimitExceeded = serviceerror.NewResourceExhausted(
+		enumspb.RESOURCE_EXHAUSTED_CAUSE_RPS_LIMIT,
+		"endpoint start operation rate limit exceeded",
+	)
+)
+
+// EndpointLimiter enforces the limits set on Nexus endpoints (see persistencespb.NexusEndpointLimits) for all
+// StartOperation requests made by a history host. State is kept in memory and not shared between hosts, so the limits
+// apply per history host: the cluster wide limit of an endpoint is the configured limit multiplied by the number of
+// history hosts.
+type EndpointLimiter struct {
+	mu        sync.Mutex
+	endpoints map[string]*endpointLimiterEntry
+}
+
+type endpointLimiterEntry struct {
+	inFlight    int32
+	rateLimiter *quotas.RateLimiterImpl
+}
+
+func NewEndpointLimiter() *EndpointLimiter {
+	return &EndpointLimiter{
+		endpoints: make(map[string]*endpointLimiterEntry),
+	}
+}
+
+// TryAcquire reserves capacity for a StartOperation request to the given endpoint. It returns a ResourceExhausted
+// error if the endpoint is at capacity, otherwise the returned function must be called once the request completes.
+func (l *EndpointLimiter) TryAcquire(entry *persistencespb.NexusEndpointEntry, now time.Time) (func(), error) {
+	limits := entry.GetEndpoint().GetSpec().GetLimits()
+	maxConcurrency := limits.GetMaxConcurrentOperations()
+	maxRPS := limits.GetMaxStartRps()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	e, ok := l.endpoints[entry.GetId()]
+	if maxConcurrency <= 0 && maxRPS <= 0 {
+		if !ok || e.inFlight == 0 {
+			// Drop state of endpoints that no longer have limits.
+			delete(l.endpoints, entry.GetId())
+			return func() {}, nil
+		}
+	}
+	if !ok {
+		e = &endpointLimiterEntry{}
+		l.endpoints[entry.GetId()] = e
+	}
+
+	if maxConcurrency > 0 && e.inFlight >= maxConcurrency {
+		return nil, errEndpointConcurrencyLimitExceeded
+	}
+	if maxRPS > 0 {
+		burst := max(1, int(math.Ceil(maxRPS)))
+		if e.rateLimiter == nil {
+			e.rateLimiter = quotas.NewRateLimiter(maxRPS, burst)
+		} else if e.rateLimiter.Rate() != maxRPS {
+			e.rateLimiter.SetRateBurst(maxRPS, burst)
+		}
+		if !e.rateLimiter.AllowN(now, 1) {
+			return nil, errEndpointRPSLimitExceeded
+		}
+	} else {
+		e.rateLimiter = nil
+	}
+
+	e.inFlight++
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			e.inFlight--
+		})
+	}, nil
+}
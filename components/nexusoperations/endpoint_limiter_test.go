@@ -0,0 +1,112 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexusoperations_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/components/nexusoperations"
+)
+
+func newLimitedEndpointEntry(limits *persistencespb.NexusEndpointLimits) *persistencespb.NexusEndpointEntry {
+	return &persistencespb.NexusEndpointEntry{
+		Id: "endpoint-id",
+		Endpoint: &persistencespb.NexusEndpoint{
+			Spec: &persistencespb.NexusEndpointSpec{
+				Name:   "endpoint",
+				Limits: limits,
+			},
+		},
+	}
+}
+
+func TestEndpointLimiter_NoLimits(t *testing.T) {
+	limiter := nexusoperations.NewEndpointLimiter()
+	entry := newLimitedEndpointEntry(nil)
+	for i := 0; i < 100; i++ {
+		_, err := limiter.TryAcquire(entry, time.Now())
+		require.NoError(t, err)
+	}
+}
+
+func TestEndpointLimiter_Concurrency(t *testing.T) {
+	limiter := nexusoperations.NewEndpointLimiter()
+	entry := newLimitedEndpointEntry(&persistencespb.NexusEndpointLimits{MaxConcurrentOperations: 2})
+	now := time.Now()
+
+	release1, err := limiter.TryAcquire(entry, now)
+	require.NoError(t, err)
+	release2, err := limiter.TryAcquire(entry, now)
+	require.NoError(t, err)
+
+	_, err = limiter.TryAcquire(entry, now)
+	var resourceExhaustedErr *serviceerror.ResourceExhausted
+	require.ErrorAs(t, err, &resourceExhaustedErr)
+	require.Equal(t, enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT, resourceExhaustedErr.Cause)
+
+	release1()
+	// Releasing more than once is a noop.
+	release1()
+	release3, err := limiter.TryAcquire(entry, now)
+	require.NoError(t, err)
+	_, err = limiter.TryAcquire(entry, now)
+	require.ErrorAs(t, err, &resourceExhaustedErr)
+
+	release2()
+	release3()
+	// Raising the limit takes effect immediately.
+	entry.Endpoint.Spec.Limits.MaxConcurrentOperations = 3
+	for i := 0; i < 3; i++ {
+		_, err = limiter.TryAcquire(entry, now)
+		require.NoError(t, err)
+	}
+}
+
+func TestEndpointLimiter_RPS(t *testing.T) {
+	limiter := nexusoperations.NewEndpointLimiter()
+	entry := newLimitedEndpointEntry(&persistencespb.NexusEndpointLimits{MaxStartRps: 2})
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		release, err := limiter.TryAcquire(entry, now)
+		require.NoError(t, err)
+		release()
+	}
+	_, err := limiter.TryAcquire(entry, now)
+	var resourceExhaustedErr *serviceerror.ResourceExhausted
+	require.ErrorAs(t, err, &resourceExhaustedErr)
+	require.Equal(t, enumspb.RESOURCE_EXHAUSTED_CAUSE_RPS_LIMIT, resourceExhaustedErr.Cause)
+
+	_, err = limiter.TryAcquire(entry, now.Add(time.Second))
+	require.NoError(t, err)
+
+	// Removing the limit lifts throttling.
+	entry.Endpoint.Spec.Limits = nil
+	_, err = limiter.TryAcquire(entry, now.Add(time.Second))
+	require.NoError(t, err)
+}
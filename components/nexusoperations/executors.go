@@ -38,6 +38,7 @@ import (
 	failurepb "go.temporal.io/api/failure/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common"
@@ -70,6 +71,7 @@ type TaskExecutorOptions struct {
 	CallbackTokenGenerator *commonnexus.CallbackTokenGenerator
 	ClientProvider         ClientProvider
 	EndpointRegistry       commonnexus.EndpointRegistry
+	EndpointLimiter        *EndpointLimiter
 }
 
 func RegisterExecutor(
@@ -158,6 +160,26 @@ func (e taskExecutor) executeInvocationTask(ctx context.Context, env hsm.Environ
 		return err
 	}
 
+	if accessErr := e.checkEndpointAccess(ns, endpoint); accessErr != nil {
+		return e.saveResult(ctx, env, ref, nil, accessErr)
+	}
+
+	release, err := e.EndpointLimiter.TryAcquire(endpoint, time.Now())
+	if err != nil {
+		EndpointCapacityBlockedCounter.With(e.MetricsHandler).Record(
+			1,
+			metrics.NamespaceTag(ns.Name().String()),
+			metrics.DestinationTag(endpoint.Endpoint.Spec.GetName()),
+		)
+		if args.blocked || !e.Config.BlockedStateEnabled() {
+			// Already marked as blocked or the blocked state is disabled, let the outbound queue reschedule the task
+			// with backoff.
+			return err
+		}
+		return e.saveBlocked(ctx, env, ref)
+	}
+	defer release()
+
 	header := nexus.Header(args.header)
 	if e.Config.CallbackURLTemplate() == "unset" {
 		return serviceerror.NewInternal(fmt.Sprintf("dynamic config %q is unset", CallbackURLTemplate.Key().String()))
@@ -279,6 +301,7 @@ type startArgs struct {
 	nexusLink                nexus.Link
 	namespaceFailoverVersion int64
 	cancelRequested          bool
+	blocked                  bool
 }
 
 func (e taskExecutor) loadOperationArgs(
@@ -303,6 +326,7 @@ func (e taskExecutor) loadOperationArgs(
 			return err
 		}
 
+		args.blocked = operation.State() == enumsspb.NEXUS_OPERATION_STATE_BLOCKED
 		args.endpointName = operation.Endpoint
 		args.endpointID = operation.EndpointId
 		args.service = operation.Service
@@ -396,6 +420,21 @@ func (e taskExecutor) saveResult(ctx context.Context, env hsm.Environment, ref h
 	})
 }
 
+// saveBlocked marks the operation as blocked on endpoint capacity. The transition emits a new invocation task which the
+// outbound queue keeps rescheduling until the endpoint has capacity.
+func (e taskExecutor) saveBlocked(ctx context.Context, env hsm.Environment, ref hsm.Ref) error {
+	return env.Access(ctx, ref, hsm.AccessWrite, func(node *hsm.Node) error {
+		if err := node.CheckRunning(); err != nil {
+			return err
+		}
+		return hsm.MachineTransition(node, func(operation Operation) (hsm.TransitionOutput, error) {
+			return TransitionBlocked.Apply(operation, EventBlocked{
+				Node: node,
+			})
+		})
+	})
+}
+
 func (e taskExecutor) handleStartOperationError(env hsm.Environment, node *hsm.Node, operation Operation, callErr error) (hsm.TransitionOutput, error) {
 	var unexpectedResponseError *nexus.UnexpectedResponseError
 	var opFailedError *nexus.UnsuccessfulOperationError
@@ -530,6 +569,10 @@ func (e taskExecutor) executeCancelationTask(ctx context.Context, env hsm.Enviro
 		return err
 	}
 
+	if accessErr := e.checkEndpointAccess(ns, endpoint); accessErr != nil {
+		return e.saveCancelationResult(ctx, env, ref, accessErr)
+	}
+
 	client, err := e.ClientProvider(
 		ctx,
 		ref.WorkflowKey.NamespaceID,
@@ -677,6 +720,32 @@ func (e taskExecutor) lookupEndpoint(ctx context.Context, namespaceID namespace.
 	return entry, nil
 }
 
+// checkEndpointAccess evaluates the endpoint's access policy for the calling namespace. Denied requests are logged and
+// counted, and a non retryable error is returned to fail the operation or cancelation.
+func (e taskExecutor) checkEndpointAccess(ns *namespace.Namespace, endpoint *persistencespb.NexusEndpointEntry) error {
+	err := commonnexus.CheckEndpointAccess(endpoint.GetEndpoint().GetSpec().GetAccessPolicy(), ns)
+	if err == nil {
+		return nil
+	}
+	e.Logger.Warn(
+		"Nexus endpoint access denied",
+		tag.WorkflowNamespace(ns.Name().String()),
+		tag.NewStringTag("endpoint", endpoint.GetEndpoint().GetSpec().GetName()),
+		tag.Error(err),
+	)
+	metrics.NexusEndpointAccessDenied.With(e.MetricsHandler).Record(
+		1,
+		metrics.NamespaceTag(ns.Name().String()),
+		metrics.DestinationTag(endpoint.GetEndpoint().GetSpec().GetName()),
+	)
+	return &nexus.UnexpectedResponseError{
+		Message: "caller namespace is not allowed to access endpoint",
+		Response: &http.Response{
+			StatusCode: http.StatusForbidden,
+		},
+	}
+}
+
 func nexusOperationFailure(operation Operation, scheduledEventID int64, cause *failurepb.Failure) *failurepb.Failure {
 	return &failurepb.Failure{
 		Message: "nexus operation completed unsuccessfully",
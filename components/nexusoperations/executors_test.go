@@ -457,6 +457,7 @@ func TestProcessInvocationTask(t *testing.T) {
 				MetricsHandler:         metricsHandler,
 				Logger:                 log.NewNoopLogger(),
 				EndpointRegistry:       endpointReg,
+				EndpointLimiter:        nexusoperations.NewEndpointLimiter(),
 				ClientProvider: func(ctx context.Context, namespaceID string, entry *persistence.NexusEndpointEntry, service string) (*nexus.Client, error) {
 					return nexus.NewClient(nexus.ClientOptions{
 						BaseURL:    "http://" + listenAddr,
@@ -488,6 +489,158 @@ func TestProcessInvocationTask(t *testing.T) {
 	}
 }
 
+func TestProcessInvocationTask_BlockedOnCapacity(t *testing.T) {
+	t.Run("BlockedStateEnabled", func(t *testing.T) {
+		testProcessInvocationTaskBlockedOnCapacity(t, true)
+	})
+	t.Run("BlockedStateDisabled", func(t *testing.T) {
+		testProcessInvocationTaskBlockedOnCapacity(t, false)
+	})
+}
+
+func testProcessInvocationTaskBlockedOnCapacity(t *testing.T, blockedStateEnabled bool) {
+	ctrl := gomock.NewController(t)
+	reg := newRegistry(t)
+	backend := &hsmtest.NodeBackend{}
+	node := newOperationNode(t, backend, mustNewScheduledEvent(time.Now(), time.Hour))
+	env := fakeEnv{node}
+
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID("ns-id")).Return(
+		namespace.NewNamespaceForTest(&persistence.NamespaceInfo{Name: "ns-name"}, nil, false, nil, 0), nil).AnyTimes()
+	limitedEntry := &persistence.NexusEndpointEntry{
+		Id: "endpoint-id",
+		Endpoint: &persistence.NexusEndpoint{
+			Spec: &persistence.NexusEndpointSpec{
+				Name:   "endpoint",
+				Limits: &persistence.NexusEndpointLimits{MaxConcurrentOperations: 1},
+			},
+		},
+	}
+	limiter := nexusoperations.NewEndpointLimiter()
+	// Take the only slot so the invocation is held back.
+	release, err := limiter.TryAcquire(limitedEntry, time.Now())
+	require.NoError(t, err)
+	defer release()
+
+	require.NoError(t, nexusoperations.RegisterExecutor(reg, nexusoperations.TaskExecutorOptions{
+		Config: &nexusoperations.Config{
+			Enabled:             dynamicconfig.GetBoolPropertyFn(true),
+			BlockedStateEnabled: dynamicconfig.GetBoolPropertyFn(blockedStateEnabled),
+		},
+		NamespaceRegistry: namespaceRegistry,
+		MetricsHandler:    metrics.NoopMetricsHandler,
+		Logger:            log.NewNoopLogger(),
+		EndpointRegistry: nexustest.FakeEndpointRegistry{
+			OnGetByID: func(ctx context.Context, endpointID string) (*persistence.NexusEndpointEntry, error) {
+				return limitedEntry, nil
+			},
+		},
+		EndpointLimiter: limiter,
+		ClientProvider: func(ctx context.Context, namespaceID string, entry *persistence.NexusEndpointEntry, service string) (*nexus.Client, error) {
+			require.FailNow(t, "should not attempt to start the operation")
+			return nil, nil
+		},
+	}))
+	execute := func() error {
+		return reg.ExecuteImmediateTask(
+			context.Background(),
+			env,
+			hsm.Ref{
+				WorkflowKey:     definition.NewWorkflowKey("ns-id", "wf-id", "run-id"),
+				StateMachineRef: &persistence.StateMachineRef{},
+			},
+			nexusoperations.InvocationTask{EndpointName: "endpoint"},
+		)
+	}
+
+	var resourceExhaustedErr *serviceerror.ResourceExhausted
+	if !blockedStateEnabled {
+		// The operation stays scheduled and the outbound queue reschedules the task.
+		require.ErrorAs(t, execute(), &resourceExhaustedErr)
+		op, err := hsm.MachineData[nexusoperations.Operation](node)
+		require.NoError(t, err)
+		require.Equal(t, enumsspb.NEXUS_OPERATION_STATE_SCHEDULED, op.State())
+		require.Equal(t, int32(0), op.Attempt)
+		require.Equal(t, 0, len(backend.Events))
+		return
+	}
+
+	// First attempt marks the operation as blocked.
+	require.NoError(t, execute())
+	op, err := hsm.MachineData[nexusoperations.Operation](node)
+	require.NoError(t, err)
+	require.Equal(t, enumsspb.NEXUS_OPERATION_STATE_BLOCKED, op.State())
+	require.Equal(t, int32(0), op.Attempt)
+	require.Nil(t, op.LastAttemptFailure)
+
+	// Subsequent attempts are left to the outbound queue to reschedule.
+	require.ErrorAs(t, execute(), &resourceExhaustedErr)
+	op, err = hsm.MachineData[nexusoperations.Operation](node)
+	require.NoError(t, err)
+	require.Equal(t, enumsspb.NEXUS_OPERATION_STATE_BLOCKED, op.State())
+	require.Equal(t, 0, len(backend.Events))
+}
+
+func TestProcessInvocationTask_AccessDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	reg := newRegistry(t)
+	event := mustNewScheduledEvent(time.Now(), time.Hour)
+	backend := &hsmtest.NodeBackend{Events: []*historypb.HistoryEvent{event}}
+	node := newOperationNode(t, backend, backend.Events[0])
+	env := fakeEnv{node}
+
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID("ns-id")).Return(
+		namespace.NewNamespaceForTest(&persistence.NamespaceInfo{Name: "ns-name"}, nil, false, nil, 0), nil)
+	restrictedEntry := &persistence.NexusEndpointEntry{
+		Id: "endpoint-id",
+		Endpoint: &persistence.NexusEndpoint{
+			Spec: &persistence.NexusEndpointSpec{
+				Name: "endpoint",
+				AccessPolicy: &persistence.NexusEndpointAccessPolicy{
+					Allow: []*persistence.NexusEndpointAccessPolicy_Rule{{NamespaceNamePattern: "other-*"}},
+				},
+			},
+		},
+	}
+
+	require.NoError(t, nexusoperations.RegisterExecutor(reg, nexusoperations.TaskExecutorOptions{
+		Config: &nexusoperations.Config{
+			Enabled: dynamicconfig.GetBoolPropertyFn(true),
+		},
+		NamespaceRegistry: namespaceRegistry,
+		MetricsHandler:    metrics.NoopMetricsHandler,
+		Logger:            log.NewNoopLogger(),
+		EndpointRegistry: nexustest.FakeEndpointRegistry{
+			OnGetByID: func(ctx context.Context, endpointID string) (*persistence.NexusEndpointEntry, error) {
+				return restrictedEntry, nil
+			},
+		},
+		EndpointLimiter: nexusoperations.NewEndpointLimiter(),
+		ClientProvider: func(ctx context.Context, namespaceID string, entry *persistence.NexusEndpointEntry, service string) (*nexus.Client, error) {
+			require.FailNow(t, "should not attempt to start the operation")
+			return nil, nil
+		},
+	}))
+
+	err := reg.ExecuteImmediateTask(
+		context.Background(),
+		env,
+		hsm.Ref{
+			WorkflowKey:     definition.NewWorkflowKey("ns-id", "wf-id", "run-id"),
+			StateMachineRef: &persistence.StateMachineRef{},
+		},
+		nexusoperations.InvocationTask{EndpointName: "endpoint"},
+	)
+	require.NoError(t, err)
+	op, err := hsm.MachineData[nexusoperations.Operation](node)
+	require.NoError(t, err)
+	require.Equal(t, enumsspb.NEXUS_OPERATION_STATE_FAILED, op.State())
+	require.Equal(t, "caller namespace is not allowed to access endpoint", op.LastAttemptFailure.Message)
+	require.Equal(t, 2, len(backend.Events))
+}
+
 func TestProcessBackoffTask(t *testing.T) {
 	reg := newRegistry(t)
 	backend := &hsmtest.NodeBackend{}
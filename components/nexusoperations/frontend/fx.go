@@ -33,6 +33,8 @@ import (
 	"go.temporal.io/server/common/metrics"
 	commonnexus "go.temporal.io/server/common/nexus"
 	"go.temporal.io/server/common/rpc"
+	"go.temporal.io/server/components/callbacks"
+	"go.temporal.io/server/components/nexusoperations"
 	"go.uber.org/fx"
 )
 
@@ -48,16 +50,20 @@ func ConfigProvider(coll *dynamicconfig.Collection) *Config {
 		Enabled:                       dynamicconfig.EnableNexus.Get(coll),
 		PayloadSizeLimit:              dynamicconfig.BlobSizeLimitError.Get(coll),
 		ForwardingEnabledForNamespace: dynamicconfig.EnableNamespaceNotActiveAutoForwarding.Get(coll),
+		CallbackRequireSignature:      nexusoperations.CallbackRequireSignature.Get(coll),
+		CallbackSigningKeys:           callbacks.SigningKeys.Get(coll),
+		CallbackSignatureTolerance:    nexusoperations.CallbackSignatureTolerance.Get(coll),
 	}
 }
 
 func RegisterHTTPHandler(options HandlerOptions, logger log.Logger, router *mux.Router) {
+	handler := &completionHandler{
+		options,
+		headers.NewDefaultVersionChecker(),
+		options.MetricsHandler.Counter(metrics.NexusCompletionRequestPreProcessErrors.Name()),
+	}
 	h := nexus.NewCompletionHTTPHandler(nexus.CompletionHandlerOptions{
-		Handler: &completionHandler{
-			options,
-			headers.NewDefaultVersionChecker(),
-			options.MetricsHandler.Counter(metrics.NexusCompletionRequestPreProcessErrors.Name()),
-		},
+		Handler:    handler,
 		Logger:     log.NewSlogLogger(logger),
 		Serializer: commonnexus.PayloadSerializer,
 	})
@@ -66,6 +72,11 @@ func RegisterHTTPHandler(options HandlerOptions, logger log.Logger, router *mux.
 		// Content headers are transformed to Payload metadata and contribute to the Payload size as well. A separate
 		// limit is enforced on top of this in the CompleteOperation method.
 		r.Body = http.MaxBytesReader(w, r.Body, rpc.MaxNexusAPIRequestBodyBytes)
+		// The signature covers the raw body, verify it before the handler consumes the body.
+		if err := handler.verifyRequestSignature(r); err != nil {
+			writeFailure(w, err)
+			return
+		}
 		h.ServeHTTP(w, r)
 	})
 }
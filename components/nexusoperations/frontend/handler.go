@@ -52,7 +52,9 @@ import (
 	"go.temporal.io/server/common/namespace"
 	commonnexus "go.temporal.io/server/common/nexus"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/rpc/interceptor"
+	"go.temporal.io/server/components/callbacks"
 	"go.temporal.io/server/service/frontend/configs"
 	"go.uber.org/fx"
 	"google.golang.org/grpc/codes"
@@ -74,6 +76,9 @@ type Config struct {
 	Enabled                       dynamicconfig.BoolPropertyFn
 	PayloadSizeLimit              dynamicconfig.IntPropertyFnWithNamespaceFilter
 	ForwardingEnabledForNamespace dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	CallbackRequireSignature      dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	CallbackSigningKeys           dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]callbacks.SigningKey]
+	CallbackSignatureTolerance    dynamicconfig.DurationPropertyFn
 }
 
 type HandlerOptions struct {
@@ -218,6 +223,45 @@ func (h *completionHandler) CompleteOperation(ctx context.Context, r *nexus.Comp
 	return nil
 }
 
+// verifyRequestSignature rejects completion requests that aren't signed with one of the namespace's callback signing
+// keys if signatures are required for the target namespace.
+func (h *completionHandler) verifyRequestSignature(r *http.Request) *nexus.HandlerError {
+	nsName, err := url.PathUnescape(commonnexus.RouteCompletionCallback.Deserialize(mux.Vars(r)))
+	if err != nil || !h.Config.CallbackRequireSignature(nsName) {
+		// Invalid URLs are rejected in CompleteOperation.
+		return nil
+	}
+	keys := callbacks.VerificationKeys(h.Config.CallbackSigningKeys(nsName))
+	err = commonnexus.VerifyRequest(r, keys, time.Now(), h.Config.CallbackSignatureTolerance(), rpc.MaxNexusAPIRequestBodyBytes)
+	if err == nil {
+		return nil
+	}
+	h.preProcessErrorsCounter.Record(1)
+	if errors.Is(err, commonnexus.ErrMissingSignature) ||
+		errors.Is(err, commonnexus.ErrInvalidSignature) ||
+		errors.Is(err, commonnexus.ErrExpiredSignature) {
+		h.Logger.Warn("rejecting Nexus completion request with invalid signature", tag.WorkflowNamespace(nsName), tag.Error(err))
+		return nexus.HandlerErrorf(nexus.HandlerErrorTypeUnauthenticated, "%v", err)
+	}
+	if errors.Is(err, commonnexus.ErrBodyTooLarge) {
+		return nexus.HandlerErrorf(nexus.HandlerErrorTypeBadRequest, "%v", err)
+	}
+	h.Logger.Error("failed to read Nexus completion request body", tag.WorkflowNamespace(nsName), tag.Error(err))
+	return nexus.HandlerErrorf(nexus.HandlerErrorTypeBadRequest, "failed to read request body")
+}
+
+// writeFailure writes a handler error in the same format used by the Nexus SDK for errors that occur before the
+// request is passed to the SDK handler.
+func writeFailure(w http.ResponseWriter, err *nexus.HandlerError) {
+	statusCode := http.StatusBadRequest
+	if err.Type == nexus.HandlerErrorTypeUnauthenticated {
+		statusCode = http.StatusUnauthorized
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(err.Failure)
+}
+
 func (h *completionHandler) forwardCompleteOperation(ctx context.Context, r *nexus.CompletionRequest, rCtx *requestContext) error {
 	client, err := h.ForwardingClients.Get(rCtx.namespace.ActiveClusterName())
 	if err != nil {
@@ -48,6 +48,8 @@ var Module = fx.Module(
 	fx.Provide(ConfigProvider),
 	fx.Provide(ClientProviderFactory),
 	fx.Provide(DefaultNexusTransportProvider),
+	fx.Provide(DefaultSecretResolverProvider),
+	fx.Provide(NewEndpointLimiter),
 	fx.Provide(CallbackTokenGeneratorProvider),
 	fx.Provide(EndpointRegistryProvider),
 	fx.Invoke(EndpointRegistryLifetimeHooks),
@@ -96,12 +98,15 @@ type clientProviderCacheKey struct {
 	// URL is part of the cache key in case the service configuration is modified to use a new URL after caching the
 	// client for the service.
 	url string
+	// Auth is part of the cache key for the same reason, encoded since protos aren't comparable.
+	auth string
 }
 
 func ClientProviderFactory(
 	namespaceRegistry namespace.Registry,
 	endpointRegistry commonnexus.EndpointRegistry,
 	httpTransportProvider NexusTransportProvider,
+	resolveSecret SecretResolver,
 	clusterMetadata cluster.Metadata,
 	rpcFactory common.RPCFactory,
 ) (ClientProvider, error) {
@@ -112,7 +117,14 @@ func ClientProviderFactory(
 
 	// TODO(bergundy): This should use an LRU or other form of cache that supports eviction.
 	m := collection.NewFallibleOnceMap(func(key clientProviderCacheKey) (*http.Client, error) {
-		transport := httpTransportProvider(key.namespaceID, key.endpointID)
+		auth := &persistencespb.NexusEndpointAuth{}
+		if err := auth.Unmarshal([]byte(key.auth)); err != nil {
+			return nil, err
+		}
+		transport, err := newEndpointAuthTransport(httpTransportProvider(key.namespaceID, key.endpointID), auth, resolveSecret)
+		if err != nil {
+			return nil, err
+		}
 		return &http.Client{
 			Transport: ResponseSizeLimiter{transport},
 		}, nil
@@ -120,24 +132,35 @@ func ClientProviderFactory(
 	return func(ctx context.Context, namespaceID string, entry *persistencespb.NexusEndpointEntry, service string) (*nexus.Client, error) {
 		var url string
 		var httpClient *http.Client
+		header := make(http.Header)
 		switch variant := entry.Endpoint.Spec.Target.Variant.(type) {
 		case *persistencespb.NexusEndpointTarget_External_:
 			url = variant.External.GetUrl()
-			var err error
-			httpClient, err = m.Get(clientProviderCacheKey{namespaceID, entry.Id, url})
+			auth, err := variant.External.GetAuth().Marshal()
+			if err != nil {
+				return nil, err
+			}
+			httpClient, err = m.Get(clientProviderCacheKey{namespaceID, entry.Id, url, string(auth)})
 			if err != nil {
 				return nil, err
 			}
 		case *persistencespb.NexusEndpointTarget_Worker_:
 			url = cl.BaseURL() + "/" + commonnexus.RouteDispatchNexusTaskByEndpoint.Path(entry.Id)
 			httpClient = &cl.Client
+			// Identify the caller to the frontend so it can enforce the endpoint's access policy.
+			header.Set(commonnexus.CallerNamespaceIDHeader, namespaceID)
 		default:
 			return nil, serviceerror.NewInternal("got unexpected endpoint target")
 		}
-		httpCaller := httpClient.Do
 		if clusterInfo, ok := clusterMetadata.GetAllClusterInfo()[clusterMetadata.GetCurrentClusterName()]; ok {
+			header.Set(NexusCallbackSourceHeader, clusterInfo.ClusterID)
+		}
+		httpCaller := httpClient.Do
+		if len(header) > 0 {
 			httpCaller = func(r *http.Request) (*http.Response, error) {
-				r.Header.Set(NexusCallbackSourceHeader, clusterInfo.ClusterID)
+				for k, v := range header {
+					r.Header[k] = v
+				}
 				return httpClient.Do(r)
 			}
 		}
@@ -32,3 +32,7 @@ var OutboundRequestLatency = metrics.NewTimerDef(
 	"nexus_outbound_latency",
 	metrics.WithDescription("Latency of outbound Nexus requests made by the history service."),
 )
+var EndpointCapacityBlockedCounter = metrics.NewCounterDef(
+	"nexus_endpoint_capacity_blocked",
+	metrics.WithDescription("The number of Nexus StartOperation requests held back because the endpoint was at capacity."),
+)
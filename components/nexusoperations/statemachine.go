@@ -152,7 +152,7 @@ func (o Operation) transitionTasks() ([]hsm.Task, error) {
 	switch o.State() { // nolint:exhaustive
 	case enumsspb.NEXUS_OPERATION_STATE_BACKING_OFF:
 		return []hsm.Task{BackoffTask{Deadline: o.NextAttemptScheduleTime.AsTime()}}, nil
-	case enumsspb.NEXUS_OPERATION_STATE_SCHEDULED:
+	case enumsspb.NEXUS_OPERATION_STATE_SCHEDULED, enumsspb.NEXUS_OPERATION_STATE_BLOCKED:
 		return []hsm.Task{InvocationTask{EndpointName: o.Endpoint}}, nil
 	default:
 		return nil, nil
@@ -292,7 +292,7 @@ type EventAttemptFailed struct {
 }
 
 var TransitionAttemptFailed = hsm.NewTransition(
-	[]enumsspb.NexusOperationState{enumsspb.NEXUS_OPERATION_STATE_SCHEDULED},
+	[]enumsspb.NexusOperationState{enumsspb.NEXUS_OPERATION_STATE_SCHEDULED, enumsspb.NEXUS_OPERATION_STATE_BLOCKED},
 	enumsspb.NEXUS_OPERATION_STATE_BACKING_OFF,
 	func(op Operation, event EventAttemptFailed) (hsm.TransitionOutput, error) {
 		op.recordAttempt(event.Time)
@@ -312,6 +312,21 @@ var TransitionAttemptFailed = hsm.NewTransition(
 	},
 )
 
+// EventBlocked is triggered when an invocation attempt could not be made because the endpoint is at capacity.
+type EventBlocked struct {
+	Node *hsm.Node
+}
+
+// TransitionBlocked does not count as an attempt. The emitted invocation task is held back by the outbound queue until
+// the endpoint has capacity.
+var TransitionBlocked = hsm.NewTransition(
+	[]enumsspb.NexusOperationState{enumsspb.NEXUS_OPERATION_STATE_SCHEDULED},
+	enumsspb.NEXUS_OPERATION_STATE_BLOCKED,
+	func(op Operation, event EventBlocked) (hsm.TransitionOutput, error) {
+		return op.output()
+	},
+)
+
 // EventFailed is triggered when an invocation attempt is failed with a non retryable error.
 type EventFailed struct {
 	Time             time.Time
@@ -323,6 +338,7 @@ type EventFailed struct {
 var TransitionFailed = hsm.NewTransition(
 	[]enumsspb.NexusOperationState{
 		enumsspb.NEXUS_OPERATION_STATE_SCHEDULED,
+		enumsspb.NEXUS_OPERATION_STATE_BLOCKED,
 		enumsspb.NEXUS_OPERATION_STATE_BACKING_OFF,
 		enumsspb.NEXUS_OPERATION_STATE_STARTED,
 	},
@@ -361,6 +377,7 @@ type EventSucceeded struct {
 var TransitionSucceeded = hsm.NewTransition(
 	[]enumsspb.NexusOperationState{
 		enumsspb.NEXUS_OPERATION_STATE_SCHEDULED,
+		enumsspb.NEXUS_OPERATION_STATE_BLOCKED,
 		enumsspb.NEXUS_OPERATION_STATE_BACKING_OFF,
 		enumsspb.NEXUS_OPERATION_STATE_STARTED,
 	},
@@ -389,6 +406,7 @@ type EventCanceled struct {
 var TransitionCanceled = hsm.NewTransition(
 	[]enumsspb.NexusOperationState{
 		enumsspb.NEXUS_OPERATION_STATE_SCHEDULED,
+		enumsspb.NEXUS_OPERATION_STATE_BLOCKED,
 		enumsspb.NEXUS_OPERATION_STATE_BACKING_OFF,
 		enumsspb.NEXUS_OPERATION_STATE_STARTED,
 	},
@@ -418,7 +436,11 @@ type EventStarted struct {
 }
 
 var TransitionStarted = hsm.NewTransition(
-	[]enumsspb.NexusOperationState{enumsspb.NEXUS_OPERATION_STATE_SCHEDULED, enumsspb.NEXUS_OPERATION_STATE_BACKING_OFF},
+	[]enumsspb.NexusOperationState{
+		enumsspb.NEXUS_OPERATION_STATE_SCHEDULED,
+		enumsspb.NEXUS_OPERATION_STATE_BLOCKED,
+		enumsspb.NEXUS_OPERATION_STATE_BACKING_OFF,
+	},
 	enumsspb.NEXUS_OPERATION_STATE_STARTED,
 	func(op Operation, event EventStarted) (hsm.TransitionOutput, error) {
 		op.recordAttempt(event.Time)
@@ -449,6 +471,7 @@ type EventTimedOut struct {
 var TransitionTimedOut = hsm.NewTransition(
 	[]enumsspb.NexusOperationState{
 		enumsspb.NEXUS_OPERATION_STATE_SCHEDULED,
+		enumsspb.NEXUS_OPERATION_STATE_BLOCKED,
 		enumsspb.NEXUS_OPERATION_STATE_BACKING_OFF,
 		enumsspb.NEXUS_OPERATION_STATE_STARTED,
 	},
@@ -490,10 +513,13 @@ func (o Operation) progress() (int, int32, error) {
 	case enumsspb.NEXUS_OPERATION_STATE_UNSPECIFIED:
 		return 0, 0, serviceerror.NewInvalidArgument("uninitialized operation state")
 	case enumsspb.NEXUS_OPERATION_STATE_BACKING_OFF:
-		return 1, o.GetAttempt() * 2, nil
+		return 1, o.GetAttempt() * 3, nil
 	case enumsspb.NEXUS_OPERATION_STATE_SCHEDULED:
 		// We've made slightly more progress if we transitioned from backing off to scheduled.
-		return 1, o.GetAttempt()*2 + 1, nil
+		return 1, o.GetAttempt()*3 + 1, nil
+	case enumsspb.NEXUS_OPERATION_STATE_BLOCKED:
+		// Blocked operations were scheduled first.
+		return 1, o.GetAttempt()*3 + 2, nil
 	case enumsspb.NEXUS_OPERATION_STATE_STARTED:
 		return 2, 0, nil
 	case enumsspb.NEXUS_OPERATION_STATE_TIMED_OUT,
@@ -133,6 +133,15 @@ func TestRegenerateTasks(t *testing.T) {
 				require.Equal(t, nexusoperations.TaskTypeInvocation, tasks[0].Type())
 			},
 		},
+		{
+			name:    "blocked | without timeout",
+			timeout: 0,
+			state:   enumsspb.NEXUS_OPERATION_STATE_BLOCKED,
+			assertTasks: func(t *testing.T, tasks []hsm.Task) {
+				require.Equal(t, 1, len(tasks))
+				require.Equal(t, nexusoperations.TaskTypeInvocation, tasks[0].Type())
+			},
+		},
 		{
 			name:    "backing off | with timeout",
 			timeout: time.Hour,
@@ -158,6 +167,13 @@ func TestRegenerateTasks(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			node := newOperationNode(t, &hsmtest.NodeBackend{}, mustNewScheduledEvent(time.Now(), tc.timeout))
 
+			if tc.state == enumsspb.NEXUS_OPERATION_STATE_BLOCKED {
+				require.NoError(t, hsm.MachineTransition(node, func(op nexusoperations.Operation) (hsm.TransitionOutput, error) {
+					return nexusoperations.TransitionBlocked.Apply(op, nexusoperations.EventBlocked{
+						Node: node,
+					})
+				}))
+			}
 			if tc.state == enumsspb.NEXUS_OPERATION_STATE_BACKING_OFF {
 				require.NoError(t, hsm.MachineTransition(node, func(op nexusoperations.Operation) (hsm.TransitionOutput, error) {
 					return nexusoperations.TransitionAttemptFailed.Apply(op, nexusoperations.EventAttemptFailed{
@@ -666,6 +682,19 @@ func TestOperationCompareState(t *testing.T) {
 			attempts2: 1,
 			sign:      -1,
 		},
+		{
+			name: "blocked > scheduled",
+			s1:   enumsspb.NEXUS_OPERATION_STATE_BLOCKED,
+			s2:   enumsspb.NEXUS_OPERATION_STATE_SCHEDULED,
+			sign: 1,
+		},
+		{
+			name:      "blocked < backing off with greater attempt",
+			s1:        enumsspb.NEXUS_OPERATION_STATE_BLOCKED,
+			s2:        enumsspb.NEXUS_OPERATION_STATE_BACKING_OFF,
+			attempts2: 1,
+			sign:      -1,
+		},
 	}
 	for _, tc := range cases {
 		tc := tc
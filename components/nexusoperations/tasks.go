@@ -116,9 +116,9 @@ func (t InvocationTask) Validate(node *hsm.Node) error {
 	if err != nil {
 		return err
 	}
-	if op.State() != enums.NEXUS_OPERATION_STATE_SCHEDULED {
+	if op.State() != enums.NEXUS_OPERATION_STATE_SCHEDULED && op.State() != enums.NEXUS_OPERATION_STATE_BLOCKED {
 		return fmt.Errorf(
-			"%w: operation is not in Scheduled state, current state: %v",
+			"%w: operation is not in Scheduled or Blocked state, current state: %v",
 			consts.ErrStaleReference,
 			op.State(),
 		)
@@ -0,0 +1 @@
+CREATE INDEX by_start_time ON executions_visibility (namespace_id, start_time, run_id);
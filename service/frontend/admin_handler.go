@@ -60,6 +60,8 @@ import (
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/client/history"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/apikey"
+	"go.temporal.io/server/common/audit"
 	"go.temporal.io/server/common/channel"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
@@ -125,6 +127,7 @@ type (
 		namespaceRegistry          namespace.Registry
 		saProvider                 searchattribute.Provider
 		saManager                  searchattribute.Manager
+		apiKeyManager              apikey.Manager
 		clusterMetadata            cluster.Metadata
 		healthServer               *health.Server
 		historyHealthChecker       HealthChecker
@@ -133,6 +136,7 @@ type (
 		// use the following components.
 		taskCategoryRegistry tasks.TaskCategoryRegistry
 		matchingClient       matchingservice.MatchingServiceClient
+		nexusEndpointClient  *NexusEndpointClient
 	}
 
 	NewAdminHandlerArgs struct {
@@ -157,6 +161,7 @@ type (
 		NamespaceRegistry                   namespace.Registry
 		SaProvider                          searchattribute.Provider
 		SaManager                           searchattribute.Manager
+		APIKeyManager                       apikey.Manager
 		ClusterMetadata                     cluster.Metadata
 		HealthServer                        *health.Server
 		EventSerializer                     serialization.Serializer
@@ -164,8 +169,9 @@ type (
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
-		CategoryRegistry tasks.TaskCategoryRegistry
-		matchingClient   matchingservice.MatchingServiceClient
+		CategoryRegistry    tasks.TaskCategoryRegistry
+		matchingClient      matchingservice.MatchingServiceClient
+		nexusEndpointClient *NexusEndpointClient
 	}
 )
 
@@ -227,11 +233,13 @@ func NewAdminHandler(
 		namespaceRegistry:          args.NamespaceRegistry,
 		saProvider:                 args.SaProvider,
 		saManager:                  args.SaManager,
+		apiKeyManager:              args.APIKeyManager,
 		clusterMetadata:            args.ClusterMetadata,
 		healthServer:               args.HealthServer,
 		historyHealthChecker:       historyHealthChecker,
 		taskCategoryRegistry:       args.CategoryRegistry,
 		matchingClient:             args.matchingClient,
+		nexusEndpointClient:        args.nexusEndpointClient,
 	}
 }
 
@@ -774,6 +782,363 @@ func (adh *AdminHandler) DescribeMutableState(ctx context.Context, request *admi
 	}, nil
 }
 
+// DescribeStateMachines returns the hierarchical state machine tree of a workflow execution.
+func (adh *AdminHandler) DescribeStateMachines(ctx context.Context, request *adminservice.DescribeStateMachinesRequest) (_ *adminservice.DescribeStateMachinesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.historyClient.DescribeStateMachines(ctx, &historyservice.DescribeStateMachinesRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeStateMachinesResponse{
+		Root: resp.GetRoot(),
+	}, nil
+}
+
+// InvokeStateMachineMethod invokes a registered remote method on a state machine of a workflow execution.
+func (adh *AdminHandler) InvokeStateMachineMethod(ctx context.Context, request *adminservice.InvokeStateMachineMethodRequest) (_ *adminservice.InvokeStateMachineMethodResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if request.GetMethodName() == "" {
+		return nil, serviceerror.NewInvalidArgument("Method name is not set on request.")
+	}
+	if request.GetReason() == "" {
+		return nil, errReasonNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	// The history API expects a reference to a specific machine instance, look up the machine to build one.
+	describeResp, err := adh.historyClient.DescribeStateMachines(ctx, &historyservice.DescribeStateMachinesRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+	})
+	if err != nil {
+		return nil, err
+	}
+	node := findStateMachineNode(describeResp.GetRoot(), request.GetPath())
+	if node == nil {
+		return nil, serviceerror.NewNotFound("state machine not found")
+	}
+
+	resp, err := adh.historyClient.InvokeStateMachineMethod(ctx, &historyservice.InvokeStateMachineMethodRequest{
+		NamespaceId: namespaceID.String(),
+		WorkflowId:  request.Execution.GetWorkflowId(),
+		RunId:       request.Execution.GetRunId(),
+		Ref: &persistencespb.StateMachineRef{
+			Path:                              request.GetPath(),
+			MachineInitialVersionedTransition: node.GetInitialVersionedTransition(),
+		},
+		MethodName: request.GetMethodName(),
+		Input:      request.GetInput(),
+	})
+	adh.recordStateMachineIntervention(ctx, "InvokeStateMachineMethod", request.GetNamespace(), request.Execution, request.GetPath(), request.GetReason(), request.GetIdentity(), err,
+		map[string]string{"method": request.GetMethodName()})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.InvokeStateMachineMethodResponse{
+		Output: resp.GetOutput(),
+	}, nil
+}
+
+// DeleteStateMachineNode deletes a state machine and its descendants from a workflow execution.
+func (adh *AdminHandler) DeleteStateMachineNode(ctx context.Context, request *adminservice.DeleteStateMachineNodeRequest) (_ *adminservice.DeleteStateMachineNodeResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if len(request.GetPath()) == 0 {
+		return nil, serviceerror.NewInvalidArgument("Path is not set on request.")
+	}
+	if request.GetReason() == "" {
+		return nil, errReasonNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.DeleteStateMachineNode(ctx, &historyservice.DeleteStateMachineNodeRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+		Path:        request.GetPath(),
+	})
+	adh.recordStateMachineIntervention(ctx, "DeleteStateMachineNode", request.GetNamespace(), request.Execution, request.GetPath(), request.GetReason(), request.GetIdentity(), err, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DeleteStateMachineNodeResponse{}, nil
+}
+
+// recordStateMachineIntervention adds the details of a manual intervention on a workflow's state machines to the audit
+// record of the call and logs it.
+func (adh *AdminHandler) recordStateMachineIntervention(
+	ctx context.Context,
+	operation string,
+	namespaceName string,
+	execution *commonpb.WorkflowExecution,
+	path []*persistencespb.StateMachineKey,
+	reason string,
+	identity string,
+	err error,
+	details map[string]string,
+) {
+	keys := make([]string, 0, len(path))
+	for _, key := range path {
+		keys = append(keys, key.GetType()+":"+key.GetId())
+	}
+	auditDetails := map[string]string{
+		"stateMachinePath": strings.Join(keys, "/"),
+		"reason":           reason,
+		"identity":         identity,
+	}
+	maps.Copy(auditDetails, details)
+	audit.AddDetails(ctx, auditDetails)
+
+	var tags []tag.Tag
+	for k, v := range details {
+		tags = append(tags, tag.NewStringTag(k, v))
+	}
+	tags = append(tags,
+		tag.Operation(operation),
+		tag.WorkflowNamespace(namespaceName),
+		tag.WorkflowID(execution.GetWorkflowId()),
+		tag.WorkflowRunID(execution.GetRunId()),
+		tag.NewStringTag("state-machine-path", strings.Join(keys, "/")),
+		tag.NewStringTag("reason", reason),
+		tag.NewStringTag("identity", identity),
+	)
+	if err != nil {
+		adh.logger.Warn("State machine intervention failed", append(tags, tag.Error(err))...)
+		return
+	}
+	adh.logger.Info("State machine intervention applied", tags...)
+}
+
+func findStateMachineNode(node *adminservice.StateMachineNodeInfo, path []*persistencespb.StateMachineKey) *adminservice.StateMachineNodeInfo {
+	for _, key := range path {
+		var next *adminservice.StateMachineNodeInfo
+		for _, child := range node.GetChildren() {
+			childKey := child.GetPath()[len(child.GetPath())-1]
+			if childKey.GetType() == key.GetType() && childKey.GetId() == key.GetId() {
+				next = child
+				break
+			}
+		}
+		if next == nil {
+			return nil
+		}
+		node = next
+	}
+	return node
+}
+
+// ScheduleDelayedSignal creates a durable timer that signals a workflow at the given time.
+func (adh *AdminHandler) ScheduleDelayedSignal(ctx context.Context, request *adminservice.ScheduleDelayedSignalRequest) (_ *adminservice.ScheduleDelayedSignalResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if target := request.GetTarget(); target.GetWorkflowId() != "" || target.GetRunId() != "" {
+		if err := validateExecution(target); err != nil {
+			return nil, err
+		}
+	}
+	if request.GetSignalName() == "" {
+		return nil, errSignalNameNotSet
+	}
+	if request.GetFireTime() == nil {
+		return nil, serviceerror.NewInvalidArgument("FireTime is not set on request.")
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	requestID := request.GetRequestId()
+	if requestID == "" {
+		requestID = uuid.New()
+	}
+	_, err = adh.historyClient.ScheduleDelayedSignal(ctx, &historyservice.ScheduleDelayedSignalRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+		Target:      request.GetTarget(),
+		SignalName:  request.GetSignalName(),
+		Input:       request.GetInput(),
+		Header:      request.GetHeader(),
+		FireTime:    request.GetFireTime(),
+		Identity:    request.GetIdentity(),
+		RequestId:   requestID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ScheduleDelayedSignalResponse{Id: requestID}, nil
+}
+
+// CancelDelayedSignal cancels a delayed signal that has not been delivered yet.
+func (adh *AdminHandler) CancelDelayedSignal(ctx context.Context, request *adminservice.CancelDelayedSignalRequest) (_ *adminservice.CancelDelayedSignalResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if request.GetId() == "" {
+		return nil, serviceerror.NewInvalidArgument("Id is not set on request.")
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.CancelDelayedSignal(ctx, &historyservice.CancelDelayedSignalRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+		Id:          request.GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.CancelDelayedSignalResponse{}, nil
+}
+
+// ListDelayedSignals lists the delayed signals hosted by a workflow execution.
+func (adh *AdminHandler) ListDelayedSignals(ctx context.Context, request *adminservice.ListDelayedSignalsRequest) (_ *adminservice.ListDelayedSignalsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.historyClient.ListDelayedSignals(ctx, &historyservice.ListDelayedSignalsRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListDelayedSignalsResponse{
+		DelayedSignals: resp.GetDelayedSignals(),
+	}, nil
+}
+
+// CreateAPIKey creates an API key. The key is only returned in the response and can't be retrieved later.
+func (adh *AdminHandler) CreateAPIKey(ctx context.Context, request *adminservice.CreateAPIKeyRequest) (_ *adminservice.CreateAPIKeyResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	key, apiKey, err := adh.apiKeyManager.Create(ctx, request.GetSubject(), request.GetPermissions(), request.GetDescription(), request.GetExpireTime())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.CreateAPIKeyResponse{
+		Key:    key,
+		ApiKey: apiKey,
+	}, nil
+}
+
+// ListAPIKeys lists the metadata of all API keys.
+func (adh *AdminHandler) ListAPIKeys(ctx context.Context, request *adminservice.ListAPIKeysRequest) (_ *adminservice.ListAPIKeysResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	apiKeys, err := adh.apiKeyManager.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListAPIKeysResponse{
+		ApiKeys: apiKeys,
+	}, nil
+}
+
+// RotateAPIKey replaces the secret of an API key. The previous key stops working once all frontends
+// refreshed their cache.
+func (adh *AdminHandler) RotateAPIKey(ctx context.Context, request *adminservice.RotateAPIKeyRequest) (_ *adminservice.RotateAPIKeyResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetId() == "" {
+		return nil, errAPIKeyIDNotSet
+	}
+	key, apiKey, err := adh.apiKeyManager.Rotate(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.RotateAPIKeyResponse{
+		Key:    key,
+		ApiKey: apiKey,
+	}, nil
+}
+
+// RevokeAPIKey deletes an API key.
+func (adh *AdminHandler) RevokeAPIKey(ctx context.Context, request *adminservice.RevokeAPIKeyRequest) (_ *adminservice.RevokeAPIKeyResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetId() == "" {
+		return nil, errAPIKeyIDNotSet
+	}
+	if err := adh.apiKeyManager.Revoke(ctx, request.GetId()); err != nil {
+		return nil, err
+	}
+	return &adminservice.RevokeAPIKeyResponse{}, nil
+}
+
 // RemoveTask returns information about the internal states of a history host
 func (adh *AdminHandler) RemoveTask(ctx context.Context, request *adminservice.RemoveTaskRequest) (_ *adminservice.RemoveTaskResponse, retError error) {
 	defer log.CapturePanic(adh.logger, &retError)
@@ -843,6 +1208,94 @@ func (adh *AdminHandler) ListHistoryTasks(
 	return resp.Response, nil
 }
 
+// DescribeHistoryQueue returns the in-memory state of a history task queue on a shard
+func (adh *AdminHandler) DescribeHistoryQueue(
+	ctx context.Context,
+	request *adminservice.DescribeHistoryQueueRequest,
+) (_ *adminservice.DescribeHistoryQueueResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	resp, err := adh.historyClient.DescribeQueue(
+		ctx, &historyservice.DescribeQueueRequest{
+			Request: request,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Response, nil
+}
+
+// MitigateHistoryQueue runs a manual mitigation on a history task queue on a shard
+func (adh *AdminHandler) MitigateHistoryQueue(
+	ctx context.Context,
+	request *adminservice.MitigateHistoryQueueRequest,
+) (_ *adminservice.MitigateHistoryQueueResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	resp, err := adh.historyClient.MitigateQueue(
+		ctx, &historyservice.MitigateQueueRequest{
+			Request: request,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Response, nil
+}
+
+// DescribeOutboundQueue lists the destinations of the outbound queue on a shard with their circuit breaker states
+func (adh *AdminHandler) DescribeOutboundQueue(
+	ctx context.Context,
+	request *adminservice.DescribeOutboundQueueRequest,
+) (_ *adminservice.DescribeOutboundQueueResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	resp, err := adh.historyClient.DescribeOutboundQueue(
+		ctx, &historyservice.DescribeOutboundQueueRequest{
+			Request: request,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Response, nil
+}
+
+// UpdateOutboundQueueCircuitBreaker forces the circuit breaker of an outbound queue destination open or closed, or resets it
+func (adh *AdminHandler) UpdateOutboundQueueCircuitBreaker(
+	ctx context.Context,
+	request *adminservice.UpdateOutboundQueueCircuitBreakerRequest,
+) (_ *adminservice.UpdateOutboundQueueCircuitBreakerResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	resp, err := adh.historyClient.UpdateOutboundQueueCircuitBreaker(
+		ctx, &historyservice.UpdateOutboundQueueCircuitBreakerRequest{
+			Request: request,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Response, nil
+}
+
 // DescribeHistoryHost returns information about the internal states of a history host
 func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *adminservice.DescribeHistoryHostRequest) (_ *adminservice.DescribeHistoryHostResponse, retError error) {
 	defer log.CapturePanic(adh.logger, &retError)
@@ -1069,7 +1522,10 @@ func (adh *AdminHandler) ListClusters(
 
 	var clusterMetadataList []*persistencespb.ClusterMetadata
 	for _, clusterResp := range resp.ClusterMetadata {
-		clusterMetadataList = append(clusterMetadataList, clusterResp.ClusterMetadata)
+		// API keys are only returned by ListApiKeys
+		clusterMetadata := common.CloneProto(clusterResp.ClusterMetadata)
+		clusterMetadata.ApiKeys = nil
+		clusterMetadataList = append(clusterMetadataList, clusterMetadata)
 	}
 	return &adminservice.ListClustersResponse{
 		Clusters:      clusterMetadataList,
@@ -1649,6 +2105,57 @@ func (adh *AdminHandler) DescribeTaskQueuePartition(
 	}, nil
 }
 
+// UpdateNexusEndpointAuth sets the credentials presented to an external Nexus endpoint.
+func (adh *AdminHandler) UpdateNexusEndpointAuth(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointAuthRequest,
+) (_ *adminservice.UpdateNexusEndpointAuthResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if !adh.config.EnableNexusAPIs() {
+		return nil, serviceerror.NewNotFound("Nexus APIs are disabled")
+	}
+
+	return adh.nexusEndpointClient.UpdateAuth(ctx, request)
+}
+
+// UpdateNexusEndpointLimits sets the limits enforced on requests to a Nexus endpoint.
+func (adh *AdminHandler) UpdateNexusEndpointLimits(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointLimitsRequest,
+) (_ *adminservice.UpdateNexusEndpointLimitsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if !adh.config.EnableNexusAPIs() {
+		return nil, serviceerror.NewNotFound("Nexus APIs are disabled")
+	}
+
+	return adh.nexusEndpointClient.UpdateLimits(ctx, request)
+}
+
+// UpdateNexusEndpointAccessPolicy sets the policy restricting which caller namespaces may access a Nexus endpoint.
+func (adh *AdminHandler) UpdateNexusEndpointAccessPolicy(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointAccessPolicyRequest,
+) (_ *adminservice.UpdateNexusEndpointAccessPolicyResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if !adh.config.EnableNexusAPIs() {
+		return nil, serviceerror.NewNotFound("Nexus APIs are disabled")
+	}
+
+	return adh.nexusEndpointClient.UpdateAccessPolicy(ctx, request)
+}
+
 func (adh *AdminHandler) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -55,6 +55,7 @@ import (
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	clientmocks "go.temporal.io/server/client"
 	historyclient "go.temporal.io/server/client/history"
+	"go.temporal.io/server/common/apikey"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
@@ -95,6 +96,7 @@ type (
 		mockMetadata               *cluster.MockMetadata
 		mockProducer               *persistence.MockNamespaceReplicationQueue
 		mockMatchingClient         *matchingservicemock.MockMatchingServiceClient
+		mockAPIKeyManager          *apikey.MockManager
 
 		namespace      namespace.Name
 		namespaceID    namespace.ID
@@ -137,6 +139,7 @@ func (s *adminHandlerSuite) SetupTest() {
 	s.mockVisibilityMgr = s.mockResource.VisibilityManager
 	s.mockProducer = persistence.NewMockNamespaceReplicationQueue(s.controller)
 	s.mockMatchingClient = s.mockResource.MatchingClient
+	s.mockAPIKeyManager = apikey.NewMockManager(s.controller)
 
 	persistenceConfig := &config.Persistence{
 		NumHistoryShards: 1,
@@ -167,12 +170,14 @@ func (s *adminHandlerSuite) SetupTest() {
 		s.mockResource.GetNamespaceRegistry(),
 		s.mockResource.GetSearchAttributesProvider(),
 		s.mockResource.GetSearchAttributesManager(),
+		s.mockAPIKeyManager,
 		s.mockMetadata,
 		health.NewServer(),
 		serialization.NewSerializer(),
 		clock.NewRealTimeSource(),
 		tasks.NewDefaultTaskCategoryRegistry(),
 		s.mockResource.GetMatchingClient(),
+		nil,
 	}
 	s.mockMetadata.EXPECT().GetCurrentClusterName().Return(uuid.New()).AnyTimes()
 	s.mockExecutionMgr.EXPECT().GetName().Return("mock-execution-manager").AnyTimes()
@@ -974,13 +979,19 @@ func (s *adminHandlerSuite) Test_DescribeCluster_NonCurrentCluster_Success() {
 func (s *adminHandlerSuite) Test_ListClusters_Success() {
 	var pageSize int32 = 1
 
+	clusterMetadata := &persistencespb.ClusterMetadata{
+		ClusterName: "test",
+		ApiKeys: map[string]*persistencespb.APIKey{
+			"0123456789abcdef": {Id: "0123456789abcdef", Subject: "ci", SecretHash: []byte("hash")},
+		},
+	}
 	s.mockClusterMetadataManager.EXPECT().ListClusterMetadata(gomock.Any(), &persistence.ListClusterMetadataRequest{
 		PageSize: int(pageSize),
 	}).Return(
 		&persistence.ListClusterMetadataResponse{
 			ClusterMetadata: []*persistence.GetClusterMetadataResponse{
 				{
-					ClusterMetadata: &persistencespb.ClusterMetadata{ClusterName: "test"},
+					ClusterMetadata: clusterMetadata,
 				},
 			}}, nil)
 
@@ -989,6 +1000,9 @@ func (s *adminHandlerSuite) Test_ListClusters_Success() {
 	})
 	s.NoError(err)
 	s.Equal(1, len(resp.Clusters))
+	s.Equal("test", resp.Clusters[0].GetClusterName())
+	s.Empty(resp.Clusters[0].GetApiKeys())
+	s.Len(clusterMetadata.GetApiKeys(), 1)
 	s.Equal(0, len(resp.GetNextPageToken()))
 }
 
@@ -1783,3 +1797,46 @@ func (s *adminHandlerSuite) validatePhysicalTaskQueueInfo(expectedPhysicalTaskQu
 	s.Equal(expectedPhysicalTaskQueueInfo.GetTaskQueueStats(), responsePhysicalTaskQueueInfo.GetTaskQueueStats())
 	s.Equal(expectedPhysicalTaskQueueInfo.GetInternalTaskQueueStatus(), responsePhysicalTaskQueueInfo.GetInternalTaskQueueStatus())
 }
+
+func (s *adminHandlerSuite) TestCreateAPIKey() {
+	ctx := context.Background()
+
+	_, err := s.handler.CreateAPIKey(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	apiKey := &persistencespb.APIKey{Id: "0123456789abcdef", Subject: "ci"}
+	s.mockAPIKeyManager.EXPECT().Create(gomock.Any(), "ci", []string{"default:write"}, "CI pipeline", nil).Return("tmprl_key", apiKey, nil)
+	resp, err := s.handler.CreateAPIKey(ctx, &adminservice.CreateAPIKeyRequest{
+		Subject:     "ci",
+		Permissions: []string{"default:write"},
+		Description: "CI pipeline",
+	})
+	s.NoError(err)
+	s.Equal("tmprl_key", resp.GetKey())
+	s.Equal(apiKey, resp.GetApiKey())
+}
+
+func (s *adminHandlerSuite) TestRotateAndRevokeAPIKey() {
+	ctx := context.Background()
+
+	_, err := s.handler.RotateAPIKey(ctx, &adminservice.RotateAPIKeyRequest{})
+	s.Equal(errAPIKeyIDNotSet, err)
+	_, err = s.handler.RevokeAPIKey(ctx, &adminservice.RevokeAPIKeyRequest{})
+	s.Equal(errAPIKeyIDNotSet, err)
+
+	apiKey := &persistencespb.APIKey{Id: "0123456789abcdef", Subject: "ci"}
+	s.mockAPIKeyManager.EXPECT().Rotate(gomock.Any(), apiKey.Id).Return("tmprl_rotated", apiKey, nil)
+	rotateResp, err := s.handler.RotateAPIKey(ctx, &adminservice.RotateAPIKeyRequest{Id: apiKey.Id})
+	s.NoError(err)
+	s.Equal("tmprl_rotated", rotateResp.GetKey())
+
+	s.mockAPIKeyManager.EXPECT().List(gomock.Any()).Return([]*persistencespb.APIKey{apiKey}, nil)
+	listResp, err := s.handler.ListAPIKeys(ctx, &adminservice.ListAPIKeysRequest{})
+	s.NoError(err)
+	s.Len(listResp.GetApiKeys(), 1)
+
+	notFound := serviceerror.NewNotFound("not found")
+	s.mockAPIKeyManager.EXPECT().Revoke(gomock.Any(), apiKey.Id).Return(notFound)
+	_, err = s.handler.RevokeAPIKey(ctx, &adminservice.RevokeAPIKeyRequest{Id: apiKey.Id})
+	s.Equal(notFound, err)
+}
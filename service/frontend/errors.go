@@ -108,6 +108,7 @@ var (
 	errSourceClusterNotSet    = serviceerror.NewInvalidArgument("SourceCluster is not set on request.")
 	errTargetClusterNotSet    = serviceerror.NewInvalidArgument("TargetCluster is not set on request.")
 	errInvalidDLQJobToken     = serviceerror.NewInvalidArgument("Invalid DLQ job token.")
+	errAPIKeyIDNotSet         = serviceerror.NewInvalidArgument("API key Id is not set on request.")
 
 	errPageSizeTooBigMessage = "PageSize is larger than allowed %d."
 
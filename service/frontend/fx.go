@@ -32,8 +32,10 @@ import (
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/client"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/apikey"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/audit"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
@@ -113,7 +115,9 @@ var Module = fx.Options(
 	fx.Provide(PersistenceRateLimitingParamsProvider),
 	service.PersistenceLazyLoadedServiceResolverModule,
 	fx.Provide(FEReplicatorNamespaceReplicationQueueProvider),
+	fx.Provide(APIKeyManagerProvider),
 	fx.Provide(AuthorizationInterceptorProvider),
+	fx.Provide(AuditInterceptorProvider),
 	fx.Provide(NamespaceCheckerProvider),
 	fx.Provide(func(so GrpcServerOptions) *grpc.Server { return grpc.NewServer(so.Options...) }),
 	fx.Provide(HandlerProvider),
@@ -171,15 +175,30 @@ type GrpcServerOptions struct {
 	UnaryInterceptors []grpc.UnaryServerInterceptor
 }
 
+func APIKeyManagerProvider(
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	timeSource clock.TimeSource,
+) apikey.Manager {
+	return apikey.NewManager(clusterMetadataManager, timeSource)
+}
+
+// AuthorizationInterceptorProvider creates the authorization interceptor. When API keys are enabled, calls to
+// the frontend with an API key are mapped to the claims of the key and all other calls to the configured claim
+// mapper (see apikey.NewClaimMapper). The internal-frontend doesn't accept API keys.
 func AuthorizationInterceptorProvider(
 	cfg *config.Config,
+	serviceName primitives.ServiceName,
 	logger log.Logger,
 	namespaceChecker authorization.NamespaceChecker,
 	metricsHandler metrics.Handler,
 	authorizer authorization.Authorizer,
 	claimMapper authorization.ClaimMapper,
 	audienceGetter authorization.JWTAudienceMapper,
+	apiKeyManager apikey.Manager,
 ) *authorization.Interceptor {
+	if serviceName == primitives.FrontendService && cfg.Global.Authorization.APIKeys.Enabled {
+		claimMapper = apikey.NewClaimMapper(apiKeyManager, claimMapper)
+	}
 	return authorization.NewInterceptor(
 		claimMapper,
 		authorizer,
@@ -192,6 +211,28 @@ func AuthorizationInterceptorProvider(
 	)
 }
 
+// AuditInterceptorProvider creates the audit interceptor from the global audit config. Calls to the internal
+// frontend are made by other services and workers of the cluster and are not audited.
+func AuditInterceptorProvider(
+	cfg *config.Config,
+	serviceName primitives.ServiceName,
+	logger log.Logger,
+	timeSource clock.TimeSource,
+	lc fx.Lifecycle,
+) (*audit.Interceptor, error) {
+	var sinks []audit.Sink
+	if serviceName == primitives.FrontendService {
+		var err error
+		sinks, err = audit.NewSinksFromConfig(&cfg.Global.Audit, logger)
+		if err != nil {
+			return nil, err
+		}
+	}
+	auditInterceptor := audit.NewInterceptor(sinks, cfg.Global.Audit.IncludeRequest, logger, timeSource)
+	lc.Append(fx.StopHook(auditInterceptor.Close))
+	return auditInterceptor, nil
+}
+
 func NamespaceCheckerProvider(registry namespace.Registry) authorization.NamespaceChecker {
 	return &namespaceChecker{r: registry}
 }
@@ -224,6 +265,7 @@ func GrpcServerOptionsProvider(
 	sdkVersionInterceptor *interceptor.SDKVersionInterceptor,
 	callerInfoInterceptor *interceptor.CallerInfoInterceptor,
 	authInterceptor *authorization.Interceptor,
+	auditInterceptor *audit.Interceptor,
 	maskInternalErrorDetailsInterceptor *interceptor.MaskInternalErrorDetailsInterceptor,
 	utf8Validator *utf8validator.Validator,
 	customInterceptors []grpc.UnaryServerInterceptor,
@@ -265,6 +307,8 @@ func GrpcServerOptionsProvider(
 		namespaceLogInterceptor.Intercept, // TODO: Deprecate this with a outer custom interceptor
 		grpc.UnaryServerInterceptor(traceInterceptor),
 		metrics.NewServerMetricsContextInjectorInterceptor(),
+		// Audit interceptor must be before the authorization interceptor to record its decision
+		auditInterceptor.Intercept,
 		authInterceptor.Intercept,
 		redirectionInterceptor.Intercept,
 		telemetryInterceptor.UnaryIntercept,
@@ -602,12 +646,14 @@ func AdminHandlerProvider(
 	namespaceRegistry namespace.Registry,
 	saProvider searchattribute.Provider,
 	saManager searchattribute.Manager,
+	apiKeyManager apikey.Manager,
 	clusterMetadata cluster.Metadata,
 	healthServer *health.Server,
 	eventSerializer serialization.Serializer,
 	timeSource clock.TimeSource,
 	taskCategoryRegistry tasks.TaskCategoryRegistry,
 	matchingClient resource.MatchingClient,
+	nexusEndpointClient *NexusEndpointClient,
 ) *AdminHandler {
 	args := NewAdminHandlerArgs{
 		persistenceConfig,
@@ -631,12 +677,14 @@ func AdminHandlerProvider(
 		namespaceRegistry,
 		saProvider,
 		saManager,
+		apiKeyManager,
 		clusterMetadata,
 		healthServer,
 		eventSerializer,
 		timeSource,
 		taskCategoryRegistry,
 		matchingClient,
+		nexusEndpointClient,
 	}
 	return NewAdminHandler(args)
 }
@@ -675,6 +723,8 @@ func OperatorHandlerProvider(
 }
 
 func HandlerProvider(
+	cfg *config.Config,
+	serviceName primitives.ServiceName,
 	dcRedirectionPolicy config.DCRedirectionPolicy,
 	serviceConfig *Config,
 	versionChecker *VersionChecker,
@@ -701,7 +751,14 @@ func HandlerProvider(
 	membershipMonitor membership.Monitor,
 	healthInterceptor *interceptor.HealthInterceptor,
 	scheduleSpecBuilder *scheduler.SpecBuilder,
+	authorizer authorization.Authorizer,
+	claimMapper authorization.ClaimMapper,
 ) Handler {
+	// Field redaction depends on the roles of the caller, so it is only enabled if calls are authorized based on
+	// claims. API keys are mapped to claims even if no claim mapper is configured.
+	apiKeysEnabled := serviceName == primitives.FrontendService && cfg.Global.Authorization.APIKeys.Enabled
+	fieldRedactionEnabled := authorizer != nil && !authorization.IsNoopAuthorizer(authorizer) &&
+		claimMapper != nil && (!authorization.IsNoopClaimMapper(claimMapper) || apiKeysEnabled)
 	wfHandler := NewWorkflowHandler(
 		serviceConfig,
 		namespaceReplicationQueue,
@@ -725,6 +782,7 @@ func HandlerProvider(
 		membershipMonitor,
 		healthInterceptor,
 		scheduleSpecBuilder,
+		fieldRedactionEnabled,
 	)
 	return wfHandler
 }
@@ -739,6 +797,7 @@ func RegisterNexusHTTPHandler(
 	namespaceRegistry namespace.Registry,
 	endpointRegistry nexus.EndpointRegistry,
 	authInterceptor *authorization.Interceptor,
+	auditInterceptor *audit.Interceptor,
 	telemetryInterceptor *interceptor.TelemetryInterceptor,
 	redirectionInterceptor *interceptor.Redirection,
 	namespaceRateLimiterInterceptor *interceptor.NamespaceRateLimitInterceptor,
@@ -757,6 +816,7 @@ func RegisterNexusHTTPHandler(
 		namespaceRegistry,
 		endpointRegistry,
 		authInterceptor,
+		auditInterceptor,
 		telemetryInterceptor,
 		redirectionInterceptor,
 		namespaceValidatorInterceptor,
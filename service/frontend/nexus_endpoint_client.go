@@ -26,6 +26,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net/url"
 	"regexp"
 
@@ -33,8 +34,10 @@ import (
 	nexuspb "go.temporal.io/api/nexus/v1"
 	"go.temporal.io/api/operatorservice/v1"
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
 	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -147,6 +150,21 @@ func (c *NexusEndpointClient) Update(
 		return nil, err
 	}
 
+	// Credentials, limits and access policies aren't part of the public API, keep the ones set via UpdateAuth,
+	// UpdateLimits and UpdateAccessPolicy.
+	// If the endpoint is modified concurrently, the version check in matching fails the update.
+	previous, err := c.persistence.GetNexusEndpoint(ctx, &p.GetNexusEndpointRequest{
+		ID: request.Id,
+	})
+	if err != nil {
+		return nil, c.transformServiceError(err, fmt.Sprintf("error looking up Nexus endpoint with ID `%v`", request.Id))
+	}
+	if external := spec.GetTarget().GetExternal(); external != nil {
+		external.Auth = previous.GetEndpoint().GetSpec().GetTarget().GetExternal().GetAuth()
+	}
+	spec.Limits = previous.GetEndpoint().GetSpec().GetLimits()
+	spec.AccessPolicy = previous.GetEndpoint().GetSpec().GetAccessPolicy()
+
 	resp, err := c.matchingClient.UpdateNexusEndpoint(ctx, &matchingservice.UpdateNexusEndpointRequest{
 		Id:      request.Id,
 		Version: request.Version,
@@ -166,6 +184,115 @@ func (c *NexusEndpointClient) Update(
 	}, nil
 }
 
+// UpdateAuth sets the credentials presented to an external endpoint, leaving the rest of the endpoint spec as is.
+func (c *NexusEndpointClient) UpdateAuth(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointAuthRequest,
+) (*adminservice.UpdateNexusEndpointAuthResponse, error) {
+	if err := validateUpdateAuthRequest(request); err != nil {
+		return nil, err
+	}
+
+	entry, err := c.persistence.GetNexusEndpoint(ctx, &p.GetNexusEndpointRequest{
+		ID: request.Id,
+	})
+	if err != nil {
+		return nil, c.transformServiceError(err, fmt.Sprintf("error looking up Nexus endpoint with ID `%v`", request.Id))
+	}
+
+	spec := common.CloneProto(entry.GetEndpoint().GetSpec())
+	external := spec.GetTarget().GetExternal()
+	if external == nil {
+		return nil, serviceerror.NewFailedPrecondition("credentials can only be set on endpoints with an external target")
+	}
+	if request.GetAuth().GetMutualTls() != nil {
+		if u, err := url.Parse(external.GetUrl()); err != nil || u.Scheme != "https" {
+			return nil, serviceerror.NewFailedPrecondition("mutual TLS requires an https target URL")
+		}
+	}
+	external.Auth = request.GetAuth()
+
+	resp, err := c.matchingClient.UpdateNexusEndpoint(ctx, &matchingservice.UpdateNexusEndpointRequest{
+		Id:      request.Id,
+		Version: request.Version,
+		Spec:    spec,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.UpdateNexusEndpointAuthResponse{
+		Entry: resp.Entry,
+	}, nil
+}
+
+// UpdateLimits sets the limits enforced on requests to an endpoint, leaving the rest of the endpoint spec as is.
+func (c *NexusEndpointClient) UpdateLimits(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointLimitsRequest,
+) (*adminservice.UpdateNexusEndpointLimitsResponse, error) {
+	if err := validateUpdateLimitsRequest(request); err != nil {
+		return nil, err
+	}
+
+	entry, err := c.persistence.GetNexusEndpoint(ctx, &p.GetNexusEndpointRequest{
+		ID: request.Id,
+	})
+	if err != nil {
+		return nil, c.transformServiceError(err, fmt.Sprintf("error looking up Nexus endpoint with ID `%v`", request.Id))
+	}
+
+	spec := common.CloneProto(entry.GetEndpoint().GetSpec())
+	spec.Limits = request.GetLimits()
+
+	resp, err := c.matchingClient.UpdateNexusEndpoint(ctx, &matchingservice.UpdateNexusEndpointRequest{
+		Id:      request.Id,
+		Version: request.Version,
+		Spec:    spec,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.UpdateNexusEndpointLimitsResponse{
+		Entry: resp.Entry,
+	}, nil
+}
+
+// UpdateAccessPolicy sets the policy restricting which caller namespaces may access an endpoint, leaving the rest of the
+// endpoint spec as is.
+func (c *NexusEndpointClient) UpdateAccessPolicy(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointAccessPolicyRequest,
+) (*adminservice.UpdateNexusEndpointAccessPolicyResponse, error) {
+	if err := validateUpdateAccessPolicyRequest(request); err != nil {
+		return nil, err
+	}
+
+	entry, err := c.persistence.GetNexusEndpoint(ctx, &p.GetNexusEndpointRequest{
+		ID: request.Id,
+	})
+	if err != nil {
+		return nil, c.transformServiceError(err, fmt.Sprintf("error looking up Nexus endpoint with ID `%v`", request.Id))
+	}
+
+	spec := common.CloneProto(entry.GetEndpoint().GetSpec())
+	spec.AccessPolicy = request.GetAccessPolicy()
+
+	resp, err := c.matchingClient.UpdateNexusEndpoint(ctx, &matchingservice.UpdateNexusEndpointRequest{
+		Id:      request.Id,
+		Version: request.Version,
+		Spec:    spec,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.UpdateNexusEndpointAccessPolicyResponse{
+		Entry: resp.Entry,
+	}, nil
+}
+
 func (c *NexusEndpointClient) Delete(
 	ctx context.Context,
 	request *operatorservice.DeleteNexusEndpointRequest,
@@ -415,6 +542,58 @@ func validateDeleteRequest(request *operatorservice.DeleteNexusEndpointRequest)
 	return issues.GetError()
 }
 
+func validateUpdateAuthRequest(request *adminservice.UpdateNexusEndpointAuthRequest) error {
+	issues := getEndpointIDIssues(request.GetId())
+
+	if request.GetVersion() <= 0 {
+		issues.Append("endpoint version is non-positive")
+	}
+
+	auth := request.GetAuth()
+	if bearerToken := auth.GetBearerToken(); bearerToken != nil && bearerToken.GetTokenSecretRef() == "" {
+		issues.Append("bearer token secret reference not set")
+	}
+	if mtls := auth.GetMutualTls(); mtls != nil && (mtls.GetClientCertSecretRef() == "" || mtls.GetClientKeySecretRef() == "") {
+		issues.Append("mutual TLS client certificate and key secret references must both be set")
+	}
+	if hmacSigning := auth.GetHmacSigning(); hmacSigning != nil && hmacSigning.GetKeySecretRef() == "" {
+		issues.Append("HMAC signing key secret reference not set")
+	}
+
+	return issues.GetError()
+}
+
+func validateUpdateLimitsRequest(request *adminservice.UpdateNexusEndpointLimitsRequest) error {
+	issues := getEndpointIDIssues(request.GetId())
+
+	if request.GetVersion() <= 0 {
+		issues.Append("endpoint version is non-positive")
+	}
+
+	limits := request.GetLimits()
+	if limits.GetMaxConcurrentOperations() < 0 {
+		issues.Append("max concurrent operations is negative")
+	}
+	if limits.GetMaxStartRps() < 0 || math.IsNaN(limits.GetMaxStartRps()) || math.IsInf(limits.GetMaxStartRps(), 0) {
+		issues.Append("max start RPS must be a non-negative finite number")
+	}
+
+	return issues.GetError()
+}
+
+func validateUpdateAccessPolicyRequest(request *adminservice.UpdateNexusEndpointAccessPolicyRequest) error {
+	issues := getEndpointIDIssues(request.GetId())
+
+	if request.GetVersion() <= 0 {
+		issues.Append("endpoint version is non-positive")
+	}
+	if err := cnexus.ValidateEndpointAccessPolicy(request.GetAccessPolicy()); err != nil {
+		issues.Append(err.Error())
+	}
+
+	return issues.GetError()
+}
+
 func validateGetRequest(request *operatorservice.GetNexusEndpointRequest) error {
 	issues := getEndpointIDIssues(request.GetId())
 	return issues.GetError()
@@ -36,6 +36,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/audit"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -56,11 +57,13 @@ import (
 // Small wrapper that does some pre-processing before handing requests over to the Nexus SDK's HTTP handler.
 type NexusHTTPHandler struct {
 	logger                               log.Logger
+	metricsHandler                       metrics.Handler
 	nexusHandler                         http.Handler
 	enpointRegistry                      commonnexus.EndpointRegistry
 	namespaceRegistry                    namespace.Registry
 	preprocessErrorCounter               metrics.CounterFunc
 	auth                                 *authorization.Interceptor
+	auditInterceptor                     *audit.Interceptor
 	namespaceValidationInterceptor       *interceptor.NamespaceValidatorInterceptor
 	namespaceRateLimitInterceptor        *interceptor.NamespaceRateLimitInterceptor
 	namespaceConcurrencyLimitInterceptor *interceptor.ConcurrentRequestLimitInterceptor
@@ -77,6 +80,7 @@ func NewNexusHTTPHandler(
 	namespaceRegistry namespace.Registry,
 	endpointRegistry commonnexus.EndpointRegistry,
 	authInterceptor *authorization.Interceptor,
+	auditInterceptor *audit.Interceptor,
 	telemetryInterceptor *interceptor.TelemetryInterceptor,
 	redirectionInterceptor *interceptor.Redirection,
 	namespaceValidationInterceptor *interceptor.NamespaceValidatorInterceptor,
@@ -87,9 +91,11 @@ func NewNexusHTTPHandler(
 ) *NexusHTTPHandler {
 	return &NexusHTTPHandler{
 		logger:                               logger,
+		metricsHandler:                       metricsHandler,
 		enpointRegistry:                      endpointRegistry,
 		namespaceRegistry:                    namespaceRegistry,
 		auth:                                 authInterceptor,
+		auditInterceptor:                     auditInterceptor,
 		namespaceValidationInterceptor:       namespaceValidationInterceptor,
 		namespaceRateLimitInterceptor:        namespaceRateLimitInterceptor,
 		namespaceConcurrencyLimitInterceptor: namespaceConcurrencyLimitIntercptor,
@@ -236,6 +242,11 @@ func (h *NexusHTTPHandler) dispatchNexusTaskByEndpoint(w http.ResponseWriter, r
 		return
 	}
 
+	if !h.checkEndpointAccess(w, r, nc, endpointEntry) {
+		// checkEndpointAccess already writes the failure response.
+		return
+	}
+
 	u, err := mux.CurrentRoute(r).URL("endpoint", endpointIDEscaped)
 	if err != nil {
 		h.logger.Error("invalid URL", tag.Error(err))
@@ -246,6 +257,55 @@ func (h *NexusHTTPHandler) dispatchNexusTaskByEndpoint(w http.ResponseWriter, r
 	h.serveResolvedURL(w, r, u, nc)
 }
 
+// checkEndpointAccess enforces the endpoint's caller namespace access policy. The caller is identified by the
+// commonnexus.CallerNamespaceIDHeader set by the history service; requests without it are only allowed to endpoints
+// that have no access policy. The header is stripped from untrusted requests in parseTlsAndAuthInfo. Denials are
+// written to the audit log.
+func (h *NexusHTTPHandler) checkEndpointAccess(w http.ResponseWriter, r *http.Request, nc *nexusContext, entry *persistencespb.NexusEndpointEntry) bool {
+	policy := entry.GetEndpoint().GetSpec().GetAccessPolicy()
+	if len(policy.GetAllow()) == 0 && len(policy.GetDeny()) == 0 {
+		return true
+	}
+	var caller *namespace.Namespace
+	if callerID := r.Header.Get(commonnexus.CallerNamespaceIDHeader); callerID != "" {
+		ns, err := h.namespaceRegistry.GetNamespaceByID(namespace.ID(callerID))
+		if err != nil && !errors.As(err, new(*serviceerror.NamespaceNotFound)) {
+			h.logger.Error("failed to get caller namespace", tag.WorkflowNamespaceID(callerID), tag.Error(err))
+			h.writeNexusFailure(w, http.StatusInternalServerError, &nexus.Failure{Message: "internal error"})
+			return false
+		}
+		caller = ns
+	}
+	if err := commonnexus.CheckEndpointAccess(policy, caller); err != nil {
+		callerName := "unknown"
+		if caller != nil {
+			callerName = caller.Name().String()
+		}
+		h.logger.Warn(
+			"Nexus endpoint access denied",
+			tag.WorkflowNamespace(callerName),
+			tag.NewStringTag("endpoint", entry.GetEndpoint().GetSpec().GetName()),
+			tag.Error(err),
+		)
+		metrics.NexusEndpointAccessDenied.With(h.metricsHandler).Record(
+			1,
+			metrics.NamespaceTag(callerName),
+			metrics.DestinationTag(entry.GetEndpoint().GetSpec().GetName()),
+		)
+		h.auditInterceptor.WriteDecision(&audit.Record{
+			API:       nc.apiName,
+			Namespace: callerName,
+			Target:    &audit.Target{NexusEndpoint: entry.GetEndpoint().GetSpec().GetName()},
+			Decision:  audit.DecisionDeny,
+			Reason:    err.Error(),
+			Code:      codes.PermissionDenied.String(),
+		}, nc.claims)
+		h.writeNexusFailure(w, http.StatusForbidden, &nexus.Failure{Message: "caller namespace is not allowed to access endpoint"})
+		return false
+	}
+	return true
+}
+
 func (h *NexusHTTPHandler) baseNexusContext(apiName string) *nexusContext {
 	return &nexusContext{
 		namespaceValidationInterceptor:       h.namespaceValidationInterceptor,
@@ -319,6 +379,11 @@ func (h *NexusHTTPHandler) parseTlsAndAuthInfo(r *http.Request, nc *nexusContext
 		// Make the auth info and claims available on the context.
 		r = r.WithContext(h.auth.EnhanceContext(r.Context(), authInfo, nc.claims))
 	}
+	// The caller namespace header is only trusted on connections with system admin claims, i.e. internal connections
+	// of the cluster. Strip it from all other requests so external callers cannot impersonate a namespace.
+	if nc.claims == nil || nc.claims.System&authorization.RoleAdmin == 0 {
+		r.Header.Del(commonnexus.CallerNamespaceIDHeader)
+	}
 
 	return r, nil
 }
@@ -0,0 +1,87 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	commonnexus "go.temporal.io/server/common/nexus"
+)
+
+type headerClaimMapper struct{}
+
+// GetClaims grants system admin to requests with the "system" auth header.
+func (headerClaimMapper) GetClaims(authInfo *authorization.AuthInfo) (*authorization.Claims, error) {
+	if authInfo.AuthToken == "system" {
+		return &authorization.Claims{System: authorization.RoleAdmin}, nil
+	}
+	return &authorization.Claims{Namespaces: map[string]authorization.Role{"ns": authorization.RoleWriter}}, nil
+}
+
+func (headerClaimMapper) AuthInfoRequired() bool {
+	return false
+}
+
+func TestNexusHTTPHandler_CallerNamespaceHeaderTrust(t *testing.T) {
+	h := &NexusHTTPHandler{
+		auth: authorization.NewInterceptor(
+			headerClaimMapper{},
+			mockAuthorizer{},
+			metrics.NoopMetricsHandler,
+			log.NewNoopLogger(),
+			mockNamespaceChecker("ns"),
+			nil,
+			"",
+			"",
+		),
+	}
+	cases := []struct {
+		name      string
+		authToken string
+		trusted   bool
+	}{
+		{name: "system", authToken: "system", trusted: true},
+		{name: "namespace", authToken: "namespace", trusted: false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := http.NewRequest(http.MethodPost, "http://localhost/nexus/endpoints/id/services/service/operation", http.NoBody)
+			require.NoError(t, err)
+			r.Header.Set("authorization", tc.authToken)
+			r.Header.Set(commonnexus.CallerNamespaceIDHeader, "caller-ns-id")
+
+			r, err = h.parseTlsAndAuthInfo(r, &nexusContext{})
+			require.NoError(t, err)
+			if tc.trusted {
+				require.Equal(t, "caller-ns-id", r.Header.Get(commonnexus.CallerNamespaceIDHeader))
+			} else {
+				require.Empty(t, r.Header.Get(commonnexus.CallerNamespaceIDHeader))
+			}
+		})
+	}
+}
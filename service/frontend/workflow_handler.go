@@ -31,6 +31,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -50,11 +51,13 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	schedspb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
@@ -77,6 +80,7 @@ import (
 	"go.temporal.io/server/common/persistence/visibility/store"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/redaction"
 	"go.temporal.io/server/common/retrypolicy"
 	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/rpc/interceptor"
@@ -87,6 +91,7 @@ import (
 	"go.temporal.io/server/common/tqid"
 	"go.temporal.io/server/common/utf8validator"
 	"go.temporal.io/server/common/util"
+	"go.temporal.io/server/components/callbacks"
 	"go.temporal.io/server/service/worker/batcher"
 	"go.temporal.io/server/service/worker/scheduler"
 	"google.golang.org/grpc/codes"
@@ -142,6 +147,9 @@ type (
 		healthInterceptor               *interceptor.HealthInterceptor
 		scheduleSpecBuilder             *scheduler.SpecBuilder
 		outstandingPollers              collection.SyncMap[string, collection.SyncMap[string, context.CancelFunc]]
+		// fieldRedactionEnabled is true if calls are authorized based on the claims of the caller, which
+		// determine whether the caller sees redacted values.
+		fieldRedactionEnabled bool
 	}
 )
 
@@ -169,6 +177,7 @@ func NewWorkflowHandler(
 	membershipMonitor membership.Monitor,
 	healthInterceptor *interceptor.HealthInterceptor,
 	scheduleSpecBuilder *scheduler.SpecBuilder,
+	fieldRedactionEnabled bool,
 ) *WorkflowHandler {
 
 	handler := &WorkflowHandler{
@@ -213,13 +222,14 @@ func NewWorkflowHandler(
 			),
 			config.SuppressErrorSetSystemSearchAttribute,
 		),
-		archivalMetadata:    archivalMetadata,
-		healthServer:        healthServer,
-		overrides:           NewOverrides(),
-		membershipMonitor:   membershipMonitor,
-		healthInterceptor:   healthInterceptor,
-		scheduleSpecBuilder: scheduleSpecBuilder,
-		outstandingPollers:  collection.NewSyncMap[string, collection.SyncMap[string, context.CancelFunc]](),
+		archivalMetadata:      archivalMetadata,
+		healthServer:          healthServer,
+		overrides:             NewOverrides(),
+		membershipMonitor:     membershipMonitor,
+		healthInterceptor:     healthInterceptor,
+		scheduleSpecBuilder:   scheduleSpecBuilder,
+		outstandingPollers:    collection.NewSyncMap[string, collection.SyncMap[string, context.CancelFunc]](),
+		fieldRedactionEnabled: fieldRedactionEnabled,
 	}
 
 	return handler
@@ -720,7 +730,11 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 		enableArchivalRead := wh.archivalMetadata.GetHistoryConfig().ReadEnabled()
 		historyArchived := wh.historyArchived(ctx, request, namespaceID)
 		if enableArchivalRead && historyArchived {
-			return wh.getArchivedHistory(ctx, request, namespaceID)
+			response, err := wh.getArchivedHistory(ctx, request, namespaceID)
+			if err != nil {
+				return nil, err
+			}
+			return wh.redactHistory(ctx, request.GetNamespace(), response)
 		}
 	}
 
@@ -732,7 +746,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 	if err != nil {
 		return nil, err
 	}
-	return response.Response, nil
+	return wh.redactHistory(ctx, request.GetNamespace(), response.Response)
 }
 
 // GetWorkflowExecutionHistory returns the history of specified workflow execution.  It fails with 'EntityNotExistError' if specified workflow
@@ -774,6 +788,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistoryReverse(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
+	wh.redact(ctx, request.GetNamespace(), response.Response)
 	return response.Response, nil
 }
 
@@ -2192,10 +2207,12 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context, reque
 		return nil, err
 	}
 
-	return &workflowservice.ListOpenWorkflowExecutionsResponse{
+	resp := &workflowservice.ListOpenWorkflowExecutionsResponse{
 		Executions:    persistenceResp.Executions,
 		NextPageToken: persistenceResp.NextPageToken,
-	}, nil
+	}
+	wh.redact(ctx, request.GetNamespace(), resp)
+	return resp, nil
 }
 
 // ListClosedWorkflowExecutions is a visibility API to list the closed executions in a specific namespace.
@@ -2307,10 +2324,12 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context, req
 		return nil, err
 	}
 
-	return &workflowservice.ListClosedWorkflowExecutionsResponse{
+	resp := &workflowservice.ListClosedWorkflowExecutionsResponse{
 		Executions:    persistenceResp.Executions,
 		NextPageToken: persistenceResp.NextPageToken,
-	}, nil
+	}
+	wh.redact(ctx, request.GetNamespace(), resp)
+	return resp, nil
 }
 
 // ListWorkflowExecutions is a visibility API to list workflow executions in a specific namespace.
@@ -2344,10 +2363,12 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(ctx context.Context, request *
 		return nil, err
 	}
 
-	return &workflowservice.ListWorkflowExecutionsResponse{
+	resp := &workflowservice.ListWorkflowExecutionsResponse{
 		Executions:    persistenceResp.Executions,
 		NextPageToken: persistenceResp.NextPageToken,
-	}, nil
+	}
+	wh.redact(ctx, request.GetNamespace(), resp)
+	return resp, nil
 }
 
 // ListArchivedWorkflowExecutions is a visibility API to list archived workflow executions in a specific namespace.
@@ -2420,10 +2441,12 @@ func (wh *WorkflowHandler) ListArchivedWorkflowExecutions(ctx context.Context, r
 		}
 	}
 
-	return &workflowservice.ListArchivedWorkflowExecutionsResponse{
+	resp := &workflowservice.ListArchivedWorkflowExecutionsResponse{
 		Executions:    archiverResponse.Executions,
 		NextPageToken: archiverResponse.NextPageToken,
-	}, nil
+	}
+	wh.redact(ctx, request.GetNamespace(), resp)
+	return resp, nil
 }
 
 // ScanWorkflowExecutions is a visibility API to list large amount of workflow executions in a specific namespace without order.
@@ -2461,6 +2484,7 @@ func (wh *WorkflowHandler) ScanWorkflowExecutions(ctx context.Context, request *
 		Executions:    persistenceResp.Executions,
 		NextPageToken: persistenceResp.NextPageToken,
 	}
+	wh.redact(ctx, request.GetNamespace(), resp)
 	return resp, nil
 }
 
@@ -2713,7 +2737,7 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(ctx context.Context, reques
 		}
 	}
 
-	return &workflowservice.DescribeWorkflowExecutionResponse{
+	resp := &workflowservice.DescribeWorkflowExecutionResponse{
 		ExecutionConfig:        response.GetExecutionConfig(),
 		WorkflowExecutionInfo:  response.GetWorkflowExecutionInfo(),
 		PendingActivities:      response.GetPendingActivities(),
@@ -2721,7 +2745,9 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(ctx context.Context, reques
 		PendingWorkflowTask:    response.GetPendingWorkflowTask(),
 		Callbacks:              response.GetCallbacks(),
 		PendingNexusOperations: response.GetPendingNexusOperations(),
-	}, nil
+	}
+	wh.redact(ctx, request.GetNamespace(), resp)
+	return resp, nil
 }
 
 // DescribeTaskQueue returns information about the target taskqueue, right now this API returns the
@@ -4580,23 +4606,13 @@ func (wh *WorkflowHandler) validateWorkflowCompletionCallbacks(
 			if err := wh.validateCallbackURL(ns, cb.Nexus.GetUrl()); err != nil {
 				return err
 			}
-
-			headerSize := 0
-			for k, v := range cb.Nexus.GetHeader() {
-				headerSize += len(k) + len(v)
-			}
-			if headerSize > wh.config.CallbackHeaderMaxSize(ns.String()) {
-				return status.Error(
-					codes.InvalidArgument,
-					fmt.Sprintf(
-						"invalid header: header size longer than max allowed size of %d",
-						wh.config.CallbackHeaderMaxSize(ns.String()),
-					),
-				)
+			if err := wh.validateCallbackHeader(ns, cb.Nexus.GetHeader()); err != nil {
+				return err
 			}
 		case *commonpb.Callback_Internal_:
-			// TODO(Tianyu): For now, there is nothing to validate given that this is an internal field.
-			continue
+			if err := wh.validateInternalCallback(ns, cb.Internal); err != nil {
+				return err
+			}
 		default:
 			return status.Error(codes.Unimplemented, fmt.Sprintf("unknown callback variant: %T", cb))
 		}
@@ -4604,6 +4620,57 @@ func (wh *WorkflowHandler) validateWorkflowCompletionCallbacks(
 	return nil
 }
 
+// validateInternalCallback validates callbacks attached as serialized persistence callbacks. Variants that call out
+// to user provided addresses are subject to the same restrictions as Nexus callbacks.
+func (wh *WorkflowHandler) validateInternalCallback(ns namespace.Name, internal *commonpb.Callback_Internal) error {
+	cb := &persistencespb.Callback{}
+	if err := proto.Unmarshal(internal.GetData(), cb); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid internal callback: %v", err)
+	}
+	switch variant := cb.GetVariant().(type) {
+	case *persistencespb.Callback_Nexus_:
+		if err := wh.validateCallbackURL(ns, variant.Nexus.GetUrl()); err != nil {
+			return err
+		}
+		return wh.validateCallbackHeader(ns, variant.Nexus.GetHeader())
+	case *persistencespb.Callback_Webhook_:
+		if err := wh.validateCallbackURL(ns, variant.Webhook.GetUrl()); err != nil {
+			return err
+		}
+		if err := wh.validateCallbackHeader(ns, variant.Webhook.GetHeader()); err != nil {
+			return err
+		}
+		if err := callbacks.ValidateWebhookBodyTemplate(variant.Webhook.GetBodyTemplate()); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid body template: %v", err)
+		}
+		return nil
+	case *persistencespb.Callback_Grpc:
+		return wh.validateGRPCCallback(ns, variant.Grpc)
+	case *persistencespb.Callback_Hsm:
+		// Attached by server components, e.g. the scheduler, and delivered in-process.
+		return nil
+	default:
+		return status.Error(codes.Unimplemented, fmt.Sprintf("unknown internal callback variant: %T", variant))
+	}
+}
+
+func (wh *WorkflowHandler) validateCallbackHeader(ns namespace.Name, header map[string]string) error {
+	headerSize := 0
+	for k, v := range header {
+		headerSize += len(k) + len(v)
+	}
+	if headerSize > wh.config.CallbackHeaderMaxSize(ns.String()) {
+		return status.Error(
+			codes.InvalidArgument,
+			fmt.Sprintf(
+				"invalid header: header size longer than max allowed size of %d",
+				wh.config.CallbackHeaderMaxSize(ns.String()),
+			),
+		)
+	}
+	return nil
+}
+
 func (wh *WorkflowHandler) validateCallbackURL(ns namespace.Name, rawURL string) error {
 	if len(rawURL) > wh.config.CallbackURLMaxLength(ns.String()) {
 		return status.Errorf(codes.InvalidArgument, "invalid url: url length longer than max length allowed of %d", wh.config.CallbackURLMaxLength(ns.String()))
@@ -4616,15 +4683,31 @@ func (wh *WorkflowHandler) validateCallbackURL(ns namespace.Name, rawURL string)
 	if !(u.Scheme == "http" || u.Scheme == "https") {
 		return status.Errorf(codes.InvalidArgument, "invalid url: unknown scheme: %v", u)
 	}
-	for _, cfg := range wh.config.CallbackEndpointConfigs(ns.String()) {
-		if cfg.Regexp.MatchString(u.Host) {
-			if u.Scheme == "http" && !cfg.AllowInsecure {
-				return status.Errorf(codes.InvalidArgument, "invalid url: callback address does not allow insecure connections: %v", u)
-			}
-			return nil
-		}
+	switch callbacks.CheckAddress(wh.config.CallbackEndpointConfigs(ns.String()), u.Host, u.Scheme == "https") {
+	case nil:
+		return nil
+	case callbacks.ErrInsecureAddress:
+		return status.Errorf(codes.InvalidArgument, "invalid url: callback address does not allow insecure connections: %v", u)
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid url: url does not match any configured callback address: %v", u)
+	}
+}
+
+func (wh *WorkflowHandler) validateGRPCCallback(ns namespace.Name, cb *persistencespb.Callback_GRPC) error {
+	if cb.GetService() == "" || cb.GetMethod() == "" {
+		return status.Error(codes.InvalidArgument, "invalid gRPC callback: service and method are required")
+	}
+	if len(cb.GetAddress()) > wh.config.CallbackURLMaxLength(ns.String()) {
+		return status.Errorf(codes.InvalidArgument, "invalid address: address length longer than max length allowed of %d", wh.config.CallbackURLMaxLength(ns.String()))
+	}
+	switch callbacks.CheckGRPCAddress(wh.config.CallbackEndpointConfigs(ns.String()), cb) {
+	case nil:
+		return wh.validateCallbackHeader(ns, cb.GetHeader())
+	case callbacks.ErrInsecureAddress:
+		return status.Errorf(codes.InvalidArgument, "invalid address: callback address does not allow insecure connections: %v", cb.GetAddress())
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid address: address does not match any configured callback address: %v", cb.GetAddress())
 	}
-	return status.Errorf(codes.InvalidArgument, "invalid url: url does not match any configured callback address: %v", u)
 }
 
 type buildIdAndFlag interface {
@@ -5107,3 +5190,74 @@ func (wh *WorkflowHandler) UpdateActivityOptionsById(
 		ActivityOptions: response.ActivityOptions,
 	}, nil
 }
+
+// redact applies the field redaction rules of the namespace for the caller of ctx to msg.
+func (wh *WorkflowHandler) redact(ctx context.Context, namespaceName string, msg proto.Message) {
+	if redactor := wh.fieldRedactor(ctx, namespaceName); redactor != nil {
+		redactor.Redact(msg)
+	}
+}
+
+// redactHistory applies the field redaction rules of the namespace for the caller of ctx to response. Raw history
+// can't be redacted, so it is deserialized into the history events first.
+func (wh *WorkflowHandler) redactHistory(
+	ctx context.Context,
+	namespaceName string,
+	response *workflowservice.GetWorkflowExecutionHistoryResponse,
+) (*workflowservice.GetWorkflowExecutionHistoryResponse, error) {
+	redactor := wh.fieldRedactor(ctx, namespaceName)
+	if redactor == nil {
+		return response, nil
+	}
+	if len(response.GetRawHistory()) > 0 {
+		history := response.GetHistory()
+		if history == nil {
+			history = &historypb.History{}
+		}
+		for _, blob := range response.GetRawHistory() {
+			events, err := wh.payloadSerializer.DeserializeEvents(blob)
+			if err != nil {
+				return nil, err
+			}
+			history.Events = append(history.Events, events...)
+		}
+		response.History = history
+		response.RawHistory = nil
+	}
+	redactor.Redact(response)
+	return response, nil
+}
+
+// fieldRedactor returns the redactor for the caller of ctx in the namespace, or nil if the caller sees
+// unredacted values. Nothing is redacted if no authorizer or claim mapper is configured. Otherwise, callers
+// without claims, e.g. without a token, are redacted. Workers, which need unredacted payloads to replay
+// workflows, and internal callers with system worker or admin roles are always exempt.
+func (wh *WorkflowHandler) fieldRedactor(ctx context.Context, namespaceName string) *redaction.Redactor {
+	if !wh.fieldRedactionEnabled {
+		return nil
+	}
+	cfg := wh.config.FieldRedaction(namespaceName)
+	if cfg.IsEmpty() {
+		return nil
+	}
+	if claims, ok := ctx.Value(authorization.MappedClaims).(*authorization.Claims); ok && claims != nil {
+		if claims.Subject != "" && slices.Contains(cfg.ExemptSubjects, claims.Subject) {
+			return nil
+		}
+		alwaysExemptRoles := authorization.RoleWorker | authorization.RoleAdmin
+		if claims.System&alwaysExemptRoles != 0 {
+			return nil
+		}
+		exemptRoles := authorization.RoleWriter | authorization.RoleWorker | authorization.RoleAdmin
+		if len(cfg.ExemptRoles) > 0 {
+			exemptRoles = authorization.RoleWorker
+			for _, role := range cfg.ExemptRoles {
+				exemptRoles |= authorization.ParseRole(role)
+			}
+		}
+		if (claims.System|claims.Namespaces[namespaceName])&exemptRoles != 0 {
+			return nil
+		}
+	}
+	return redaction.NewRedactor(cfg)
+}
@@ -55,6 +55,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	dc "go.temporal.io/server/common/dynamicconfig"
@@ -63,11 +64,13 @@ import (
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/redaction"
 	"go.temporal.io/server/common/resourcetest"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/searchattribute"
@@ -195,6 +198,7 @@ func (s *workflowHandlerSuite) getWorkflowHandler(config *Config) *WorkflowHandl
 		s.mockResource.GetMembershipMonitor(),
 		healthInterceptor,
 		scheduler.NewSpecBuilder(),
+		true,
 	)
 }
 
@@ -1599,6 +1603,160 @@ func (s *workflowHandlerSuite) TestDescribeWorkflowExecution_CompletedStatus() {
 	s.NoError(err)
 }
 
+func (s *workflowHandlerSuite) TestDescribeWorkflowExecution_FieldRedaction() {
+	config := s.newConfig()
+	config.FieldRedaction = dc.GetTypedPropertyFnFilteredByNamespace(redaction.Config{
+		MemoKeys:         []string{"email"},
+		SearchAttributes: []string{"CustomerId"},
+		ExemptSubjects:   []string{"support"},
+	})
+	wh := s.getWorkflowHandler(config)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.testNamespaceID, nil).AnyTimes()
+	s.mockSearchAttributesProvider.EXPECT().GetSearchAttributes(gomock.Any(), false).Return(searchattribute.TestNameTypeMap, nil).AnyTimes()
+	s.mockSearchAttributesMapperProvider.EXPECT().GetMapper(gomock.Any()).Return(nil, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, *historyservice.DescribeWorkflowExecutionRequest, ...grpc.CallOption) (*historyservice.DescribeWorkflowExecutionResponse, error) {
+			return &historyservice.DescribeWorkflowExecutionResponse{
+				WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+					Memo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+						"email": payload.EncodeString("jane@example.org"),
+					}},
+					SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+						"CustomerId": payload.EncodeString("42"),
+					}},
+				},
+			}, nil
+		},
+	).AnyTimes()
+
+	request := &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: s.testNamespace.String(),
+		Execution: &commonpb.WorkflowExecution{WorkflowId: testWorkflowID},
+	}
+	describe := func(claims *authorization.Claims) *workflowpb.WorkflowExecutionInfo {
+		ctx := context.Background()
+		if claims != nil {
+			ctx = context.WithValue(ctx, authorization.MappedClaims, claims)
+		}
+		resp, err := wh.DescribeWorkflowExecution(ctx, request)
+		s.NoError(err)
+		return resp.GetWorkflowExecutionInfo()
+	}
+	assertRedacted := func(info *workflowpb.WorkflowExecutionInfo, redacted bool) {
+		s.T().Helper()
+		var email string
+		s.NoError(payload.Decode(info.GetMemo().GetFields()["email"], &email))
+		if redacted {
+			s.Equal(redaction.RedactedValue, email)
+			s.NotContains(info.GetSearchAttributes().GetIndexedFields(), "CustomerId")
+		} else {
+			s.Equal("jane@example.org", email)
+			s.Contains(info.GetSearchAttributes().GetIndexedFields(), "CustomerId")
+		}
+	}
+
+	assertRedacted(describe(nil), true)
+	assertRedacted(describe(&authorization.Claims{
+		Namespaces: map[string]authorization.Role{s.testNamespace.String(): authorization.RoleReader},
+	}), true)
+	assertRedacted(describe(&authorization.Claims{
+		Namespaces: map[string]authorization.Role{"other": authorization.RoleAdmin},
+	}), true)
+	assertRedacted(describe(&authorization.Claims{
+		Namespaces: map[string]authorization.Role{s.testNamespace.String(): authorization.RoleWriter},
+	}), false)
+	assertRedacted(describe(&authorization.Claims{System: authorization.RoleAdmin}), false)
+	assertRedacted(describe(&authorization.Claims{Subject: "support"}), false)
+}
+
+func (s *workflowHandlerSuite) TestGetWorkflowExecutionHistory_FieldRedaction_RawHistory() {
+	config := s.newConfig()
+	config.FieldRedaction = dc.GetTypedPropertyFnFilteredByNamespace(redaction.Config{
+		PayloadFields: []string{"input"},
+		ExemptRoles:   []string{"admin"},
+	})
+	wh := s.getWorkflowHandler(config)
+
+	events := []*historypb.HistoryEvent{{
+		EventId:   1,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+			WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+				Input: payloads.EncodeString("secret"),
+			},
+		},
+	}}
+	blob, err := serialization.NewSerializer().SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.testNamespaceID, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, *historyservice.GetWorkflowExecutionHistoryRequest, ...grpc.CallOption) (*historyservice.GetWorkflowExecutionHistoryResponse, error) {
+			return &historyservice.GetWorkflowExecutionHistoryResponse{
+				Response: &workflowservice.GetWorkflowExecutionHistoryResponse{
+					RawHistory: []*commonpb.DataBlob{blob},
+				},
+			}, nil
+		},
+	).Times(2)
+
+	getHistory := func(role authorization.Role) *workflowservice.GetWorkflowExecutionHistoryResponse {
+		ctx := context.WithValue(context.Background(), authorization.MappedClaims, &authorization.Claims{
+			Namespaces: map[string]authorization.Role{s.testNamespace.String(): role},
+		})
+		resp, err := wh.GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+			Namespace:    s.testNamespace.String(),
+			Execution:    &commonpb.WorkflowExecution{WorkflowId: testWorkflowID},
+			SkipArchival: true,
+		})
+		s.NoError(err)
+		return resp
+	}
+
+	// A writer gets redacted payloads, because only admins are exempt.
+	resp := getHistory(authorization.RoleWriter)
+	s.Empty(resp.GetRawHistory())
+	s.Len(resp.GetHistory().GetEvents(), 1)
+	var input string
+	s.NoError(payloads.Decode(resp.GetHistory().GetEvents()[0].GetWorkflowExecutionStartedEventAttributes().GetInput(), &input))
+	s.Equal(redaction.RedactedValue, input)
+
+	// A worker is always exempt, because it needs the payloads to replay the workflow.
+	resp = getHistory(authorization.RoleWorker)
+	s.Len(resp.GetRawHistory(), 1)
+}
+
+func (s *workflowHandlerSuite) TestDescribeWorkflowExecution_FieldRedaction_Disabled() {
+	config := s.newConfig()
+	config.FieldRedaction = dc.GetTypedPropertyFnFilteredByNamespace(redaction.Config{
+		MemoKeys: []string{"email"},
+	})
+	wh := s.getWorkflowHandler(config)
+	// Without an authorizer and claim mapper, there are no roles to exempt callers.
+	wh.fieldRedactionEnabled = false
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.testNamespaceID, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		&historyservice.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+				Memo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+					"email": payload.EncodeString("jane@example.org"),
+				}},
+			},
+		}, nil,
+	)
+
+	resp, err := wh.DescribeWorkflowExecution(context.Background(), &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: s.testNamespace.String(),
+		Execution: &commonpb.WorkflowExecution{WorkflowId: testWorkflowID},
+	})
+	s.NoError(err)
+	var email string
+	s.NoError(payload.Decode(resp.GetWorkflowExecutionInfo().GetMemo().GetFields()["email"], &email))
+	s.Equal("jane@example.org", email)
+}
+
 func (s *workflowHandlerSuite) TestListWorkflowExecutions() {
 	config := s.newConfig()
 	wh := s.getWorkflowHandler(config)
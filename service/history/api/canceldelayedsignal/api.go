@@ -0,0 +1,100 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package canceldelayedsignal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/components/delayedsignals"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/hsm"
+	"go.temporal.io/server/service/history/shard"
+)
+
+func Invoke(
+	ctx context.Context,
+	req *historyservice.CancelDelayedSignalRequest,
+	shardContext shard.Context,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (_ *historyservice.CancelDelayedSignalResponse, retError error) {
+	namespaceEntry, err := api.GetActiveNamespace(shardContext, namespace.ID(req.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	if req.GetId() == "" {
+		return nil, serviceerror.NewInvalidArgument("delayed signal ID is not set")
+	}
+
+	err = api.GetAndUpdateWorkflowWithNew(
+		ctx,
+		nil,
+		definition.NewWorkflowKey(
+			namespaceEntry.ID().String(),
+			req.GetExecution().GetWorkflowId(),
+			req.GetExecution().GetRunId(),
+		),
+		func(workflowLease api.WorkflowLease) (*api.UpdateWorkflowAction, error) {
+			coll := delayedsignals.MachineCollection(workflowLease.GetMutableState().HSM())
+			ds, err := coll.Data(req.GetId())
+			if err != nil {
+				if errors.Is(err, hsm.ErrStateMachineNotFound) {
+					return nil, serviceerror.NewNotFound(fmt.Sprintf("delayed signal %q not found", req.GetId()))
+				}
+				return nil, err
+			}
+			if ds.State() == enumsspb.DELAYED_SIGNAL_STATE_CANCELED {
+				// Already canceled by a previous request.
+				return &api.UpdateWorkflowAction{
+					Noop:               true,
+					CreateWorkflowTask: false,
+				}, nil
+			}
+			if !ds.Pending() {
+				return nil, serviceerror.NewFailedPrecondition(fmt.Sprintf("delayed signal %q is already in state %v", req.GetId(), ds.State()))
+			}
+			if err := coll.Transition(req.GetId(), func(ds delayedsignals.DelayedSignal) (hsm.TransitionOutput, error) {
+				return delayedsignals.TransitionCanceled.Apply(ds, delayedsignals.EventCanceled{})
+			}); err != nil {
+				return nil, err
+			}
+			return &api.UpdateWorkflowAction{
+				Noop:               false,
+				CreateWorkflowTask: false,
+			}, nil
+		},
+		nil,
+		shardContext,
+		workflowConsistencyChecker,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.CancelDelayedSignalResponse{}, nil
+}
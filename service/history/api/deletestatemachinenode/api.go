@@ -0,0 +1,97 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletestatemachinenode
+
+import (
+	"context"
+	"errors"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/hsm"
+	"go.temporal.io/server/service/history/shard"
+)
+
+func Invoke(
+	ctx context.Context,
+	req *historyservice.DeleteStateMachineNodeRequest,
+	shardContext shard.Context,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (_ *historyservice.DeleteStateMachineNodeResponse, retError error) {
+	namespaceID := namespace.ID(req.GetNamespaceId())
+	if err := api.ValidateNamespaceUUID(namespaceID); err != nil {
+		return nil, err
+	}
+	namespaceEntry, err := api.GetActiveNamespace(shardContext, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if namespaceEntry.ReplicationPolicy() == namespace.ReplicationPolicyMultiCluster {
+		// Deletions don't produce transition outputs and aren't replicated, deleting a node would make the clusters
+		// diverge.
+		return nil, serviceerror.NewFailedPrecondition("state machines of workflows in namespaces replicated to multiple clusters cannot be deleted")
+	}
+	if len(req.GetPath()) == 0 {
+		return nil, serviceerror.NewInvalidArgument("the root state machine cannot be deleted")
+	}
+	path := make([]hsm.Key, 0, len(req.GetPath()))
+	for _, key := range req.GetPath() {
+		path = append(path, hsm.Key{Type: key.GetType(), ID: key.GetId()})
+	}
+
+	err = api.GetAndUpdateWorkflowWithNew(
+		ctx,
+		nil,
+		definition.NewWorkflowKey(
+			req.NamespaceId,
+			req.Execution.GetWorkflowId(),
+			req.Execution.GetRunId(),
+		),
+		func(workflowLease api.WorkflowLease) (*api.UpdateWorkflowAction, error) {
+			parent, err := workflowLease.GetMutableState().HSM().Child(path[:len(path)-1])
+			if err == nil {
+				err = parent.DeleteChild(path[len(path)-1])
+			}
+			if err != nil {
+				if errors.Is(err, hsm.ErrStateMachineNotFound) {
+					return nil, serviceerror.NewNotFound(err.Error())
+				}
+				return nil, err
+			}
+			return &api.UpdateWorkflowAction{
+				Noop:               false,
+				CreateWorkflowTask: false,
+			}, nil
+		},
+		nil,
+		shardContext,
+		workflowConsistencyChecker,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.DeleteStateMachineNodeResponse{}, nil
+}
@@ -0,0 +1,125 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package describeoutboundqueue
+
+import (
+	"context"
+	"sort"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/circuitbreaker"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/queues"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Invoke(
+	_ context.Context,
+	namespaceRegistry namespace.Registry,
+	queueProcessors map[tasks.Category]queues.Queue,
+	circuitBreakerPool *queues.CircuitBreakerPool,
+	_ *historyservice.DescribeOutboundQueueRequest,
+) (*historyservice.DescribeOutboundQueueResponse, error) {
+	queue, ok := queueProcessors[tasks.CategoryOutbound]
+	if !ok {
+		return nil, serviceerror.NewFailedPrecondition("outbound queue is not enabled")
+	}
+
+	destinations := make(map[tasks.TaskGroupNamespaceIDAndDestination]*adminservice.OutboundQueueDestination)
+	getOrCreate := func(key tasks.TaskGroupNamespaceIDAndDestination) *adminservice.OutboundQueueDestination {
+		destination, ok := destinations[key]
+		if !ok {
+			destination = &adminservice.OutboundQueueDestination{
+				NamespaceId: key.NamespaceID,
+				TaskGroup:   key.TaskGroup,
+				Destination: key.Destination,
+			}
+			if nsName, err := namespaceRegistry.GetNamespaceName(namespace.ID(key.NamespaceID)); err == nil {
+				destination.NamespaceName = nsName.String()
+			}
+			destinations[key] = destination
+		}
+		return destination
+	}
+
+	for groupKey, count := range queue.PendingTaskCountPerGroup() {
+		key, ok := groupKey.(tasks.TaskGroupNamespaceIDAndDestination)
+		if !ok {
+			continue
+		}
+		getOrCreate(key).PendingTaskCount += int64(count)
+	}
+	// Collect circuit breakers first, the pool is locked while iterating.
+	circuitBreakers := make(map[tasks.TaskGroupNamespaceIDAndDestination]*queues.DestinationCircuitBreaker)
+	circuitBreakerPool.Range(func(key tasks.TaskGroupNamespaceIDAndDestination, cb *queues.DestinationCircuitBreaker) bool {
+		circuitBreakers[key] = cb
+		return true
+	})
+	for key, cb := range circuitBreakers {
+		getOrCreate(key).CircuitBreaker = toAdminCircuitBreaker(cb)
+	}
+
+	response := &adminservice.DescribeOutboundQueueResponse{
+		Destinations: make([]*adminservice.OutboundQueueDestination, 0, len(destinations)),
+	}
+	for _, destination := range destinations {
+		response.Destinations = append(response.Destinations, destination)
+	}
+	sort.Slice(response.Destinations, func(i, j int) bool {
+		a, b := response.Destinations[i], response.Destinations[j]
+		if a.NamespaceId != b.NamespaceId {
+			return a.NamespaceId < b.NamespaceId
+		}
+		if a.TaskGroup != b.TaskGroup {
+			return a.TaskGroup < b.TaskGroup
+		}
+		return a.Destination < b.Destination
+	})
+
+	return &historyservice.DescribeOutboundQueueResponse{
+		Response: response,
+	}, nil
+}
+
+func toAdminCircuitBreaker(cb *queues.DestinationCircuitBreaker) *adminservice.OutboundQueueCircuitBreaker {
+	counts := cb.Counts()
+	adminCircuitBreaker := &adminservice.OutboundQueueCircuitBreaker{
+		State:                cb.State().String(),
+		Requests:             int64(counts.Requests),
+		TotalSuccesses:       int64(counts.TotalSuccesses),
+		TotalFailures:        int64(counts.TotalFailures),
+		ConsecutiveSuccesses: int64(counts.ConsecutiveSuccesses),
+		ConsecutiveFailures:  int64(counts.ConsecutiveFailures),
+	}
+	if forcedState := cb.ForcedState(); forcedState != circuitbreaker.ForcedStateNone {
+		adminCircuitBreaker.ForcedState = forcedState.String()
+	}
+	if lastError := cb.LastError(); lastError != nil {
+		adminCircuitBreaker.LastError = lastError.Err.Error()
+		adminCircuitBreaker.LastErrorTime = timestamppb.New(lastError.Time)
+	}
+	return adminCircuitBreaker
+}
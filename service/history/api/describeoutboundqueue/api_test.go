@@ -0,0 +1,124 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package describeoutboundqueue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/circuitbreaker"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/queues"
+	"go.temporal.io/server/service/history/tasks"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newCircuitBreakerPool() *queues.CircuitBreakerPool {
+	return queues.NewCircuitBreakerPool(func(_ tasks.TaskGroupNamespaceIDAndDestination) *circuitbreaker.TwoStepCircuitBreakerWithDynamicSettings {
+		cb := circuitbreaker.NewTwoStepCircuitBreakerWithDynamicSettings(circuitbreaker.Settings{})
+		cb.UpdateSettings(dynamicconfig.CircuitBreakerSettings{})
+		return cb
+	})
+}
+
+func TestInvoke_NoOutboundQueue(t *testing.T) {
+	_, err := Invoke(
+		context.Background(),
+		nil,
+		map[tasks.Category]queues.Queue{},
+		newCircuitBreakerPool(),
+		&historyservice.DescribeOutboundQueueRequest{
+			Request: &adminservice.DescribeOutboundQueueRequest{ShardId: 1},
+		},
+	)
+	var failedPreconditionErr *serviceerror.FailedPrecondition
+	require.ErrorAs(t, err, &failedPreconditionErr)
+}
+
+func TestInvoke_Success(t *testing.T) {
+	controller := gomock.NewController(t)
+	pendingKey := tasks.TaskGroupNamespaceIDAndDestination{
+		TaskGroup:   "nexusoperations.Invocation",
+		NamespaceID: "namespace-id-1",
+		Destination: "endpoint-a",
+	}
+	circuitBreakerKey := tasks.TaskGroupNamespaceIDAndDestination{
+		TaskGroup:   "callbacks.Invocation",
+		NamespaceID: "namespace-id-1",
+		Destination: "http://localhost",
+	}
+	lastErrorTime := time.Now()
+
+	queue := queues.NewMockQueue(controller)
+	queue.EXPECT().PendingTaskCountPerGroup().Return(map[any]int{pendingKey: 3})
+	namespaceRegistry := namespace.NewMockRegistry(controller)
+	namespaceRegistry.EXPECT().GetNamespaceName(namespace.ID("namespace-id-1")).Return(namespace.Name("namespace-1"), nil).AnyTimes()
+
+	pool := newCircuitBreakerPool()
+	cb := pool.Get(circuitBreakerKey)
+	cb.Force(circuitbreaker.ForcedStateOpen)
+	cb.RecordError(errors.New("destination down"), lastErrorTime)
+
+	resp, err := Invoke(
+		context.Background(),
+		namespaceRegistry,
+		map[tasks.Category]queues.Queue{tasks.CategoryOutbound: queue},
+		pool,
+		&historyservice.DescribeOutboundQueueRequest{
+			Request: &adminservice.DescribeOutboundQueueRequest{ShardId: 1},
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(&adminservice.DescribeOutboundQueueResponse{
+		Destinations: []*adminservice.OutboundQueueDestination{
+			{
+				NamespaceId:   "namespace-id-1",
+				NamespaceName: "namespace-1",
+				TaskGroup:     "callbacks.Invocation",
+				Destination:   "http://localhost",
+				CircuitBreaker: &adminservice.OutboundQueueCircuitBreaker{
+					State:         "closed",
+					ForcedState:   "open",
+					LastError:     "destination down",
+					LastErrorTime: timestamppb.New(lastErrorTime),
+				},
+			},
+			{
+				NamespaceId:      "namespace-id-1",
+				NamespaceName:    "namespace-1",
+				TaskGroup:        "nexusoperations.Invocation",
+				Destination:      "endpoint-a",
+				PendingTaskCount: 3,
+			},
+		},
+	}, resp.Response), resp.Response.String())
+}
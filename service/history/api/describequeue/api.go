@@ -0,0 +1,107 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package describequeue
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/service/history/queues"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Invoke(
+	_ context.Context,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+	queueProcessors map[tasks.Category]queues.Queue,
+	request *historyservice.DescribeQueueRequest,
+) (*historyservice.DescribeQueueResponse, error) {
+	adminRequest := request.Request
+	taskCategory, ok := taskCategoryRegistry.GetCategoryByID(int(adminRequest.Category))
+	if !ok {
+		return nil, &serviceerror.InvalidArgument{
+			Message: fmt.Sprintf("unknown task category: %v", adminRequest.Category),
+		}
+	}
+
+	queue, ok := queueProcessors[taskCategory]
+	if !ok {
+		return nil, &serviceerror.InvalidArgument{
+			Message: fmt.Sprintf("no queue processor registered for task category: %v", taskCategory.Name()),
+		}
+	}
+
+	return &historyservice.DescribeQueueResponse{
+		Response: toAdminResponse(queue.Describe()),
+	}, nil
+}
+
+func toAdminResponse(description *queues.QueueDescription) *adminservice.DescribeHistoryQueueResponse {
+	response := &adminservice.DescribeHistoryQueueResponse{
+		PendingTaskCount:             int64(description.PendingTaskCount),
+		PendingTaskCountPerNamespace: toInt64Map(description.PendingTaskCountPerNamespace),
+	}
+
+	for _, reader := range description.Readers {
+		adminReader := &adminservice.HistoryQueueReader{
+			ReaderId:      reader.ReaderID,
+			StuckAttempts: int32(reader.StuckAttempts),
+		}
+		if !reader.Watermark.FireTime.IsZero() || reader.Watermark.TaskID != 0 {
+			adminReader.Watermark = queues.ToPersistenceTaskKey(reader.Watermark)
+		}
+		for _, slice := range reader.Slices {
+			adminReader.Slices = append(adminReader.Slices, &adminservice.HistoryQueueSlice{
+				Scope:                        queues.ToPersistenceScope(slice.Scope),
+				PendingTaskCount:             int64(slice.PendingTaskCount),
+				PendingTaskCountPerNamespace: toInt64Map(slice.PendingTaskCountPerNamespace),
+			})
+		}
+		response.Readers = append(response.Readers, adminReader)
+	}
+
+	for _, alert := range description.Alerts {
+		adminAlert := &adminservice.HistoryQueueAlert{
+			AlertType: alert.AlertType.String(),
+			Pending:   alert.Pending,
+		}
+		if !alert.SilencedUntil.IsZero() {
+			adminAlert.SilencedUntil = timestamppb.New(alert.SilencedUntil)
+		}
+		response.Alerts = append(response.Alerts, adminAlert)
+	}
+
+	return response
+}
+
+func toInt64Map(counts map[string]int) map[string]int64 {
+	result := make(map[string]int64, len(counts))
+	for key, count := range counts {
+		result[key] = int64(count)
+	}
+	return result
+}
@@ -0,0 +1,132 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package describequeue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/predicates"
+	"go.temporal.io/server/service/history/queues"
+	"go.temporal.io/server/service/history/tasks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestInvoke_InvalidTaskCategory(t *testing.T) {
+	_, err := Invoke(
+		context.Background(),
+		tasks.NewDefaultTaskCategoryRegistry(),
+		nil,
+		&historyservice.DescribeQueueRequest{
+			Request: &adminservice.DescribeHistoryQueueRequest{
+				ShardId:  1,
+				Category: -1,
+			},
+		},
+	)
+	var invalidArgErr *serviceerror.InvalidArgument
+	require.ErrorAs(t, err, &invalidArgErr)
+}
+
+func TestInvoke_NoQueueProcessor(t *testing.T) {
+	_, err := Invoke(
+		context.Background(),
+		tasks.NewDefaultTaskCategoryRegistry(),
+		map[tasks.Category]queues.Queue{},
+		&historyservice.DescribeQueueRequest{
+			Request: &adminservice.DescribeHistoryQueueRequest{
+				ShardId:  1,
+				Category: int32(tasks.CategoryIDTransfer),
+			},
+		},
+	)
+	var invalidArgErr *serviceerror.InvalidArgument
+	require.ErrorAs(t, err, &invalidArgErr)
+}
+
+func TestInvoke_Success(t *testing.T) {
+	controller := gomock.NewController(t)
+	namespaceID := "test-namespace-id"
+	silencedUntil := time.Now().Add(time.Minute)
+	scope := queues.NewScope(
+		queues.NewRange(tasks.NewImmediateKey(10), tasks.NewImmediateKey(20)),
+		predicates.Universal[tasks.Task](),
+	)
+
+	mockQueue := queues.NewMockQueue(controller)
+	mockQueue.EXPECT().Describe().Return(&queues.QueueDescription{
+		Readers: []queues.ReaderDescription{
+			{
+				ReaderID: queues.DefaultReaderId,
+				Slices: []queues.SliceDescription{
+					{
+						Scope:                        scope,
+						PendingTaskCount:             3,
+						PendingTaskCountPerNamespace: map[string]int{namespaceID: 3},
+					},
+				},
+			},
+		},
+		PendingTaskCount:             3,
+		PendingTaskCountPerNamespace: map[string]int{namespaceID: 3},
+		Alerts: []queues.AlertDescription{
+			{
+				AlertType:     queues.AlertTypeReaderStuck,
+				SilencedUntil: silencedUntil,
+			},
+		},
+	})
+
+	resp, err := Invoke(
+		context.Background(),
+		tasks.NewDefaultTaskCategoryRegistry(),
+		map[tasks.Category]queues.Queue{tasks.CategoryTransfer: mockQueue},
+		&historyservice.DescribeQueueRequest{
+			Request: &adminservice.DescribeHistoryQueueRequest{
+				ShardId:  1,
+				Category: int32(tasks.CategoryIDTransfer),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	response := resp.Response
+	require.Equal(t, int64(3), response.PendingTaskCount)
+	require.Equal(t, map[string]int64{namespaceID: 3}, response.PendingTaskCountPerNamespace)
+	require.Len(t, response.Readers, 1)
+	require.Equal(t, queues.DefaultReaderId, response.Readers[0].ReaderId)
+	require.Nil(t, response.Readers[0].Watermark)
+	require.Len(t, response.Readers[0].Slices, 1)
+	require.Equal(t, int64(10), response.Readers[0].Slices[0].Scope.Range.InclusiveMin.TaskId)
+	require.Equal(t, int64(20), response.Readers[0].Slices[0].Scope.Range.ExclusiveMax.TaskId)
+	require.Equal(t, map[string]int64{namespaceID: 3}, response.Readers[0].Slices[0].PendingTaskCountPerNamespace)
+	require.Len(t, response.Alerts, 1)
+	require.Equal(t, "ReaderStuck", response.Alerts[0].AlertType)
+	require.False(t, response.Alerts[0].Pending)
+	require.True(t, silencedUntil.Equal(response.Alerts[0].SilencedUntil.AsTime()))
+}
@@ -0,0 +1,157 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package describestatemachines
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/locks"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/hsm"
+	"go.temporal.io/server/service/history/workflow"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Invoke(
+	ctx context.Context,
+	req *historyservice.DescribeStateMachinesRequest,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (_ *historyservice.DescribeStateMachinesResponse, retError error) {
+	namespaceID := namespace.ID(req.GetNamespaceId())
+	if err := api.ValidateNamespaceUUID(namespaceID); err != nil {
+		return nil, err
+	}
+
+	workflowLease, err := workflowConsistencyChecker.GetWorkflowLease(
+		ctx,
+		nil,
+		definition.NewWorkflowKey(
+			req.NamespaceId,
+			req.Execution.GetWorkflowId(),
+			req.Execution.GetRunId(),
+		),
+		locks.PriorityHigh,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { workflowLease.GetReleaseFn()(retError) }()
+
+	mutableState := workflowLease.GetMutableState()
+	root, err := DescribeNode(mutableState.HSM())
+	if err != nil {
+		return nil, err
+	}
+	root.Type = workflow.StateMachineType
+	root.State = mutableState.GetExecutionState().GetState().String()
+
+	return &historyservice.DescribeStateMachinesResponse{
+		Root: root,
+	}, nil
+}
+
+// DescribeNode describes the given node and all of its descendants.
+func DescribeNode(node *hsm.Node) (*adminservice.StateMachineNodeInfo, error) {
+	repr := node.InternalRepr()
+	info := &adminservice.StateMachineNodeInfo{
+		Type:                          node.Key.Type,
+		TransitionCount:               repr.GetTransitionCount(),
+		InitialVersionedTransition:    repr.GetInitialVersionedTransition(),
+		LastUpdateVersionedTransition: repr.GetLastUpdateVersionedTransition(),
+	}
+	for _, key := range node.Path() {
+		info.Path = append(info.Path, &persistencespb.StateMachineKey{Type: key.Type, Id: key.ID})
+	}
+
+	// The root is the workflow itself, which is described by the caller.
+	if node.Parent != nil {
+		data, err := hsm.MachineData[any](node)
+		if err != nil {
+			return nil, err
+		}
+		info.State = machineState(data)
+		if regenerator, ok := data.(hsm.TaskRegenerator); ok {
+			tasks, err := regenerator.RegenerateTasks(node)
+			if err != nil {
+				return nil, fmt.Errorf("failed to regenerate tasks for %v: %w", node.Key, err)
+			}
+			for _, task := range tasks {
+				info.PendingTasks = append(info.PendingTasks, describeTask(task))
+			}
+		}
+	}
+
+	keys := make([]hsm.Key, 0)
+	for machineType, machines := range repr.GetChildren() {
+		for id := range machines.GetMachinesById() {
+			keys = append(keys, hsm.Key{Type: machineType, ID: id})
+		}
+	}
+	slices.SortFunc(keys, func(a, b hsm.Key) int {
+		return cmp.Or(cmp.Compare(a.Type, b.Type), cmp.Compare(a.ID, b.ID))
+	})
+	for _, key := range keys {
+		child, err := node.Child([]hsm.Key{key})
+		if err != nil {
+			return nil, err
+		}
+		childInfo, err := DescribeNode(child)
+		if err != nil {
+			return nil, err
+		}
+		info.Children = append(info.Children, childInfo)
+	}
+	return info, nil
+}
+
+func describeTask(task hsm.Task) *adminservice.StateMachineTaskInfo {
+	info := &adminservice.StateMachineTaskInfo{
+		Type: task.Type(),
+	}
+	switch kind := task.Kind().(type) {
+	case hsm.TaskKindTimer:
+		info.Deadline = timestamppb.New(kind.Deadline)
+	case hsm.TaskKindOutbound:
+		info.Destination = kind.Destination
+	}
+	return info
+}
+
+// machineState returns the state of machines implementing hsm.StateMachine. The state type is a type parameter of the
+// interface, so it is looked up via reflection.
+func machineState(data any) string {
+	method := reflect.ValueOf(data).MethodByName("State")
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		return ""
+	}
+	return fmt.Sprint(method.Call(nil)[0].Interface())
+}
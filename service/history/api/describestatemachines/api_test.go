@@ -0,0 +1,81 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package describestatemachines
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/testing/protorequire"
+	"go.temporal.io/server/service/history/hsm"
+	"go.temporal.io/server/service/history/hsm/hsmtest"
+)
+
+func TestDescribeNode(t *testing.T) {
+	def := hsmtest.NewDefinition("test")
+	reg := hsm.NewRegistry()
+	require.NoError(t, reg.RegisterMachine(def))
+
+	root, err := hsm.NewRoot(reg, def.Type(), hsmtest.NewData(hsmtest.State1), make(map[string]*persistencespb.StateMachineMap), &hsmtest.NodeBackend{})
+	require.NoError(t, err)
+	b, err := root.AddChild(hsm.Key{Type: def.Type(), ID: "b"}, hsmtest.NewData(hsmtest.State2))
+	require.NoError(t, err)
+	_, err = b.AddChild(hsm.Key{Type: def.Type(), ID: "nested"}, hsmtest.NewData(hsmtest.State3))
+	require.NoError(t, err)
+	_, err = root.AddChild(hsm.Key{Type: def.Type(), ID: "a"}, hsmtest.NewData(hsmtest.State1))
+	require.NoError(t, err)
+	require.NoError(t, hsm.MachineTransition(b, func(d *hsmtest.Data) (hsm.TransitionOutput, error) {
+		d.SetState(hsmtest.State4)
+		return hsm.TransitionOutput{}, nil
+	}))
+
+	info, err := DescribeNode(root)
+	require.NoError(t, err)
+	// The root is the workflow, its state and tasks are filled in by the caller.
+	require.Empty(t, info.Path)
+	require.Empty(t, info.State)
+	require.Empty(t, info.PendingTasks)
+
+	// Children are sorted by key.
+	require.Len(t, info.Children, 2)
+	a, b1 := info.Children[0], info.Children[1]
+	protorequire.ProtoSliceEqual(t, []*persistencespb.StateMachineKey{{Type: def.Type(), Id: "a"}}, a.Path)
+	require.Equal(t, string(hsmtest.State1), a.State)
+	require.Equal(t, int64(0), a.TransitionCount)
+
+	require.Equal(t, def.Type(), b1.Type)
+	require.Equal(t, string(hsmtest.State4), b1.State)
+	require.Equal(t, int64(1), b1.TransitionCount)
+	require.Len(t, b1.PendingTasks, 2)
+	require.Equal(t, hsmtest.TaskType, b1.PendingTasks[0].Type)
+	require.NotNil(t, b1.PendingTasks[0].Deadline)
+	require.Equal(t, string(hsmtest.State4), b1.PendingTasks[1].Destination)
+
+	require.Len(t, b1.Children, 1)
+	protorequire.ProtoSliceEqual(t, []*persistencespb.StateMachineKey{
+		{Type: def.Type(), Id: "b"},
+		{Type: def.Type(), Id: "nested"},
+	}, b1.Children[0].Path)
+	require.Equal(t, string(hsmtest.State3), b1.Children[0].State)
+}
@@ -47,6 +47,7 @@ import (
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/workflow"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -304,8 +305,19 @@ func Invoke(
 					Header: variant.Nexus.GetHeader(),
 				},
 			}
+		case *persistence.Callback_Webhook_, *persistence.Callback_Grpc:
+			// There's no public API variant for webhooks and gRPC callbacks, expose them the same way they are attached.
+			data, err := proto.Marshal(callback.Callback)
+			if err != nil {
+				return nil, serviceerror.NewInternal("failed to construct describe response")
+			}
+			cbSpec.Variant = &commonpb.Callback_Internal_{
+				Internal: &commonpb.Callback_Internal{
+					Data: data,
+				},
+			}
 		default:
-			// Ignore non-nexus callbacks for now (there aren't any just yet).
+			// Ignore internal HSM callbacks.
 			continue
 		}
 		trigger := &workflowpb.CallbackInfo_Trigger{}
@@ -352,7 +364,9 @@ func Invoke(
 			return nil, serviceerror.NewInternal("failed to construct describe response")
 		case enumsspb.NEXUS_OPERATION_STATE_BACKING_OFF:
 			state = enumspb.PENDING_NEXUS_OPERATION_STATE_BACKING_OFF
-		case enumsspb.NEXUS_OPERATION_STATE_SCHEDULED:
+		case enumsspb.NEXUS_OPERATION_STATE_SCHEDULED,
+			// The public API has no blocked state, a blocked operation is still waiting to be sent.
+			enumsspb.NEXUS_OPERATION_STATE_BLOCKED:
 			state = enumspb.PENDING_NEXUS_OPERATION_STATE_SCHEDULED
 		case enumsspb.NEXUS_OPERATION_STATE_STARTED:
 			state = enumspb.PENDING_NEXUS_OPERATION_STATE_STARTED
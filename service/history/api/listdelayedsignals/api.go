@@ -0,0 +1,82 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package listdelayedsignals
+
+import (
+	"context"
+	"slices"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/locks"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/components/delayedsignals"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/hsm"
+	"google.golang.org/protobuf/proto"
+)
+
+func Invoke(
+	ctx context.Context,
+	req *historyservice.ListDelayedSignalsRequest,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (_ *historyservice.ListDelayedSignalsResponse, retError error) {
+	namespaceID := namespace.ID(req.GetNamespaceId())
+	if err := api.ValidateNamespaceUUID(namespaceID); err != nil {
+		return nil, err
+	}
+
+	workflowLease, err := workflowConsistencyChecker.GetWorkflowLease(
+		ctx,
+		nil,
+		definition.NewWorkflowKey(
+			req.NamespaceId,
+			req.Execution.GetWorkflowId(),
+			req.Execution.GetRunId(),
+		),
+		locks.PriorityHigh,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { workflowLease.GetReleaseFn()(retError) }()
+
+	nodes := delayedsignals.MachineCollection(workflowLease.GetMutableState().HSM()).List()
+	infos := make([]*persistencespb.DelayedSignalInfo, 0, len(nodes))
+	for _, node := range nodes {
+		ds, err := hsm.MachineData[delayedsignals.DelayedSignal](node)
+		if err != nil {
+			return nil, err
+		}
+		// Copy the info, the response is serialized after the workflow lock is released.
+		infos = append(infos, proto.Clone(ds.DelayedSignalInfo).(*persistencespb.DelayedSignalInfo))
+	}
+	slices.SortFunc(infos, func(a, b *persistencespb.DelayedSignalInfo) int {
+		return a.GetFireTime().AsTime().Compare(b.GetFireTime().AsTime())
+	})
+
+	return &historyservice.ListDelayedSignalsResponse{
+		DelayedSignals: infos,
+	}, nil
+}
@@ -0,0 +1,104 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mitigatequeue
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/service/history/queues"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+func Invoke(
+	ctx context.Context,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+	queueProcessors map[tasks.Category]queues.Queue,
+	request *historyservice.MitigateQueueRequest,
+) (*historyservice.MitigateQueueResponse, error) {
+	adminRequest := request.Request
+	taskCategory, ok := taskCategoryRegistry.GetCategoryByID(int(adminRequest.Category))
+	if !ok {
+		return nil, &serviceerror.InvalidArgument{
+			Message: fmt.Sprintf("unknown task category: %v", adminRequest.Category),
+		}
+	}
+
+	queue, ok := queueProcessors[taskCategory]
+	if !ok {
+		return nil, &serviceerror.InvalidArgument{
+			Message: fmt.Sprintf("no queue processor registered for task category: %v", taskCategory.Name()),
+		}
+	}
+
+	action, err := toOperatorAction(adminRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := queue.RunOperatorAction(ctx, action); err != nil {
+		return nil, err
+	}
+
+	return &historyservice.MitigateQueueResponse{
+		Response: &adminservice.MitigateHistoryQueueResponse{},
+	}, nil
+}
+
+func toOperatorAction(
+	request *adminservice.MitigateHistoryQueueRequest,
+) (queues.OperatorAction, error) {
+	switch action := request.Action.(type) {
+	case *adminservice.MitigateHistoryQueueRequest_SplitSlice:
+		return queues.OperatorAction{
+			ActionType: queues.OperatorActionTypeSplitSlice,
+			OperatorActionAttributesSplitSlice: &queues.OperatorActionAttributesSplitSlice{
+				NamespaceIDs: action.SplitSlice.GetNamespaceIds(),
+				ReaderID:     action.SplitSlice.GetReaderId(),
+				SliceIndex:   int(action.SplitSlice.GetSliceIndex()),
+			},
+		}, nil
+	case *adminservice.MitigateHistoryQueueRequest_MoveNamespace:
+		return queues.OperatorAction{
+			ActionType: queues.OperatorActionTypeMoveNamespace,
+			OperatorActionAttributesMoveNamespace: &queues.OperatorActionAttributesMoveNamespace{
+				NamespaceIDs:   action.MoveNamespace.GetNamespaceIds(),
+				SourceReaderID: action.MoveNamespace.GetSourceReaderId(),
+				TargetReaderID: action.MoveNamespace.GetTargetReaderId(),
+			},
+		}, nil
+	case *adminservice.MitigateHistoryQueueRequest_ThrottleNamespace:
+		return queues.OperatorAction{
+			ActionType: queues.OperatorActionTypeThrottleNamespace,
+			OperatorActionAttributesThrottleNamespace: &queues.OperatorActionAttributesThrottleNamespace{
+				NamespaceIDs: action.ThrottleNamespace.GetNamespaceIds(),
+				Duration:     action.ThrottleNamespace.GetDuration().AsDuration(),
+			},
+		}, nil
+	default:
+		return queues.OperatorAction{}, serviceerror.NewInvalidArgument("queue mitigation action is not set")
+	}
+}
@@ -0,0 +1,142 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mitigatequeue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/service/history/queues"
+	"go.temporal.io/server/service/history/tasks"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestInvoke_NoAction(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockQueue := queues.NewMockQueue(controller)
+
+	_, err := Invoke(
+		context.Background(),
+		tasks.NewDefaultTaskCategoryRegistry(),
+		map[tasks.Category]queues.Queue{tasks.CategoryTransfer: mockQueue},
+		&historyservice.MitigateQueueRequest{
+			Request: &adminservice.MitigateHistoryQueueRequest{
+				ShardId:  1,
+				Category: int32(tasks.CategoryIDTransfer),
+			},
+		},
+	)
+	var invalidArgErr *serviceerror.InvalidArgument
+	require.ErrorAs(t, err, &invalidArgErr)
+}
+
+func TestInvoke_NoQueueProcessor(t *testing.T) {
+	_, err := Invoke(
+		context.Background(),
+		tasks.NewDefaultTaskCategoryRegistry(),
+		map[tasks.Category]queues.Queue{},
+		&historyservice.MitigateQueueRequest{
+			Request: &adminservice.MitigateHistoryQueueRequest{
+				ShardId:  1,
+				Category: int32(tasks.CategoryIDTimer),
+			},
+		},
+	)
+	var invalidArgErr *serviceerror.InvalidArgument
+	require.ErrorAs(t, err, &invalidArgErr)
+}
+
+func TestInvoke_ThrottleNamespace(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockQueue := queues.NewMockQueue(controller)
+	namespaceIDs := []string{"test-namespace-id"}
+
+	mockQueue.EXPECT().RunOperatorAction(gomock.Any(), queues.OperatorAction{
+		ActionType: queues.OperatorActionTypeThrottleNamespace,
+		OperatorActionAttributesThrottleNamespace: &queues.OperatorActionAttributesThrottleNamespace{
+			NamespaceIDs: namespaceIDs,
+			Duration:     time.Minute,
+		},
+	}).Return(nil).Times(1)
+
+	resp, err := Invoke(
+		context.Background(),
+		tasks.NewDefaultTaskCategoryRegistry(),
+		map[tasks.Category]queues.Queue{tasks.CategoryTransfer: mockQueue},
+		&historyservice.MitigateQueueRequest{
+			Request: &adminservice.MitigateHistoryQueueRequest{
+				ShardId:  1,
+				Category: int32(tasks.CategoryIDTransfer),
+				Action: &adminservice.MitigateHistoryQueueRequest_ThrottleNamespace{
+					ThrottleNamespace: &adminservice.ThrottleHistoryQueueNamespaceAction{
+						NamespaceIds: namespaceIDs,
+						Duration:     durationpb.New(time.Minute),
+					},
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.NotNil(t, resp.Response)
+}
+
+func TestInvoke_MoveNamespace_Error(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockQueue := queues.NewMockQueue(controller)
+	expectedErr := serviceerror.NewNotFound("reader 3 not found")
+
+	mockQueue.EXPECT().RunOperatorAction(gomock.Any(), queues.OperatorAction{
+		ActionType: queues.OperatorActionTypeMoveNamespace,
+		OperatorActionAttributesMoveNamespace: &queues.OperatorActionAttributesMoveNamespace{
+			NamespaceIDs:   []string{"test-namespace-id"},
+			SourceReaderID: 3,
+			TargetReaderID: 4,
+		},
+	}).Return(expectedErr).Times(1)
+
+	_, err := Invoke(
+		context.Background(),
+		tasks.NewDefaultTaskCategoryRegistry(),
+		map[tasks.Category]queues.Queue{tasks.CategoryTransfer: mockQueue},
+		&historyservice.MitigateQueueRequest{
+			Request: &adminservice.MitigateHistoryQueueRequest{
+				ShardId:  1,
+				Category: int32(tasks.CategoryIDTransfer),
+				Action: &adminservice.MitigateHistoryQueueRequest_MoveNamespace{
+					MoveNamespace: &adminservice.MoveHistoryQueueNamespaceAction{
+						NamespaceIds:   []string{"test-namespace-id"},
+						SourceReaderId: 3,
+						TargetReaderId: 4,
+					},
+				},
+			},
+		},
+	)
+	require.ErrorIs(t, err, expectedErr)
+}
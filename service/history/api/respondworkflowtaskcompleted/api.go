@@ -166,6 +166,7 @@ func (handler *WorkflowTaskCompletedHandler) Invoke(
 	weContext := workflowLease.GetContext()
 	ms := workflowLease.GetMutableState()
 	currentWorkflowTask := ms.GetWorkflowTaskByID(token.GetScheduledEventId())
+	prevNextEventID := ms.GetNextEventID()
 	defer func() {
 		var errForRelease error
 		if releaseLeaseWithError {
@@ -487,6 +488,12 @@ func (handler *WorkflowTaskCompletedHandler) Invoke(
 
 			newWorkflowTaskType = enumsspb.WORKFLOW_TASK_TYPE_NORMAL
 
+		} else if updateRegistry.HasDurableOutgoingMessages() {
+			// Durably admitted updates must not depend on a speculative WFT,
+			// which is lost if the shard is reloaded before it is completed.
+
+			newWorkflowTaskType = enumsspb.WORKFLOW_TASK_TYPE_NORMAL
+
 		} else if updateRegistry.HasOutgoingMessages(true) {
 			// There shouldn't be any sent updates in the registry because
 			// all sent but not processed updates were rejected by server.
@@ -633,6 +640,10 @@ func (handler *WorkflowTaskCompletedHandler) Invoke(
 	// then effects needs to be applied immediately to keep registry and mutable state in sync.
 	effects.Apply(ctx)
 
+	if ms.IsWorkflowExecutionRunning() {
+		api.StripHistoryPayloads(handler.shardContext, ms, prevNextEventID)
+	}
+
 	if !ms.IsWorkflowExecutionRunning() {
 		// NOTE: It is important to call this *after* applying effects to be sure there are no pending effects.
 
@@ -251,7 +251,7 @@ func (handler *workflowTaskCompletedHandler) rejectUnprocessedUpdates(
 
 	rejectedUpdateIDs, err := handler.updateRegistry.RejectUnprocessed(
 		ctx,
-		handler.effects)
+		workflow.WithEffects(handler.effects, handler.mutableState))
 
 	if err != nil {
 		return err
@@ -0,0 +1,164 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduledelayedsignal
+
+import (
+	"context"
+	"errors"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/rpc/interceptor"
+	"go.temporal.io/server/components/delayedsignals"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/hsm"
+	"go.temporal.io/server/service/history/shard"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Invoke(
+	ctx context.Context,
+	req *historyservice.ScheduleDelayedSignalRequest,
+	shardContext shard.Context,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (_ *historyservice.ScheduleDelayedSignalResponse, retError error) {
+	namespaceEntry, err := api.GetActiveNamespace(shardContext, namespace.ID(req.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	if req.GetSignalName() == "" {
+		return nil, serviceerror.NewInvalidArgument("signal name is not set")
+	}
+	if req.GetFireTime() == nil {
+		return nil, serviceerror.NewInvalidArgument("fire time is not set")
+	}
+	if req.GetRequestId() == "" {
+		return nil, serviceerror.NewInvalidArgument("request ID is not set")
+	}
+	target := req.GetTarget()
+	if target.GetWorkflowId() == "" {
+		// Signal the current run of the hosting workflow.
+		target = &commonpb.WorkflowExecution{WorkflowId: req.GetExecution().GetWorkflowId()}
+	}
+
+	config := shardContext.GetConfig()
+	namespaceName := namespaceEntry.Name().String()
+
+	err = api.GetAndUpdateWorkflowWithNew(
+		ctx,
+		nil,
+		definition.NewWorkflowKey(
+			namespaceEntry.ID().String(),
+			req.GetExecution().GetWorkflowId(),
+			req.GetExecution().GetRunId(),
+		),
+		func(workflowLease api.WorkflowLease) (*api.UpdateWorkflowAction, error) {
+			mutableState := workflowLease.GetMutableState()
+			releaseFn := workflowLease.GetReleaseFn()
+			if !mutableState.IsWorkflowExecutionRunning() {
+				// in-memory mutable state is still clean, release the lock with nil error to prevent
+				// clearing and reloading mutable state
+				releaseFn(nil)
+				return nil, consts.ErrWorkflowCompleted
+			}
+
+			coll := delayedsignals.MachineCollection(mutableState.HSM())
+			if _, err := coll.Node(req.GetRequestId()); err == nil {
+				// Already scheduled by a previous request.
+				return &api.UpdateWorkflowAction{
+					Noop:               true,
+					CreateWorkflowTask: false,
+				}, nil
+			} else if !errors.Is(err, hsm.ErrStateMachineNotFound) {
+				return nil, err
+			}
+
+			if err := common.CheckEventBlobSizeLimit(
+				req.GetInput().Size(),
+				config.BlobSizeLimitWarn(namespaceName),
+				config.BlobSizeLimitError(namespaceName),
+				namespaceName,
+				mutableState.GetExecutionInfo().WorkflowId,
+				mutableState.GetExecutionState().RunId,
+				interceptor.GetMetricsHandlerFromContext(ctx, shardContext.GetLogger()).WithTags(
+					metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String()),
+				),
+				shardContext.GetThrottledLogger(),
+				tag.BlobSizeViolationOperation("ScheduleDelayedSignal"),
+			); err != nil {
+				releaseFn(nil)
+				return nil, err
+			}
+
+			maxPending := config.MaximumPendingDelayedSignalsPerExecution(namespaceName)
+			if maxPending > 0 && pendingCount(coll) >= maxPending {
+				releaseFn(nil)
+				return nil, consts.ErrPendingDelayedSignalsLimitExceeded
+			}
+
+			if _, err := delayedsignals.AddChild(mutableState.HSM(), &persistencespb.DelayedSignalInfo{
+				Id:         req.GetRequestId(),
+				Target:     target,
+				SignalName: req.GetSignalName(),
+				Input:      req.GetInput(),
+				Header:     req.GetHeader(),
+				FireTime:   req.GetFireTime(),
+				CreateTime: timestamppb.New(shardContext.GetTimeSource().Now()),
+				Identity:   req.GetIdentity(),
+			}); err != nil {
+				return nil, err
+			}
+			return &api.UpdateWorkflowAction{
+				Noop:               false,
+				CreateWorkflowTask: false,
+			}, nil
+		},
+		nil,
+		shardContext,
+		workflowConsistencyChecker,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.ScheduleDelayedSignalResponse{}, nil
+}
+
+func pendingCount(coll hsm.Collection[delayedsignals.DelayedSignal]) int {
+	count := 0
+	for _, node := range coll.List() {
+		ds, err := hsm.MachineData[delayedsignals.DelayedSignal](node)
+		if err == nil && ds.Pending() {
+			count++
+		}
+	}
+	return count
+}
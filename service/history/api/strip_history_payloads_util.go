@@ -0,0 +1,279 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/service/history/events"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/workflow"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	stripHistoryPayloadsPageSize = 100
+	stripHistoryPayloadsTimeout  = 30 * time.Second
+	// stripHistoryPayloadsMaxInFlight bounds the number of background strips per host. Strips above the bound
+	// are skipped, the events are picked up again when the next multiple of the threshold is crossed.
+	stripHistoryPayloadsMaxInFlight = 64
+)
+
+var stripHistoryPayloadsInFlight atomic.Int32
+
+type (
+	// stripHistoryPayloadsRequest is a snapshot of the mutable state taken under the workflow lock,
+	// which is all that is needed to strip payloads without holding the lock.
+	stripHistoryPayloadsRequest struct {
+		workflowKey   definition.WorkflowKey
+		branchToken   []byte
+		cutoffEventID int64
+		threshold     int64
+		// pendingActivities holds the scheduled event IDs of activities which are still pending.
+		// Their input is loaded from the scheduled event on dispatch and must be kept.
+		pendingActivities map[int64]struct{}
+	}
+)
+
+// StripHistoryPayloads replaces activity inputs and results of events which are more than
+// StripHistoryPayloadsEventThreshold events behind the end of history with tombstones, see
+// payload.NewStrippedTombstone. The events themselves are kept, as are inputs of activities which
+// are still pending.
+//
+// It must be called after events starting at prevNextEventID are persisted, while the workflow lock
+// is still held. To bound the amount of work, history is only scanned each time the next event ID crosses
+// a multiple of the threshold, and only nodes starting within two thresholds before the cutoff are visited.
+// The scan runs in the background so that it doesn't extend the time the workflow lock is held.
+// Nodes of ancestor branches are shared with other branches and are never modified.
+func StripHistoryPayloads(
+	shardContext shard.Context,
+	mutableState workflow.MutableState,
+	prevNextEventID int64,
+) {
+	request, err := newStripHistoryPayloadsRequest(shardContext, mutableState, prevNextEventID)
+	if err != nil || request == nil {
+		return
+	}
+	if stripHistoryPayloadsInFlight.Add(1) > stripHistoryPayloadsMaxInFlight {
+		stripHistoryPayloadsInFlight.Add(-1)
+		return
+	}
+
+	go func() {
+		defer stripHistoryPayloadsInFlight.Add(-1)
+
+		ctx, cancel := context.WithTimeout(context.Background(), stripHistoryPayloadsTimeout)
+		defer cancel()
+		ctx = headers.SetCallerInfo(ctx, headers.SystemBackgroundCallerInfo)
+
+		if err := request.run(ctx, shardContext); err != nil {
+			// best effort
+			shardContext.GetLogger().Error("unable to strip history payloads",
+				tag.WorkflowNamespaceID(request.workflowKey.NamespaceID),
+				tag.WorkflowID(request.workflowKey.WorkflowID),
+				tag.WorkflowRunID(request.workflowKey.RunID),
+				tag.Error(err),
+			)
+		}
+	}()
+}
+
+// newStripHistoryPayloadsRequest returns nil if no stripping is due.
+func newStripHistoryPayloadsRequest(
+	shardContext shard.Context,
+	mutableState workflow.MutableState,
+	prevNextEventID int64,
+) (*stripHistoryPayloadsRequest, error) {
+	threshold := int64(shardContext.GetConfig().StripHistoryPayloadsEventThreshold(
+		mutableState.GetNamespaceEntry().Name().String(),
+	))
+	nextEventID := mutableState.GetNextEventID()
+	if threshold <= 0 || (prevNextEventID-1)/threshold == (nextEventID-1)/threshold {
+		return nil, nil
+	}
+
+	branchToken, err := mutableState.GetCurrentBranchToken()
+	if err != nil {
+		return nil, err
+	}
+	pendingActivities := make(map[int64]struct{}, len(mutableState.GetPendingActivityInfos()))
+	for scheduledEventID := range mutableState.GetPendingActivityInfos() {
+		pendingActivities[scheduledEventID] = struct{}{}
+	}
+	return &stripHistoryPayloadsRequest{
+		workflowKey:       mutableState.GetWorkflowKey(),
+		branchToken:       branchToken,
+		cutoffEventID:     nextEventID - threshold,
+		threshold:         threshold,
+		pendingActivities: pendingActivities,
+	}, nil
+}
+
+func (r *stripHistoryPayloadsRequest) run(
+	ctx context.Context,
+	shardContext shard.Context,
+) error {
+	executionManager := shardContext.GetExecutionManager()
+	branch, err := executionManager.GetHistoryBranchUtil().ParseHistoryBranchInfo(r.branchToken)
+	if err != nil {
+		return err
+	}
+
+	minEventID := max(r.cutoffEventID-2*r.threshold, common.FirstEventID)
+	if len(branch.Ancestors) > 0 {
+		minEventID = max(minEventID, branch.Ancestors[len(branch.Ancestors)-1].GetEndNodeId())
+	}
+	if minEventID >= r.cutoffEventID {
+		return nil
+	}
+
+	isPendingActivity := func(scheduledEventID int64) bool {
+		_, ok := r.pendingActivities[scheduledEventID]
+		return ok
+	}
+	serializer := shardContext.GetPayloadSerializer()
+	var pageToken []byte
+	for doContinue := true; doContinue; doContinue = len(pageToken) > 0 {
+		resp, err := executionManager.ReadRawHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+			ShardID:       shardContext.GetShardID(),
+			BranchToken:   r.branchToken,
+			MinEventID:    minEventID,
+			MaxEventID:    r.cutoffEventID,
+			PageSize:      stripHistoryPayloadsPageSize,
+			NextPageToken: pageToken,
+			// nodes are rewritten below, references to their offloaded payloads must stay as they are
+			KeepOffloadedPayloads: true,
+		})
+		if err != nil {
+			if _, ok := err.(*serviceerror.NotFound); ok {
+				// no history node starts in range
+				return nil
+			}
+			return err
+		}
+
+		for i, blob := range resp.HistoryEventBlobs {
+			events, err := serializer.DeserializeEvents(blob)
+			if err != nil {
+				return err
+			}
+			if len(events) == 0 || events[len(events)-1].GetEventId() >= r.cutoffEventID {
+				continue
+			}
+			stripped := StripEventPayloads(events, isPendingActivity)
+			if len(stripped) == 0 {
+				continue
+			}
+
+			strippedBlob, err := serializer.SerializeEvents(events, blob.EncodingType)
+			if err != nil {
+				return err
+			}
+			// rewrite the node in place, keeping its transaction IDs so that the node chain stays valid
+			if _, err := executionManager.AppendRawHistoryNodes(ctx, &persistence.AppendRawHistoryNodesRequest{
+				ShardID:           shardContext.GetShardID(),
+				BranchToken:       r.branchToken,
+				History:           strippedBlob,
+				PrevTransactionID: resp.PrevTransactionIDs[i],
+				TransactionID:     resp.TransactionIDs[i],
+				NodeID:            resp.NodeIDs[i],
+				// the node keeps referencing the payloads offloaded when it was first written
+				KeepOffloadedPayloads: true,
+			}); err != nil {
+				return err
+			}
+			r.invalidateEventsCache(shardContext.GetEventsCache(), stripped)
+		}
+		pageToken = resp.NextPageToken
+	}
+	return nil
+}
+
+func (r *stripHistoryPayloadsRequest) invalidateEventsCache(
+	eventsCache events.Cache,
+	stripped []*historypb.HistoryEvent,
+) {
+	for _, event := range stripped {
+		eventsCache.DeleteEvent(events.EventKey{
+			NamespaceID: namespace.ID(r.workflowKey.NamespaceID),
+			WorkflowID:  r.workflowKey.WorkflowID,
+			RunID:       r.workflowKey.RunID,
+			EventID:     event.GetEventId(),
+			Version:     event.GetVersion(),
+		})
+	}
+}
+
+// StripEventPayloads replaces activity inputs and results of the given events with tombstones in place.
+// Inputs of activities for which isPendingActivity returns true are kept, as are references to offloaded
+// payloads, which don't count towards the size of history and are owned by the node. It returns the modified
+// events.
+func StripEventPayloads(
+	events []*historypb.HistoryEvent,
+	isPendingActivity func(scheduledEventID int64) bool,
+) []*historypb.HistoryEvent {
+	var stripped []*historypb.HistoryEvent
+	for _, event := range events {
+		modified := false
+		switch event.GetEventType() {
+		case enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED:
+			if !isPendingActivity(event.GetEventId()) {
+				modified = stripPayloads(event.GetActivityTaskScheduledEventAttributes().GetInput())
+			}
+		case enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED:
+			modified = stripPayloads(event.GetActivityTaskCompletedEventAttributes().GetResult())
+		default:
+			// other events are kept as is
+		}
+		if modified {
+			stripped = append(stripped, event)
+		}
+	}
+	return stripped
+}
+
+func stripPayloads(payloads *commonpb.Payloads) bool {
+	stripped := false
+	for i, p := range payloads.GetPayloads() {
+		if payload.IsStrippedTombstone(p) || serialization.IsOffloadedPayload(p) {
+			continue
+		}
+		payloads.Payloads[i] = payload.NewStrippedTombstone(proto.Size(p))
+		stripped = true
+	}
+	return stripped
+}
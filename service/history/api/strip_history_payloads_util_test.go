@@ -0,0 +1,344 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/blobstore/filestore"
+	persistencemock "go.temporal.io/server/common/persistence/mock"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/service/history/events"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tests"
+	"go.temporal.io/server/service/history/workflow"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestStripEventPayloads(t *testing.T) {
+	events := []*historypb.HistoryEvent{
+		newActivityTaskScheduledEvent(1),
+		newActivityTaskCompletedEvent(2),
+		{
+			EventId:   3,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+				WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+					Input: payloads.EncodeString("signal"),
+				},
+			},
+		},
+	}
+
+	require.Equal(t, events[:2], StripEventPayloads(events, noPendingActivity))
+	requireStripped(t, events[0].GetActivityTaskScheduledEventAttributes().GetInput())
+	requireStripped(t, events[1].GetActivityTaskCompletedEventAttributes().GetResult())
+	require.False(t, payload.IsStrippedTombstone(events[2].GetWorkflowExecutionSignaledEventAttributes().GetInput().Payloads[0]))
+
+	// stripping is idempotent
+	require.Empty(t, StripEventPayloads(events, noPendingActivity))
+}
+
+func TestStripEventPayloads_PendingActivity(t *testing.T) {
+	events := []*historypb.HistoryEvent{
+		newActivityTaskScheduledEvent(1),
+		newActivityTaskScheduledEvent(2),
+	}
+
+	stripped := StripEventPayloads(events, func(scheduledEventID int64) bool { return scheduledEventID == 1 })
+	require.Equal(t, events[1:], stripped)
+	// input of the pending activity is still needed to dispatch it
+	require.False(t, payload.IsStrippedTombstone(events[0].GetActivityTaskScheduledEventAttributes().GetInput().Payloads[0]))
+	requireStripped(t, events[1].GetActivityTaskScheduledEventAttributes().GetInput())
+}
+
+func TestStripHistoryPayloads_ThresholdNotCrossed(t *testing.T) {
+	controller := gomock.NewController(t)
+	shardContext := shard.NewMockContext(controller)
+	mutableState := workflow.NewMockMutableState(controller)
+
+	config := tests.NewDynamicConfig()
+	config.StripHistoryPayloadsEventThreshold = dynamicconfig.GetIntPropertyFnFilteredByNamespace(10)
+	shardContext.EXPECT().GetConfig().Return(config).AnyTimes()
+	mutableState.EXPECT().GetNamespaceEntry().Return(tests.GlobalNamespaceEntry).AnyTimes()
+	mutableState.EXPECT().GetNextEventID().Return(int64(20)).AnyTimes()
+
+	request, err := newStripHistoryPayloadsRequest(shardContext, mutableState, 12)
+	require.NoError(t, err)
+	require.Nil(t, request)
+}
+
+func TestStripHistoryPayloads_RewritesOldNodes(t *testing.T) {
+	controller := gomock.NewController(t)
+	shardContext := shard.NewMockContext(controller)
+	mutableState := workflow.NewMockMutableState(controller)
+	executionManager := persistence.NewMockExecutionManager(controller)
+	eventsCache := events.NewMockCache(controller)
+	serializer := serialization.NewSerializer()
+	branchUtil := &persistence.HistoryBranchUtilImpl{}
+
+	config := tests.NewDynamicConfig()
+	config.StripHistoryPayloadsEventThreshold = dynamicconfig.GetIntPropertyFnFilteredByNamespace(10)
+	shardContext.EXPECT().GetConfig().Return(config).AnyTimes()
+	shardContext.EXPECT().GetShardID().Return(int32(1)).AnyTimes()
+	shardContext.EXPECT().GetExecutionManager().Return(executionManager).AnyTimes()
+	shardContext.EXPECT().GetPayloadSerializer().Return(serializer).AnyTimes()
+	shardContext.EXPECT().GetEventsCache().Return(eventsCache).AnyTimes()
+	executionManager.EXPECT().GetHistoryBranchUtil().Return(branchUtil).AnyTimes()
+
+	branchToken, err := branchUtil.NewHistoryBranch(
+		tests.NamespaceID.String(), tests.WorkflowID, tests.RunID, "tree-id", nil, nil, 0, 0, 0,
+	)
+	require.NoError(t, err)
+	mutableState.EXPECT().GetNamespaceEntry().Return(tests.GlobalNamespaceEntry).AnyTimes()
+	mutableState.EXPECT().GetNextEventID().Return(int64(22)).AnyTimes()
+	mutableState.EXPECT().GetWorkflowKey().Return(definition.NewWorkflowKey(
+		tests.NamespaceID.String(), tests.WorkflowID, tests.RunID,
+	)).AnyTimes()
+	mutableState.EXPECT().GetCurrentBranchToken().Return(branchToken, nil).AnyTimes()
+	mutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistencespb.ActivityInfo{
+		3: {ScheduledEventId: 3},
+	}).AnyTimes()
+
+	serialize := func(events ...*historypb.HistoryEvent) *commonpb.DataBlob {
+		blob, err := serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+		require.NoError(t, err)
+		return blob
+	}
+	executionManager.EXPECT().ReadRawHistoryBranch(gomock.Any(), &persistence.ReadHistoryBranchRequest{
+		ShardID:     1,
+		BranchToken: branchToken,
+		MinEventID:  1,
+		MaxEventID:  12,
+		PageSize:    stripHistoryPayloadsPageSize,
+
+		KeepOffloadedPayloads: true,
+	}).Return(&persistence.ReadRawHistoryBranchResponse{
+		HistoryEventBlobs: []*commonpb.DataBlob{
+			// activity is still pending, so its input is kept and the node is not rewritten
+			serialize(newActivityTaskScheduledEvent(3)),
+			serialize(newActivityTaskScheduledEvent(5)),
+			// last event is not behind the cutoff
+			serialize(newActivityTaskCompletedEvent(10), newActivityTaskScheduledEvent(11), newActivityTaskCompletedEvent(12)),
+		},
+		NodeIDs:            []int64{3, 5, 10},
+		TransactionIDs:     []int64{101, 105, 110},
+		PrevTransactionIDs: []int64{100, 101, 105},
+	}, nil)
+	executionManager.EXPECT().AppendRawHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AppendRawHistoryNodesRequest) (*persistence.AppendHistoryNodesResponse, error) {
+			require.Equal(t, int64(5), request.NodeID)
+			require.Equal(t, int64(105), request.TransactionID)
+			require.Equal(t, int64(101), request.PrevTransactionID)
+			require.Equal(t, branchToken, request.BranchToken)
+			require.True(t, request.KeepOffloadedPayloads)
+			events, err := serializer.DeserializeEvents(request.History)
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			requireStripped(t, events[0].GetActivityTaskScheduledEventAttributes().GetInput())
+			return &persistence.AppendHistoryNodesResponse{}, nil
+		},
+	)
+	eventsCache.EXPECT().DeleteEvent(events.EventKey{
+		NamespaceID: tests.NamespaceID,
+		WorkflowID:  tests.WorkflowID,
+		RunID:       tests.RunID,
+		EventID:     5,
+	})
+
+	request, err := newStripHistoryPayloadsRequest(shardContext, mutableState, 18)
+	require.NoError(t, err)
+	require.NotNil(t, request)
+	require.NoError(t, request.run(context.Background(), shardContext))
+}
+
+func TestStripHistoryPayloads_KeepsOffloadedPayloads(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		appendErr error
+	}{
+		{name: "success"},
+		{name: "definite write failure", appendErr: &persistence.ShardOwnershipLostError{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			shardContext := shard.NewMockContext(controller)
+			mutableState := workflow.NewMockMutableState(controller)
+			executionStore := persistencemock.NewMockExecutionStore(controller)
+			eventsCache := events.NewMockCache(controller)
+			serializer := serialization.NewSerializer()
+			branchUtil := &persistence.HistoryBranchUtilImpl{}
+			blobStore, err := filestore.NewStore(&config.FilestoreBlobStore{Path: t.TempDir()})
+			require.NoError(t, err)
+			executionManager := persistence.NewExecutionManager(
+				executionStore,
+				serializer,
+				nil,
+				log.NewNoopLogger(),
+				dynamicconfig.GetIntPropertyFn(1024*1024),
+				serialization.NewPayloadOffloader(blobStore, dynamicconfig.GetIntPropertyFn(100)),
+			)
+
+			dynamicConfig := tests.NewDynamicConfig()
+			dynamicConfig.StripHistoryPayloadsEventThreshold = dynamicconfig.GetIntPropertyFnFilteredByNamespace(10)
+			shardContext.EXPECT().GetConfig().Return(dynamicConfig).AnyTimes()
+			shardContext.EXPECT().GetShardID().Return(int32(1)).AnyTimes()
+			shardContext.EXPECT().GetExecutionManager().Return(executionManager).AnyTimes()
+			shardContext.EXPECT().GetPayloadSerializer().Return(serializer).AnyTimes()
+			shardContext.EXPECT().GetEventsCache().Return(eventsCache).AnyTimes()
+			executionStore.EXPECT().GetHistoryBranchUtil().Return(branchUtil).AnyTimes()
+
+			branchToken, err := branchUtil.NewHistoryBranch(
+				tests.NamespaceID.String(), tests.WorkflowID, tests.RunID, "tree-id", nil, nil, 0, 0, 0,
+			)
+			require.NoError(t, err)
+			mutableState.EXPECT().GetNamespaceEntry().Return(tests.GlobalNamespaceEntry).AnyTimes()
+			mutableState.EXPECT().GetNextEventID().Return(int64(22)).AnyTimes()
+			mutableState.EXPECT().GetWorkflowKey().Return(definition.NewWorkflowKey(
+				tests.NamespaceID.String(), tests.WorkflowID, tests.RunID,
+			)).AnyTimes()
+			mutableState.EXPECT().GetCurrentBranchToken().Return(branchToken, nil).AnyTimes()
+			mutableState.EXPECT().GetPendingActivityInfos().Return(nil).AnyTimes()
+
+			// write a node with a small inline input and a large offloaded result
+			largeResult := payloads.EncodeString(strings.Repeat("r", 1000))
+			completedEvent := newActivityTaskCompletedEvent(6)
+			completedEvent.GetActivityTaskCompletedEventAttributes().Result = largeResult
+			var node persistence.InternalHistoryNode
+			executionStore.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, request *persistence.InternalAppendHistoryNodesRequest) error {
+					node = request.Node
+					return nil
+				})
+			_, err = executionManager.AppendHistoryNodes(context.Background(), &persistence.AppendHistoryNodesRequest{
+				ShardID:           1,
+				BranchToken:       branchToken,
+				Events:            []*historypb.HistoryEvent{newActivityTaskScheduledEvent(5), completedEvent},
+				PrevTransactionID: 101,
+				TransactionID:     105,
+			})
+			require.NoError(t, err)
+			require.True(t, serialization.HasOffloadedPayloads(node.Events))
+			blobKeys, err := blobStore.List(context.Background(), "")
+			require.NoError(t, err)
+			require.Len(t, blobKeys, 1)
+
+			executionStore.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).Return(&persistence.InternalReadHistoryBranchResponse{
+				Nodes: []persistence.InternalHistoryNode{node},
+			}, nil)
+			executionStore.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, request *persistence.InternalAppendHistoryNodesRequest) error {
+					require.Equal(t, int64(5), request.Node.NodeID)
+					require.Equal(t, int64(105), request.Node.TransactionID)
+					require.Equal(t, int64(101), request.Node.PrevTransactionID)
+					events, err := serializer.DeserializeEvents(request.Node.Events)
+					require.NoError(t, err)
+					require.Len(t, events, 2)
+					requireStripped(t, events[0].GetActivityTaskScheduledEventAttributes().GetInput())
+					// the reference written with the node is kept as is
+					result := events[1].GetActivityTaskCompletedEventAttributes().GetResult().GetPayloads()
+					require.Len(t, result, 1)
+					require.True(t, serialization.IsOffloadedPayload(result[0]))
+					require.Equal(t, blobKeys[0], string(result[0].GetMetadata()[serialization.OffloadedPayloadMetadataKey]))
+					return tc.appendErr
+				})
+			if tc.appendErr == nil {
+				eventsCache.EXPECT().DeleteEvent(events.EventKey{
+					NamespaceID: tests.NamespaceID,
+					WorkflowID:  tests.WorkflowID,
+					RunID:       tests.RunID,
+					EventID:     5,
+				})
+			}
+
+			request, err := newStripHistoryPayloadsRequest(shardContext, mutableState, 18)
+			require.NoError(t, err)
+			require.NotNil(t, request)
+			err = request.run(context.Background(), shardContext)
+			if tc.appendErr != nil {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			// the offloaded payload is neither overwritten nor deleted
+			keys, err := blobStore.List(context.Background(), "")
+			require.NoError(t, err)
+			require.Equal(t, blobKeys, keys)
+			resolved := []*historypb.HistoryEvent{proto.Clone(completedEvent).(*historypb.HistoryEvent)}
+			resolved[0].GetActivityTaskCompletedEventAttributes().Result = &commonpb.Payloads{Payloads: []*commonpb.Payload{{
+				Metadata: map[string][]byte{serialization.OffloadedPayloadMetadataKey: []byte(blobKeys[0])},
+			}}}
+			require.NoError(t, serialization.NewPayloadOffloader(blobStore, dynamicconfig.GetIntPropertyFn(100)).ResolveEvents(context.Background(), resolved))
+			require.True(t, proto.Equal(largeResult, resolved[0].GetActivityTaskCompletedEventAttributes().GetResult()))
+		})
+	}
+}
+
+func noPendingActivity(int64) bool {
+	return false
+}
+
+func newActivityTaskScheduledEvent(eventID int64) *historypb.HistoryEvent {
+	return &historypb.HistoryEvent{
+		EventId:   eventID,
+		EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+		Attributes: &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{
+			ActivityTaskScheduledEventAttributes: &historypb.ActivityTaskScheduledEventAttributes{
+				Input: payloads.EncodeString("input"),
+			},
+		},
+	}
+}
+
+func newActivityTaskCompletedEvent(eventID int64) *historypb.HistoryEvent {
+	return &historypb.HistoryEvent{
+		EventId:   eventID,
+		EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED,
+		Attributes: &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{
+			ActivityTaskCompletedEventAttributes: &historypb.ActivityTaskCompletedEventAttributes{
+				Result: payloads.EncodeString("result"),
+			},
+		},
+	}
+}
+
+func requireStripped(t *testing.T, p *commonpb.Payloads) {
+	require.Len(t, p.GetPayloads(), 1)
+	require.True(t, payload.IsStrippedTombstone(p.Payloads[0]))
+}
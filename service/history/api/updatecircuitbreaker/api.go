@@ -0,0 +1,81 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package updatecircuitbreaker
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/circuitbreaker"
+	"go.temporal.io/server/service/history/queues"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+func Invoke(
+	_ context.Context,
+	queueProcessors map[tasks.Category]queues.Queue,
+	circuitBreakerPool *queues.CircuitBreakerPool,
+	request *historyservice.UpdateOutboundQueueCircuitBreakerRequest,
+) (*historyservice.UpdateOutboundQueueCircuitBreakerResponse, error) {
+	if _, ok := queueProcessors[tasks.CategoryOutbound]; !ok {
+		return nil, serviceerror.NewFailedPrecondition("outbound queue is not enabled")
+	}
+
+	adminRequest := request.Request
+	if adminRequest.GetNamespaceId() == "" {
+		return nil, serviceerror.NewInvalidArgument("namespace ID is not set")
+	}
+	if adminRequest.GetTaskGroup() == "" {
+		return nil, serviceerror.NewInvalidArgument("task group is not set")
+	}
+	if adminRequest.GetDestination() == "" {
+		return nil, serviceerror.NewInvalidArgument("destination is not set")
+	}
+
+	var update func(cb *queues.DestinationCircuitBreaker)
+	switch adminRequest.Action {
+	case enumsspb.CIRCUIT_BREAKER_ACTION_FORCE_OPEN:
+		update = func(cb *queues.DestinationCircuitBreaker) { cb.Force(circuitbreaker.ForcedStateOpen) }
+	case enumsspb.CIRCUIT_BREAKER_ACTION_FORCE_CLOSED:
+		update = func(cb *queues.DestinationCircuitBreaker) { cb.Force(circuitbreaker.ForcedStateClosed) }
+	case enumsspb.CIRCUIT_BREAKER_ACTION_RESET:
+		update = func(cb *queues.DestinationCircuitBreaker) { cb.Reset() }
+	default:
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("unknown circuit breaker action: %v", adminRequest.Action))
+	}
+
+	// The circuit breaker is created if it doesn't exist yet, which allows forcing it open before any task is sent.
+	update(circuitBreakerPool.Get(tasks.TaskGroupNamespaceIDAndDestination{
+		TaskGroup:   adminRequest.TaskGroup,
+		NamespaceID: adminRequest.NamespaceId,
+		Destination: adminRequest.Destination,
+	}))
+
+	return &historyservice.UpdateOutboundQueueCircuitBreakerResponse{
+		Response: &adminservice.UpdateOutboundQueueCircuitBreakerResponse{},
+	}, nil
+}
@@ -0,0 +1,118 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package updatecircuitbreaker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/circuitbreaker"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/service/history/queues"
+	"go.temporal.io/server/service/history/tasks"
+	"go.uber.org/mock/gomock"
+)
+
+func newCircuitBreakerPool() *queues.CircuitBreakerPool {
+	return queues.NewCircuitBreakerPool(func(_ tasks.TaskGroupNamespaceIDAndDestination) *circuitbreaker.TwoStepCircuitBreakerWithDynamicSettings {
+		cb := circuitbreaker.NewTwoStepCircuitBreakerWithDynamicSettings(circuitbreaker.Settings{})
+		cb.UpdateSettings(dynamicconfig.CircuitBreakerSettings{})
+		return cb
+	})
+}
+
+func newRequest(action enumsspb.CircuitBreakerAction) *historyservice.UpdateOutboundQueueCircuitBreakerRequest {
+	return &historyservice.UpdateOutboundQueueCircuitBreakerRequest{
+		Request: &adminservice.UpdateOutboundQueueCircuitBreakerRequest{
+			ShardId:     1,
+			NamespaceId: "namespace-id",
+			TaskGroup:   "nexusoperations.Invocation",
+			Destination: "endpoint",
+			Action:      action,
+		},
+	}
+}
+
+func TestInvoke_NoOutboundQueue(t *testing.T) {
+	_, err := Invoke(
+		context.Background(),
+		map[tasks.Category]queues.Queue{},
+		newCircuitBreakerPool(),
+		newRequest(enumsspb.CIRCUIT_BREAKER_ACTION_FORCE_OPEN),
+	)
+	var failedPreconditionErr *serviceerror.FailedPrecondition
+	require.ErrorAs(t, err, &failedPreconditionErr)
+}
+
+func TestInvoke_InvalidRequest(t *testing.T) {
+	queueProcessors := map[tasks.Category]queues.Queue{
+		tasks.CategoryOutbound: queues.NewMockQueue(gomock.NewController(t)),
+	}
+	pool := newCircuitBreakerPool()
+
+	missingDestination := newRequest(enumsspb.CIRCUIT_BREAKER_ACTION_FORCE_OPEN)
+	missingDestination.Request.Destination = ""
+	for _, request := range []*historyservice.UpdateOutboundQueueCircuitBreakerRequest{
+		missingDestination,
+		newRequest(enumsspb.CIRCUIT_BREAKER_ACTION_UNSPECIFIED),
+	} {
+		_, err := Invoke(context.Background(), queueProcessors, pool, request)
+		var invalidArgErr *serviceerror.InvalidArgument
+		require.ErrorAs(t, err, &invalidArgErr)
+	}
+
+	// no circuit breaker is created for invalid requests
+	pool.Range(func(key tasks.TaskGroupNamespaceIDAndDestination, _ *queues.DestinationCircuitBreaker) bool {
+		require.Fail(t, "unexpected circuit breaker", "key: %v", key)
+		return false
+	})
+}
+
+func TestInvoke_Success(t *testing.T) {
+	queueProcessors := map[tasks.Category]queues.Queue{
+		tasks.CategoryOutbound: queues.NewMockQueue(gomock.NewController(t)),
+	}
+	pool := newCircuitBreakerPool()
+	cb := pool.Get(tasks.TaskGroupNamespaceIDAndDestination{
+		TaskGroup:   "nexusoperations.Invocation",
+		NamespaceID: "namespace-id",
+		Destination: "endpoint",
+	})
+
+	_, err := Invoke(context.Background(), queueProcessors, pool, newRequest(enumsspb.CIRCUIT_BREAKER_ACTION_FORCE_OPEN))
+	require.NoError(t, err)
+	require.Equal(t, circuitbreaker.ForcedStateOpen, cb.ForcedState())
+
+	_, err = Invoke(context.Background(), queueProcessors, pool, newRequest(enumsspb.CIRCUIT_BREAKER_ACTION_FORCE_CLOSED))
+	require.NoError(t, err)
+	require.Equal(t, circuitbreaker.ForcedStateClosed, cb.ForcedState())
+
+	_, err = Invoke(context.Background(), queueProcessors, pool, newRequest(enumsspb.CIRCUIT_BREAKER_ACTION_RESET))
+	require.NoError(t, err)
+	require.Equal(t, circuitbreaker.ForcedStateNone, cb.ForcedState())
+}
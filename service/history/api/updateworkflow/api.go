@@ -170,6 +170,14 @@ func (u *Updater) ApplyRequest(
 		return nil, consts.ErrWorkflowClosing
 	}
 
+	// Durably admitted requests are stored in mutable state only and are not replicated, so they would be lost on
+	// failover of a multi-cluster namespace.
+	nsEntry := ms.GetNamespaceEntry()
+	if nsEntry.ReplicationPolicy() != namespace.ReplicationPolicyMultiCluster &&
+		u.shardCtx.GetConfig().EnableDurableUpdateAdmission(nsEntry.Name().String()) {
+		return u.applyDurableRequest(ctx, updateReg, ms, updateID)
+	}
+
 	var (
 		alreadyExisted bool
 		err            error
@@ -223,6 +231,33 @@ func (u *Updater) ApplyRequest(
 	}, nil
 }
 
+// applyDurableRequest admits the update by storing its request in mutable state, which is then persisted together
+// with a normal WFT (if there is no pending one) to deliver it. Unlike a speculative WFT, neither is lost if the shard
+// is reloaded before the update is accepted, so the update doesn't have to be retried by the client.
+func (u *Updater) applyDurableRequest(
+	ctx context.Context,
+	updateReg update.Registry,
+	ms workflow.MutableState,
+	updateID string,
+) (*api.UpdateWorkflowAction, error) {
+	var err error
+	if u.upd, _, err = updateReg.FindOrCreateDurable(ctx, updateID); err != nil {
+		return nil, err
+	}
+	_, storedBefore := ms.GetExecutionInfo().GetUpdateInfos()[updateID]
+	if err = u.upd.AdmitDurably(u.req.GetRequest().GetRequest(), workflow.WithEffects(effect.Immediate(ctx), ms)); err != nil {
+		return nil, err
+	}
+	if _, stored := ms.GetExecutionInfo().GetUpdateInfos()[updateID]; storedBefore || !stored {
+		// If update is duplicate, then it was already admitted (durably or not) and there is nothing to persist.
+		return &api.UpdateWorkflowAction{
+			Noop:               true,
+			CreateWorkflowTask: false,
+		}, nil
+	}
+	return api.UpdateWorkflowWithNewWorkflowTask, nil
+}
+
 func (u *Updater) OnSuccess(
 	ctx context.Context,
 ) (*historyservice.UpdateWorkflowExecutionResponse, error) {
@@ -42,6 +42,11 @@ var (
 		"/temporal.server.api.historyservice.v1.HistoryService/DeleteWorkflowExecution":                1,
 		"/temporal.server.api.historyservice.v1.HistoryService/DescribeHistoryHost":                    1,
 		"/temporal.server.api.historyservice.v1.HistoryService/DescribeMutableState":                   1,
+		"/temporal.server.api.historyservice.v1.HistoryService/DescribeStateMachines":                  1,
+		"/temporal.server.api.historyservice.v1.HistoryService/DeleteStateMachineNode":                 1,
+		"/temporal.server.api.historyservice.v1.HistoryService/ScheduleDelayedSignal":                  1,
+		"/temporal.server.api.historyservice.v1.HistoryService/CancelDelayedSignal":                    1,
+		"/temporal.server.api.historyservice.v1.HistoryService/ListDelayedSignals":                     1,
 		"/temporal.server.api.historyservice.v1.HistoryService/DescribeWorkflowExecution":              1,
 		"/temporal.server.api.historyservice.v1.HistoryService/GetDLQMessages":                         1,
 		"/temporal.server.api.historyservice.v1.HistoryService/GetDLQReplicationMessages":              1,
@@ -100,6 +105,10 @@ var (
 		"/temporal.server.api.historyservice.v1.HistoryService/AddTasks":                               1,
 		"/temporal.server.api.historyservice.v1.HistoryService/ListQueues":                             1,
 		"/temporal.server.api.historyservice.v1.HistoryService/ListTasks":                              1,
+		"/temporal.server.api.historyservice.v1.HistoryService/DescribeQueue":                          1,
+		"/temporal.server.api.historyservice.v1.HistoryService/MitigateQueue":                          1,
+		"/temporal.server.api.historyservice.v1.HistoryService/DescribeOutboundQueue":                  1,
+		"/temporal.server.api.historyservice.v1.HistoryService/UpdateOutboundQueueCircuitBreaker":      1,
 		"/temporal.server.api.historyservice.v1.HistoryService/CompleteNexusOperation":                 1,
 		"/temporal.server.api.historyservice.v1.HistoryService/InvokeStateMachineMethod":               1,
 		"/temporal.server.api.historyservice.v1.HistoryService/DeepHealthCheck":                        1,
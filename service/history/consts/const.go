@@ -78,6 +78,8 @@ var (
 	ErrDeserializingToken = serviceerror.NewInvalidArgument("error deserializing task token")
 	// ErrSignalsLimitExceeded is the error indicating limit reached for maximum number of signal events
 	ErrSignalsLimitExceeded = serviceerror.NewInvalidArgument("exceeded workflow execution limit for signal events")
+	// ErrPendingDelayedSignalsLimitExceeded is the error indicating limit reached for maximum number of pending delayed signals
+	ErrPendingDelayedSignalsLimitExceeded = serviceerror.NewInvalidArgument("exceeded workflow execution limit for pending delayed signals")
 	// ErrWorkflowClosing is the error indicating requests to workflow can not be applied as workflow is closing
 	ErrWorkflowClosing = &serviceerror.ResourceExhausted{
 		Cause:   enumspb.RESOURCE_EXHAUSTED_CAUSE_BUSY_WORKFLOW,
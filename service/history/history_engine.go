@@ -57,8 +57,13 @@ import (
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/api/addtasks"
+	"go.temporal.io/server/service/history/api/canceldelayedsignal"
+	"go.temporal.io/server/service/history/api/deletestatemachinenode"
 	"go.temporal.io/server/service/history/api/deleteworkflow"
 	"go.temporal.io/server/service/history/api/describemutablestate"
+	"go.temporal.io/server/service/history/api/describeoutboundqueue"
+	"go.temporal.io/server/service/history/api/describequeue"
+	"go.temporal.io/server/service/history/api/describestatemachines"
 	"go.temporal.io/server/service/history/api/describeworkflow"
 	"go.temporal.io/server/service/history/api/getworkflowexecutionhistory"
 	"go.temporal.io/server/service/history/api/getworkflowexecutionhistoryreverse"
@@ -66,7 +71,9 @@ import (
 	"go.temporal.io/server/service/history/api/getworkflowexecutionrawhistoryv2"
 	"go.temporal.io/server/service/history/api/isactivitytaskvalid"
 	"go.temporal.io/server/service/history/api/isworkflowtaskvalid"
+	"go.temporal.io/server/service/history/api/listdelayedsignals"
 	"go.temporal.io/server/service/history/api/listtasks"
+	"go.temporal.io/server/service/history/api/mitigatequeue"
 	"go.temporal.io/server/service/history/api/multioperation"
 	"go.temporal.io/server/service/history/api/pollupdate"
 	"go.temporal.io/server/service/history/api/queryworkflow"
@@ -87,11 +94,13 @@ import (
 	"go.temporal.io/server/service/history/api/respondactivitytaskfailed"
 	"go.temporal.io/server/service/history/api/respondworkflowtaskcompleted"
 	"go.temporal.io/server/service/history/api/respondworkflowtaskfailed"
+	"go.temporal.io/server/service/history/api/scheduledelayedsignal"
 	"go.temporal.io/server/service/history/api/scheduleworkflowtask"
 	"go.temporal.io/server/service/history/api/signalwithstartworkflow"
 	"go.temporal.io/server/service/history/api/signalworkflow"
 	"go.temporal.io/server/service/history/api/startworkflow"
 	"go.temporal.io/server/service/history/api/terminateworkflow"
+	"go.temporal.io/server/service/history/api/updatecircuitbreaker"
 	"go.temporal.io/server/service/history/api/updateworkflow"
 	"go.temporal.io/server/service/history/api/verifychildworkflowcompletionrecorded"
 	"go.temporal.io/server/service/history/api/verifyfirstworkflowtaskscheduled"
@@ -111,45 +120,47 @@ import (
 
 type (
 	historyEngineImpl struct {
-		status                     int32
-		currentClusterName         string
-		shardContext               shard.Context
-		timeSource                 clock.TimeSource
-		clusterMetadata            cluster.Metadata
-		executionManager           persistence.ExecutionManager
-		queueProcessors            map[tasks.Category]queues.Queue
-		replicationAckMgr          replication.AckManager
-		nDCHistoryReplicator       ndc.HistoryReplicator
-		nDCHistoryImporter         ndc.HistoryImporter
-		nDCActivityStateReplicator ndc.ActivityStateReplicator
-		nDCWorkflowStateReplicator ndc.WorkflowStateReplicator
-		nDCHSMStateReplicator      ndc.HSMStateReplicator
-		replicationProcessorMgr    replication.TaskProcessor
-		eventNotifier              events.Notifier
-		tokenSerializer            common.TaskTokenSerializer
-		metricsHandler             metrics.Handler
-		logger                     log.Logger
-		throttledLogger            log.Logger
-		config                     *configs.Config
-		workflowRebuilder          workflowRebuilder
-		workflowResetter           ndc.WorkflowResetter
-		sdkClientFactory           sdk.ClientFactory
-		eventsReapplier            ndc.EventsReapplier
-		matchingClient             matchingservice.MatchingServiceClient
-		rawMatchingClient          matchingservice.MatchingServiceClient
-		replicationDLQHandler      replication.DLQHandler
-		persistenceVisibilityMgr   manager.VisibilityManager
-		searchAttributesValidator  *searchattribute.Validator
-		workflowDeleteManager      deletemanager.DeleteManager
-		eventSerializer            serialization.Serializer
-		workflowConsistencyChecker api.WorkflowConsistencyChecker
-		versionChecker             headers.VersionChecker
-		tracer                     trace.Tracer
-		taskCategoryRegistry       tasks.TaskCategoryRegistry
-		commandHandlerRegistry     *workflow.CommandHandlerRegistry
-		stateMachineEnvironment    *stateMachineEnvironment
-		replicationProgressCache   replication.ProgressCache
-		syncStateRetriever         replication.SyncStateRetriever
+		status             int32
+		currentClusterName string
+		shardContext       shard.Context
+		timeSource         clock.TimeSource
+		clusterMetadata    cluster.Metadata
+		executionManager   persistence.ExecutionManager
+		queueProcessors    map[tasks.Category]queues.Queue
+		// outboundQueueCircuitBreakerPool is shared by all shards on the host.
+		outboundQueueCircuitBreakerPool *queues.CircuitBreakerPool
+		replicationAckMgr               replication.AckManager
+		nDCHistoryReplicator            ndc.HistoryReplicator
+		nDCHistoryImporter              ndc.HistoryImporter
+		nDCActivityStateReplicator      ndc.ActivityStateReplicator
+		nDCWorkflowStateReplicator      ndc.WorkflowStateReplicator
+		nDCHSMStateReplicator           ndc.HSMStateReplicator
+		replicationProcessorMgr         replication.TaskProcessor
+		eventNotifier                   events.Notifier
+		tokenSerializer                 common.TaskTokenSerializer
+		metricsHandler                  metrics.Handler
+		logger                          log.Logger
+		throttledLogger                 log.Logger
+		config                          *configs.Config
+		workflowRebuilder               workflowRebuilder
+		workflowResetter                ndc.WorkflowResetter
+		sdkClientFactory                sdk.ClientFactory
+		eventsReapplier                 ndc.EventsReapplier
+		matchingClient                  matchingservice.MatchingServiceClient
+		rawMatchingClient               matchingservice.MatchingServiceClient
+		replicationDLQHandler           replication.DLQHandler
+		persistenceVisibilityMgr        manager.VisibilityManager
+		searchAttributesValidator       *searchattribute.Validator
+		workflowDeleteManager           deletemanager.DeleteManager
+		eventSerializer                 serialization.Serializer
+		workflowConsistencyChecker      api.WorkflowConsistencyChecker
+		versionChecker                  headers.VersionChecker
+		tracer                          trace.Tracer
+		taskCategoryRegistry            tasks.TaskCategoryRegistry
+		commandHandlerRegistry          *workflow.CommandHandlerRegistry
+		stateMachineEnvironment         *stateMachineEnvironment
+		replicationProgressCache        replication.ProgressCache
+		syncStateRetriever              replication.SyncStateRetriever
 	}
 )
 
@@ -175,6 +186,7 @@ func NewEngineWithShardContext(
 	taskCategoryRegistry tasks.TaskCategoryRegistry,
 	dlqWriter replication.DLQWriter,
 	commandHandlerRegistry *workflow.CommandHandlerRegistry,
+	outboundQueueCircuitBreakerPool *queues.CircuitBreakerPool,
 ) shard.Engine {
 	currentClusterName := shard.GetClusterMetadata().GetCurrentClusterName()
 
@@ -190,29 +202,30 @@ func NewEngineWithShardContext(
 	)
 
 	historyEngImpl := &historyEngineImpl{
-		status:                     common.DaemonStatusInitialized,
-		currentClusterName:         currentClusterName,
-		shardContext:               shard,
-		clusterMetadata:            shard.GetClusterMetadata(),
-		timeSource:                 shard.GetTimeSource(),
-		executionManager:           executionManager,
-		tokenSerializer:            common.NewProtoTaskTokenSerializer(),
-		logger:                     log.With(logger, tag.ComponentHistoryEngine),
-		throttledLogger:            log.With(shard.GetThrottledLogger(), tag.ComponentHistoryEngine),
-		metricsHandler:             shard.GetMetricsHandler(),
-		eventNotifier:              eventNotifier,
-		config:                     config,
-		sdkClientFactory:           sdkClientFactory,
-		matchingClient:             matchingClient,
-		rawMatchingClient:          rawMatchingClient,
-		persistenceVisibilityMgr:   persistenceVisibilityMgr,
-		workflowDeleteManager:      workflowDeleteManager,
-		eventSerializer:            eventSerializer,
-		workflowConsistencyChecker: workflowConsistencyChecker,
-		versionChecker:             headers.NewDefaultVersionChecker(),
-		tracer:                     tracerProvider.Tracer(consts.LibraryName),
-		taskCategoryRegistry:       taskCategoryRegistry,
-		commandHandlerRegistry:     commandHandlerRegistry,
+		status:                          common.DaemonStatusInitialized,
+		currentClusterName:              currentClusterName,
+		shardContext:                    shard,
+		clusterMetadata:                 shard.GetClusterMetadata(),
+		timeSource:                      shard.GetTimeSource(),
+		executionManager:                executionManager,
+		tokenSerializer:                 common.NewProtoTaskTokenSerializer(),
+		logger:                          log.With(logger, tag.ComponentHistoryEngine),
+		throttledLogger:                 log.With(shard.GetThrottledLogger(), tag.ComponentHistoryEngine),
+		metricsHandler:                  shard.GetMetricsHandler(),
+		eventNotifier:                   eventNotifier,
+		config:                          config,
+		sdkClientFactory:                sdkClientFactory,
+		matchingClient:                  matchingClient,
+		rawMatchingClient:               rawMatchingClient,
+		persistenceVisibilityMgr:        persistenceVisibilityMgr,
+		workflowDeleteManager:           workflowDeleteManager,
+		eventSerializer:                 eventSerializer,
+		workflowConsistencyChecker:      workflowConsistencyChecker,
+		versionChecker:                  headers.NewDefaultVersionChecker(),
+		tracer:                          tracerProvider.Tracer(consts.LibraryName),
+		taskCategoryRegistry:            taskCategoryRegistry,
+		commandHandlerRegistry:          commandHandlerRegistry,
+		outboundQueueCircuitBreakerPool: outboundQueueCircuitBreakerPool,
 		stateMachineEnvironment: &stateMachineEnvironment{
 			shardContext:   shard,
 			cache:          workflowCache,
@@ -485,6 +498,46 @@ func (e *historyEngineImpl) DescribeMutableState(
 	return describemutablestate.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
 }
 
+// DescribeStateMachines returns the hierarchical state machine tree of a workflow execution.
+func (e *historyEngineImpl) DescribeStateMachines(
+	ctx context.Context,
+	request *historyservice.DescribeStateMachinesRequest,
+) (*historyservice.DescribeStateMachinesResponse, error) {
+	return describestatemachines.Invoke(ctx, request, e.workflowConsistencyChecker)
+}
+
+// DeleteStateMachineNode deletes a state machine and its descendants from a workflow execution.
+func (e *historyEngineImpl) DeleteStateMachineNode(
+	ctx context.Context,
+	request *historyservice.DeleteStateMachineNodeRequest,
+) (*historyservice.DeleteStateMachineNodeResponse, error) {
+	return deletestatemachinenode.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
+}
+
+// ScheduleDelayedSignal creates a delayed signal on the given workflow execution.
+func (e *historyEngineImpl) ScheduleDelayedSignal(
+	ctx context.Context,
+	request *historyservice.ScheduleDelayedSignalRequest,
+) (*historyservice.ScheduleDelayedSignalResponse, error) {
+	return scheduledelayedsignal.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
+}
+
+// CancelDelayedSignal cancels a delayed signal hosted by the given workflow execution.
+func (e *historyEngineImpl) CancelDelayedSignal(
+	ctx context.Context,
+	request *historyservice.CancelDelayedSignalRequest,
+) (*historyservice.CancelDelayedSignalResponse, error) {
+	return canceldelayedsignal.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
+}
+
+// ListDelayedSignals lists the delayed signals hosted by the given workflow execution.
+func (e *historyEngineImpl) ListDelayedSignals(
+	ctx context.Context,
+	request *historyservice.ListDelayedSignalsRequest,
+) (*historyservice.ListDelayedSignalsResponse, error) {
+	return listdelayedsignals.Invoke(ctx, request, e.workflowConsistencyChecker)
+}
+
 // ResetStickyTaskQueue reset the volatile information in mutable state of a given workflow.
 // Volatile information are the information related to client, such as:
 // 1. StickyTaskQueue
@@ -1029,6 +1082,55 @@ func (e *historyEngineImpl) ListTasks(
 	)
 }
 
+func (e *historyEngineImpl) DescribeQueue(
+	ctx context.Context,
+	request *historyservice.DescribeQueueRequest,
+) (*historyservice.DescribeQueueResponse, error) {
+	return describequeue.Invoke(
+		ctx,
+		e.taskCategoryRegistry,
+		e.queueProcessors,
+		request,
+	)
+}
+
+func (e *historyEngineImpl) MitigateQueue(
+	ctx context.Context,
+	request *historyservice.MitigateQueueRequest,
+) (*historyservice.MitigateQueueResponse, error) {
+	return mitigatequeue.Invoke(
+		ctx,
+		e.taskCategoryRegistry,
+		e.queueProcessors,
+		request,
+	)
+}
+
+func (e *historyEngineImpl) DescribeOutboundQueue(
+	ctx context.Context,
+	request *historyservice.DescribeOutboundQueueRequest,
+) (*historyservice.DescribeOutboundQueueResponse, error) {
+	return describeoutboundqueue.Invoke(
+		ctx,
+		e.shardContext.GetNamespaceRegistry(),
+		e.queueProcessors,
+		e.outboundQueueCircuitBreakerPool,
+		request,
+	)
+}
+
+func (e *historyEngineImpl) UpdateOutboundQueueCircuitBreaker(
+	ctx context.Context,
+	request *historyservice.UpdateOutboundQueueCircuitBreakerRequest,
+) (*historyservice.UpdateOutboundQueueCircuitBreakerResponse, error) {
+	return updatecircuitbreaker.Invoke(
+		ctx,
+		e.queueProcessors,
+		e.outboundQueueCircuitBreakerPool,
+		request,
+	)
+}
+
 // StateMachineEnvironment implements shard.Engine.
 func (e *historyEngineImpl) StateMachineEnvironment() hsm.Environment {
 	return e.stateMachineEnvironment
@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/events"
+	"go.temporal.io/server/service/history/queues"
 	"go.temporal.io/server/service/history/replication"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
@@ -66,6 +67,7 @@ type (
 		TaskCategoryRegistry            tasks.TaskCategoryRegistry
 		ReplicationDLQWriter            replication.DLQWriter
 		CommandHandlerRegistry          *workflow.CommandHandlerRegistry
+		OutboundQueueCircuitBreakerPool *queues.CircuitBreakerPool
 	}
 
 	historyEngineFactory struct {
@@ -105,5 +107,6 @@ func (f *historyEngineFactory) CreateEngine(
 		f.TaskCategoryRegistry,
 		f.ReplicationDLQWriter,
 		f.CommandHandlerRegistry,
+		f.OutboundQueueCircuitBreakerPool,
 	)
 }
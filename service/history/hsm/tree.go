@@ -327,6 +327,29 @@ func (n *Node) AddChild(key Key, data any) (*Node, error) {
 	return node, nil
 }
 
+// DeleteChild removes an immediate child and all of its descendants from a node, marking the node as dirty.
+// Tasks referencing the deleted nodes are considered stale and dropped when executed.
+// Returns [ErrStateMachineNotFound] if a child with the given key does not exist.
+//
+// NOTE: Deletion is meant for manual intervention only. It does not produce transition outputs and is not replicated,
+// callers must not delete nodes of workflows that are replicated to other clusters.
+func (n *Node) DeleteChild(key Key) error {
+	machines, ok := n.persistence.Children[key.Type]
+	if !ok {
+		return fmt.Errorf("%w: %v", ErrStateMachineNotFound, key)
+	}
+	if _, ok := machines.MachinesById[key.ID]; !ok {
+		return fmt.Errorf("%w: %v", ErrStateMachineNotFound, key)
+	}
+	delete(machines.MachinesById, key.ID)
+	if len(machines.MachinesById) == 0 {
+		delete(n.persistence.Children, key.Type)
+	}
+	delete(n.cache.children, key)
+	n.cache.dirty = true
+	return nil
+}
+
 // AddHistoryEvent adds a history event to be committed at the end of the current transaction.
 // Must be called within an [Environment.Access] function block with write access.
 func (n *Node) AddHistoryEvent(t enumspb.EventType, setAttributes func(*historypb.HistoryEvent)) *historypb.HistoryEvent {
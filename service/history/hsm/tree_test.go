@@ -211,6 +211,34 @@ func TestNode_AddChild(t *testing.T) {
 	require.ErrorIs(t, err, hsm.ErrStateMachineAlreadyExists)
 }
 
+func TestNode_DeleteChild(t *testing.T) {
+	root, err := hsm.NewRoot(reg, def1.Type(), hsmtest.NewData(hsmtest.State1), make(map[string]*persistencespb.StateMachineMap), &backend{})
+	require.NoError(t, err)
+	l1, err := root.AddChild(hsm.Key{Type: def1.Type(), ID: "l1"}, hsmtest.NewData(hsmtest.State1))
+	require.NoError(t, err)
+	_, err = l1.AddChild(hsm.Key{Type: def1.Type(), ID: "l2"}, hsmtest.NewData(hsmtest.State1))
+	require.NoError(t, err)
+	_, err = root.AddChild(hsm.Key{Type: def1.Type(), ID: "sibling"}, hsmtest.NewData(hsmtest.State1))
+	require.NoError(t, err)
+	root.ClearTransactionState()
+
+	err = root.DeleteChild(hsm.Key{Type: def1.Type(), ID: "not-found"})
+	require.ErrorIs(t, err, hsm.ErrStateMachineNotFound)
+	require.False(t, root.Dirty())
+
+	require.NoError(t, root.DeleteChild(hsm.Key{Type: def1.Type(), ID: "l1"}))
+	require.True(t, root.Dirty())
+	_, err = root.Child([]hsm.Key{{Type: def1.Type(), ID: "l1"}})
+	require.ErrorIs(t, err, hsm.ErrStateMachineNotFound)
+	_, err = root.Child([]hsm.Key{{Type: def1.Type(), ID: "l1"}, {Type: def1.Type(), ID: "l2"}})
+	require.ErrorIs(t, err, hsm.ErrStateMachineNotFound)
+	_, err = root.Child([]hsm.Key{{Type: def1.Type(), ID: "sibling"}})
+	require.NoError(t, err)
+
+	require.NoError(t, root.DeleteChild(hsm.Key{Type: def1.Type(), ID: "sibling"}))
+	require.Empty(t, root.InternalRepr().Children)
+}
+
 func TestNode_Child(t *testing.T) {
 	root, err := hsm.NewRoot(reg, def1.Type(), hsmtest.NewData(hsmtest.State1), make(map[string]*persistencespb.StateMachineMap), &backend{})
 	require.NoError(t, err)
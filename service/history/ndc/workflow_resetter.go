@@ -36,6 +36,8 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
+	updatepb "go.temporal.io/api/update/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/collection"
@@ -138,6 +140,7 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	currentMutableState := currentWorkflow.GetMutableState()
 	currentUpdateRegistry := currentWorkflow.GetContext().UpdateRegistry(ctx, nil)
 	if currentMutableState.IsWorkflowExecutionRunning() {
+		durableUpdateRequests := durablyAdmittedUpdateRequests(currentMutableState)
 		if err := r.terminateWorkflow(
 			currentMutableState,
 			resetReason,
@@ -177,6 +180,9 @@ func (r *workflowResetterImpl) ResetWorkflow(
 						return err
 					}
 				}
+				if err := reapplyDurablyAdmittedUpdates(resetMutableState, durableUpdateRequests, resetReapplyExcludeTypes); err != nil {
+					return err
+				}
 			}
 			return nil
 		}
@@ -833,6 +839,42 @@ func reapplyEvents(
 	return reappliedEvents, nil
 }
 
+// durablyAdmittedUpdateRequests returns the requests of the durably admitted updates of mutableState in the order they
+// were admitted. These requests are only stored in mutable state, so they can't be reapplied from history.
+func durablyAdmittedUpdateRequests(mutableState workflow.MutableState) []*updatepb.Request {
+	var requests []*updatepb.Request
+	mutableState.VisitUpdates(func(_ string, updInfo *persistencespb.UpdateInfo) {
+		if inline := updInfo.GetAdmission().GetInlineRequest(); inline != nil {
+			requests = append(requests, inline.GetRequest())
+		}
+	})
+	return requests
+}
+
+// reapplyDurablyAdmittedUpdates admits the durably admitted updates of the current run on the reset run. Like the
+// reapplied UpdateAccepted events, they are converted to UpdateAdmitted events.
+func reapplyDurablyAdmittedUpdates(
+	mutableState workflow.MutableState,
+	requests []*updatepb.Request,
+	resetReapplyExcludeTypes map[enumspb.ResetReapplyExcludeType]bool,
+) error {
+	if resetReapplyExcludeTypes[enumspb.RESET_REAPPLY_EXCLUDE_TYPE_UPDATE] {
+		return nil
+	}
+	for _, request := range requests {
+		if _, ok := mutableState.GetExecutionInfo().GetUpdateInfos()[request.GetMeta().GetUpdateId()]; ok {
+			continue
+		}
+		if _, err := mutableState.AddWorkflowExecutionUpdateAdmittedEvent(
+			request,
+			enumspb.UPDATE_ADMITTED_EVENT_ORIGIN_REAPPLY,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *workflowResetterImpl) getPaginationFn(
 	ctx context.Context,
 	firstEventID int64,
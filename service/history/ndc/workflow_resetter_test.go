@@ -922,6 +922,55 @@ func (s *workflowResetterSuite) TestReapplyEvents() {
 	s.NoError(err)
 }
 
+func (s *workflowResetterSuite) TestReapplyDurablyAdmittedUpdates() {
+	inlineUpdateInfo := func(updateID string) *persistencespb.UpdateInfo {
+		return &persistencespb.UpdateInfo{
+			Value: &persistencespb.UpdateInfo_Admission{
+				Admission: &persistencespb.UpdateAdmissionInfo{
+					Location: &persistencespb.UpdateAdmissionInfo_InlineRequest_{
+						InlineRequest: &persistencespb.UpdateAdmissionInfo_InlineRequest{
+							Request: &updatepb.Request{Meta: &updatepb.Meta{UpdateId: updateID}},
+						},
+					},
+				},
+			},
+		}
+	}
+	currentMutableState := workflow.NewMockMutableState(s.controller)
+	currentMutableState.EXPECT().VisitUpdates(gomock.Any()).DoAndReturn(
+		func(visitor func(updID string, updInfo *persistencespb.UpdateInfo)) {
+			visitor("update-accepted", &persistencespb.UpdateInfo{
+				Value: &persistencespb.UpdateInfo_Acceptance{Acceptance: &persistencespb.UpdateAcceptanceInfo{EventId: 10}},
+			})
+			visitor("update-b", inlineUpdateInfo("update-b"))
+			visitor("update-reapplied", inlineUpdateInfo("update-reapplied"))
+			visitor("update-a", inlineUpdateInfo("update-a"))
+		})
+	requests := durablyAdmittedUpdateRequests(currentMutableState)
+	s.Len(requests, 3)
+
+	resetMutableState := workflow.NewMockMutableState(s.controller)
+	resetMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+		UpdateInfos: map[string]*persistencespb.UpdateInfo{"update-reapplied": {}},
+	}).AnyTimes()
+	gomock.InOrder(
+		resetMutableState.EXPECT().AddWorkflowExecutionUpdateAdmittedEvent(
+			requests[0],
+			enumspb.UPDATE_ADMITTED_EVENT_ORIGIN_REAPPLY,
+		).Return(&historypb.HistoryEvent{}, nil),
+		resetMutableState.EXPECT().AddWorkflowExecutionUpdateAdmittedEvent(
+			requests[2],
+			enumspb.UPDATE_ADMITTED_EVENT_ORIGIN_REAPPLY,
+		).Return(&historypb.HistoryEvent{}, nil),
+	)
+	s.NoError(reapplyDurablyAdmittedUpdates(resetMutableState, requests, nil))
+
+	// excluded updates are not reapplied
+	s.NoError(reapplyDurablyAdmittedUpdates(resetMutableState, requests, map[enumspb.ResetReapplyExcludeType]bool{
+		enumspb.RESET_REAPPLY_EXCLUDE_TYPE_UPDATE: true,
+	}))
+}
+
 func (s *workflowResetterSuite) TestPagination() {
 	firstEventID := common.FirstEventID
 	nextEventID := int64(101)
@@ -51,6 +51,7 @@ type outboundQueueFactoryParams struct {
 	fx.In
 
 	QueueFactoryBaseParams
+	CircuitBreakerPool *queues.CircuitBreakerPool
 }
 
 type groupLimiter struct {
@@ -120,33 +121,6 @@ func NewOutboundQueueFactory(params outboundQueueFactoryParams) QueueFactory {
 		},
 	)
 
-	circuitBreakerPool := collection.NewOnceMap(
-		func(key tasks.TaskGroupNamespaceIDAndDestination) circuitbreaker.TwoStepCircuitBreaker {
-			// This is intentionally not failing the function in case of error. The circuit breaker is
-			// agnostic to Task implementation, and thus the settings function is not expected to return
-			// an error. Also, in this case, if the namespace registry fails to get the name, then the
-			// task itself will fail when it is processed and tries to get the namespace name.
-			nsName := getNamespaceNameOrDefault(
-				params.NamespaceRegistry,
-				key.NamespaceID,
-				"",
-				metricsHandler,
-			)
-			cb := circuitbreaker.NewTwoStepCircuitBreakerWithDynamicSettings(circuitbreaker.Settings{
-				Name: fmt.Sprintf(
-					"circuit_breaker:%s:%s:%s",
-					key.TaskGroup,
-					key.NamespaceID,
-					key.Destination,
-				),
-			})
-			initial, cancel := params.Config.OutboundQueueCircuitBreakerSettings(nsName, key.Destination, cb.UpdateSettings)
-			cb.UpdateSettings(initial)
-			_ = cancel // OnceMap never deletes anything. use this if we support deletion
-			return cb
-		},
-	)
-
 	grouper := queues.GrouperStateMachineNamespaceIDAndDestination{}
 	f := &outboundQueueFactory{
 		outboundQueueFactoryParams: params,
@@ -184,7 +158,7 @@ func NewOutboundQueueFactory(params outboundQueueFactoryParams) QueueFactory {
 							ctasks.RunnableTask{
 								Task: queues.NewCircuitBreakerExecutable(
 									e,
-									circuitBreakerPool.Get(key),
+									params.CircuitBreakerPool.Get(key),
 									taggedMetricsHandler,
 								),
 							},
@@ -227,6 +201,38 @@ func NewOutboundQueueFactory(params outboundQueueFactoryParams) QueueFactory {
 	return f
 }
 
+// OutboundQueueCircuitBreakerPoolProvider provides the circuit breakers of the outbound queue destinations. The pool is
+// shared by the outbound queue factory and the admin APIs for inspecting and overriding circuit breakers.
+func OutboundQueueCircuitBreakerPoolProvider(params QueueFactoryBaseParams) *queues.CircuitBreakerPool {
+	metricsHandler := getOutbountQueueProcessorMetricsHandler(params.MetricsHandler)
+	return queues.NewCircuitBreakerPool(
+		func(key tasks.TaskGroupNamespaceIDAndDestination) *circuitbreaker.TwoStepCircuitBreakerWithDynamicSettings {
+			// This is intentionally not failing the function in case of error. The circuit breaker is
+			// agnostic to Task implementation, and thus the settings function is not expected to return
+			// an error. Also, in this case, if the namespace registry fails to get the name, then the
+			// task itself will fail when it is processed and tries to get the namespace name.
+			nsName := getNamespaceNameOrDefault(
+				params.NamespaceRegistry,
+				key.NamespaceID,
+				"",
+				metricsHandler,
+			)
+			cb := circuitbreaker.NewTwoStepCircuitBreakerWithDynamicSettings(circuitbreaker.Settings{
+				Name: fmt.Sprintf(
+					"circuit_breaker:%s:%s:%s",
+					key.TaskGroup,
+					key.NamespaceID,
+					key.Destination,
+				),
+			})
+			initial, cancel := params.Config.OutboundQueueCircuitBreakerSettings(nsName, key.Destination, cb.UpdateSettings)
+			cb.UpdateSettings(initial)
+			_ = cancel // the pool never deletes anything. use this if we support deletion
+			return cb
+		},
+	)
+}
+
 // Start implements QueueFactory.
 func (f *outboundQueueFactory) Start() {
 	f.hostScheduler.Start()
@@ -102,6 +102,7 @@ var QueueModule = fx.Options(
 			return tqm
 		},
 		queues.NewDLQWriter,
+		OutboundQueueCircuitBreakerPoolProvider,
 		fx.Annotated{
 			Group:  QueueFactoryFxGroup,
 			Target: NewTransferQueueFactory,
@@ -24,6 +24,11 @@
 
 package queues
 
+import (
+	"go.temporal.io/server/common/predicates"
+	"go.temporal.io/server/service/history/tasks"
+)
+
 type (
 	// Action is a set of operations that can be run on a ReaderGroup.
 	// It is created and run by Mitigator upon receiving an Alert.
@@ -32,3 +37,36 @@ type (
 		Run(*ReaderGroup)
 	}
 )
+
+// splitSlicesByPredicate splits every slice in the reader for which predicateFn returns a predicate
+// into a part matching that predicate and a remaining part. The remaining parts stay in the reader,
+// while the matching parts are removed from the reader and returned in order.
+func splitSlicesByPredicate(
+	reader Reader,
+	predicateFn func(Slice) (tasks.Predicate, bool),
+) []Slice {
+	var splitSlices []Slice
+	reader.SplitSlices(func(s Slice) ([]Slice, bool) {
+		predicate, ok := predicateFn(s)
+		if !ok {
+			return nil, false
+		}
+
+		scopePredicate := s.Scope().Predicate
+		matchingPredicate := tasks.AndPredicates(scopePredicate, predicate)
+		if predicates.Empty[tasks.Task]().Equals(matchingPredicate) {
+			// slice contains no matching task
+			return nil, false
+		}
+		if matchingPredicate.Equals(scopePredicate) {
+			// slice only contains matching tasks, move it as a whole
+			splitSlices = append(splitSlices, s)
+			return nil, true
+		}
+
+		split, remain := s.SplitByPredicate(predicate)
+		splitSlices = append(splitSlices, split)
+		return []Slice{remain}, true
+	})
+	return splitSlices
+}
@@ -0,0 +1,168 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"time"
+
+	"go.temporal.io/server/common/predicates"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+var _ Action = (*actionMoveNamespace)(nil)
+var _ Action = (*actionThrottleNamespace)(nil)
+
+type (
+	// actionMoveNamespace splits slices in the source reader by namespace and moves
+	// the parts containing tasks of the given namespaces to the target reader,
+	// so that those namespaces no longer block task loading for other namespaces
+	// in the source reader.
+	// If sliceIndex is non-negative, only the slice at that position is split.
+	//
+	// This action is only triggered by operators, see OperatorAction.
+	actionMoveNamespace struct {
+		name           string
+		namespaceIDs   []string
+		sourceReaderID int64
+		targetReaderID int64
+		sliceIndex     int
+	}
+
+	// actionThrottleNamespace unloads tasks of the given namespaces from all other readers,
+	// moves them to the target reader and pauses the target reader for the given duration.
+	// Tasks of other namespaces in the target reader are moved to the reader before it first,
+	// so the pause only applies to the throttled namespaces.
+	//
+	// NOTE: the pause is not persisted and is lifted upon shard reload. Automatic mitigations
+	// running during the pause may still move slices of other namespaces to the target reader.
+	//
+	// This action is only triggered by operators, see OperatorAction.
+	actionThrottleNamespace struct {
+		namespaceIDs   []string
+		targetReaderID int64
+		duration       time.Duration
+	}
+)
+
+func newSplitSliceAction(
+	namespaceIDs []string,
+	readerID int64,
+	sliceIndex int,
+) *actionMoveNamespace {
+	return &actionMoveNamespace{
+		name:           "split-slice",
+		namespaceIDs:   namespaceIDs,
+		sourceReaderID: readerID,
+		targetReaderID: readerID + 1,
+		sliceIndex:     sliceIndex,
+	}
+}
+
+func newMoveNamespaceAction(
+	namespaceIDs []string,
+	sourceReaderID int64,
+	targetReaderID int64,
+) *actionMoveNamespace {
+	return &actionMoveNamespace{
+		name:           "move-namespace",
+		namespaceIDs:   namespaceIDs,
+		sourceReaderID: sourceReaderID,
+		targetReaderID: targetReaderID,
+		sliceIndex:     -1,
+	}
+}
+
+func (a *actionMoveNamespace) Name() string {
+	return a.name
+}
+
+func (a *actionMoveNamespace) Run(readerGroup *ReaderGroup) {
+	reader, ok := readerGroup.ReaderByID(a.sourceReaderID)
+	if !ok {
+		return
+	}
+
+	predicate := tasks.NewNamespacePredicate(a.namespaceIDs)
+	sliceIndex := -1
+	splitSlices := splitSlicesByPredicate(reader, func(_ Slice) (tasks.Predicate, bool) {
+		sliceIndex++
+		return predicate, a.sliceIndex < 0 || a.sliceIndex == sliceIndex
+	})
+	if len(splitSlices) == 0 {
+		return
+	}
+
+	targetReader := readerGroup.GetOrCreateReader(a.targetReaderID)
+	targetReader.MergeSlices(splitSlices...)
+}
+
+func newThrottleNamespaceAction(
+	namespaceIDs []string,
+	targetReaderID int64,
+	duration time.Duration,
+) *actionThrottleNamespace {
+	return &actionThrottleNamespace{
+		namespaceIDs:   namespaceIDs,
+		targetReaderID: targetReaderID,
+		duration:       duration,
+	}
+}
+
+func (a *actionThrottleNamespace) Name() string {
+	return "throttle-namespace"
+}
+
+func (a *actionThrottleNamespace) Run(readerGroup *ReaderGroup) {
+	predicate := tasks.NewNamespacePredicate(a.namespaceIDs)
+	splitNamespace := func(_ Slice) (tasks.Predicate, bool) { return predicate, true }
+
+	var throttledSlices []Slice
+	for readerID, reader := range readerGroup.Readers() {
+		if readerID == a.targetReaderID {
+			continue
+		}
+
+		for _, split := range splitSlicesByPredicate(reader, splitNamespace) {
+			split.Clear()
+			throttledSlices = append(throttledSlices, split)
+		}
+	}
+
+	targetReader := readerGroup.GetOrCreateReader(a.targetReaderID)
+	throttledSlices = append(throttledSlices, splitSlicesByPredicate(targetReader, splitNamespace)...)
+
+	// whatever is left in the target reader belongs to other namespaces,
+	// keep loading those tasks while the target reader is paused.
+	otherSlices := splitSlicesByPredicate(targetReader, func(_ Slice) (tasks.Predicate, bool) {
+		return predicates.Universal[tasks.Task](), true
+	})
+	if len(otherSlices) != 0 {
+		readerGroup.GetOrCreateReader(a.targetReaderID - 1).MergeSlices(otherSlices...)
+	}
+
+	// slices from different readers may overlap, merge them one by one.
+	for _, split := range throttledSlices {
+		targetReader.MergeSlices(split)
+	}
+	targetReader.Pause(a.duration)
+}
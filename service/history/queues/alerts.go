@@ -61,3 +61,16 @@ const (
 	AlertTypeReaderStuck
 	AlertTypeSliceCount
 )
+
+func (a AlertType) String() string {
+	switch a {
+	case AlertTypeQueuePendingTaskCount:
+		return "QueuePendingTaskCount"
+	case AlertTypeReaderStuck:
+		return "ReaderStuck"
+	case AlertTypeSliceCount:
+		return "SliceCount"
+	default:
+		return "Unspecified"
+	}
+}
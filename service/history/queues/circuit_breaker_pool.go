@@ -0,0 +1,90 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common/circuitbreaker"
+	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	// DestinationCircuitBreaker is the circuit breaker for tasks of a task group and namespace sent to a destination.
+	// On top of the circuit breaker state, it keeps track of the last error returned by the destination so operators
+	// can tell why the circuit breaker tripped.
+	DestinationCircuitBreaker struct {
+		*circuitbreaker.TwoStepCircuitBreakerWithDynamicSettings
+		lastError atomic.Pointer[DestinationError]
+	}
+
+	DestinationError struct {
+		Err  error
+		Time time.Time
+	}
+
+	// CircuitBreakerPool holds the circuit breakers of all destinations of the outbound queue.
+	// Circuit breakers are created on first use and shared by all shards on a host.
+	CircuitBreakerPool struct {
+		breakers *collection.OnceMap[tasks.TaskGroupNamespaceIDAndDestination, *DestinationCircuitBreaker]
+	}
+
+	destinationErrorRecorder interface {
+		RecordError(err error, now time.Time)
+	}
+)
+
+var _ destinationErrorRecorder = (*DestinationCircuitBreaker)(nil)
+
+func NewCircuitBreakerPool(
+	construct func(key tasks.TaskGroupNamespaceIDAndDestination) *circuitbreaker.TwoStepCircuitBreakerWithDynamicSettings,
+) *CircuitBreakerPool {
+	return &CircuitBreakerPool{
+		breakers: collection.NewOnceMap(func(key tasks.TaskGroupNamespaceIDAndDestination) *DestinationCircuitBreaker {
+			return &DestinationCircuitBreaker{
+				TwoStepCircuitBreakerWithDynamicSettings: construct(key),
+			}
+		}),
+	}
+}
+
+// Get returns the circuit breaker for the given key, creating it if needed.
+func (p *CircuitBreakerPool) Get(key tasks.TaskGroupNamespaceIDAndDestination) *DestinationCircuitBreaker {
+	return p.breakers.Get(key)
+}
+
+// Range calls fn for each circuit breaker created so far until fn returns false.
+func (p *CircuitBreakerPool) Range(fn func(key tasks.TaskGroupNamespaceIDAndDestination, cb *DestinationCircuitBreaker) bool) {
+	p.breakers.Range(fn)
+}
+
+func (cb *DestinationCircuitBreaker) RecordError(err error, now time.Time) {
+	cb.lastError.Store(&DestinationError{Err: err, Time: now})
+}
+
+// LastError returns the last error returned by the destination, or nil if there was none.
+func (cb *DestinationCircuitBreaker) LastError() *DestinationError {
+	return cb.lastError.Load()
+}
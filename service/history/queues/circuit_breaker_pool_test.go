@@ -0,0 +1,75 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/circuitbreaker"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/service/history/tasks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCircuitBreakerPool_RecordsDestinationError(t *testing.T) {
+	controller := gomock.NewController(t)
+	pool := NewCircuitBreakerPool(func(_ tasks.TaskGroupNamespaceIDAndDestination) *circuitbreaker.TwoStepCircuitBreakerWithDynamicSettings {
+		cb := circuitbreaker.NewTwoStepCircuitBreakerWithDynamicSettings(circuitbreaker.Settings{})
+		cb.UpdateSettings(dynamicconfig.CircuitBreakerSettings{})
+		return cb
+	})
+	key := tasks.TaskGroupNamespaceIDAndDestination{
+		TaskGroup:   "group",
+		NamespaceID: "namespace-id",
+		Destination: "destination",
+	}
+	cb := pool.Get(key)
+	require.Same(t, cb, pool.Get(key))
+	require.Nil(t, cb.LastError())
+
+	innerErr := errors.New("connection refused")
+	executable := NewMockExecutable(controller)
+	executable.EXPECT().Execute().Return(NewDestinationDownError("unreachable", innerErr))
+	err := NewCircuitBreakerExecutable(executable, cb, metrics.NoopMetricsHandler).Execute()
+	require.ErrorIs(t, err, innerErr)
+
+	lastError := cb.LastError()
+	require.NotNil(t, lastError)
+	require.ErrorIs(t, lastError.Err, innerErr)
+	require.Equal(t, uint32(1), cb.Counts().TotalFailures)
+
+	// other errors don't count as destination errors
+	executable.EXPECT().Execute().Return(errors.New("internal error"))
+	_ = NewCircuitBreakerExecutable(executable, cb, metrics.NoopMetricsHandler).Execute()
+	require.Same(t, lastError, cb.LastError())
+
+	var keys []tasks.TaskGroupNamespaceIDAndDestination
+	pool.Range(func(key tasks.TaskGroupNamespaceIDAndDestination, _ *DestinationCircuitBreaker) bool {
+		keys = append(keys, key)
+		return true
+	})
+	require.Equal(t, []tasks.TaskGroupNamespaceIDAndDestination{key}, keys)
+}
@@ -0,0 +1,145 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"sort"
+	"time"
+
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	// QueueDescription is a point-in-time snapshot of the in-memory state of a queue,
+	// used for debugging stuck or slow queues.
+	QueueDescription struct {
+		Readers                      []ReaderDescription
+		PendingTaskCount             int
+		PendingTaskCountPerNamespace map[string]int
+		Alerts                       []AlertDescription
+	}
+
+	ReaderDescription struct {
+		ReaderID int64
+		// Watermark and StuckAttempts are only tracked for the default reader of scheduled queues.
+		Watermark     tasks.Key
+		StuckAttempts int
+		Slices        []SliceDescription
+	}
+
+	SliceDescription struct {
+		Scope                        Scope
+		PendingTaskCount             int
+		PendingTaskCountPerNamespace map[string]int
+	}
+
+	AlertDescription struct {
+		AlertType AlertType
+		// Pending is true if the alert has been raised but not yet resolved by the mitigator.
+		Pending bool
+		// SilencedUntil is non-zero if the alert is silenced after a mitigation attempt.
+		SilencedUntil time.Time
+	}
+)
+
+func (p *queueBase) Describe() *QueueDescription {
+	description := &QueueDescription{
+		PendingTaskCount:             p.monitor.GetTotalPendingTaskCount(),
+		PendingTaskCountPerNamespace: make(map[string]int),
+	}
+
+	readers := p.readerGroup.Readers()
+	readerIDs := make([]int64, 0, len(readers))
+	for readerID := range readers {
+		readerIDs = append(readerIDs, readerID)
+	}
+	sort.Slice(readerIDs, func(i, j int) bool { return readerIDs[i] < readerIDs[j] })
+
+	for _, readerID := range readerIDs {
+		readerDescription := ReaderDescription{
+			ReaderID:      readerID,
+			StuckAttempts: p.monitor.GetReaderStuckAttempts(readerID),
+		}
+		if watermark, ok := p.monitor.GetReaderWatermark(readerID); ok {
+			readerDescription.Watermark = watermark
+		}
+
+		readers[readerID].WalkSlices(func(s Slice) {
+			sliceDescription := SliceDescription{
+				Scope:                        s.Scope(),
+				PendingTaskCount:             p.monitor.GetSlicePendingTaskCount(s),
+				PendingTaskCountPerNamespace: make(map[string]int),
+			}
+			for namespaceID, count := range s.TaskStats().PendingPerNamespace {
+				if count <= 0 {
+					continue
+				}
+				sliceDescription.PendingTaskCountPerNamespace[namespaceID] = count
+				description.PendingTaskCountPerNamespace[namespaceID] += count
+			}
+			readerDescription.Slices = append(readerDescription.Slices, sliceDescription)
+		})
+
+		description.Readers = append(description.Readers, readerDescription)
+	}
+
+	alerts := make(map[AlertType]*AlertDescription)
+	for _, alertType := range p.monitor.GetPendingAlerts() {
+		alerts[alertType] = &AlertDescription{
+			AlertType: alertType,
+			Pending:   true,
+		}
+	}
+	for alertType, silencedUntil := range p.monitor.GetSilencedAlerts() {
+		alert, ok := alerts[alertType]
+		if !ok {
+			alert = &AlertDescription{AlertType: alertType}
+			alerts[alertType] = alert
+		}
+		alert.SilencedUntil = silencedUntil
+	}
+	for _, alert := range alerts {
+		description.Alerts = append(description.Alerts, *alert)
+	}
+	sort.Slice(description.Alerts, func(i, j int) bool {
+		return description.Alerts[i].AlertType < description.Alerts[j].AlertType
+	})
+
+	return description
+}
+
+// PendingTaskCountPerGroup returns the number of pending tasks keyed by the Grouper key of the queue, e.g. task
+// group, namespace ID and destination for the outbound queue.
+func (p *queueBase) PendingTaskCountPerGroup() map[any]int {
+	counts := make(map[any]int)
+	for _, reader := range p.readerGroup.Readers() {
+		reader.WalkSlices(func(s Slice) {
+			for key, count := range s.TaskStats().PendingPerKey {
+				if count > 0 {
+					counts[key] += count
+				}
+			}
+		})
+	}
+	return counts
+}
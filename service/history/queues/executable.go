@@ -846,6 +846,9 @@ func (e *CircuitBreakerExecutable) Execute() error {
 	var destinationDownErr *DestinationDownError
 	if errors.As(err, &destinationDownErr) {
 		err = destinationDownErr.Unwrap()
+		if recorder, ok := e.cb.(destinationErrorRecorder); ok {
+			recorder.RecordError(destinationDownErr, time.Now())
+		}
 	}
 
 	doneCb(destinationDownErr == nil)
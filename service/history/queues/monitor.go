@@ -52,6 +52,7 @@ type (
 
 		GetReaderWatermark(readerID int64) (tasks.Key, bool)
 		SetReaderWatermark(readerID int64, watermark tasks.Key)
+		GetReaderStuckAttempts(readerID int64) int
 
 		GetTotalSliceCount() int
 		GetSliceCount(readerID int64) int
@@ -62,6 +63,8 @@ type (
 
 		ResolveAlert(AlertType)
 		SilenceAlert(AlertType)
+		GetPendingAlerts() []AlertType
+		GetSilencedAlerts() map[AlertType]time.Time
 		AlertCh() <-chan *Alert
 		Close()
 	}
@@ -175,6 +178,16 @@ func (m *monitorImpl) GetReaderWatermark(readerID int64) (tasks.Key, bool) {
 	return stats.progress.watermark, true
 }
 
+func (m *monitorImpl) GetReaderStuckAttempts(readerID int64) int {
+	m.Lock()
+	defer m.Unlock()
+
+	if stats, ok := m.readerStats[readerID]; ok {
+		return stats.progress.attempts
+	}
+	return 0
+}
+
 func (m *monitorImpl) SetReaderWatermark(readerID int64, watermark tasks.Key) {
 	// TODO: currently only tracking default reader progress for scheduled queue
 	if readerID != DefaultReaderId || m.categoryType != tasks.CategoryTypeScheduled {
@@ -297,6 +310,31 @@ func (m *monitorImpl) SilenceAlert(alertType AlertType) {
 	m.silencedAlerts[alertType] = m.timeSource.Now().Add(defaultAlertSilenceDuration)
 }
 
+func (m *monitorImpl) GetPendingAlerts() []AlertType {
+	m.Lock()
+	defer m.Unlock()
+
+	alertTypes := make([]AlertType, 0, len(m.pendingAlerts))
+	for alertType := range m.pendingAlerts {
+		alertTypes = append(alertTypes, alertType)
+	}
+	return alertTypes
+}
+
+func (m *monitorImpl) GetSilencedAlerts() map[AlertType]time.Time {
+	m.Lock()
+	defer m.Unlock()
+
+	now := m.timeSource.Now()
+	silencedAlerts := make(map[AlertType]time.Time, len(m.silencedAlerts))
+	for alertType, expiration := range m.silencedAlerts {
+		if now.Before(expiration) {
+			silencedAlerts[alertType] = expiration
+		}
+	}
+	return silencedAlerts
+}
+
 func (m *monitorImpl) AlertCh() <-chan *Alert {
 	return m.alertCh
 }
@@ -273,3 +273,27 @@ func (s *monitorSuite) TestSilenceAlert() {
 		s.FailNow("Same alert type should fire after silence duration")
 	}
 }
+
+func (s *monitorSuite) TestGetAlerts() {
+	now := time.Now()
+	s.mockTimeSource.Update(now)
+
+	s.Empty(s.monitor.GetPendingAlerts())
+	s.Empty(s.monitor.GetSilencedAlerts())
+
+	sliceCount := s.monitor.options.SliceCountCriticalThreshold() * 2
+	s.monitor.SetSliceCount(DefaultReaderId, sliceCount) // trigger an alert
+
+	alert := <-s.alertCh
+	s.Equal([]AlertType{alert.AlertType}, s.monitor.GetPendingAlerts())
+
+	s.monitor.SilenceAlert(alert.AlertType)
+	s.Empty(s.monitor.GetPendingAlerts())
+	s.Equal(map[AlertType]time.Time{
+		alert.AlertType: now.Add(defaultAlertSilenceDuration),
+	}, s.monitor.GetSilencedAlerts())
+
+	// expired silences are not reported
+	s.mockTimeSource.Update(now.Add(defaultAlertSilenceDuration * 2))
+	s.Empty(s.monitor.GetSilencedAlerts())
+}
@@ -0,0 +1,220 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+)
+
+type (
+	// OperatorAction is a manual mitigation requested by an operator,
+	// for example during a noisy neighbor incident.
+	OperatorAction struct {
+		ActionType                                OperatorActionType
+		OperatorActionAttributesSplitSlice        *OperatorActionAttributesSplitSlice
+		OperatorActionAttributesMoveNamespace     *OperatorActionAttributesMoveNamespace
+		OperatorActionAttributesThrottleNamespace *OperatorActionAttributesThrottleNamespace
+	}
+
+	OperatorActionType int
+
+	// OperatorActionAttributesSplitSlice moves tasks of the given namespaces in one slice
+	// to the next reader.
+	OperatorActionAttributesSplitSlice struct {
+		NamespaceIDs []string
+		ReaderID     int64
+		// SliceIndex is the position of the slice in the reader, as returned by Queue.Describe().
+		SliceIndex int
+	}
+
+	// OperatorActionAttributesMoveNamespace moves tasks of the given namespaces in all slices
+	// of the source reader to the target reader.
+	OperatorActionAttributesMoveNamespace struct {
+		NamespaceIDs   []string
+		SourceReaderID int64
+		TargetReaderID int64
+	}
+
+	// OperatorActionAttributesThrottleNamespace unloads tasks of the given namespaces
+	// and moves them to the last reader, which is then paused for the given duration.
+	// Tasks of other namespaces are moved out of the last reader first, so they keep loading.
+	OperatorActionAttributesThrottleNamespace struct {
+		NamespaceIDs []string
+		Duration     time.Duration
+	}
+
+	operatorActionRequest struct {
+		action OperatorAction
+		// resultCh is buffered so that the event loop never blocks on a caller that has given up.
+		resultCh chan error
+	}
+)
+
+const (
+	OperatorActionTypeUnspecified OperatorActionType = iota
+	OperatorActionTypeSplitSlice
+	OperatorActionTypeMoveNamespace
+	OperatorActionTypeThrottleNamespace
+)
+
+var errQueueShutdown = serviceerror.NewUnavailable("queue is shutting down")
+
+// RunOperatorAction hands the action over to the queue event loop and waits for it to complete.
+// Running the action on the event loop makes sure it won't interleave with checkpointing and
+// automatic mitigations.
+func (p *queueBase) RunOperatorAction(
+	ctx context.Context,
+	action OperatorAction,
+) error {
+	request := &operatorActionRequest{
+		action:   action,
+		resultCh: make(chan error, 1),
+	}
+
+	select {
+	case p.operatorActionCh <- request:
+	case <-p.shutdownCh:
+		return errQueueShutdown
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-request.resultCh:
+		return err
+	case <-p.shutdownCh:
+		return errQueueShutdown
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *queueBase) handleOperatorAction(request *operatorActionRequest) {
+	action, err := p.newOperatorAction(request.action)
+	if err != nil {
+		request.resultCh <- err
+		return
+	}
+
+	runAction(action, p.readerGroup, p.metricsHandler, p.logger)
+
+	// checkpoint the action taken & update reader progress
+	p.checkpoint()
+
+	// reader may be able to load more tasks after progress is updated
+	p.notifyReaders()
+
+	request.resultCh <- nil
+}
+
+func (p *queueBase) newOperatorAction(operatorAction OperatorAction) (Action, error) {
+	maxReaderCount := int64(p.mitigator.maxReaderCount())
+	validateReaderID := func(readerID int64) error {
+		if readerID < DefaultReaderId || readerID >= maxReaderCount {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf(
+				"invalid reader ID %v, must be in range [%v, %v)", readerID, DefaultReaderId, maxReaderCount,
+			))
+		}
+		return nil
+	}
+	validateNamespaceIDs := func(namespaceIDs []string) error {
+		if len(namespaceIDs) == 0 {
+			return serviceerror.NewInvalidArgument("namespace IDs are not set")
+		}
+		return nil
+	}
+
+	switch operatorAction.ActionType {
+	case OperatorActionTypeSplitSlice:
+		attributes := operatorAction.OperatorActionAttributesSplitSlice
+		if attributes == nil {
+			return nil, serviceerror.NewInvalidArgument("split slice attributes are not set")
+		}
+		if err := validateNamespaceIDs(attributes.NamespaceIDs); err != nil {
+			return nil, err
+		}
+		if err := validateReaderID(attributes.ReaderID); err != nil {
+			return nil, err
+		}
+		if attributes.ReaderID+1 >= maxReaderCount {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
+				"slices of the last reader %v can't be split", attributes.ReaderID,
+			))
+		}
+		reader, ok := p.readerGroup.ReaderByID(attributes.ReaderID)
+		if !ok {
+			return nil, serviceerror.NewNotFound(fmt.Sprintf("reader %v not found", attributes.ReaderID))
+		}
+		if sliceCount := len(reader.Scopes()); attributes.SliceIndex < 0 || attributes.SliceIndex >= sliceCount {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
+				"invalid slice index %v, reader %v has %v slices", attributes.SliceIndex, attributes.ReaderID, sliceCount,
+			))
+		}
+		return newSplitSliceAction(attributes.NamespaceIDs, attributes.ReaderID, attributes.SliceIndex), nil
+
+	case OperatorActionTypeMoveNamespace:
+		attributes := operatorAction.OperatorActionAttributesMoveNamespace
+		if attributes == nil {
+			return nil, serviceerror.NewInvalidArgument("move namespace attributes are not set")
+		}
+		if err := validateNamespaceIDs(attributes.NamespaceIDs); err != nil {
+			return nil, err
+		}
+		if err := validateReaderID(attributes.SourceReaderID); err != nil {
+			return nil, err
+		}
+		if err := validateReaderID(attributes.TargetReaderID); err != nil {
+			return nil, err
+		}
+		if attributes.SourceReaderID == attributes.TargetReaderID {
+			return nil, serviceerror.NewInvalidArgument("source and target reader must be different")
+		}
+		if _, ok := p.readerGroup.ReaderByID(attributes.SourceReaderID); !ok {
+			return nil, serviceerror.NewNotFound(fmt.Sprintf("reader %v not found", attributes.SourceReaderID))
+		}
+		return newMoveNamespaceAction(attributes.NamespaceIDs, attributes.SourceReaderID, attributes.TargetReaderID), nil
+
+	case OperatorActionTypeThrottleNamespace:
+		attributes := operatorAction.OperatorActionAttributesThrottleNamespace
+		if attributes == nil {
+			return nil, serviceerror.NewInvalidArgument("throttle namespace attributes are not set")
+		}
+		if err := validateNamespaceIDs(attributes.NamespaceIDs); err != nil {
+			return nil, err
+		}
+		if attributes.Duration <= 0 {
+			return nil, serviceerror.NewInvalidArgument("throttle duration must be positive")
+		}
+		if maxReaderCount <= DefaultReaderId+1 {
+			return nil, serviceerror.NewFailedPrecondition("throttling requires more than one queue reader")
+		}
+		return newThrottleNamespaceAction(attributes.NamespaceIDs, maxReaderCount-1, attributes.Duration), nil
+
+	default:
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("unknown operator action type: %v", operatorAction.ActionType))
+	}
+}
@@ -25,6 +25,8 @@
 package queues
 
 import (
+	"context"
+
 	"go.temporal.io/server/service/history/tasks"
 )
 
@@ -35,6 +37,9 @@ type (
 		Category() tasks.Category
 		NotifyNewTasks(tasks []tasks.Task)
 		FailoverNamespace(namespaceID string)
+		Describe() *QueueDescription
+		PendingTaskCountPerGroup() map[any]int
+		RunOperatorAction(ctx context.Context, action OperatorAction) error
 		Start()
 		Stop()
 	}
@@ -105,7 +105,8 @@ type (
 		checkpointRetrier backoff.Retrier
 		checkpointTimer   *time.Timer
 
-		alertCh <-chan *Alert
+		alertCh          <-chan *Alert
+		operatorActionCh chan *operatorActionRequest
 	}
 
 	Options struct {
@@ -237,7 +238,8 @@ func newQueueBase(
 			clock.NewRealTimeSource(),
 		),
 
-		alertCh: monitor.AlertCh(),
+		alertCh:          monitor.AlertCh(),
+		operatorActionCh: make(chan *operatorActionRequest),
 	}
 }
 
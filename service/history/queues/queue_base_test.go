@@ -534,6 +534,279 @@ func (s *queueBaseSuite) TestCheckPoint_MoveSlices() {
 	s.True(scopes[0].Range.InclusiveMin.CompareTo(base.exclusiveDeletionHighWatermark) == 0)
 }
 
+func (s *queueBaseSuite) TestDescribe() {
+	readerScopes := map[int64][]Scope{
+		DefaultReaderId:     NewRandomScopes(3),
+		DefaultReaderId + 1: NewRandomScopes(2),
+	}
+	queueState := &queueState{
+		readerScopes:                 readerScopes,
+		exclusiveReaderHighWatermark: tasks.MaximumKey,
+	}
+
+	mockShard := shard.NewTestContext(
+		s.controller,
+		&persistencespb.ShardInfo{
+			ShardId: 0,
+			RangeId: 10,
+			QueueStates: map[int32]*persistencespb.QueueState{
+				int32(tasks.CategoryIDTransfer): ToPersistenceQueueState(queueState),
+			},
+		},
+		s.config,
+	)
+	base := s.newQueueBase(mockShard, tasks.CategoryTransfer, nil)
+
+	description := base.Describe()
+	s.Zero(description.PendingTaskCount)
+	s.Empty(description.PendingTaskCountPerNamespace)
+	s.Empty(base.PendingTaskCountPerGroup())
+	s.Empty(description.Alerts)
+	s.Len(description.Readers, len(readerScopes))
+	for idx, readerID := range []int64{DefaultReaderId, DefaultReaderId + 1} {
+		readerDescription := description.Readers[idx]
+		s.Equal(readerID, readerDescription.ReaderID)
+		s.Len(readerDescription.Slices, len(readerScopes[readerID]))
+		for sliceIdx, sliceDescription := range readerDescription.Slices {
+			expectedScope := readerScopes[readerID][sliceIdx]
+			s.True(expectedScope.Range.Equals(sliceDescription.Scope.Range))
+			s.True(expectedScope.Predicate.Equals(sliceDescription.Scope.Predicate))
+			s.Zero(sliceDescription.PendingTaskCount)
+		}
+	}
+}
+
+func (s *queueBaseSuite) TestNewOperatorAction_Validation() {
+	base := s.newQueueBaseWithReaderScopes(map[int64][]Scope{
+		DefaultReaderId: NewRandomScopes(2),
+	})
+	maxReaderID := int64(s.options.MaxReaderCount()) - 1
+	namespaceIDs := []string{uuid.New()}
+
+	testCases := []struct {
+		name   string
+		action OperatorAction
+	}{
+		{
+			name:   "unspecified",
+			action: OperatorAction{},
+		},
+		{
+			name: "missing attributes",
+			action: OperatorAction{
+				ActionType: OperatorActionTypeMoveNamespace,
+			},
+		},
+		{
+			name: "missing namespace IDs",
+			action: OperatorAction{
+				ActionType: OperatorActionTypeThrottleNamespace,
+				OperatorActionAttributesThrottleNamespace: &OperatorActionAttributesThrottleNamespace{
+					Duration: time.Minute,
+				},
+			},
+		},
+		{
+			name: "split slice in last reader",
+			action: OperatorAction{
+				ActionType: OperatorActionTypeSplitSlice,
+				OperatorActionAttributesSplitSlice: &OperatorActionAttributesSplitSlice{
+					NamespaceIDs: namespaceIDs,
+					ReaderID:     maxReaderID,
+				},
+			},
+		},
+		{
+			name: "split slice in unknown reader",
+			action: OperatorAction{
+				ActionType: OperatorActionTypeSplitSlice,
+				OperatorActionAttributesSplitSlice: &OperatorActionAttributesSplitSlice{
+					NamespaceIDs: namespaceIDs,
+					ReaderID:     DefaultReaderId + 1,
+				},
+			},
+		},
+		{
+			name: "split slice index out of range",
+			action: OperatorAction{
+				ActionType: OperatorActionTypeSplitSlice,
+				OperatorActionAttributesSplitSlice: &OperatorActionAttributesSplitSlice{
+					NamespaceIDs: namespaceIDs,
+					ReaderID:     DefaultReaderId,
+					SliceIndex:   2,
+				},
+			},
+		},
+		{
+			name: "move namespace to same reader",
+			action: OperatorAction{
+				ActionType: OperatorActionTypeMoveNamespace,
+				OperatorActionAttributesMoveNamespace: &OperatorActionAttributesMoveNamespace{
+					NamespaceIDs:   namespaceIDs,
+					SourceReaderID: DefaultReaderId,
+					TargetReaderID: DefaultReaderId,
+				},
+			},
+		},
+		{
+			name: "move namespace to invalid reader",
+			action: OperatorAction{
+				ActionType: OperatorActionTypeMoveNamespace,
+				OperatorActionAttributesMoveNamespace: &OperatorActionAttributesMoveNamespace{
+					NamespaceIDs:   namespaceIDs,
+					SourceReaderID: DefaultReaderId,
+					TargetReaderID: maxReaderID + 1,
+				},
+			},
+		},
+		{
+			name: "throttle without duration",
+			action: OperatorAction{
+				ActionType: OperatorActionTypeThrottleNamespace,
+				OperatorActionAttributesThrottleNamespace: &OperatorActionAttributesThrottleNamespace{
+					NamespaceIDs: namespaceIDs,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := base.newOperatorAction(tc.action)
+		s.Error(err, tc.name)
+	}
+
+	action, err := base.newOperatorAction(OperatorAction{
+		ActionType: OperatorActionTypeThrottleNamespace,
+		OperatorActionAttributesThrottleNamespace: &OperatorActionAttributesThrottleNamespace{
+			NamespaceIDs: namespaceIDs,
+			Duration:     time.Minute,
+		},
+	})
+	s.NoError(err)
+	s.Equal(maxReaderID, action.(*actionThrottleNamespace).targetReaderID)
+}
+
+func (s *queueBaseSuite) TestSplitSliceAction() {
+	scopes := NewRandomScopes(3)
+	base := s.newQueueBaseWithReaderScopes(map[int64][]Scope{
+		DefaultReaderId: scopes,
+	})
+	namespaceIDs := []string{uuid.New()}
+
+	runAction(newSplitSliceAction(namespaceIDs, DefaultReaderId, 1), base.readerGroup, s.metricsHandler, s.logger)
+
+	defaultReader, ok := base.readerGroup.ReaderByID(DefaultReaderId)
+	s.True(ok)
+	defaultScopes := defaultReader.Scopes()
+	s.Len(defaultScopes, 3)
+	s.True(defaultScopes[0].Predicate.Equals(scopes[0].Predicate))
+	s.True(defaultScopes[1].Predicate.Equals(
+		predicates.And(scopes[1].Predicate, predicates.Not[tasks.Task](tasks.NewNamespacePredicate(namespaceIDs))),
+	))
+	s.True(defaultScopes[2].Predicate.Equals(scopes[2].Predicate))
+
+	nextReader, ok := base.readerGroup.ReaderByID(DefaultReaderId + 1)
+	s.True(ok)
+	nextScopes := nextReader.Scopes()
+	s.Len(nextScopes, 1)
+	s.True(nextScopes[0].Range.Equals(scopes[1].Range))
+	s.True(nextScopes[0].Predicate.Equals(tasks.NewNamespacePredicate(namespaceIDs)))
+}
+
+func (s *queueBaseSuite) TestMoveNamespaceAction() {
+	namespaceIDs := []string{uuid.New()}
+	scopes := NewRandomScopes(3)
+	// slice only containing tasks of the namespace is moved as a whole
+	scopes[0].Predicate = tasks.NewNamespacePredicate(namespaceIDs)
+	// slice not containing tasks of the namespace is not touched
+	scopes[2].Predicate = tasks.NewNamespacePredicate([]string{uuid.New()})
+	base := s.newQueueBaseWithReaderScopes(map[int64][]Scope{
+		DefaultReaderId: scopes,
+	})
+	targetReaderID := DefaultReaderId + 2
+
+	runAction(newMoveNamespaceAction(namespaceIDs, DefaultReaderId, targetReaderID), base.readerGroup, s.metricsHandler, s.logger)
+
+	defaultReader, ok := base.readerGroup.ReaderByID(DefaultReaderId)
+	s.True(ok)
+	defaultScopes := defaultReader.Scopes()
+	s.Len(defaultScopes, 2)
+	s.True(defaultScopes[0].Range.Equals(scopes[1].Range))
+	s.True(defaultScopes[1].Range.Equals(scopes[2].Range))
+	s.True(defaultScopes[1].Predicate.Equals(scopes[2].Predicate))
+
+	targetReader, ok := base.readerGroup.ReaderByID(targetReaderID)
+	s.True(ok)
+	targetScopes := targetReader.Scopes()
+	s.Len(targetScopes, 2)
+	s.True(targetScopes[0].Range.Equals(scopes[0].Range))
+	s.True(targetScopes[1].Range.Equals(scopes[1].Range))
+	for _, scope := range targetScopes {
+		s.True(scope.Predicate.Equals(tasks.NewNamespacePredicate(namespaceIDs)))
+	}
+}
+
+func (s *queueBaseSuite) TestThrottleNamespaceAction() {
+	namespaceIDs := []string{uuid.New()}
+	targetReaderID := int64(s.options.MaxReaderCount()) - 1
+	s.Greater(targetReaderID, DefaultReaderId+1)
+	// the target reader already holds tasks of another namespace, which must not be paused
+	otherNamespacePredicate := tasks.NewNamespacePredicate([]string{uuid.New()})
+	otherNamespaceScopes := NewRandomScopes(1)
+	otherNamespaceScopes[0].Predicate = otherNamespacePredicate
+	base := s.newQueueBaseWithReaderScopes(map[int64][]Scope{
+		DefaultReaderId:     NewRandomScopes(2),
+		DefaultReaderId + 1: NewRandomScopes(2),
+		targetReaderID:      otherNamespaceScopes,
+	})
+
+	runAction(newThrottleNamespaceAction(namespaceIDs, targetReaderID, time.Minute), base.readerGroup, s.metricsHandler, s.logger)
+
+	for _, readerID := range []int64{DefaultReaderId, DefaultReaderId + 1} {
+		reader, ok := base.readerGroup.ReaderByID(readerID)
+		s.True(ok)
+		for _, scope := range reader.Scopes() {
+			s.False(scope.Predicate.Equals(tasks.NewNamespacePredicate(namespaceIDs)))
+		}
+	}
+
+	targetReader, ok := base.readerGroup.ReaderByID(targetReaderID)
+	s.True(ok)
+	targetScopes := targetReader.Scopes()
+	s.NotEmpty(targetScopes)
+	for _, scope := range targetScopes {
+		s.True(scope.Predicate.Equals(tasks.NewNamespacePredicate(namespaceIDs)))
+	}
+	s.NotNil(targetReader.(*ReaderImpl).throttleTimer)
+
+	previousReader, ok := base.readerGroup.ReaderByID(targetReaderID - 1)
+	s.True(ok)
+	s.Len(previousReader.Scopes(), 1)
+	s.True(previousReader.Scopes()[0].Range.Equals(otherNamespaceScopes[0].Range))
+	s.True(previousReader.Scopes()[0].Predicate.Equals(otherNamespacePredicate))
+	s.Nil(previousReader.(*ReaderImpl).throttleTimer)
+}
+
+func (s *queueBaseSuite) newQueueBaseWithReaderScopes(
+	readerScopes map[int64][]Scope,
+) *queueBase {
+	mockShard := shard.NewTestContext(
+		s.controller,
+		&persistencespb.ShardInfo{
+			ShardId: 0,
+			RangeId: 10,
+			QueueStates: map[int32]*persistencespb.QueueState{
+				int32(tasks.CategoryIDTransfer): ToPersistenceQueueState(&queueState{
+					readerScopes:                 readerScopes,
+					exclusiveReaderHighWatermark: tasks.MaximumKey,
+				}),
+			},
+		},
+		s.config,
+	)
+	return s.newQueueBase(mockShard, tasks.CategoryTransfer, nil)
+}
+
 func (s *queueBaseSuite) QueueStateEqual(
 	this *persistencespb.QueueState,
 	that *persistencespb.QueueState,
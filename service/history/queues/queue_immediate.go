@@ -173,6 +173,8 @@ func (p *immediateQueue) processEventLoop() {
 			p.checkpoint()
 		case alert := <-p.alertCh:
 			p.handleAlert(alert)
+		case request := <-p.operatorActionCh:
+			p.handleOperatorAction(request)
 		}
 	}
 }
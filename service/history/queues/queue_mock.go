@@ -34,6 +34,7 @@
 package queues
 
 import (
+	context "context"
 	reflect "reflect"
 
 	tasks "go.temporal.io/server/service/history/tasks"
@@ -77,6 +78,20 @@ func (mr *MockQueueMockRecorder) Category() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Category", reflect.TypeOf((*MockQueue)(nil).Category))
 }
 
+// Describe mocks base method.
+func (m *MockQueue) Describe() *QueueDescription {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*QueueDescription)
+	return ret0
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockQueueMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockQueue)(nil).Describe))
+}
+
 // FailoverNamespace mocks base method.
 func (m *MockQueue) FailoverNamespace(namespaceID string) {
 	m.ctrl.T.Helper()
@@ -101,6 +116,34 @@ func (mr *MockQueueMockRecorder) NotifyNewTasks(tasks any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewTasks", reflect.TypeOf((*MockQueue)(nil).NotifyNewTasks), tasks)
 }
 
+// PendingTaskCountPerGroup mocks base method.
+func (m *MockQueue) PendingTaskCountPerGroup() map[any]int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingTaskCountPerGroup")
+	ret0, _ := ret[0].(map[any]int)
+	return ret0
+}
+
+// PendingTaskCountPerGroup indicates an expected call of PendingTaskCountPerGroup.
+func (mr *MockQueueMockRecorder) PendingTaskCountPerGroup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingTaskCountPerGroup", reflect.TypeOf((*MockQueue)(nil).PendingTaskCountPerGroup))
+}
+
+// RunOperatorAction mocks base method.
+func (m *MockQueue) RunOperatorAction(ctx context.Context, action OperatorAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunOperatorAction", ctx, action)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunOperatorAction indicates an expected call of RunOperatorAction.
+func (mr *MockQueueMockRecorder) RunOperatorAction(ctx, action any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunOperatorAction", reflect.TypeOf((*MockQueue)(nil).RunOperatorAction), ctx, action)
+}
+
 // Start mocks base method.
 func (m *MockQueue) Start() {
 	m.ctrl.T.Helper()
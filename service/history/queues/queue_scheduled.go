@@ -219,6 +219,8 @@ func (p *scheduledQueue) processEventLoop() {
 			p.checkpoint()
 		case alert := <-p.alertCh:
 			p.handleAlert(alert)
+		case request := <-p.operatorActionCh:
+			p.handleOperatorAction(request)
 		}
 	}
 }
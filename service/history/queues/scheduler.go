@@ -73,6 +73,11 @@ type (
 		WorkerCount             dynamicconfig.TypedSubscribable[int]
 		ActiveNamespaceWeights  dynamicconfig.MapPropertyFnWithNamespaceFilter
 		StandbyNamespaceWeights dynamicconfig.MapPropertyFnWithNamespaceFilter
+		// NamespaceWeight is optional. If specified, it's multiplied with the priority weight
+		// of the namespace so that namespaces with higher weight get a larger share of
+		// processing capacity when the host is saturated. Like the priority weights, it's read
+		// when a task channel is created and re-read only upon namespace state changes.
+		NamespaceWeight dynamicconfig.IntPropertyFnWithNamespaceFilter
 	}
 
 	RateLimitedSchedulerOptions struct {
@@ -129,10 +134,14 @@ func NewScheduler(
 			)
 		}
 
-		return configs.ConvertDynamicConfigValueToWeights(
+		weight := configs.ConvertDynamicConfigValueToWeights(
 			namespaceWeights(namespaceName.String()),
 			logger,
 		)[key.Priority]
+		if options.NamespaceWeight != nil {
+			weight *= max(options.NamespaceWeight(namespaceName.String()), 1)
+		}
+		return weight
 	}
 	channelWeightUpdateCh := make(chan struct{}, 1)
 	fifoSchedulerOptions := &tasks.FIFOSchedulerOptions{
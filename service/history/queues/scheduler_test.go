@@ -0,0 +1,109 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	persistencepb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/tasks"
+	"go.temporal.io/server/service/history/configs"
+	"go.uber.org/mock/gomock"
+)
+
+func TestScheduler_ChannelWeight_NamespaceWeight(t *testing.T) {
+	controller := gomock.NewController(t)
+	registry := namespace.NewMockRegistry(controller)
+
+	newNamespace := func(id, name, activeCluster string) *namespace.Namespace {
+		return namespace.NewGlobalNamespaceForTest(
+			&persistencepb.NamespaceInfo{Id: id, Name: name},
+			nil,
+			&persistencepb.NamespaceReplicationConfig{
+				ActiveClusterName: activeCluster,
+				Clusters:          []string{cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName},
+			},
+			1,
+		)
+	}
+	namespaces := map[namespace.ID]*namespace.Namespace{
+		"premium-id":  newNamespace("premium-id", "premium", cluster.TestCurrentClusterName),
+		"standard-id": newNamespace("standard-id", "standard", cluster.TestCurrentClusterName),
+		"invalid-id":  newNamespace("invalid-id", "invalid", cluster.TestCurrentClusterName),
+		"standby-id":  newNamespace("standby-id", "standby", cluster.TestAlternativeClusterName),
+	}
+	registry.EXPECT().GetNamespaceByID(gomock.Any()).DoAndReturn(func(id namespace.ID) (*namespace.Namespace, error) {
+		return namespaces[id], nil
+	}).AnyTimes()
+
+	namespaceWeights := map[string]int{
+		"premium": 5,
+		"standby": 3,
+		"invalid": -1,
+	}
+	scheduler := NewScheduler(
+		cluster.TestCurrentClusterName,
+		SchedulerOptions{
+			WorkerCount: func(func(int)) (int, func()) { return 1, func() {} },
+			ActiveNamespaceWeights: dynamicconfig.GetMapPropertyFnFilteredByNamespace(
+				configs.ConvertWeightsToDynamicConfigValue(configs.DefaultActiveTaskPriorityWeight),
+			),
+			StandbyNamespaceWeights: dynamicconfig.GetMapPropertyFnFilteredByNamespace(
+				configs.ConvertWeightsToDynamicConfigValue(configs.DefaultStandbyTaskPriorityWeight),
+			),
+			NamespaceWeight: func(namespaceName string) int {
+				if weight, ok := namespaceWeights[namespaceName]; ok {
+					return weight
+				}
+				return 1
+			},
+		},
+		registry,
+		log.NewTestLogger(),
+	).(*schedulerImpl)
+
+	testCases := []struct {
+		namespaceID    string
+		priority       tasks.Priority
+		expectedWeight int
+	}{
+		{"premium-id", tasks.PriorityHigh, 5 * configs.DefaultActiveTaskPriorityWeight[tasks.PriorityHigh]},
+		{"premium-id", tasks.PriorityLow, 5 * configs.DefaultActiveTaskPriorityWeight[tasks.PriorityLow]},
+		{"standard-id", tasks.PriorityHigh, configs.DefaultActiveTaskPriorityWeight[tasks.PriorityHigh]},
+		{"invalid-id", tasks.PriorityHigh, configs.DefaultActiveTaskPriorityWeight[tasks.PriorityHigh]},
+		{"standby-id", tasks.PriorityHigh, 3 * configs.DefaultStandbyTaskPriorityWeight[tasks.PriorityHigh]},
+	}
+	for _, tc := range testCases {
+		require.Equal(
+			t,
+			tc.expectedWeight,
+			scheduler.channelWeightFn(TaskChannelKey{NamespaceID: tc.namespaceID, Priority: tc.priority}),
+			"namespace %v, priority %v", tc.namespaceID, tc.priority,
+		)
+	}
+}
@@ -58,7 +58,8 @@ type (
 	}
 
 	TaskStats struct {
-		PendingPerKey map[any]int
+		PendingPerKey       map[any]int
+		PendingPerNamespace map[string]int
 	}
 
 	SliceImpl struct {
@@ -425,7 +426,8 @@ func (s *SliceImpl) TaskStats() TaskStats {
 	s.stateSanityCheck()
 
 	return TaskStats{
-		PendingPerKey: s.executableTracker.pendingPerKey,
+		PendingPerKey:       s.executableTracker.pendingPerKey,
+		PendingPerNamespace: s.executableTracker.pendingPerNamespace,
 	}
 }
 
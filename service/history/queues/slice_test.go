@@ -609,6 +609,27 @@ func (s *sliceSuite) TestClear() {
 	s.Equal(slice.scope.Range, slice.iterators[0].Range())
 }
 
+func (s *sliceSuite) TestTaskStats_PendingPerNamespace() {
+	r := NewRandomRange()
+	namespaceIDs := []string{uuid.New(), uuid.New()}
+	slice := NewSlice(nil, s.executableFactory, s.monitor, NewScope(r, predicates.Universal[tasks.Task]()), GrouperNamespaceID{}, noPredicateSizeLimit)
+
+	expectedPendingPerNamespace := make(map[string]int)
+	for idx, executable := range s.randomExecutablesInRange(r, 10) {
+		namespaceID := namespaceIDs[idx%len(namespaceIDs)]
+		executable.(*MockExecutable).EXPECT().GetNamespaceID().Return(namespaceID).AnyTimes()
+		slice.add(executable)
+		expectedPendingPerNamespace[namespaceID]++
+	}
+	s.Equal(expectedPendingPerNamespace, slice.TaskStats().PendingPerNamespace)
+
+	passSlice, failSlice := slice.SplitByPredicate(tasks.NewNamespacePredicate(namespaceIDs[:1]))
+	s.Equal(expectedPendingPerNamespace[namespaceIDs[0]], passSlice.TaskStats().PendingPerNamespace[namespaceIDs[0]])
+	s.Zero(passSlice.TaskStats().PendingPerNamespace[namespaceIDs[1]])
+	s.Equal(expectedPendingPerNamespace[namespaceIDs[1]], failSlice.TaskStats().PendingPerNamespace[namespaceIDs[1]])
+	s.Zero(failSlice.TaskStats().PendingPerNamespace[namespaceIDs[0]])
+}
+
 func (s *sliceSuite) newTestSlice(
 	r Range,
 	namespaceIDs []string,
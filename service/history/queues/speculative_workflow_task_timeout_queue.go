@@ -25,6 +25,9 @@
 package queues
 
 import (
+	"context"
+
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
@@ -114,3 +117,17 @@ func (q SpeculativeWorkflowTaskTimeoutQueue) NotifyNewTasks(ts []tasks.Task) {
 
 func (q SpeculativeWorkflowTaskTimeoutQueue) FailoverNamespace(_ string) {
 }
+
+func (q SpeculativeWorkflowTaskTimeoutQueue) Describe() *QueueDescription {
+	return &QueueDescription{
+		PendingTaskCountPerNamespace: make(map[string]int),
+	}
+}
+
+func (q SpeculativeWorkflowTaskTimeoutQueue) PendingTaskCountPerGroup() map[any]int {
+	return make(map[any]int)
+}
+
+func (q SpeculativeWorkflowTaskTimeoutQueue) RunOperatorAction(_ context.Context, _ OperatorAction) error {
+	return serviceerror.NewUnimplemented("operator actions are not supported by speculative workflow task timeout queue")
+}
@@ -38,6 +38,8 @@ type (
 		pendingExecutables map[tasks.Key]Executable
 		grouper            Grouper
 		pendingPerKey      map[any]int
+
+		pendingPerNamespace map[string]int
 	}
 )
 
@@ -46,6 +48,8 @@ func newExecutableTracker(grouper Grouper) *executableTracker {
 		pendingExecutables: make(map[tasks.Key]Executable),
 		grouper:            grouper,
 		pendingPerKey:      make(map[any]int, 0),
+
+		pendingPerNamespace: make(map[string]int, 0),
 	}
 }
 
@@ -57,6 +61,8 @@ func (t *executableTracker) split(
 		pendingExecutables: make(map[tasks.Key]Executable, len(t.pendingExecutables)/2),
 		grouper:            t.grouper,
 		pendingPerKey:      make(map[any]int, len(t.pendingPerKey)),
+
+		pendingPerNamespace: make(map[string]int, len(t.pendingPerNamespace)),
 	}
 
 	for key, executable := range t.pendingExecutables {
@@ -75,26 +81,33 @@ func (t *executableTracker) split(
 		groupKey := t.grouper.Key(executable)
 		t.pendingPerKey[groupKey]--
 		that.pendingPerKey[groupKey]++
+
+		namespaceID := executable.GetNamespaceID()
+		t.pendingPerNamespace[namespaceID]--
+		that.pendingPerNamespace[namespaceID]++
 	}
 
 	return t, &that
 }
 
 func (t *executableTracker) merge(incomingTracker *executableTracker) *executableTracker {
-	thisExecutables, thisPendingTasks := t.pendingExecutables, t.pendingPerKey
-	thatExecutables, thatPendingTasks := incomingTracker.pendingExecutables, incomingTracker.pendingPerKey
+	thisExecutables, thisPendingTasks, thisPendingNamespaceTasks := t.pendingExecutables, t.pendingPerKey, t.pendingPerNamespace
+	thatExecutables, thatPendingTasks, thatPendingNamespaceTasks := incomingTracker.pendingExecutables, incomingTracker.pendingPerKey, incomingTracker.pendingPerNamespace
 	if len(thisExecutables) < len(thatExecutables) {
 		thisExecutables, thatExecutables = thatExecutables, thisExecutables
 		thisPendingTasks = thatPendingTasks
+		thisPendingNamespaceTasks = thatPendingNamespaceTasks
 	}
 
 	for key, executable := range thatExecutables {
 		thisExecutables[key] = executable
 		key := t.grouper.Key(executable)
 		thisPendingTasks[key]++
+		thisPendingNamespaceTasks[executable.GetNamespaceID()]++
 	}
 	t.pendingExecutables = thisExecutables
 	t.pendingPerKey = thisPendingTasks
+	t.pendingPerNamespace = thisPendingNamespaceTasks
 	return t
 }
 
@@ -104,6 +117,7 @@ func (t *executableTracker) add(
 	t.pendingExecutables[executable.GetKey()] = executable
 	key := t.grouper.Key(executable)
 	t.pendingPerKey[key]++
+	t.pendingPerNamespace[executable.GetNamespaceID()]++
 }
 
 func (t *executableTracker) shrink() (tasks.Key, int) {
@@ -112,6 +126,7 @@ func (t *executableTracker) shrink() (tasks.Key, int) {
 	for key, executable := range t.pendingExecutables {
 		if executable.State() == ctasks.TaskStateAcked {
 			t.pendingPerKey[t.grouper.Key(executable)]--
+			t.pendingPerNamespace[executable.GetNamespaceID()]--
 			delete(t.pendingExecutables, key)
 			tasksCompleted++
 			continue
@@ -125,6 +140,11 @@ func (t *executableTracker) shrink() (tasks.Key, int) {
 			delete(t.pendingPerKey, key)
 		}
 	}
+	for namespaceID, numPending := range t.pendingPerNamespace {
+		if numPending == 0 {
+			delete(t.pendingPerNamespace, namespaceID)
+		}
+	}
 
 	return minPendingTaskKey, tasksCompleted
 }
@@ -136,4 +156,5 @@ func (t *executableTracker) clear() {
 
 	t.pendingExecutables = make(map[tasks.Key]Executable)
 	t.pendingPerKey = make(map[any]int, 0)
+	t.pendingPerNamespace = make(map[string]int, 0)
 }
@@ -52,6 +52,11 @@ type (
 		GetMutableState(ctx context.Context, request *historyservice.GetMutableStateRequest) (*historyservice.GetMutableStateResponse, error)
 		PollMutableState(ctx context.Context, request *historyservice.PollMutableStateRequest) (*historyservice.PollMutableStateResponse, error)
 		DescribeMutableState(ctx context.Context, request *historyservice.DescribeMutableStateRequest) (*historyservice.DescribeMutableStateResponse, error)
+		DescribeStateMachines(ctx context.Context, request *historyservice.DescribeStateMachinesRequest) (*historyservice.DescribeStateMachinesResponse, error)
+		DeleteStateMachineNode(ctx context.Context, request *historyservice.DeleteStateMachineNodeRequest) (*historyservice.DeleteStateMachineNodeResponse, error)
+		ScheduleDelayedSignal(ctx context.Context, request *historyservice.ScheduleDelayedSignalRequest) (*historyservice.ScheduleDelayedSignalResponse, error)
+		CancelDelayedSignal(ctx context.Context, request *historyservice.CancelDelayedSignalRequest) (*historyservice.CancelDelayedSignalResponse, error)
+		ListDelayedSignals(ctx context.Context, request *historyservice.ListDelayedSignalsRequest) (*historyservice.ListDelayedSignalsResponse, error)
 		ResetStickyTaskQueue(ctx context.Context, resetRequest *historyservice.ResetStickyTaskQueueRequest) (*historyservice.ResetStickyTaskQueueResponse, error)
 		DescribeWorkflowExecution(ctx context.Context, request *historyservice.DescribeWorkflowExecutionRequest) (*historyservice.DescribeWorkflowExecutionResponse, error)
 		RecordWorkflowTaskStarted(ctx context.Context, request *historyservice.RecordWorkflowTaskStartedRequest) (*historyservice.RecordWorkflowTaskStartedResponse, error)
@@ -113,6 +118,10 @@ type (
 		GetWorkflowExecutionRawHistoryV2(ctx context.Context, request *historyservice.GetWorkflowExecutionRawHistoryV2Request) (*historyservice.GetWorkflowExecutionRawHistoryV2Response, error)
 		AddTasks(ctx context.Context, request *historyservice.AddTasksRequest) (*historyservice.AddTasksResponse, error)
 		ListTasks(ctx context.Context, request *historyservice.ListTasksRequest) (*historyservice.ListTasksResponse, error)
+		DescribeQueue(ctx context.Context, request *historyservice.DescribeQueueRequest) (*historyservice.DescribeQueueResponse, error)
+		MitigateQueue(ctx context.Context, request *historyservice.MitigateQueueRequest) (*historyservice.MitigateQueueResponse, error)
+		DescribeOutboundQueue(ctx context.Context, request *historyservice.DescribeOutboundQueueRequest) (*historyservice.DescribeOutboundQueueResponse, error)
+		UpdateOutboundQueueCircuitBreaker(ctx context.Context, request *historyservice.UpdateOutboundQueueCircuitBreakerRequest) (*historyservice.UpdateOutboundQueueCircuitBreakerResponse, error)
 		SyncWorkflowState(ctx context.Context, request *historyservice.SyncWorkflowStateRequest) (*historyservice.SyncWorkflowStateResponse, error)
 
 		NotifyNewHistoryEvent(event *events.Notification)
@@ -105,6 +105,21 @@ func (mr *MockEngineMockRecorder) BackfillHistoryEvents(ctx, request any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillHistoryEvents", reflect.TypeOf((*MockEngine)(nil).BackfillHistoryEvents), ctx, request)
 }
 
+// CancelDelayedSignal mocks base method.
+func (m *MockEngine) CancelDelayedSignal(ctx context.Context, request *historyservice.CancelDelayedSignalRequest) (*historyservice.CancelDelayedSignalResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelDelayedSignal", ctx, request)
+	ret0, _ := ret[0].(*historyservice.CancelDelayedSignalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelDelayedSignal indicates an expected call of CancelDelayedSignal.
+func (mr *MockEngineMockRecorder) CancelDelayedSignal(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDelayedSignal", reflect.TypeOf((*MockEngine)(nil).CancelDelayedSignal), ctx, request)
+}
+
 // ConvertReplicationTask mocks base method.
 func (m *MockEngine) ConvertReplicationTask(ctx context.Context, task tasks.Task, clusterID int32) (*repication.ReplicationTask, error) {
 	m.ctrl.T.Helper()
@@ -120,6 +135,21 @@ func (mr *MockEngineMockRecorder) ConvertReplicationTask(ctx, task, clusterID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertReplicationTask", reflect.TypeOf((*MockEngine)(nil).ConvertReplicationTask), ctx, task, clusterID)
 }
 
+// DeleteStateMachineNode mocks base method.
+func (m *MockEngine) DeleteStateMachineNode(ctx context.Context, request *historyservice.DeleteStateMachineNodeRequest) (*historyservice.DeleteStateMachineNodeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStateMachineNode", ctx, request)
+	ret0, _ := ret[0].(*historyservice.DeleteStateMachineNodeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStateMachineNode indicates an expected call of DeleteStateMachineNode.
+func (mr *MockEngineMockRecorder) DeleteStateMachineNode(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStateMachineNode", reflect.TypeOf((*MockEngine)(nil).DeleteStateMachineNode), ctx, request)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockEngine) DeleteWorkflowExecution(ctx context.Context, deleteRequest *historyservice.DeleteWorkflowExecutionRequest) (*historyservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -150,6 +180,51 @@ func (mr *MockEngineMockRecorder) DescribeMutableState(ctx, request any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockEngine)(nil).DescribeMutableState), ctx, request)
 }
 
+// DescribeOutboundQueue mocks base method.
+func (m *MockEngine) DescribeOutboundQueue(ctx context.Context, request *historyservice.DescribeOutboundQueueRequest) (*historyservice.DescribeOutboundQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOutboundQueue", ctx, request)
+	ret0, _ := ret[0].(*historyservice.DescribeOutboundQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOutboundQueue indicates an expected call of DescribeOutboundQueue.
+func (mr *MockEngineMockRecorder) DescribeOutboundQueue(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOutboundQueue", reflect.TypeOf((*MockEngine)(nil).DescribeOutboundQueue), ctx, request)
+}
+
+// DescribeQueue mocks base method.
+func (m *MockEngine) DescribeQueue(ctx context.Context, request *historyservice.DescribeQueueRequest) (*historyservice.DescribeQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeQueue", ctx, request)
+	ret0, _ := ret[0].(*historyservice.DescribeQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeQueue indicates an expected call of DescribeQueue.
+func (mr *MockEngineMockRecorder) DescribeQueue(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueue", reflect.TypeOf((*MockEngine)(nil).DescribeQueue), ctx, request)
+}
+
+// DescribeStateMachines mocks base method.
+func (m *MockEngine) DescribeStateMachines(ctx context.Context, request *historyservice.DescribeStateMachinesRequest) (*historyservice.DescribeStateMachinesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeStateMachines", ctx, request)
+	ret0, _ := ret[0].(*historyservice.DescribeStateMachinesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeStateMachines indicates an expected call of DescribeStateMachines.
+func (mr *MockEngineMockRecorder) DescribeStateMachines(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStateMachines", reflect.TypeOf((*MockEngine)(nil).DescribeStateMachines), ctx, request)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockEngine) DescribeWorkflowExecution(ctx context.Context, request *historyservice.DescribeWorkflowExecutionRequest) (*historyservice.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -405,6 +480,21 @@ func (mr *MockEngineMockRecorder) IsWorkflowTaskValid(ctx, request any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowTaskValid", reflect.TypeOf((*MockEngine)(nil).IsWorkflowTaskValid), ctx, request)
 }
 
+// ListDelayedSignals mocks base method.
+func (m *MockEngine) ListDelayedSignals(ctx context.Context, request *historyservice.ListDelayedSignalsRequest) (*historyservice.ListDelayedSignalsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDelayedSignals", ctx, request)
+	ret0, _ := ret[0].(*historyservice.ListDelayedSignalsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDelayedSignals indicates an expected call of ListDelayedSignals.
+func (mr *MockEngineMockRecorder) ListDelayedSignals(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDelayedSignals", reflect.TypeOf((*MockEngine)(nil).ListDelayedSignals), ctx, request)
+}
+
 // ListTasks mocks base method.
 func (m *MockEngine) ListTasks(ctx context.Context, request *historyservice.ListTasksRequest) (*historyservice.ListTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -435,6 +525,21 @@ func (mr *MockEngineMockRecorder) MergeDLQMessages(ctx, messagesRequest any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockEngine)(nil).MergeDLQMessages), ctx, messagesRequest)
 }
 
+// MitigateQueue mocks base method.
+func (m *MockEngine) MitigateQueue(ctx context.Context, request *historyservice.MitigateQueueRequest) (*historyservice.MitigateQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MitigateQueue", ctx, request)
+	ret0, _ := ret[0].(*historyservice.MitigateQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MitigateQueue indicates an expected call of MitigateQueue.
+func (mr *MockEngineMockRecorder) MitigateQueue(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MitigateQueue", reflect.TypeOf((*MockEngine)(nil).MitigateQueue), ctx, request)
+}
+
 // NotifyNewHistoryEvent mocks base method.
 func (m *MockEngine) NotifyNewHistoryEvent(event *events.Notification) {
 	m.ctrl.T.Helper()
@@ -797,6 +902,21 @@ func (mr *MockEngineMockRecorder) RespondWorkflowTaskFailed(ctx, request any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondWorkflowTaskFailed", reflect.TypeOf((*MockEngine)(nil).RespondWorkflowTaskFailed), ctx, request)
 }
 
+// ScheduleDelayedSignal mocks base method.
+func (m *MockEngine) ScheduleDelayedSignal(ctx context.Context, request *historyservice.ScheduleDelayedSignalRequest) (*historyservice.ScheduleDelayedSignalResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleDelayedSignal", ctx, request)
+	ret0, _ := ret[0].(*historyservice.ScheduleDelayedSignalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleDelayedSignal indicates an expected call of ScheduleDelayedSignal.
+func (mr *MockEngineMockRecorder) ScheduleDelayedSignal(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleDelayedSignal", reflect.TypeOf((*MockEngine)(nil).ScheduleDelayedSignal), ctx, request)
+}
+
 // ScheduleWorkflowTask mocks base method.
 func (m *MockEngine) ScheduleWorkflowTask(ctx context.Context, request *historyservice.ScheduleWorkflowTaskRequest) error {
 	m.ctrl.T.Helper()
@@ -1007,6 +1127,21 @@ func (mr *MockEngineMockRecorder) UnsubscribeReplicationNotification(arg0 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeReplicationNotification", reflect.TypeOf((*MockEngine)(nil).UnsubscribeReplicationNotification), arg0)
 }
 
+// UpdateOutboundQueueCircuitBreaker mocks base method.
+func (m *MockEngine) UpdateOutboundQueueCircuitBreaker(ctx context.Context, request *historyservice.UpdateOutboundQueueCircuitBreakerRequest) (*historyservice.UpdateOutboundQueueCircuitBreakerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOutboundQueueCircuitBreaker", ctx, request)
+	ret0, _ := ret[0].(*historyservice.UpdateOutboundQueueCircuitBreakerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOutboundQueueCircuitBreaker indicates an expected call of UpdateOutboundQueueCircuitBreaker.
+func (mr *MockEngineMockRecorder) UpdateOutboundQueueCircuitBreaker(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOutboundQueueCircuitBreaker", reflect.TypeOf((*MockEngine)(nil).UpdateOutboundQueueCircuitBreaker), ctx, request)
+}
+
 // UpdateWorkflowExecution mocks base method.
 func (m *MockEngine) UpdateWorkflowExecution(ctx context.Context, request *historyservice.UpdateWorkflowExecutionRequest) (*historyservice.UpdateWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +79,7 @@ func NewTimerQueueFactory(
 					WorkerCount:             params.Config.TimerProcessorSchedulerWorkerCount,
 					ActiveNamespaceWeights:  params.Config.TimerProcessorSchedulerActiveRoundRobinWeights,
 					StandbyNamespaceWeights: params.Config.TimerProcessorSchedulerStandbyRoundRobinWeights,
+					NamespaceWeight:         params.Config.TimerProcessorSchedulerNamespaceWeight,
 				},
 				params.NamespaceRegistry,
 				params.Logger,
@@ -82,6 +82,7 @@ func NewTransferQueueFactory(
 					WorkerCount:             params.Config.TransferProcessorSchedulerWorkerCount,
 					ActiveNamespaceWeights:  params.Config.TransferProcessorSchedulerActiveRoundRobinWeights,
 					StandbyNamespaceWeights: params.Config.TransferProcessorSchedulerStandbyRoundRobinWeights,
+					NamespaceWeight:         params.Config.TransferProcessorSchedulerNamespaceWeight,
 				},
 				params.NamespaceRegistry,
 				params.Logger,
@@ -932,6 +932,7 @@ func (c *ContextImpl) UpdateRegistry(ctx context.Context, fallbackMutableState M
 
 	if c.updateRegistry == nil {
 		nsIDStr := ms.GetNamespaceEntry().ID().String()
+		nsName := ms.GetNamespaceEntry().Name().String()
 
 		c.updateRegistry = update.NewRegistry(
 			ms,
@@ -948,6 +949,11 @@ func (c *ContextImpl) UpdateRegistry(ctx context.Context, fallbackMutableState M
 					return c.config.WorkflowExecutionMaxTotalUpdates(nsIDStr)
 				},
 			),
+			update.WithDurableAdmissionLimit(
+				func() int {
+					return c.config.WorkflowExecutionMaxDurablyAdmittedUpdates(nsName)
+				},
+			),
 		)
 	}
 	return c.updateRegistry
@@ -28,6 +28,7 @@ import (
 	"cmp"
 	"context"
 	"fmt"
+	"math"
 	"math/rand"
 	"slices"
 	"time"
@@ -998,12 +999,14 @@ func (ms *MutableStateImpl) GetQueryRegistry() QueryRegistry {
 
 // VisitUpdates visits mutable state update entries, ordered by the ID of the history event pointed to by the mutable
 // state entry. Thus, for example, updates entries in Admitted state will be visited in the order that their Admitted
-// events were added to history.
+// events were added to history. Durably admitted updates that are not backed by a history event are visited last, in
+// the order they were admitted.
 func (ms *MutableStateImpl) VisitUpdates(visitor func(updID string, updInfo *persistencespb.UpdateInfo)) {
 	type updateEvent struct {
-		updId   string
-		updInfo *persistencespb.UpdateInfo
-		eventId int64
+		updId    string
+		updInfo  *persistencespb.UpdateInfo
+		eventId  int64
+		sequence int64
 	}
 	var updateEvents []updateEvent
 	for updID, updInfo := range ms.executionInfo.GetUpdateInfos() {
@@ -1011,7 +1014,10 @@ func (ms *MutableStateImpl) VisitUpdates(visitor func(updID string, updInfo *per
 			updId:   updID,
 			updInfo: updInfo,
 		}
-		if adm := updInfo.GetAdmission(); adm != nil {
+		if inline := updInfo.GetAdmission().GetInlineRequest(); inline != nil {
+			u.eventId = math.MaxInt64
+			u.sequence = inline.Sequence
+		} else if adm := updInfo.GetAdmission(); adm != nil {
 			u.eventId = adm.GetHistoryPointer().EventId
 		} else if acc := updInfo.GetAcceptance(); acc != nil {
 			u.eventId = acc.EventId
@@ -1020,7 +1026,9 @@ func (ms *MutableStateImpl) VisitUpdates(visitor func(updID string, updInfo *per
 		}
 		updateEvents = append(updateEvents, u)
 	}
-	slices.SortFunc(updateEvents, func(u1, u2 updateEvent) int { return cmp.Compare(u1.eventId, u2.eventId) })
+	slices.SortFunc(updateEvents, func(u1, u2 updateEvent) int {
+		return cmp.Or(cmp.Compare(u1.eventId, u2.eventId), cmp.Compare(u1.sequence, u2.sequence))
+	})
 
 	for _, u := range updateEvents {
 		visitor(u.updId, u.updInfo)
@@ -4112,6 +4120,55 @@ func (ms *MutableStateImpl) ApplyWorkflowExecutionUpdateAdmittedEvent(event *his
 	return nil
 }
 
+// AddDurablyAdmittedUpdate stores the request of an admitted update in mutable state without writing an event to
+// history. The entry is replaced when the update is accepted and removed when it is rejected. The total size of the
+// stored requests is limited by history.maxDurablyAdmittedUpdatesSizeBytes.
+func (ms *MutableStateImpl) AddDurablyAdmittedUpdate(request *updatepb.Request) error {
+	if err := ms.checkMutability(tag.WorkflowActionUpdateAdmitted); err != nil {
+		return err
+	}
+	if ms.executionInfo.UpdateInfos == nil {
+		ms.executionInfo.UpdateInfos = make(map[string]*persistencespb.UpdateInfo, 1)
+	}
+	updateID := request.GetMeta().GetUpdateId()
+	if _, ok := ms.executionInfo.UpdateInfos[updateID]; ok {
+		return serviceerror.NewInternal(fmt.Sprintf("Update ID %s is already present in mutable state", updateID))
+	}
+	sizeLimit := ms.config.WorkflowExecutionMaxDurablyAdmittedUpdatesSizeBytes(ms.namespaceEntry.Name().String())
+	size := request.Size()
+	// UpdateCount can't be used as the sequence because it is decremented when an update is rejected.
+	var sequence int64
+	for _, ui := range ms.executionInfo.UpdateInfos {
+		inline := ui.GetAdmission().GetInlineRequest()
+		size += inline.GetRequest().Size()
+		sequence = max(sequence, inline.GetSequence())
+	}
+	if size > sizeLimit {
+		return &serviceerror.ResourceExhausted{
+			Cause:   enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT,
+			Scope:   enumspb.RESOURCE_EXHAUSTED_SCOPE_NAMESPACE,
+			Message: fmt.Sprintf("limit on total size of durably admitted updates has been reached (%v bytes)", sizeLimit),
+		}
+	}
+	ms.executionInfo.UpdateCount++
+	ui := persistencespb.UpdateInfo{
+		Value: &persistencespb.UpdateInfo_Admission{
+			Admission: &persistencespb.UpdateAdmissionInfo{
+				Location: &persistencespb.UpdateAdmissionInfo_InlineRequest_{
+					InlineRequest: &persistencespb.UpdateAdmissionInfo_InlineRequest{
+						Request:  request,
+						Sequence: sequence + 1,
+					},
+				},
+			},
+		},
+	}
+	ms.executionInfo.UpdateInfos[updateID] = &ui
+	ms.approximateSize += ui.Size() + len(updateID)
+	ms.updateInfoUpdated[updateID] = struct{}{}
+	return nil
+}
+
 func (ms *MutableStateImpl) AddWorkflowExecutionUpdateAcceptedEvent(
 	protocolInstanceID string,
 	acceptedRequestMessageId string,
@@ -4209,11 +4266,43 @@ func (ms *MutableStateImpl) ApplyWorkflowExecutionUpdateCompletedEvent(
 	return nil
 }
 
-func (ms *MutableStateImpl) RejectWorkflowExecutionUpdate(_ string, _ *updatepb.Rejection) error {
-	// TODO (alex-update): This method is noop because we don't currently write rejections to the history.
+// RejectWorkflowExecutionUpdate removes the request of a durably admitted update from mutable state. Like rejected
+// updates that were not durably admitted, it no longer counts towards the total number of updates.
+func (ms *MutableStateImpl) RejectWorkflowExecutionUpdate(protocolInstanceID string, _ *updatepb.Rejection) error {
+	// TODO (alex-update): Rejections are not written to the history.
+	ui, ok := ms.executionInfo.GetUpdateInfos()[protocolInstanceID]
+	if !ok || ui.GetAdmission().GetInlineRequest() == nil {
+		return nil
+	}
+	delete(ms.executionInfo.UpdateInfos, protocolInstanceID)
+	delete(ms.updateInfoUpdated, protocolInstanceID)
+	ms.executionInfo.UpdateCount--
+	ms.approximateSize -= ui.Size() + len(protocolInstanceID)
 	return nil
 }
 
+// RestoreDurablyAdmittedUpdates copies durably admitted updates from the update entries of another mutable state of
+// the same workflow execution. It is used when mutable state is rebuilt from history, which doesn't contain them.
+// Entries that are not durably admitted updates or are already present are skipped.
+func (ms *MutableStateImpl) RestoreDurablyAdmittedUpdates(updateInfos map[string]*persistencespb.UpdateInfo) {
+	for updateID, ui := range updateInfos {
+		if ui.GetAdmission().GetInlineRequest() == nil {
+			continue
+		}
+		if _, ok := ms.executionInfo.GetUpdateInfos()[updateID]; ok {
+			continue
+		}
+		if ms.executionInfo.UpdateInfos == nil {
+			ms.executionInfo.UpdateInfos = make(map[string]*persistencespb.UpdateInfo, len(updateInfos))
+		}
+		ui = common.CloneProto(ui)
+		ms.executionInfo.UpdateInfos[updateID] = ui
+		ms.executionInfo.UpdateCount++
+		ms.approximateSize += ui.Size() + len(updateID)
+		ms.updateInfoUpdated[updateID] = struct{}{}
+	}
+}
+
 func (ms *MutableStateImpl) ApplyWorkflowExecutionTerminatedEvent(
 	firstEventID int64,
 	event *historypb.HistoryEvent,
@@ -27,6 +27,7 @@ package workflow
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -1273,6 +1274,136 @@ func (s *mutableStateSuite) TestUpdateInfos() {
 	s.Require().IsType((*serviceerror.NotFound)(nil), err)
 }
 
+func (s *mutableStateSuite) TestDurablyAdmittedUpdates() {
+	dbstate := s.buildWorkflowMutableState()
+	var err error
+
+	namespaceEntry := tests.GlobalNamespaceEntry
+	s.mutableState, err = NewMutableStateFromDB(
+		s.mockShard,
+		NewMapEventCache(s.T(), map[events.EventKey]*historypb.HistoryEvent{}),
+		s.logger,
+		namespaceEntry,
+		dbstate,
+		123,
+	)
+	s.NoError(err)
+	err = s.mutableState.UpdateCurrentVersion(namespaceEntry.FailoverVersion(), false)
+	s.NoError(err)
+
+	updateIDs := []string{"update-c", "update-a", "update-b"}
+	for _, updateID := range updateIDs {
+		err = s.mutableState.AddDurablyAdmittedUpdate(&updatepb.Request{
+			Meta:  &updatepb.Meta{UpdateId: updateID},
+			Input: &updatepb.Input{Name: "not_empty"},
+		})
+		s.Require().NoError(err)
+	}
+	err = s.mutableState.AddDurablyAdmittedUpdate(&updatepb.Request{Meta: &updatepb.Meta{UpdateId: updateIDs[0]}})
+	s.Require().Error(err, "update must not be admitted twice")
+	s.Equal(int64(3), s.mutableState.GetExecutionInfo().GetUpdateCount())
+
+	acptEvent, err := s.mutableState.AddWorkflowExecutionUpdateAcceptedEvent(
+		updateIDs[2],
+		updateIDs[2]+"/request",
+		1,
+		&updatepb.Request{Meta: &updatepb.Meta{UpdateId: updateIDs[2]}},
+	)
+	s.Require().NoError(err)
+
+	err = s.mutableState.RejectWorkflowExecutionUpdate(updateIDs[1], &updatepb.Rejection{})
+	s.Require().NoError(err)
+	// Rejecting an accepted update doesn't remove it.
+	err = s.mutableState.RejectWorkflowExecutionUpdate(updateIDs[2], &updatepb.Rejection{})
+	s.Require().NoError(err)
+	s.Equal(int64(2), s.mutableState.GetExecutionInfo().GetUpdateCount(), "rejected update must not be counted")
+
+	err = s.mutableState.AddDurablyAdmittedUpdate(&updatepb.Request{Meta: &updatepb.Meta{UpdateId: "update-d"}})
+	s.Require().NoError(err)
+	s.Equal(int64(3), s.mutableState.GetExecutionInfo().GetUpdateCount())
+
+	var visited []string
+	s.mutableState.VisitUpdates(func(updID string, updInfo *persistencespb.UpdateInfo) {
+		visited = append(visited, updID)
+		if updID == updateIDs[2] {
+			s.Equal(acptEvent.GetEventId(), updInfo.GetAcceptance().GetEventId())
+		} else {
+			s.Equal(updID, updInfo.GetAdmission().GetInlineRequest().GetRequest().GetMeta().GetUpdateId())
+		}
+	})
+	s.Equal([]string{updateIDs[2], updateIDs[0], "update-d"}, visited,
+		"expected accepted update first, then durably admitted updates in admission order")
+
+	s.mockConfig.WorkflowExecutionMaxDurablyAdmittedUpdatesSizeBytes = func(string) int { return 100 }
+	err = s.mutableState.AddDurablyAdmittedUpdate(&updatepb.Request{
+		Meta:  &updatepb.Meta{UpdateId: "update-e"},
+		Input: &updatepb.Input{Name: strings.Repeat("a", 100)},
+	})
+	var resExh *serviceerror.ResourceExhausted
+	s.Require().ErrorAs(err, &resExh, "total size of durably admitted updates must be limited")
+	s.NotContains(s.mutableState.GetExecutionInfo().GetUpdateInfos(), "update-e")
+}
+
+func (s *mutableStateSuite) TestRestoreDurablyAdmittedUpdates() {
+	dbstate := s.buildWorkflowMutableState()
+	var err error
+
+	namespaceEntry := tests.GlobalNamespaceEntry
+	s.mutableState, err = NewMutableStateFromDB(
+		s.mockShard,
+		NewMapEventCache(s.T(), map[events.EventKey]*historypb.HistoryEvent{}),
+		s.logger,
+		namespaceEntry,
+		dbstate,
+		123,
+	)
+	s.NoError(err)
+	err = s.mutableState.UpdateCurrentVersion(namespaceEntry.FailoverVersion(), false)
+	s.NoError(err)
+
+	_, err = s.mutableState.AddWorkflowExecutionUpdateAcceptedEvent(
+		"update-accepted",
+		"update-accepted/request",
+		1,
+		&updatepb.Request{Meta: &updatepb.Meta{UpdateId: "update-accepted"}},
+	)
+	s.Require().NoError(err)
+	updateCount := s.mutableState.GetExecutionInfo().GetUpdateCount()
+
+	inlineUpdateInfo := func(updateID string, sequence int64) *persistencespb.UpdateInfo {
+		return &persistencespb.UpdateInfo{
+			Value: &persistencespb.UpdateInfo_Admission{
+				Admission: &persistencespb.UpdateAdmissionInfo{
+					Location: &persistencespb.UpdateAdmissionInfo_InlineRequest_{
+						InlineRequest: &persistencespb.UpdateAdmissionInfo_InlineRequest{
+							Request:  &updatepb.Request{Meta: &updatepb.Meta{UpdateId: updateID}},
+							Sequence: sequence,
+						},
+					},
+				},
+			},
+		}
+	}
+	s.mutableState.RestoreDurablyAdmittedUpdates(map[string]*persistencespb.UpdateInfo{
+		"update-b": inlineUpdateInfo("update-b", 2),
+		"update-a": inlineUpdateInfo("update-a", 1),
+		// entries backed by history are rebuilt from history and are not restored
+		"update-accepted": {
+			Value: &persistencespb.UpdateInfo_Acceptance{Acceptance: &persistencespb.UpdateAcceptanceInfo{EventId: 10}},
+		},
+		"update-completed": {
+			Value: &persistencespb.UpdateInfo_Completion{Completion: &persistencespb.UpdateCompletionInfo{EventId: 11}},
+		},
+	})
+	s.Equal(updateCount+2, s.mutableState.GetExecutionInfo().GetUpdateCount())
+
+	var visited []string
+	s.mutableState.VisitUpdates(func(updID string, _ *persistencespb.UpdateInfo) {
+		visited = append(visited, updID)
+	})
+	s.Equal([]string{"update-accepted", "update-a", "update-b"}, visited)
+}
+
 func (s *mutableStateSuite) TestApplyActivityTaskStartedEvent() {
 	state := s.buildWorkflowMutableState()
 
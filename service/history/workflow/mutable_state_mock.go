@@ -317,6 +317,20 @@ func (mr *MockMutableStateMockRecorder) AddContinueAsNewEvent(arg0, arg1, arg2,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddContinueAsNewEvent", reflect.TypeOf((*MockMutableState)(nil).AddContinueAsNewEvent), arg0, arg1, arg2, arg3, arg4)
 }
 
+// AddDurablyAdmittedUpdate mocks base method.
+func (m *MockMutableState) AddDurablyAdmittedUpdate(request *update.Request) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDurablyAdmittedUpdate", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDurablyAdmittedUpdate indicates an expected call of AddDurablyAdmittedUpdate.
+func (mr *MockMutableStateMockRecorder) AddDurablyAdmittedUpdate(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDurablyAdmittedUpdate", reflect.TypeOf((*MockMutableState)(nil).AddDurablyAdmittedUpdate), request)
+}
+
 // AddExternalWorkflowExecutionCancelRequested mocks base method.
 func (m *MockMutableState) AddExternalWorkflowExecutionCancelRequested(arg0 int64, arg1 namespace.Name, arg2 namespace.ID, arg3, arg4 string) (*history.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -2838,6 +2852,18 @@ func (mr *MockMutableStateMockRecorder) RemoveSpeculativeWorkflowTaskTimeoutTask
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSpeculativeWorkflowTaskTimeoutTask", reflect.TypeOf((*MockMutableState)(nil).RemoveSpeculativeWorkflowTaskTimeoutTask))
 }
 
+// RestoreDurablyAdmittedUpdates mocks base method.
+func (m *MockMutableState) RestoreDurablyAdmittedUpdates(updateInfos map[string]*persistence.UpdateInfo) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RestoreDurablyAdmittedUpdates", updateInfos)
+}
+
+// RestoreDurablyAdmittedUpdates indicates an expected call of RestoreDurablyAdmittedUpdates.
+func (mr *MockMutableStateMockRecorder) RestoreDurablyAdmittedUpdates(updateInfos any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDurablyAdmittedUpdates", reflect.TypeOf((*MockMutableState)(nil).RestoreDurablyAdmittedUpdates), updateInfos)
+}
+
 // RetryActivity mocks base method.
 func (m *MockMutableState) RetryActivity(ai *persistence.ActivityInfo, failure *failure.Failure) (enums.RetryState, error) {
 	m.ctrl.T.Helper()
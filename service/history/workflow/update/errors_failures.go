@@ -28,6 +28,7 @@ import (
 	"errors"
 
 	failurepb "go.temporal.io/api/failure/v1"
+	updatepb "go.temporal.io/api/update/v1"
 )
 
 var (
@@ -43,4 +44,5 @@ var (
 			NonRetryable: true,
 		}},
 	}
+	unprocessedUpdateRejection = &updatepb.Rejection{Failure: unprocessedUpdateFailure}
 )
@@ -39,9 +39,9 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/future"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/utf8validator"
-	"go.temporal.io/server/internal/effect"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
@@ -55,6 +55,11 @@ type (
 		// created (false).
 		FindOrCreate(ctx context.Context, updateID string) (_ *Update, alreadyExisted bool, _ error)
 
+		// FindOrCreateDurable works like FindOrCreate, but a newly created
+		// Update is limited by the number of durably admitted Updates instead of
+		// the number of in-flight Updates. It must be admitted with AdmitDurably.
+		FindOrCreateDurable(ctx context.Context, updateID string) (_ *Update, alreadyExisted bool, _ error)
+
 		// Find finds an existing Update in this Registry but does not create a
 		// new Update if no Update is found.
 		Find(ctx context.Context, updateID string) *Update
@@ -72,6 +77,12 @@ type (
 		// even if an Update message was already sent but not processed by worker.
 		HasOutgoingMessages(includeAlreadySent bool) bool
 
+		// HasDurableOutgoingMessages returns true if the Registry has any durably
+		// admitted Updates for which outgoing message can be generated. These
+		// Updates must be delivered on a normal workflow task, which, unlike a
+		// speculative one, is not lost together with the Registry.
+		HasDurableOutgoingMessages() bool
+
 		// Send returns messages for all Updates that need to be sent to the worker.
 		// If includeAlreadySent is set to true, then messages will be created even
 		// for Updates which were already sent but not processed by worker.
@@ -80,7 +91,7 @@ type (
 		// RejectUnprocessed rejects all Updates that are waiting for a workflow task to be completed.
 		// This method should be called after all messages from worker are handled to make sure
 		// that worker processed (rejected or accepted) all Updates that were delivered on the workflow task.
-		RejectUnprocessed(ctx context.Context, effects effect.Controller) ([]string, error)
+		RejectUnprocessed(ctx context.Context, eventStore EventStore) ([]string, error)
 
 		// Abort all incomplete Updates in the Registry.
 		Abort(reason AbortReason)
@@ -112,6 +123,7 @@ type (
 		instrumentation instrumentation
 		maxInFlight     func() int
 		maxTotal        func() int
+		maxDurable      func() int
 		completedCount  int
 		failoverVersion int64
 	}
@@ -136,6 +148,14 @@ func WithTotalLimit(f func() int) Option {
 	}
 }
 
+// WithDurableAdmissionLimit provides an optional limit to the number of
+// durably admitted Updates that are not yet accepted or rejected.
+func WithDurableAdmissionLimit(f func() int) Option {
+	return func(r *registry) {
+		r.maxDurable = f
+	}
+}
+
 // WithLogger sets the log.Logger to be used by Registry and its Updates.
 func WithLogger(l log.Logger) Option {
 	return func(r *registry) {
@@ -168,6 +188,7 @@ func NewRegistry(
 		instrumentation: noopInstrumentation,
 		maxInFlight:     func() int { return math.MaxInt },
 		maxTotal:        func() int { return math.MaxInt },
+		maxDurable:      func() int { return math.MaxInt },
 		failoverVersion: store.GetCurrentVersion(),
 	}
 	for _, opt := range opts {
@@ -175,7 +196,26 @@ func NewRegistry(
 	}
 
 	r.store.VisitUpdates(func(updID string, updInfo *persistencespb.UpdateInfo) {
-		if updInfo.GetAdmission() != nil {
+		if inline := updInfo.GetAdmission().GetInlineRequest(); inline != nil {
+			// A durably admitted Update carries its request payload in mutable state. It is loaded into the
+			// Registry, so that it is sent to the worker and written to the UpdateAccepted event as usual.
+			reqAny, err := anypb.New(inline.GetRequest())
+			if err != nil {
+				r.instrumentation.log.Error("Unable to load durably admitted Update request.", tag.Error(err))
+				return
+			}
+			u := newAdmitted(
+				updID,
+				reqAny,
+				r.remover(updID),
+				withInstrumentation(&r.instrumentation),
+				withDurable(),
+			)
+			if !r.store.IsWorkflowExecutionRunning() {
+				u.abort(AbortReasonWorkflowCompleted)
+			}
+			r.updates[updID] = u
+		} else if updInfo.GetAdmission() != nil {
 			// An Update entry in the Registry may have a request payload: we use this to write the payload to an
 			// UpdateAccepted event, in the event that the Update is accepted. However, when populating the registry
 			// from mutable state, we do not have access to Update request payloads. In this situation it is correct
@@ -222,6 +262,18 @@ func (r *registry) FindOrCreate(ctx context.Context, id string) (*Update, bool,
 	return upd, false, nil
 }
 
+func (r *registry) FindOrCreateDurable(ctx context.Context, id string) (*Update, bool, error) {
+	if upd := r.Find(ctx, id); upd != nil {
+		return upd, true, nil
+	}
+	if err := r.checkDurableLimits(); err != nil {
+		return nil, false, err
+	}
+	upd := New(id, r.remover(id), withInstrumentation(&r.instrumentation))
+	r.updates[id] = upd
+	return upd, false, nil
+}
+
 func (r *registry) TryResurrect(_ context.Context, acptOrRejMsg *protocolpb.Message) (*Update, error) {
 	if acptOrRejMsg == nil || acptOrRejMsg.Body == nil {
 		return nil, nil
@@ -284,7 +336,7 @@ func (r *registry) Contains(updateID string) bool {
 
 func (r *registry) RejectUnprocessed(
 	_ context.Context,
-	effects effect.Controller,
+	eventStore EventStore,
 ) ([]string, error) {
 	var updatesToReject []*Update
 	for _, upd := range r.updates {
@@ -295,7 +347,7 @@ func (r *registry) RejectUnprocessed(
 
 	var rejectedUpdateIDs []string
 	for _, upd := range updatesToReject {
-		if err := upd.reject(unprocessedUpdateFailure, effects); err != nil {
+		if err := upd.reject(unprocessedUpdateRejection, eventStore); err != nil {
 			return nil, err
 		}
 		rejectedUpdateIDs = append(rejectedUpdateIDs, upd.id)
@@ -312,6 +364,15 @@ func (r *registry) HasOutgoingMessages(includeAlreadySent bool) bool {
 	return false
 }
 
+func (r *registry) HasDurableOutgoingMessages() bool {
+	for _, upd := range r.updates {
+		if upd.durable && upd.needToSend(true) {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *registry) Send(
 	_ context.Context,
 	includeAlreadySent bool,
@@ -367,7 +428,7 @@ func (r *registry) remover(id string) updateOpt {
 }
 
 func (r *registry) checkLimits() error {
-	if len(r.updates) >= r.maxInFlight() {
+	if len(r.updates)-r.durableLen() >= r.maxInFlight() {
 		r.instrumentation.countRateLimited()
 		return &serviceerror.ResourceExhausted{
 			Cause:   enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT,
@@ -378,6 +439,29 @@ func (r *registry) checkLimits() error {
 	return r.checkTotalLimit()
 }
 
+func (r *registry) checkDurableLimits() error {
+	if r.durableLen() >= r.maxDurable() {
+		r.instrumentation.countRateLimited()
+		return &serviceerror.ResourceExhausted{
+			Cause:   enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT,
+			Scope:   enumspb.RESOURCE_EXHAUSTED_SCOPE_NAMESPACE,
+			Message: fmt.Sprintf("limit on number of durably admitted updates has been reached (%v)", r.maxDurable()),
+		}
+	}
+	return r.checkTotalLimit()
+}
+
+// durableLen returns the number of durably admitted Updates that are not yet accepted or rejected.
+func (r *registry) durableLen() int {
+	var n int
+	for _, upd := range r.updates {
+		if upd.durable && upd.state.Matches(stateSet(stateProvisionallyAdmitted|stateAdmitted|stateSent)) {
+			n++
+		}
+	}
+	return n
+}
+
 func (r *registry) checkTotalLimit() error {
 	if len(r.updates)+r.completedCount >= r.maxTotal() {
 		r.instrumentation.countTooMany()
@@ -84,6 +84,55 @@ func TestNewRegistry(t *testing.T) {
 		assertCompleteUpdateInRegistry(t, reg, evStore, upd)
 	})
 
+	t.Run("registry created from store with durably admitted update contains admitted update with request", func(t *testing.T) {
+		reg := update.NewRegistry(&mockUpdateStore{
+			VisitUpdatesFunc: func(visitor func(updID string, updInfo *persistencespb.UpdateInfo)) {
+				visitor(
+					tv.UpdateID(),
+					&persistencespb.UpdateInfo{
+						Value: &persistencespb.UpdateInfo_Admission{
+							Admission: &persistencespb.UpdateAdmissionInfo{
+								Location: &persistencespb.UpdateAdmissionInfo_InlineRequest_{
+									InlineRequest: &persistencespb.UpdateAdmissionInfo_InlineRequest{
+										Request: &updatepb.Request{
+											Meta:  &updatepb.Meta{UpdateId: tv.UpdateID()},
+											Input: &updatepb.Input{Name: "not_empty"},
+										},
+										Sequence: 1,
+									},
+								},
+							},
+						},
+					})
+			},
+		})
+		var rejectedUpdateID string
+		evStore := mockEventStore{
+			Controller: effect.Immediate(context.Background()),
+			RejectWorkflowExecutionUpdateFunc: func(protocolInstanceID string, _ *updatepb.Rejection) error {
+				rejectedUpdateID = protocolInstanceID
+				return nil
+			},
+		}
+
+		require.Equal(t, 1, reg.Len())
+		require.True(t, reg.HasDurableOutgoingMessages())
+
+		upd := reg.Find(context.Background(), tv.UpdateID())
+		require.NotNil(t, upd)
+		assertAdmitted(t, upd)
+
+		msgs := reg.Send(context.Background(), skipAlreadySent, testSequencingEventID)
+		require.Len(t, msgs, 1)
+		req := &updatepb.Request{}
+		require.NoError(t, msgs[0].Body.UnmarshalTo(req))
+		require.Equal(t, "not_empty", req.GetInput().GetName())
+
+		// rejecting the update removes it from the store
+		assertRejectUpdateInRegistry(t, reg, evStore, upd)
+		require.Equal(t, tv.UpdateID(), rejectedUpdateID)
+	})
+
 	t.Run("registry created from store with update in stateAccepted contains accepted update", func(t *testing.T) {
 		reg := update.NewRegistry(&mockUpdateStore{
 			VisitUpdatesFunc: func(visitor func(updID string, updInfo *persistencespb.UpdateInfo)) {
@@ -361,6 +410,82 @@ func TestFindOrCreate(t *testing.T) {
 	})
 }
 
+func TestFindOrCreateDurable(t *testing.T) {
+	t.Parallel()
+	tv := testvars.New(t)
+
+	t.Run("admit update durably", func(t *testing.T) {
+		reg := update.NewRegistry(emptyUpdateStore)
+		var storedRequest *updatepb.Request
+		evStore := mockEventStore{
+			Controller: effect.Immediate(context.Background()),
+			AddDurablyAdmittedUpdateFunc: func(request *updatepb.Request) error {
+				storedRequest = request
+				return nil
+			},
+		}
+
+		upd, existed, err := reg.FindOrCreateDurable(context.Background(), tv.UpdateID())
+		require.NoError(t, err)
+		require.False(t, existed)
+		require.False(t, reg.HasDurableOutgoingMessages())
+
+		require.NoError(t, admitDurably(t, evStore, upd))
+		assertAdmitted(t, upd)
+		require.Equal(t, tv.UpdateID(), storedRequest.GetMeta().GetUpdateId())
+		require.True(t, reg.HasDurableOutgoingMessages())
+
+		_, existed, err = reg.FindOrCreateDurable(context.Background(), tv.UpdateID())
+		require.NoError(t, err)
+		require.True(t, existed)
+	})
+
+	t.Run("enforce durable admission limit", func(t *testing.T) {
+		var (
+			upd1    *update.Update
+			existed bool
+			err     error
+			limit   = 1
+			reg     = update.NewRegistry(
+				emptyUpdateStore,
+				update.WithInFlightLimit(
+					func() int { return 1 },
+				),
+				update.WithDurableAdmissionLimit(
+					func() int { return limit },
+				),
+			)
+			evStore = mockEventStore{Controller: effect.Immediate(context.Background())}
+		)
+		upd1, existed, err = reg.FindOrCreateDurable(context.Background(), tv.UpdateID("1"))
+		require.NoError(t, err)
+		require.False(t, existed)
+		require.NoError(t, admitDurably(t, evStore, upd1))
+
+		t.Run("deny new durable update since it is exceeding the limit", func(t *testing.T) {
+			_, _, err = reg.FindOrCreateDurable(context.Background(), tv.UpdateID("2"))
+			var resExh *serviceerror.ResourceExhausted
+			require.ErrorAs(t, err, &resExh)
+			require.Equal(t, 1, reg.Len())
+		})
+
+		t.Run("durable update does not count towards in-flight limit", func(t *testing.T) {
+			_, existed, err = reg.FindOrCreate(context.Background(), tv.UpdateID("3"))
+			require.NoError(t, err)
+			require.False(t, existed)
+			require.Equal(t, 2, reg.Len())
+		})
+
+		t.Run("accepting 1st update allows new durable update to be created", func(t *testing.T) {
+			mustAccept(t, evStore, upd1)
+
+			_, existed, err = reg.FindOrCreateDurable(context.Background(), tv.UpdateID("2"))
+			require.NoError(t, err, "update #2 should be created after update #1 is accepted")
+			require.False(t, existed)
+		})
+	})
+}
+
 func TestHasOutgoingMessages(t *testing.T) {
 	t.Parallel()
 
@@ -67,6 +67,18 @@ type (
 			resp *updatepb.Response,
 		) (*historypb.HistoryEvent, error)
 
+		// AddDurablyAdmittedUpdate stores the request of an admitted Update
+		// outside of history. The data may not be durable when this function
+		// returns.
+		AddDurablyAdmittedUpdate(request *updatepb.Request) error
+
+		// RejectWorkflowExecutionUpdate removes any stored state of a rejected
+		// Update. The data may not be durable when this function returns.
+		RejectWorkflowExecutionUpdate(
+			protocolInstanceID string,
+			updRejection *updatepb.Rejection,
+		) error
+
 		// CanAddEvent returns true if an event can be added to the EventStore.
 		CanAddEvent() bool
 	}
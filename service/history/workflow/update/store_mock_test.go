@@ -96,6 +96,13 @@ type mockEventStore struct {
 		resp *updatepb.Response,
 	) (*historypb.HistoryEvent, error)
 
+	AddDurablyAdmittedUpdateFunc func(request *updatepb.Request) error
+
+	RejectWorkflowExecutionUpdateFunc func(
+		protocolInstanceID string,
+		updRejection *updatepb.Rejection,
+	) error
+
 	CanAddEventFunc func() bool
 }
 
@@ -121,6 +128,23 @@ func (m mockEventStore) AddWorkflowExecutionUpdateCompletedEvent(
 	return &historypb.HistoryEvent{}, nil
 }
 
+func (m mockEventStore) AddDurablyAdmittedUpdate(request *updatepb.Request) error {
+	if m.AddDurablyAdmittedUpdateFunc != nil {
+		return m.AddDurablyAdmittedUpdateFunc(request)
+	}
+	return nil
+}
+
+func (m mockEventStore) RejectWorkflowExecutionUpdate(
+	protocolInstanceID string,
+	updRejection *updatepb.Rejection,
+) error {
+	if m.RejectWorkflowExecutionUpdateFunc != nil {
+		return m.RejectWorkflowExecutionUpdateFunc(protocolInstanceID, updRejection)
+	}
+	return nil
+}
+
 func (m mockEventStore) CanAddEvent() bool {
 	if m.CanAddEventFunc != nil {
 		return m.CanAddEventFunc()
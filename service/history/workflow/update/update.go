@@ -38,7 +38,6 @@ import (
 	"go.temporal.io/server/common/future"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/utf8validator"
-	"go.temporal.io/server/internal/effect"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
@@ -65,7 +64,11 @@ type (
 		//    so we don't *need* to load the request into the Registry.
 		// 3. Furthermore, it is possible that many UpdateAdmitted events were created after a Reset or during conflict
 		//    resolution. In that situation, we *must not* attempt to load all the payloads into the Registry.
-		request         *anypb.Any // of type *updatepb.Request
+		request *anypb.Any // of type *updatepb.Request
+		// durable is true if the request of this Update is stored in an UpdateInfo.AdmissionInfo.InlineRequest
+		// entry in MutableState, i.e. the Update was admitted with AdmitDurably. Such an Update survives a reload
+		// of the Registry, and the entry must be removed from MutableState if the Update is rejected.
+		durable         bool
 		acceptedEventID int64
 		onComplete      func()
 		instrumentation *instrumentation
@@ -154,6 +157,12 @@ func withCompletionCallback(cb func()) updateOpt {
 	}
 }
 
+func withDurable() updateOpt {
+	return func(u *Update) {
+		u.durable = true
+	}
+}
+
 func withInstrumentation(i *instrumentation) updateOpt {
 	return func(u *Update) {
 		u.instrumentation = i
@@ -290,7 +299,26 @@ func (u *Update) abort(reason AbortReason) {
 // when Send is called.
 func (u *Update) Admit(
 	req *updatepb.Request,
-	eventStore EventStore, // Will be useful for durable admitted.
+	eventStore EventStore,
+) error {
+	return u.admit(req, eventStore, false)
+}
+
+// AdmitDurably works like Admit, but it also stores the Update request in the
+// EventStore. Unlike an Update admitted with Admit, a durably admitted Update
+// is not lost when the Registry is cleared (e.g., due to shard movement): it
+// is recreated in stateAdmitted when the Registry is reloaded from the store.
+func (u *Update) AdmitDurably(
+	req *updatepb.Request,
+	eventStore EventStore,
+) error {
+	return u.admit(req, eventStore, true)
+}
+
+func (u *Update) admit(
+	req *updatepb.Request,
+	eventStore EventStore,
+	durable bool,
 ) error {
 	if u.state != stateCreated {
 		return nil
@@ -315,7 +343,13 @@ func (u *Update) Admit(
 	if err != nil {
 		return invalidArgf("unable to unmarshal request: %v", err)
 	}
+	if durable {
+		if err := eventStore.AddDurablyAdmittedUpdate(req); err != nil {
+			return err
+		}
+	}
 	u.request = reqAny
+	u.durable = durable
 
 	prevState := u.setState(stateProvisionallyAdmitted)
 	eventStore.OnAfterCommit(func(context.Context) {
@@ -330,6 +364,7 @@ func (u *Update) Admit(
 			return
 		}
 		u.setState(prevState)
+		u.durable = false
 		var timeZero time.Time
 		u.admittedTime = timeZero
 	})
@@ -533,7 +568,7 @@ func (u *Update) onAcceptanceMsg(
 // are both completed with the failurepb.Failure value from the updatepb.Rejection input message.
 func (u *Update) onRejectionMsg(
 	rej *updatepb.Rejection,
-	effects effect.Controller,
+	eventStore EventStore,
 ) error {
 	// See comment in onAcceptanceMsg about stateAdmitted.
 	if err := u.checkStateSet(rej, stateSet(stateSent|stateAdmitted)); err != nil {
@@ -543,16 +578,22 @@ func (u *Update) onRejectionMsg(
 		return err
 	}
 	u.instrumentation.countRejectionMsg()
-	return u.reject(rej.Failure, effects)
+	return u.reject(rej, eventStore)
 }
 
-// rejects an Update with provided failure.
+// rejects an Update with provided rejection.
 func (u *Update) reject(
-	rejectionFailure *failurepb.Failure,
-	effects effect.Controller,
+	rej *updatepb.Rejection,
+	eventStore EventStore,
 ) error {
+	if u.durable {
+		if err := eventStore.RejectWorkflowExecutionUpdate(u.id, rej); err != nil {
+			return err
+		}
+	}
+	rejectionFailure := rej.GetFailure()
 	prevState := u.setState(stateProvisionallyCompleted)
-	effects.OnAfterCommit(func(context.Context) {
+	eventStore.OnAfterCommit(func(context.Context) {
 		if u.state != stateProvisionallyCompleted {
 			return
 		}
@@ -566,7 +607,7 @@ func (u *Update) reject(
 		u.outcome.(*future.FutureImpl[*updatepb.Outcome]).Set(&outcome, nil)
 		u.onComplete()
 	})
-	effects.OnAfterRollback(func(context.Context) {
+	eventStore.OnAfterRollback(func(context.Context) {
 		if u.state != stateProvisionallyCompleted {
 			return
 		}
@@ -879,6 +879,13 @@ func admit(t *testing.T, store mockEventStore, upd *update.Update) error {
 	}, store)
 }
 
+func admitDurably(t *testing.T, store mockEventStore, upd *update.Update) error {
+	return upd.AdmitDurably(&updatepb.Request{
+		Meta:  &updatepb.Meta{UpdateId: upd.ID()},
+		Input: &updatepb.Input{Name: "not_empty"},
+	}, store)
+}
+
 func send(t *testing.T, upd *update.Update, includeAlreadySent bool) *protocolpb.Message {
 	t.Helper()
 	return upd.Send(includeAlreadySent, &protocolpb.Message_EventId{EventId: testSequencingEventID})
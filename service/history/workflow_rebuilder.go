@@ -32,6 +32,7 @@ import (
 
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/locks"
 	"go.temporal.io/server/common/log"
@@ -51,6 +52,8 @@ type (
 		stateTransitionCount int64
 		dbRecordVersion      int64
 		requestID            string
+		// updateInfos contains the durably admitted updates, which are not recorded in history
+		updateInfos map[string]*persistencespb.UpdateInfo
 	}
 	workflowRebuilder interface {
 		// rebuild rebuilds a workflow, in case of any kind of corruption
@@ -118,6 +121,7 @@ func (r *workflowRebuilderImpl) rebuild(
 		rebuildSpec.stateTransitionCount,
 		rebuildSpec.dbRecordVersion,
 		rebuildSpec.requestID,
+		rebuildSpec.updateInfos,
 	)
 	if err != nil {
 		return err
@@ -167,6 +171,7 @@ func (r *workflowRebuilderImpl) getRebuildSpecFromMutableState(
 		stateTransitionCount: mutableState.ExecutionInfo.StateTransitionCount,
 		dbRecordVersion:      resp.DBRecordVersion,
 		requestID:            mutableState.ExecutionState.CreateRequestId,
+		updateInfos:          mutableState.ExecutionInfo.UpdateInfos,
 	}, nil
 }
 
@@ -177,6 +182,7 @@ func (r *workflowRebuilderImpl) replayResetWorkflow(
 	stateTransitionCount int64,
 	dbRecordVersion int64,
 	requestID string,
+	updateInfos map[string]*persistencespb.UpdateInfo,
 ) (workflow.MutableState, error) {
 
 	rebuildMutableState, rebuildHistorySize, err := ndc.NewStateRebuilder(r.shard, r.logger).Rebuild(
@@ -198,6 +204,7 @@ func (r *workflowRebuilderImpl) replayResetWorkflow(
 	// should remain the same, the -= 1 exists here since later CloseTransactionAsSnapshot will += 1 to state transition count
 	rebuildMutableState.GetExecutionInfo().StateTransitionCount = stateTransitionCount - 1
 	rebuildMutableState.AddHistorySize(rebuildHistorySize)
+	rebuildMutableState.RestoreDurablyAdmittedUpdates(updateInfos)
 	rebuildMutableState.SetUpdateCondition(rebuildMutableState.GetNextEventID(), dbRecordVersion)
 	return rebuildMutableState, nil
 }
@@ -0,0 +1,188 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reindexvisibility
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/service/worker/scanner/executions"
+)
+
+type (
+	activities struct {
+		historyShardCount int32
+		executionManager  persistence.ExecutionManager
+		namespaceRegistry namespace.Registry
+		historyClient     historyservice.HistoryServiceClient
+		logger            log.Logger
+	}
+
+	// reindexShardHeartbeatDetails is the progress of the shard up to the execution at IndexInPage
+	// of the page being reindexed, so the activity resumes from that execution after a retry
+	// without reindexing or counting the previous executions again.
+	reindexShardHeartbeatDetails struct {
+		PageToken   []byte
+		IndexInPage int
+		reindexShardResponse
+	}
+)
+
+// GetReindexVisibilityMetadata returns history shard count and the IDs of the requested namespaces.
+func (a *activities) GetReindexVisibilityMetadata(_ context.Context, request metadataRequest) (*metadataResponse, error) {
+	namespaceIDs := make([]string, 0, len(request.Namespaces))
+	for _, nsName := range request.Namespaces {
+		nsEntry, err := a.namespaceRegistry.GetNamespace(namespace.Name(nsName))
+		if err != nil {
+			if _, isNotFound := err.(*serviceerror.NamespaceNotFound); isNotFound {
+				return nil, temporal.NewNonRetryableApplicationError(
+					fmt.Sprintf("InvalidArgument: %v", err),
+					"InvalidArgument",
+					nil,
+				)
+			}
+			return nil, err
+		}
+		namespaceIDs = append(namespaceIDs, nsEntry.ID().String())
+	}
+
+	return &metadataResponse{
+		ShardCount:   a.historyShardCount,
+		NamespaceIDs: namespaceIDs,
+	}, nil
+}
+
+// ReindexShard regenerates the visibility tasks of all executions in the shard which belong to
+// the requested namespaces.
+func (a *activities) ReindexShard(ctx context.Context, request reindexShardRequest) (*reindexShardResponse, error) {
+	ctx = headers.SetCallerInfo(ctx, headers.SystemPreemptableCallerInfo)
+	rateLimiter := quotas.NewRateLimiter(request.RPS, int(math.Ceil(request.RPS)))
+	logger := log.With(a.logger, tag.ShardID(request.ShardID))
+
+	var checkpoint reindexShardHeartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &checkpoint); err != nil {
+			logger.Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+			checkpoint = reindexShardHeartbeatDetails{}
+		}
+	}
+
+	namespaceIDs := make(map[string]struct{}, len(request.NamespaceIDs))
+	for _, namespaceID := range request.NamespaceIDs {
+		namespaceIDs[namespaceID] = struct{}{}
+	}
+
+	paginationFn := executions.NewPaginationFn(ctx, a.executionManager, request.ShardID, request.PageSize)
+	for {
+		mutableStates, nextPageToken, err := paginationFn(checkpoint.PageToken)
+		if err != nil {
+			return nil, err
+		}
+
+		for index := checkpoint.IndexInPage; index < len(mutableStates); index++ {
+			mutableState := mutableStates[index]
+			checkpoint.ScannedExecutionCount++
+			if _, ok := namespaceIDs[mutableState.GetExecutionInfo().GetNamespaceId()]; ok || len(namespaceIDs) == 0 {
+				if err := rateLimiter.Wait(ctx); err != nil {
+					return nil, err
+				}
+				reindexed, err := a.reindexExecution(ctx, request.ShardID, mutableState)
+				if err != nil {
+					if isRetryableError(err) {
+						return nil, err
+					}
+					logger.Error("reindex-visibility failed to refresh visibility tasks",
+						tag.WorkflowNamespaceID(mutableState.GetExecutionInfo().GetNamespaceId()),
+						tag.WorkflowID(mutableState.GetExecutionInfo().GetWorkflowId()),
+						tag.WorkflowRunID(mutableState.GetExecutionState().GetRunId()),
+						tag.Error(err),
+					)
+					checkpoint.FailedExecutionCount++
+				} else if reindexed {
+					checkpoint.ReindexedExecutionCount++
+				}
+			}
+			checkpoint.IndexInPage = index + 1
+			activity.RecordHeartbeat(ctx, checkpoint)
+		}
+
+		checkpoint.PageToken = nextPageToken
+		checkpoint.IndexInPage = 0
+		activity.RecordHeartbeat(ctx, checkpoint)
+
+		if len(nextPageToken) == 0 {
+			return &checkpoint.reindexShardResponse, nil
+		}
+	}
+}
+
+// reindexExecution regenerates the visibility task of the execution. It returns false if the
+// execution has no visibility record or if it or its namespace were deleted.
+func (a *activities) reindexExecution(
+	ctx context.Context,
+	shardID int32,
+	mutableState *persistencespb.WorkflowMutableState,
+) (bool, error) {
+	namespaceID := namespace.ID(mutableState.GetExecutionInfo().GetNamespaceId())
+	nsName, err := a.namespaceRegistry.GetNamespaceName(namespaceID)
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+
+	ctx = headers.SetCallerInfo(ctx, headers.NewPreemptableCallerInfo(nsName.String()))
+	reindexed, err := RefreshVisibilityTasks(ctx, a.historyClient, shardID, mutableState)
+	switch err.(type) {
+	case nil:
+		return reindexed, nil
+	case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// isRetryableError returns true if the error is expected to go away on retry, in which case the
+// activity fails and is retried from the last checkpoint. Other errors are specific to the
+// execution and only counted as failures.
+func isRetryableError(err error) bool {
+	return common.IsPersistenceTransientError(err) ||
+		common.IsContextDeadlineExceededErr(err) ||
+		common.IsContextCanceledErr(err)
+}
@@ -0,0 +1,294 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reindexvisibility
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/testsuite"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/testing/protomock"
+	"go.temporal.io/server/service/history/tasks"
+	"go.uber.org/mock/gomock"
+)
+
+type activitiesSuite struct {
+	suite.Suite
+	*require.Assertions
+	testsuite.WorkflowTestSuite
+
+	controller            *gomock.Controller
+	mockExecutionManager  *persistence.MockExecutionManager
+	mockNamespaceRegistry *namespace.MockRegistry
+	mockHistoryClient     *historyservicemock.MockHistoryServiceClient
+
+	a *activities
+}
+
+const (
+	testShardID     = int32(1)
+	testNamespace   = "test-ns"
+	testNamespaceID = "test-ns-id"
+)
+
+func TestActivitiesSuite(t *testing.T) {
+	suite.Run(t, new(activitiesSuite))
+}
+
+func (s *activitiesSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockExecutionManager = persistence.NewMockExecutionManager(s.controller)
+	s.mockNamespaceRegistry = namespace.NewMockRegistry(s.controller)
+	s.mockHistoryClient = historyservicemock.NewMockHistoryServiceClient(s.controller)
+
+	s.a = &activities{
+		historyShardCount: 4,
+		executionManager:  s.mockExecutionManager,
+		namespaceRegistry: s.mockNamespaceRegistry,
+		historyClient:     s.mockHistoryClient,
+		logger:            log.NewNoopLogger(),
+	}
+}
+
+func (s *activitiesSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *activitiesSuite) TestGetMetadata() {
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.a)
+
+	s.mockNamespaceRegistry.EXPECT().GetNamespace(namespace.Name(testNamespace)).Return(
+		namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{Id: testNamespaceID, Name: testNamespace}, nil, ""),
+		nil,
+	)
+	result, err := env.ExecuteActivity(s.a.GetReindexVisibilityMetadata, metadataRequest{Namespaces: []string{testNamespace}})
+	s.NoError(err)
+	var resp metadataResponse
+	s.NoError(result.Get(&resp))
+	s.Equal(metadataResponse{ShardCount: 4, NamespaceIDs: []string{testNamespaceID}}, resp)
+
+	s.mockNamespaceRegistry.EXPECT().GetNamespace(namespace.Name("unknown-ns")).Return(nil, serviceerror.NewNamespaceNotFound("unknown-ns"))
+	_, err = env.ExecuteActivity(s.a.GetReindexVisibilityMetadata, metadataRequest{Namespaces: []string{"unknown-ns"}})
+	s.ErrorContains(err, "InvalidArgument")
+}
+
+func (s *activitiesSuite) TestReindexShard() {
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.a)
+
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:  testShardID,
+		PageSize: 2,
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			newMutableState(testNamespaceID, "wf-1"),
+			newMutableState("other-ns-id", "wf-2"),
+		},
+		PageToken: []byte("next-page"),
+	}, nil)
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:   testShardID,
+		PageSize:  2,
+		PageToken: []byte("next-page"),
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			newMutableState(testNamespaceID, "wf-3"),
+			newMutableState(testNamespaceID, "wf-4"),
+		},
+	}, nil)
+
+	s.mockNamespaceRegistry.EXPECT().GetNamespaceName(namespace.ID(testNamespaceID)).Return(namespace.Name(testNamespace), nil).Times(3)
+	s.expectAddTasks("wf-1", nil)
+	s.expectAddTasks("wf-3", serviceerror.NewNamespaceNotFound(testNamespace))
+	s.expectAddTasks("wf-4", serviceerror.NewInvalidArgument("corrupted task"))
+
+	result, err := env.ExecuteActivity(s.a.ReindexShard, reindexShardRequest{
+		ShardID:      testShardID,
+		NamespaceIDs: []string{testNamespaceID},
+		RPS:          100,
+		PageSize:     2,
+	})
+	s.NoError(err)
+	var resp reindexShardResponse
+	s.NoError(result.Get(&resp))
+	s.Equal(reindexShardResponse{
+		ScannedExecutionCount:   4,
+		ReindexedExecutionCount: 1,
+		FailedExecutionCount:    1,
+	}, resp)
+}
+
+func (s *activitiesSuite) TestReindexShard_TransientError() {
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.a)
+
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			newMutableState(testNamespaceID, "wf-1"),
+		},
+	}, nil)
+	s.mockNamespaceRegistry.EXPECT().GetNamespaceName(namespace.ID(testNamespaceID)).Return(namespace.Name(testNamespace), nil)
+	s.expectAddTasks("wf-1", serviceerror.NewUnavailable("history unavailable"))
+
+	_, err := env.ExecuteActivity(s.a.ReindexShard, reindexShardRequest{
+		ShardID:  testShardID,
+		RPS:      100,
+		PageSize: 2,
+	})
+	s.ErrorContains(err, "history unavailable")
+}
+
+func (s *activitiesSuite) TestReindexShard_DeletedNamespace() {
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.a)
+
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			newMutableState("deleted-ns-id", "wf-1"),
+		},
+	}, nil)
+	s.mockNamespaceRegistry.EXPECT().GetNamespaceName(namespace.ID("deleted-ns-id")).Return(namespace.EmptyName, serviceerror.NewNamespaceNotFound("deleted-ns-id"))
+
+	result, err := env.ExecuteActivity(s.a.ReindexShard, reindexShardRequest{
+		ShardID:  testShardID,
+		RPS:      100,
+		PageSize: 2,
+	})
+	s.NoError(err)
+	var resp reindexShardResponse
+	s.NoError(result.Get(&resp))
+	s.Equal(reindexShardResponse{ScannedExecutionCount: 1}, resp)
+}
+
+func (s *activitiesSuite) TestReindexShard_ResumeFromHeartbeat() {
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.a)
+	env.SetHeartbeatDetails(reindexShardHeartbeatDetails{
+		PageToken:   []byte("page-2"),
+		IndexInPage: 1,
+		reindexShardResponse: reindexShardResponse{
+			ScannedExecutionCount:   3,
+			ReindexedExecutionCount: 3,
+		},
+	})
+
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:   testShardID,
+		PageSize:  2,
+		PageToken: []byte("page-2"),
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			newMutableState(testNamespaceID, "wf-3"),
+			newMutableState(testNamespaceID, "wf-4"),
+		},
+	}, nil)
+	s.mockNamespaceRegistry.EXPECT().GetNamespaceName(namespace.ID(testNamespaceID)).Return(namespace.Name(testNamespace), nil)
+	s.expectAddTasks("wf-4", nil)
+
+	result, err := env.ExecuteActivity(s.a.ReindexShard, reindexShardRequest{
+		ShardID:  testShardID,
+		RPS:      100,
+		PageSize: 2,
+	})
+	s.NoError(err)
+	var resp reindexShardResponse
+	s.NoError(result.Get(&resp))
+	s.Equal(reindexShardResponse{
+		ScannedExecutionCount:   4,
+		ReindexedExecutionCount: 4,
+	}, resp)
+}
+
+func (s *activitiesSuite) TestNewVisibilityTask() {
+	task, err := newVisibilityTask(newMutableState(testNamespaceID, "wf-1"))
+	s.NoError(err)
+	s.Equal(&tasks.UpsertExecutionVisibilityTask{
+		WorkflowKey: definition.NewWorkflowKey(testNamespaceID, "wf-1", "wf-1-run"),
+	}, task)
+
+	mutableState := newMutableState(testNamespaceID, "wf-2")
+	mutableState.ExecutionState.State = enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED
+	mutableState.ExecutionInfo.VersionHistories = versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(
+		nil,
+		[]*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(10, 5)},
+	))
+	task, err = newVisibilityTask(mutableState)
+	s.NoError(err)
+	s.Equal(&tasks.CloseExecutionVisibilityTask{
+		WorkflowKey: definition.NewWorkflowKey(testNamespaceID, "wf-2", "wf-2-run"),
+		Version:     5,
+	}, task)
+
+	mutableState.ExecutionState.State = enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE
+	task, err = newVisibilityTask(mutableState)
+	s.NoError(err)
+	s.Nil(task)
+}
+
+func (s *activitiesSuite) expectAddTasks(workflowID string, err error) {
+	var resp *historyservice.AddTasksResponse
+	if err == nil {
+		resp = &historyservice.AddTasksResponse{}
+	}
+	blob, serializeErr := serialization.NewTaskSerializer().SerializeTask(&tasks.UpsertExecutionVisibilityTask{
+		WorkflowKey: definition.NewWorkflowKey(testNamespaceID, workflowID, workflowID+"-run"),
+	})
+	s.NoError(serializeErr)
+	s.mockHistoryClient.EXPECT().AddTasks(gomock.Any(), protomock.Eq(&historyservice.AddTasksRequest{
+		ShardId: testShardID,
+		Tasks: []*historyservice.AddTasksRequest_Task{
+			{
+				CategoryId: int32(tasks.CategoryIDVisibility),
+				Blob:       blob,
+			},
+		},
+	})).Return(resp, err)
+}
+
+func newMutableState(namespaceID string, workflowID string) *persistencespb.WorkflowMutableState {
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId: namespaceID,
+			WorkflowId:  workflowID,
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId: workflowID + "-run",
+			State: enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		},
+	}
+}
@@ -0,0 +1,103 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reindexvisibility
+
+import (
+	"context"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+var taskSerializer = serialization.NewTaskSerializer()
+
+// RefreshVisibilityTasks adds a visibility task for the execution to its shard, which rebuilds the
+// visibility record from the mutable state: an upsert task if the execution is running, or a close
+// task if it is completed. Unlike RefreshWorkflowTasks, no other task types are regenerated. The
+// task is a no-op if the execution changed state since the mutable state was read, since the
+// visibility queue reloads the mutable state when processing it. It returns false without adding
+// a task for zombie and corrupted executions, which have no visibility record.
+func RefreshVisibilityTasks(
+	ctx context.Context,
+	historyClient historyservice.HistoryServiceClient,
+	shardID int32,
+	mutableState *persistencespb.WorkflowMutableState,
+) (bool, error) {
+	task, err := newVisibilityTask(mutableState)
+	if err != nil || task == nil {
+		return false, err
+	}
+	blob, err := taskSerializer.SerializeTask(task)
+	if err != nil {
+		return false, err
+	}
+	_, err = historyClient.AddTasks(ctx, &historyservice.AddTasksRequest{
+		ShardId: shardID,
+		Tasks: []*historyservice.AddTasksRequest_Task{
+			{
+				CategoryId: int32(tasks.CategoryIDVisibility),
+				Blob:       blob,
+			},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func newVisibilityTask(mutableState *persistencespb.WorkflowMutableState) (tasks.Task, error) {
+	executionInfo := mutableState.GetExecutionInfo()
+	workflowKey := definition.NewWorkflowKey(
+		executionInfo.GetNamespaceId(),
+		executionInfo.GetWorkflowId(),
+		mutableState.GetExecutionState().GetRunId(),
+	)
+	switch mutableState.GetExecutionState().GetState() {
+	case enumsspb.WORKFLOW_EXECUTION_STATE_CREATED,
+		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING:
+		return &tasks.UpsertExecutionVisibilityTask{WorkflowKey: workflowKey}, nil
+	case enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED:
+	default:
+		return nil, nil
+	}
+
+	// the close version is the version of the last event, see MutableState.GetCloseVersion.
+	versionHistory, err := versionhistory.GetCurrentVersionHistory(executionInfo.GetVersionHistories())
+	if err != nil {
+		return nil, err
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(versionHistory)
+	if err != nil {
+		return nil, err
+	}
+	return &tasks.CloseExecutionVisibilityTask{
+		WorkflowKey: workflowKey,
+		Version:     lastItem.GetVersion(),
+	}, nil
+}
@@ -0,0 +1,238 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reindexvisibility
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	// ReindexVisibilityParams is the input of the reindex visibility workflow. The workflow scans
+	// the executions of every history shard and regenerates their visibility tasks, which rebuilds
+	// the visibility records from mutable state.
+	ReindexVisibilityParams struct {
+		// Namespaces limits the reindex to executions of these namespaces. All namespaces are
+		// reindexed if empty.
+		Namespaces []string
+		// RPS limits the number of executions reindexed per second across all shards.
+		RPS float64
+		// ConcurrentActivityCount is the number of shards reindexed concurrently.
+		ConcurrentActivityCount int
+		// PageSize of the executions listed from each shard.
+		PageSize int
+		// ShardCountPerExecution is the number of shards reindexed before continue as new.
+		ShardCountPerExecution int
+
+		// Used by continue as new.
+		NextShardID int32
+		Status      ReindexVisibilityStatus
+	}
+
+	// ReindexVisibilityStatus is returned by the status query to report the progress of the reindex.
+	ReindexVisibilityStatus struct {
+		ShardCount              int32
+		CompletedShardCount     int32
+		ScannedExecutionCount   int64
+		ReindexedExecutionCount int64
+		FailedExecutionCount    int64
+		ContinuedAsNewCount     int
+	}
+
+	metadataRequest struct {
+		Namespaces []string
+	}
+
+	metadataResponse struct {
+		ShardCount   int32
+		NamespaceIDs []string
+	}
+
+	reindexShardRequest struct {
+		ShardID      int32
+		NamespaceIDs []string
+		RPS          float64
+		PageSize     int
+	}
+
+	reindexShardResponse struct {
+		ScannedExecutionCount   int64
+		ReindexedExecutionCount int64
+		FailedExecutionCount    int64
+	}
+)
+
+const (
+	reindexVisibilityWorkflowName    = "reindex-visibility"
+	reindexVisibilityStatusQueryType = "reindex-visibility-status"
+
+	defaultConcurrentActivityCount = 1
+	defaultRPS                     = 100
+	defaultPageSize                = 100
+	defaultShardCountPerExecution  = 100
+	maxShardCountPerExecution      = 1000
+)
+
+var (
+	reindexVisibilityActivityRetryPolicy = &temporal.RetryPolicy{
+		InitialInterval: time.Second,
+		MaximumInterval: time.Minute,
+	}
+)
+
+// ReindexVisibilityWorkflow rebuilds the visibility records of the executions in primary persistence.
+// It is started by operators in the system namespace with the "reindex-visibility" workflow type on
+// the default worker task queue, and its progress is returned by the "reindex-visibility-status" query.
+func ReindexVisibilityWorkflow(ctx workflow.Context, params ReindexVisibilityParams) error {
+	ctx = workflow.WithTaskQueue(ctx, primitives.ReindexVisibilityActivityTQ)
+
+	workflow.SetQueryHandler(ctx, reindexVisibilityStatusQueryType, func() (ReindexVisibilityStatus, error) {
+		return params.Status, nil
+	})
+
+	validateAndSetReindexVisibilityParams(&params)
+
+	metadataResp, err := getMetadata(ctx, params)
+	if err != nil {
+		return err
+	}
+	params.Status.ShardCount = metadataResp.ShardCount
+
+	if err := reindexShards(ctx, metadataResp, &params); err != nil {
+		return err
+	}
+
+	if params.NextShardID > metadataResp.ShardCount {
+		return nil
+	}
+
+	params.Status.ContinuedAsNewCount++
+
+	// There are still more shards to reindex. Continue-as-new to process on a new run.
+	// This prevents history size from exceeding the server-defined limit
+	return workflow.NewContinueAsNewError(ctx, ReindexVisibilityWorkflow, params)
+}
+
+func validateAndSetReindexVisibilityParams(params *ReindexVisibilityParams) {
+	if params.ConcurrentActivityCount <= 0 {
+		params.ConcurrentActivityCount = defaultConcurrentActivityCount
+	}
+
+	if params.RPS <= 0 {
+		params.RPS = defaultRPS
+	}
+
+	if params.PageSize <= 0 {
+		params.PageSize = defaultPageSize
+	}
+
+	if params.ShardCountPerExecution <= 0 {
+		params.ShardCountPerExecution = defaultShardCountPerExecution
+	}
+
+	if params.ShardCountPerExecution > maxShardCountPerExecution {
+		params.ShardCountPerExecution = maxShardCountPerExecution
+	}
+
+	// Shard IDs start from 1.
+	if params.NextShardID <= 0 {
+		params.NextShardID = 1
+	}
+}
+
+func getMetadata(ctx workflow.Context, params ReindexVisibilityParams) (metadataResponse, error) {
+	var a *activities
+
+	lao := workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Second * 10,
+		RetryPolicy:         reindexVisibilityActivityRetryPolicy,
+	}
+
+	actx := workflow.WithLocalActivityOptions(ctx, lao)
+	var metadataResp metadataResponse
+	err := workflow.ExecuteLocalActivity(actx, a.GetReindexVisibilityMetadata, metadataRequest{Namespaces: params.Namespaces}).Get(ctx, &metadataResp)
+	return metadataResp, err
+}
+
+// reindexShards reindexes up to ShardCountPerExecution shards starting from NextShardID, with at
+// most ConcurrentActivityCount shards in progress at a time. Params are updated with the progress.
+func reindexShards(ctx workflow.Context, metadataResp metadataResponse, params *ReindexVisibilityParams) error {
+	var a *activities
+
+	ao := workflow.ActivityOptions{
+		// Large shards can take a long time to scan, rely on heartbeats for liveness detection.
+		StartToCloseTimeout: time.Hour * 24 * 7,
+		HeartbeatTimeout:    time.Second * 30,
+		RetryPolicy:         reindexVisibilityActivityRetryPolicy,
+	}
+	actx := workflow.WithActivityOptions(ctx, ao)
+
+	lastShardID := min(metadataResp.ShardCount, params.NextShardID+int32(params.ShardCountPerExecution)-1)
+	selector := workflow.NewSelector(ctx)
+	pendingActivities := 0
+	var lastActivityErr error
+
+	for shardID := params.NextShardID; shardID <= lastShardID; shardID++ {
+		future := workflow.ExecuteActivity(actx, a.ReindexShard, reindexShardRequest{
+			ShardID:      shardID,
+			NamespaceIDs: metadataResp.NamespaceIDs,
+			RPS:          params.RPS / float64(params.ConcurrentActivityCount),
+			PageSize:     params.PageSize,
+		})
+
+		pendingActivities++
+		selector.AddFuture(future, func(f workflow.Future) {
+			pendingActivities--
+
+			var resp reindexShardResponse
+			if err := f.Get(ctx, &resp); err != nil {
+				lastActivityErr = err
+				return
+			}
+			params.Status.CompletedShardCount++
+			params.Status.ScannedExecutionCount += resp.ScannedExecutionCount
+			params.Status.ReindexedExecutionCount += resp.ReindexedExecutionCount
+			params.Status.FailedExecutionCount += resp.FailedExecutionCount
+		})
+
+		for pendingActivities >= params.ConcurrentActivityCount {
+			selector.Select(ctx)
+			if lastActivityErr != nil {
+				return lastActivityErr
+			}
+		}
+	}
+
+	for pendingActivities > 0 {
+		selector.Select(ctx)
+		if lastActivityErr != nil {
+			return lastActivityErr
+		}
+	}
+
+	params.NextShardID = lastShardID + 1
+	return nil
+}
@@ -0,0 +1,138 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reindexvisibility
+
+import (
+	"context"
+
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestReindexVisibilityWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.GetReindexVisibilityMetadata, mock.Anything, metadataRequest{Namespaces: []string{"test-ns"}}).
+		Return(&metadataResponse{ShardCount: 4, NamespaceIDs: []string{"test-ns-id"}}, nil)
+
+	var reindexedShardIDs []int32
+	env.OnActivity(a.ReindexShard, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, request reindexShardRequest) (*reindexShardResponse, error) {
+			require.Equal(t, []string{"test-ns-id"}, request.NamespaceIDs)
+			require.Equal(t, 5.0, request.RPS)
+			require.Equal(t, 10, request.PageSize)
+			reindexedShardIDs = append(reindexedShardIDs, request.ShardID)
+			return &reindexShardResponse{ScannedExecutionCount: 3, ReindexedExecutionCount: 2, FailedExecutionCount: 1}, nil
+		}).Times(4)
+
+	env.ExecuteWorkflow(ReindexVisibilityWorkflow, ReindexVisibilityParams{
+		Namespaces:              []string{"test-ns"},
+		RPS:                     10,
+		ConcurrentActivityCount: 2,
+		PageSize:                10,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+	require.ElementsMatch(t, []int32{1, 2, 3, 4}, reindexedShardIDs)
+
+	envValue, err := env.QueryWorkflow(reindexVisibilityStatusQueryType)
+	require.NoError(t, err)
+	var status ReindexVisibilityStatus
+	require.NoError(t, envValue.Get(&status))
+	require.Equal(t, ReindexVisibilityStatus{
+		ShardCount:              4,
+		CompletedShardCount:     4,
+		ScannedExecutionCount:   12,
+		ReindexedExecutionCount: 8,
+		FailedExecutionCount:    4,
+	}, status)
+}
+
+func TestReindexVisibilityWorkflow_ContinueAsNew(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.GetReindexVisibilityMetadata, mock.Anything, mock.Anything).
+		Return(&metadataResponse{ShardCount: 4}, nil)
+	env.OnActivity(a.ReindexShard, mock.Anything, mock.Anything).
+		Return(&reindexShardResponse{ScannedExecutionCount: 1, ReindexedExecutionCount: 1}, nil).Times(2)
+
+	env.ExecuteWorkflow(ReindexVisibilityWorkflow, ReindexVisibilityParams{
+		ShardCountPerExecution: 2,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	var canErr *workflow.ContinueAsNewError
+	require.ErrorAs(t, err, &canErr)
+	env.AssertExpectations(t)
+
+	envValue, err := env.QueryWorkflow(reindexVisibilityStatusQueryType)
+	require.NoError(t, err)
+	var status ReindexVisibilityStatus
+	require.NoError(t, envValue.Get(&status))
+	require.Equal(t, ReindexVisibilityStatus{
+		ShardCount:              4,
+		CompletedShardCount:     2,
+		ScannedExecutionCount:   2,
+		ReindexedExecutionCount: 2,
+		ContinuedAsNewCount:     1,
+	}, status)
+}
+
+func TestReindexVisibilityWorkflow_Resume(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.GetReindexVisibilityMetadata, mock.Anything, mock.Anything).
+		Return(&metadataResponse{ShardCount: 4}, nil)
+	env.OnActivity(a.ReindexShard, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, request reindexShardRequest) (*reindexShardResponse, error) {
+			require.GreaterOrEqual(t, request.ShardID, int32(3))
+			return &reindexShardResponse{}, nil
+		}).Times(2)
+
+	env.ExecuteWorkflow(ReindexVisibilityWorkflow, ReindexVisibilityParams{
+		ShardCountPerExecution: 2,
+		NextShardID:            3,
+		Status: ReindexVisibilityStatus{
+			CompletedShardCount: 2,
+			ContinuedAsNewCount: 1,
+		},
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
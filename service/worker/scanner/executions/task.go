@@ -209,19 +209,35 @@ func (t *task) validate(
 }
 
 func (t *task) getPaginationFn() collection.PaginationFn[*persistencespb.WorkflowMutableState] {
+	paginationFn := NewPaginationFn(t.ctx, t.executionManager, t.shardID, executionsPageSize)
 	return func(paginationToken []byte) ([]*persistencespb.WorkflowMutableState, []byte, error) {
-		req := &persistence.ListConcreteExecutionsRequest{
-			ShardID:   t.shardID,
-			PageSize:  executionsPageSize,
-			PageToken: paginationToken,
+		paginateItems, nextPageToken, err := paginationFn(paginationToken)
+		if err != nil {
+			return nil, nil, err
 		}
-		resp, err := t.executionManager.ListConcreteExecutions(t.ctx, req)
+		t.paginationToken = nextPageToken
+		return paginateItems, nextPageToken, nil
+	}
+}
+
+// NewPaginationFn returns a pagination function which lists the mutable states of all
+// concrete executions in the shard.
+func NewPaginationFn(
+	ctx context.Context,
+	executionManager persistence.ExecutionManager,
+	shardID int32,
+	pageSize int,
+) collection.PaginationFn[*persistencespb.WorkflowMutableState] {
+	return func(paginationToken []byte) ([]*persistencespb.WorkflowMutableState, []byte, error) {
+		resp, err := executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			ShardID:   shardID,
+			PageSize:  pageSize,
+			PageToken: paginationToken,
+		})
 		if err != nil {
 			return nil, nil, err
 		}
-		paginateItems := resp.States
-		t.paginationToken = resp.PageToken
-		return paginateItems, resp.PageToken, nil
+		return resp.States, resp.PageToken, nil
 	}
 }
 
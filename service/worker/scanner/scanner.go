@@ -49,6 +49,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/worker/scanner/build_ids"
 )
 
@@ -87,6 +88,22 @@ type (
 		ExecutionScannerWorkerCount dynamicconfig.IntPropertyFn
 		// ExecutionScannerHistoryEventIdValidator indicates if the execution scavenger to validate history event id.
 		ExecutionScannerHistoryEventIdValidator dynamicconfig.BoolPropertyFn
+		// VisibilityScannerEnabled indicates if visibility scanner should be started as part of scanner
+		VisibilityScannerEnabled dynamicconfig.BoolPropertyFn
+		// VisibilityScannerSampleRate is the fraction of executions checked by the visibility scavenger
+		VisibilityScannerSampleRate dynamicconfig.FloatPropertyFn
+		// VisibilityScannerPerHostQPS the max rate of calls to scan execution data per host by the visibility scavenger
+		VisibilityScannerPerHostQPS dynamicconfig.IntPropertyFn
+		// VisibilityScannerPerShardQPS the max rate of calls to scan execution data per shard by the visibility scavenger
+		VisibilityScannerPerShardQPS dynamicconfig.IntPropertyFn
+		// VisibilityScannerWorkerCount is the visibility scavenger task worker number
+		VisibilityScannerWorkerCount dynamicconfig.IntPropertyFn
+		// VisibilityScannerExecutionMinAge indicates the minimum time since the last update of an execution
+		// for it to be checked by the visibility scavenger
+		VisibilityScannerExecutionMinAge dynamicconfig.DurationPropertyFn
+		// VisibilityScannerRepairEnabled indicates if the visibility scavenger regenerates the visibility tasks
+		// of the mismatched executions
+		VisibilityScannerRepairEnabled dynamicconfig.BoolPropertyFn
 
 		// RemovableBuildIdDurationSinceDefault is the minimum duration since a build ID was last default in its
 		// containing set for it to be considered for removal.
@@ -110,6 +127,7 @@ type (
 		matchingClient     matchingservice.MatchingServiceClient
 		adminClient        adminservice.AdminServiceClient
 		namespaceRegistry  namespace.Registry
+		mapperProvider     searchattribute.MapperProvider
 		currentClusterName string
 	}
 
@@ -141,6 +159,7 @@ func New(
 	adminClient adminservice.AdminServiceClient,
 	matchingClient matchingservice.MatchingServiceClient,
 	registry namespace.Registry,
+	mapperProvider searchattribute.MapperProvider,
 	currentClusterName string,
 ) *Scanner {
 	return &Scanner{
@@ -157,6 +176,7 @@ func New(
 			matchingClient:     matchingClient,
 			adminClient:        adminClient,
 			namespaceRegistry:  registry,
+			mapperProvider:     mapperProvider,
 			currentClusterName: currentClusterName,
 		},
 	}
@@ -196,6 +216,12 @@ func (s *Scanner) Start() error {
 		workerTaskQueueNames = append(workerTaskQueueNames, historyScannerTaskQueueName)
 	}
 
+	if s.context.cfg.VisibilityScannerEnabled() {
+		s.wg.Add(1)
+		go s.startWorkflowWithRetry(ctx, visibilityScannerWFStartOptions, visibilityScannerWFTypeName)
+		workerTaskQueueNames = append(workerTaskQueueNames, visibilityScannerTaskQueueName)
+	}
+
 	if s.context.cfg.BuildIdScavengerEnabled() {
 		s.wg.Add(1)
 		go s.startWorkflowWithRetry(ctx, build_ids.BuildIdScavengerWFStartOptions, build_ids.BuildIdScavangerWorkflowName)
@@ -229,9 +255,11 @@ func (s *Scanner) Start() error {
 		work.RegisterWorkflowWithOptions(TaskQueueScannerWorkflow, workflow.RegisterOptions{Name: tqScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(ExecutionsScannerWorkflow, workflow.RegisterOptions{Name: executionsScannerWFTypeName})
+		work.RegisterWorkflowWithOptions(VisibilityScannerWorkflow, workflow.RegisterOptions{Name: visibilityScannerWFTypeName})
 		work.RegisterActivityWithOptions(TaskQueueScavengerActivity, activity.RegisterOptions{Name: taskQueueScavengerActivityName})
 		work.RegisterActivityWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
 		work.RegisterActivityWithOptions(ExecutionsScavengerActivity, activity.RegisterOptions{Name: executionsScavengerActivityName})
+		work.RegisterActivityWithOptions(VisibilityScavengerActivity, activity.RegisterOptions{Name: visibilityScavengerActivityName})
 
 		// TODO: Nothing is gracefully stopping these workers or listening for fatal errors.
 		if err := work.Start(); err != nil {
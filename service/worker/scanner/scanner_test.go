@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/testing/mocksdk"
 	"go.temporal.io/server/service/worker/scanner/build_ids"
 	"go.uber.org/mock/gomock"
@@ -71,6 +72,10 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 		WFTypeName:    historyScannerWFTypeName,
 		TaskQueueName: historyScannerTaskQueueName,
 	}
+	visibilityScanner := expectedScanner{
+		WFTypeName:    visibilityScannerWFTypeName,
+		TaskQueueName: visibilityScannerTaskQueueName,
+	}
 	buildIdScavenger := expectedScanner{
 		WFTypeName:    build_ids.BuildIdScavangerWorkflowName,
 		TaskQueueName: build_ids.BuildIdScavengerTaskQueueName,
@@ -79,6 +84,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 	type testCase struct {
 		Name                     string
 		ExecutionsScannerEnabled bool
+		VisibilityScannerEnabled bool
 		TaskQueueScannerEnabled  bool
 		HistoryScannerEnabled    bool
 		BuildIdScavengerEnabled  bool
@@ -177,6 +183,16 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 			DefaultStore:             config.StoreTypeSQL,
 			ExpectedScanners:         []expectedScanner{buildIdScavenger},
 		},
+		{
+			Name:                     "VisibilityScanner",
+			ExecutionsScannerEnabled: false,
+			TaskQueueScannerEnabled:  false,
+			HistoryScannerEnabled:    false,
+			BuildIdScavengerEnabled:  false,
+			VisibilityScannerEnabled: true,
+			DefaultStore:             config.StoreTypeSQL,
+			ExpectedScanners:         []expectedScanner{visibilityScanner},
+		},
 		{
 			Name:                     "AllScannersSQL",
 			ExecutionsScannerEnabled: true,
@@ -204,6 +220,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 					BuildIdScavengerEnabled:                dynamicconfig.GetBoolPropertyFn(c.BuildIdScavengerEnabled),
 					ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(c.ExecutionsScannerEnabled),
 					TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(c.TaskQueueScannerEnabled),
+					VisibilityScannerEnabled:               dynamicconfig.GetBoolPropertyFn(c.VisibilityScannerEnabled),
 					Persistence: &config.Persistence{
 						DefaultStore: c.DefaultStore,
 						DataStores: map[string]config.DataStore{
@@ -225,6 +242,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 				mockAdminClient,
 				nil,
 				mockNamespaceRegistry,
+				searchattribute.NewTestMapperProvider(nil),
 				"active-cluster",
 			)
 			var wg sync.WaitGroup
@@ -282,6 +300,7 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 			ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(false),
 			TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
 			BuildIdScavengerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
+			VisibilityScannerEnabled:               dynamicconfig.GetBoolPropertyFn(false),
 			Persistence: &config.Persistence{
 				DefaultStore: config.StoreTypeNoSQL,
 				DataStores: map[string]config.DataStore{
@@ -300,6 +319,7 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 		mockAdminClient,
 		nil,
 		mockNamespaceRegistry,
+		searchattribute.NewTestMapperProvider(nil),
 		"active-cluster",
 	)
 	mockSdkClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient).AnyTimes()
@@ -0,0 +1,169 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	mismatchTypeMissing          = "missing"
+	mismatchTypeStatus           = "status"
+	mismatchTypeCloseTime        = "close_time"
+	mismatchTypeSearchAttributes = "search_attributes"
+)
+
+type (
+	// mismatch describes a difference between the mutable state of an execution
+	// and its visibility record
+	mismatch struct {
+		mismatchType string
+		details      string
+	}
+)
+
+// compareExecution returns the differences between the mutable state of an execution
+// and its visibility record. A nil visibility record is reported as missing.
+// Visibility returns custom search attributes by alias, so the field names stored in
+// mutable state are aliased with the mapper of the namespace before comparing them.
+func compareExecution(
+	mutableState *persistencespb.WorkflowMutableState,
+	visExecution *workflowpb.WorkflowExecutionInfo,
+	mapperProvider searchattribute.MapperProvider,
+	nsName namespace.Name,
+) ([]mismatch, error) {
+	if visExecution == nil {
+		return []mismatch{{
+			mismatchType: mismatchTypeMissing,
+			details:      "visibility record not found",
+		}}, nil
+	}
+
+	var mismatches []mismatch
+	executionInfo := mutableState.GetExecutionInfo()
+
+	status := mutableState.GetExecutionState().GetStatus()
+	if status != visExecution.GetStatus() {
+		mismatches = append(mismatches, mismatch{
+			mismatchType: mismatchTypeStatus,
+			details:      fmt.Sprintf("mutable state: %v, visibility: %v", status, visExecution.GetStatus()),
+		})
+	}
+
+	if executionInfo.GetCloseTime() != nil {
+		closeTime := executionInfo.GetCloseTime().AsTime().Truncate(time.Millisecond)
+		visCloseTime := visExecution.GetCloseTime().AsTime().Truncate(time.Millisecond)
+		if visExecution.GetCloseTime() == nil || !closeTime.Equal(visCloseTime) {
+			mismatches = append(mismatches, mismatch{
+				mismatchType: mismatchTypeCloseTime,
+				details: fmt.Sprintf(
+					"mutable state: %v, visibility: %v",
+					closeTime,
+					visExecution.GetCloseTime().AsTime(),
+				),
+			})
+		}
+	}
+
+	// fields whose alias was deleted are dropped by AliasFields and not compared
+	searchAttributes, err := searchattribute.AliasFields(
+		mapperProvider,
+		&commonpb.SearchAttributes{IndexedFields: executionInfo.GetSearchAttributes()},
+		nsName.String(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	visSearchAttributes := visExecution.GetSearchAttributes().GetIndexedFields()
+	for name, payload := range searchAttributes.GetIndexedFields() {
+		if searchattribute.IsSystem(name) {
+			continue
+		}
+		visPayload, ok := visSearchAttributes[name]
+		if !ok {
+			mismatches = append(mismatches, mismatch{
+				mismatchType: mismatchTypeSearchAttributes,
+				details:      fmt.Sprintf("search attribute %s is missing in visibility", name),
+			})
+			continue
+		}
+		if !equalSearchAttributeValues(payload, visPayload) {
+			mismatches = append(mismatches, mismatch{
+				mismatchType: mismatchTypeSearchAttributes,
+				details:      fmt.Sprintf("search attribute %s has a different value in visibility", name),
+			})
+		}
+	}
+
+	return mismatches, nil
+}
+
+// equalSearchAttributeValues compares a search attribute value from mutable state with the one
+// from the visibility record. Mutable state payloads may not carry the type metadata, so both
+// are decoded with the type of the visibility payload.
+func equalSearchAttributeValues(payload *commonpb.Payload, visPayload *commonpb.Payload) bool {
+	saType, err := enumspb.IndexedValueTypeFromString(string(visPayload.GetMetadata()[searchattribute.MetadataType]))
+	if err != nil {
+		saType = enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED
+	}
+	value, err := searchattribute.DecodeValue(payload, saType, true)
+	if err != nil {
+		return false
+	}
+	visValue, err := searchattribute.DecodeValue(visPayload, saType, true)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(normalizeSearchAttributeValue(value), normalizeSearchAttributeValue(visValue))
+}
+
+// normalizeSearchAttributeValue unwraps single element lists and truncates datetime values to
+// the millisecond precision supported by the visibility stores.
+func normalizeSearchAttributeValue(value any) any {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Slice {
+		if rv.Len() == 1 {
+			return normalizeSearchAttributeValue(rv.Index(0).Interface())
+		}
+		if times, ok := value.([]time.Time); ok {
+			normalized := make([]time.Time, len(times))
+			for i, t := range times {
+				normalized[i] = t.Truncate(time.Millisecond).UTC()
+			}
+			return normalized
+		}
+	}
+	if t, ok := value.(time.Time); ok {
+		return t.Truncate(time.Millisecond).UTC()
+	}
+	return value
+}
@@ -0,0 +1,207 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/searchattribute"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCompareExecution(t *testing.T) {
+	closeTime := time.Date(2024, 5, 1, 10, 0, 0, 123456789, time.UTC)
+	datetime := time.Date(2024, 5, 1, 9, 0, 0, 987654321, time.UTC)
+
+	newMutableState := func() *persistencespb.WorkflowMutableState {
+		return &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				CloseTime: timestamppb.New(closeTime),
+				SearchAttributes: map[string]*commonpb.Payload{
+					"CustomKeywordField":  payload.EncodeString("keyword"),
+					"CustomIntField":      encodeValue(t, int64(42), enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED),
+					"CustomDatetimeField": encodeValue(t, datetime, enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED),
+				},
+			},
+			ExecutionState: &persistencespb.WorkflowExecutionState{
+				Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			},
+		}
+	}
+	newVisExecution := func() *workflowpb.WorkflowExecutionInfo {
+		return &workflowpb.WorkflowExecutionInfo{
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			CloseTime: timestamppb.New(closeTime.Truncate(time.Millisecond)),
+			SearchAttributes: &commonpb.SearchAttributes{
+				IndexedFields: map[string]*commonpb.Payload{
+					"CustomKeywordField":  encodeValue(t, []string{"keyword"}, enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+					"CustomIntField":      encodeValue(t, int64(42), enumspb.INDEXED_VALUE_TYPE_INT),
+					"CustomDatetimeField": encodeValue(t, datetime.Truncate(time.Millisecond), enumspb.INDEXED_VALUE_TYPE_DATETIME),
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		mutate        func(*persistencespb.WorkflowMutableState, *workflowpb.WorkflowExecutionInfo) *workflowpb.WorkflowExecutionInfo
+		expectedTypes []string
+	}{
+		{
+			name: "match",
+			mutate: func(_ *persistencespb.WorkflowMutableState, vis *workflowpb.WorkflowExecutionInfo) *workflowpb.WorkflowExecutionInfo {
+				return vis
+			},
+		},
+		{
+			name: "missing",
+			mutate: func(_ *persistencespb.WorkflowMutableState, _ *workflowpb.WorkflowExecutionInfo) *workflowpb.WorkflowExecutionInfo {
+				return nil
+			},
+			expectedTypes: []string{mismatchTypeMissing},
+		},
+		{
+			name: "closed but running in visibility",
+			mutate: func(_ *persistencespb.WorkflowMutableState, vis *workflowpb.WorkflowExecutionInfo) *workflowpb.WorkflowExecutionInfo {
+				vis.Status = enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+				vis.CloseTime = nil
+				return vis
+			},
+			expectedTypes: []string{mismatchTypeStatus, mismatchTypeCloseTime},
+		},
+		{
+			name: "running",
+			mutate: func(ms *persistencespb.WorkflowMutableState, vis *workflowpb.WorkflowExecutionInfo) *workflowpb.WorkflowExecutionInfo {
+				ms.ExecutionState.Status = enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+				ms.ExecutionInfo.CloseTime = nil
+				vis.Status = enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+				vis.CloseTime = nil
+				return vis
+			},
+		},
+		{
+			name: "close time",
+			mutate: func(_ *persistencespb.WorkflowMutableState, vis *workflowpb.WorkflowExecutionInfo) *workflowpb.WorkflowExecutionInfo {
+				vis.CloseTime = timestamppb.New(closeTime.Add(time.Second))
+				return vis
+			},
+			expectedTypes: []string{mismatchTypeCloseTime},
+		},
+		{
+			name: "search attribute missing",
+			mutate: func(_ *persistencespb.WorkflowMutableState, vis *workflowpb.WorkflowExecutionInfo) *workflowpb.WorkflowExecutionInfo {
+				delete(vis.SearchAttributes.IndexedFields, "CustomIntField")
+				return vis
+			},
+			expectedTypes: []string{mismatchTypeSearchAttributes},
+		},
+		{
+			name: "search attribute value",
+			mutate: func(_ *persistencespb.WorkflowMutableState, vis *workflowpb.WorkflowExecutionInfo) *workflowpb.WorkflowExecutionInfo {
+				vis.SearchAttributes.IndexedFields["CustomKeywordField"] = encodeValue(t, "other", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+				vis.SearchAttributes.IndexedFields["CustomDatetimeField"] = encodeValue(t, datetime.Add(time.Minute), enumspb.INDEXED_VALUE_TYPE_DATETIME)
+				return vis
+			},
+			expectedTypes: []string{mismatchTypeSearchAttributes, mismatchTypeSearchAttributes},
+		},
+		{
+			name: "search attribute only in visibility",
+			mutate: func(_ *persistencespb.WorkflowMutableState, vis *workflowpb.WorkflowExecutionInfo) *workflowpb.WorkflowExecutionInfo {
+				vis.SearchAttributes.IndexedFields["CustomBoolField"] = encodeValue(t, true, enumspb.INDEXED_VALUE_TYPE_BOOL)
+				return vis
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mutableState := newMutableState()
+			visExecution := tc.mutate(mutableState, newVisExecution())
+			mismatches, err := compareExecution(mutableState, visExecution, searchattribute.NewTestMapperProvider(nil), "test-namespace")
+			require.NoError(t, err)
+			var mismatchTypes []string
+			for _, m := range mismatches {
+				mismatchTypes = append(mismatchTypes, m.mismatchType)
+			}
+			require.ElementsMatch(t, tc.expectedTypes, mismatchTypes)
+		})
+	}
+}
+
+func TestCompareExecution_AliasedSearchAttributes(t *testing.T) {
+	mutableState := &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			SearchAttributes: map[string]*commonpb.Payload{
+				"Keyword01":                           payload.EncodeString("keyword"),
+				"Int01":                               encodeValue(t, int64(42), enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED),
+				searchattribute.TemporalChangeVersion: encodeValue(t, []string{"version"}, enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED),
+				// the alias of this field was deleted
+				"wrong_field": payload.EncodeString("deleted"),
+			},
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+	}
+	visExecution := &workflowpb.WorkflowExecutionInfo{
+		Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		SearchAttributes: &commonpb.SearchAttributes{
+			IndexedFields: map[string]*commonpb.Payload{
+				"AliasForKeyword01": encodeValue(t, "keyword", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+				"AliasForInt01":     encodeValue(t, int64(42), enumspb.INDEXED_VALUE_TYPE_INT),
+				// predefined search attributes are not aliased
+				searchattribute.TemporalChangeVersion: encodeValue(t, []string{"version"}, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST),
+			},
+		},
+	}
+	mapperProvider := searchattribute.NewTestMapperProvider(&searchattribute.TestMapper{})
+
+	mismatches, err := compareExecution(mutableState, visExecution, mapperProvider, "test-namespace")
+	require.NoError(t, err)
+	require.Empty(t, mismatches)
+
+	visExecution.SearchAttributes.IndexedFields["AliasForInt01"] = encodeValue(t, int64(43), enumspb.INDEXED_VALUE_TYPE_INT)
+	delete(visExecution.SearchAttributes.IndexedFields, "AliasForKeyword01")
+	mismatches, err = compareExecution(mutableState, visExecution, mapperProvider, "test-namespace")
+	require.NoError(t, err)
+	require.Len(t, mismatches, 2)
+	for _, m := range mismatches {
+		require.Equal(t, mismatchTypeSearchAttributes, m.mismatchType)
+	}
+
+	_, err = compareExecution(mutableState, visExecution, mapperProvider, "error-namespace")
+	require.Error(t, err)
+}
+
+func encodeValue(t *testing.T, val any, saType enumspb.IndexedValueType) *commonpb.Payload {
+	p, err := searchattribute.EncodeValue(val, saType)
+	require.NoError(t, err)
+	return p
+}
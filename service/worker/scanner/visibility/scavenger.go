@@ -0,0 +1,207 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/worker/scanner/executor"
+)
+
+const (
+	executorPollInterval     = time.Minute
+	executorMaxDeferredTasks = 50000
+)
+
+type (
+	// Scavenger is the type that holds the state for visibility scavenger daemon
+	Scavenger struct {
+		status           int32
+		numHistoryShards int32
+		activityContext  context.Context
+
+		executionManager  persistence.ExecutionManager
+		visibilityManager manager.VisibilityManager
+		registry          namespace.Registry
+		mapperProvider    searchattribute.MapperProvider
+		historyClient     historyservice.HistoryServiceClient
+		executor          executor.Executor
+		rateLimiter       quotas.RateLimiter
+		perShardQPS       dynamicconfig.IntPropertyFn
+		sampleRate        dynamicconfig.FloatPropertyFn
+		executionMinAge   dynamicconfig.DurationPropertyFn
+		repairEnabled     dynamicconfig.BoolPropertyFn
+		metricsHandler    metrics.Handler
+		logger            log.Logger
+
+		stopC  chan struct{}
+		stopWG sync.WaitGroup
+	}
+)
+
+// NewScavenger returns an instance of the visibility scavenger daemon. The scavenger compares
+// a sample of the executions of every shard with their visibility records, emits metrics for
+// the mismatches and, if enabled, regenerates the visibility tasks of the mismatched executions.
+func NewScavenger(
+	activityContext context.Context,
+	numHistoryShards int32,
+	perHostQPS dynamicconfig.IntPropertyFn,
+	perShardQPS dynamicconfig.IntPropertyFn,
+	workerCount dynamicconfig.IntPropertyFn,
+	sampleRate dynamicconfig.FloatPropertyFn,
+	executionMinAge dynamicconfig.DurationPropertyFn,
+	repairEnabled dynamicconfig.BoolPropertyFn,
+	executionManager persistence.ExecutionManager,
+	visibilityManager manager.VisibilityManager,
+	registry namespace.Registry,
+	mapperProvider searchattribute.MapperProvider,
+	historyClient historyservice.HistoryServiceClient,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *Scavenger {
+	return &Scavenger{
+		activityContext:   activityContext,
+		numHistoryShards:  numHistoryShards,
+		executionManager:  executionManager,
+		visibilityManager: visibilityManager,
+		registry:          registry,
+		mapperProvider:    mapperProvider,
+		historyClient:     historyClient,
+		executor: executor.NewFixedSizePoolExecutor(
+			workerCount(),
+			executorMaxDeferredTasks,
+			metricsHandler,
+			metrics.VisibilityScavengerScope,
+		),
+		rateLimiter: quotas.NewDefaultOutgoingRateLimiter(
+			func() float64 { return float64(perHostQPS()) },
+		),
+		perShardQPS:     perShardQPS,
+		sampleRate:      sampleRate,
+		executionMinAge: executionMinAge,
+		repairEnabled:   repairEnabled,
+		metricsHandler:  metricsHandler.WithTags(metrics.OperationTag(metrics.VisibilityScavengerScope)),
+		logger:          logger,
+
+		stopC: make(chan struct{}),
+	}
+}
+
+func (s *Scavenger) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&s.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+	s.logger.Info("Visibility scavenger starting")
+	s.stopWG.Add(1)
+	s.executor.Start()
+	go s.run()
+	metrics.StartedCount.With(s.metricsHandler).Record(1)
+	s.logger.Info("Visibility scavenger started")
+}
+
+func (s *Scavenger) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&s.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+	metrics.StoppedCount.With(s.metricsHandler).Record(1)
+	s.logger.Info("Visibility scavenger stopping")
+	close(s.stopC)
+	s.executor.Stop()
+	s.stopWG.Wait()
+	s.logger.Info("Visibility scavenger stopped")
+}
+
+func (s *Scavenger) Alive() bool {
+	return atomic.LoadInt32(&s.status) == common.DaemonStatusStarted
+}
+
+func (s *Scavenger) run() {
+	defer func() {
+		go s.Stop()
+		s.stopWG.Done()
+	}()
+
+	for shardID := int32(1); shardID <= s.numHistoryShards; shardID++ {
+		submitted := s.executor.Submit(newTask(
+			s.activityContext,
+			shardID,
+			s.executionManager,
+			s.visibilityManager,
+			s.registry,
+			s.mapperProvider,
+			s.historyClient,
+			s.metricsHandler,
+			s.logger,
+			quotas.NewMultiRateLimiter([]quotas.RateLimiter{
+				quotas.NewDefaultOutgoingRateLimiter(
+					func() float64 { return float64(s.perShardQPS()) },
+				),
+				s.rateLimiter,
+			}),
+			s.sampleRate,
+			s.executionMinAge,
+			s.repairEnabled,
+		))
+		if !submitted {
+			s.logger.Error("unable to submit task to executor", tag.ShardID(shardID))
+		}
+	}
+
+	s.awaitExecutor()
+}
+
+func (s *Scavenger) awaitExecutor() {
+	outstanding := s.executor.TaskCount()
+	for outstanding > 0 {
+		timer := time.NewTimer(executorPollInterval)
+		select {
+		case <-timer.C:
+			outstanding = s.executor.TaskCount()
+		case <-s.stopC:
+			timer.Stop()
+			return
+		}
+	}
+}
@@ -0,0 +1,227 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/worker/reindexvisibility"
+	"go.temporal.io/server/service/worker/scanner/executions"
+	"go.temporal.io/server/service/worker/scanner/executor"
+)
+
+const (
+	executionsPageSize = 100
+)
+
+type (
+	// task is a runnable task that adheres to the executor.Task interface
+	// for the scavenger, each of this task checks a sample of the executions of a single shard
+	task struct {
+		shardID           int32
+		executionManager  persistence.ExecutionManager
+		visibilityManager manager.VisibilityManager
+		registry          namespace.Registry
+		mapperProvider    searchattribute.MapperProvider
+		historyClient     historyservice.HistoryServiceClient
+		metricsHandler    metrics.Handler
+		logger            log.Logger
+
+		ctx             context.Context
+		rateLimiter     quotas.RateLimiter
+		sampleRate      dynamicconfig.FloatPropertyFn
+		executionMinAge dynamicconfig.DurationPropertyFn
+		repairEnabled   dynamicconfig.BoolPropertyFn
+		paginationToken []byte
+	}
+)
+
+// newTask returns a new instance of an executable task which will check the executions of a single shard
+func newTask(
+	ctx context.Context,
+	shardID int32,
+	executionManager persistence.ExecutionManager,
+	visibilityManager manager.VisibilityManager,
+	registry namespace.Registry,
+	mapperProvider searchattribute.MapperProvider,
+	historyClient historyservice.HistoryServiceClient,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+	rateLimiter quotas.RateLimiter,
+	sampleRate dynamicconfig.FloatPropertyFn,
+	executionMinAge dynamicconfig.DurationPropertyFn,
+	repairEnabled dynamicconfig.BoolPropertyFn,
+) executor.Task {
+	return &task{
+		shardID:           shardID,
+		executionManager:  executionManager,
+		visibilityManager: visibilityManager,
+		registry:          registry,
+		mapperProvider:    mapperProvider,
+		historyClient:     historyClient,
+		metricsHandler:    metricsHandler,
+		logger:            log.With(logger, tag.ShardID(shardID)),
+
+		ctx:             ctx,
+		rateLimiter:     rateLimiter,
+		sampleRate:      sampleRate,
+		executionMinAge: executionMinAge,
+		repairEnabled:   repairEnabled,
+	}
+}
+
+// Run runs the task. Executions are sampled while paging through the shard, and only the sampled
+// executions are checked. Executions which can't be checked are recorded and skipped, the task is
+// only deferred if the shard can't be paginated, in which case it resumes from the failed page.
+func (t *task) Run() executor.TaskStatus {
+	paginationFn := executions.NewPaginationFn(t.ctx, t.executionManager, t.shardID, executionsPageSize)
+	for {
+		_ = t.rateLimiter.Wait(t.ctx)
+		mutableStates, nextPageToken, err := paginationFn(t.paginationToken)
+		if err != nil {
+			t.logger.Error("unable to paginate concrete execution", tag.Error(err))
+			return executor.TaskStatusDefer
+		}
+		for _, mutableState := range mutableStates {
+			if !t.shouldCheck(mutableState) {
+				continue
+			}
+			_ = t.rateLimiter.Wait(t.ctx)
+			if err := t.check(mutableState); err != nil {
+				metrics.VisibilityScannerCheckFailuresCount.With(t.metricsHandler).Record(1)
+				t.logger.Error("unable to check visibility record",
+					tag.Error(err),
+					tag.WorkflowNamespaceID(mutableState.GetExecutionInfo().GetNamespaceId()),
+					tag.WorkflowID(mutableState.GetExecutionInfo().GetWorkflowId()),
+					tag.WorkflowRunID(mutableState.GetExecutionState().GetRunId()),
+				)
+			}
+		}
+		if len(nextPageToken) == 0 {
+			return executor.TaskStatusDone
+		}
+		t.paginationToken = nextPageToken
+	}
+}
+
+// shouldCheck returns true if the execution is sampled for checking. Executions updated recently
+// are skipped since their visibility tasks may not have been processed yet.
+func (t *task) shouldCheck(mutableState *persistencespb.WorkflowMutableState) bool {
+	if rand.Float64() >= t.sampleRate() {
+		return false
+	}
+	switch mutableState.GetExecutionState().GetState() {
+	case enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED:
+	default:
+		return false
+	}
+	lastUpdateTime := mutableState.GetExecutionInfo().GetLastUpdateTime()
+	if lastUpdateTime == nil || time.Since(lastUpdateTime.AsTime()) < t.executionMinAge() {
+		return false
+	}
+	return true
+}
+
+func (t *task) check(mutableState *persistencespb.WorkflowMutableState) error {
+	executionInfo := mutableState.GetExecutionInfo()
+	namespaceID := namespace.ID(executionInfo.GetNamespaceId())
+	nsName, err := t.registry.GetNamespaceName(namespaceID)
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
+		// the namespace was deleted, the execution will be removed by the executions scavenger.
+		return nil
+	default:
+		return err
+	}
+
+	metrics.VisibilityScannerChecksCount.With(t.metricsHandler).Record(1)
+	resp, err := t.visibilityManager.GetWorkflowExecution(t.ctx, &manager.GetWorkflowExecutionRequest{
+		NamespaceID: namespaceID,
+		Namespace:   nsName,
+		RunID:       mutableState.GetExecutionState().GetRunId(),
+		WorkflowID:  executionInfo.GetWorkflowId(),
+	})
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		resp = &manager.GetWorkflowExecutionResponse{}
+	default:
+		return err
+	}
+
+	mismatches, err := compareExecution(mutableState, resp.Execution, t.mapperProvider, nsName)
+	if err != nil {
+		return err
+	}
+	if len(mismatches) == 0 {
+		return nil
+	}
+	for _, m := range mismatches {
+		metrics.VisibilityScannerMismatchesCount.With(t.metricsHandler).Record(1, metrics.FailureTag(m.mismatchType))
+		t.logger.Info("visibility record does not match mutable state",
+			tag.WorkflowNamespaceID(namespaceID.String()),
+			tag.WorkflowID(executionInfo.GetWorkflowId()),
+			tag.WorkflowRunID(mutableState.GetExecutionState().GetRunId()),
+			tag.Value(m.details),
+		)
+	}
+
+	if !t.repairEnabled() {
+		return nil
+	}
+	return t.repair(mutableState)
+}
+
+// repair regenerates the visibility task of the execution, which upserts the visibility record
+// from the current mutable state.
+func (t *task) repair(mutableState *persistencespb.WorkflowMutableState) error {
+	repaired, err := reindexvisibility.RefreshVisibilityTasks(t.ctx, t.historyClient, t.shardID, mutableState)
+	switch err.(type) {
+	case nil:
+		if repaired {
+			metrics.VisibilityScannerRepairsCount.With(t.metricsHandler).Record(1)
+		}
+		return nil
+	case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
+		return nil
+	default:
+		return err
+	}
+}
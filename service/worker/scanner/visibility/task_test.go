@@ -0,0 +1,250 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/worker/scanner/executor"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	testNamespaceID = "deadbeef-0123-4567-890a-bcdef0123456"
+	testNamespace   = "test-namespace"
+	testShardID     = int32(1)
+)
+
+type (
+	taskSuite struct {
+		suite.Suite
+		controller *gomock.Controller
+
+		mockExecutionManager  *persistence.MockExecutionManager
+		mockVisibilityManager *manager.MockVisibilityManager
+		mockRegistry          *namespace.MockRegistry
+		mockHistoryClient     *historyservicemock.MockHistoryServiceClient
+	}
+)
+
+func TestTaskSuite(t *testing.T) {
+	suite.Run(t, new(taskSuite))
+}
+
+func (s *taskSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockExecutionManager = persistence.NewMockExecutionManager(s.controller)
+	s.mockVisibilityManager = manager.NewMockVisibilityManager(s.controller)
+	s.mockRegistry = namespace.NewMockRegistry(s.controller)
+	s.mockHistoryClient = historyservicemock.NewMockHistoryServiceClient(s.controller)
+	s.mockRegistry.EXPECT().GetNamespaceName(namespace.ID(testNamespaceID)).Return(namespace.Name(testNamespace), nil).AnyTimes()
+}
+
+func (s *taskSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *taskSuite) TestRun_RepairsMismatches() {
+	closed := s.newMutableState("closed", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, 2*time.Hour)
+	missing := s.newMutableState("missing", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, 2*time.Hour)
+	matching := s.newMutableState("matching", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, 2*time.Hour)
+	recent := s.newMutableState("recent", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, time.Minute)
+	zombie := s.newMutableState("zombie", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, 2*time.Hour)
+	zombie.ExecutionState.State = enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE
+
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:  testShardID,
+		PageSize: executionsPageSize,
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{closed, missing, matching, recent, zombie},
+	}, nil)
+
+	s.mockVisibilityManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.GetWorkflowExecutionRequest) (*manager.GetWorkflowExecutionResponse, error) {
+			s.Equal(namespace.ID(testNamespaceID), request.NamespaceID)
+			s.Equal(namespace.Name(testNamespace), request.Namespace)
+			switch request.RunID {
+			case "closed":
+				// closed in mutable state but shown as running in visibility
+				return &manager.GetWorkflowExecutionResponse{Execution: &workflowpb.WorkflowExecutionInfo{
+					Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+				}}, nil
+			case "missing":
+				return nil, serviceerror.NewNotFound("not found")
+			case "matching":
+				return &manager.GetWorkflowExecutionResponse{Execution: &workflowpb.WorkflowExecutionInfo{
+					Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+				}}, nil
+			}
+			s.Fail("unexpected run ID", request.RunID)
+			return nil, nil
+		}).Times(3)
+
+	var repaired []tasks.Task
+	s.mockHistoryClient.EXPECT().AddTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.AddTasksRequest, _ ...any) (*historyservice.AddTasksResponse, error) {
+			s.Equal(testShardID, request.GetShardId())
+			s.Len(request.GetTasks(), 1)
+			s.Equal(int32(tasks.CategoryIDVisibility), request.GetTasks()[0].GetCategoryId())
+			task, err := serialization.NewTaskSerializer().DeserializeTask(tasks.CategoryVisibility, request.GetTasks()[0].GetBlob())
+			s.NoError(err)
+			repaired = append(repaired, task)
+			return &historyservice.AddTasksResponse{}, nil
+		}).Times(2)
+
+	status := s.newTask(true).Run()
+	s.Equal(executor.TaskStatusDone, status)
+	s.Len(repaired, 2)
+	s.IsType(&tasks.CloseExecutionVisibilityTask{}, repaired[0])
+	s.Equal("closed", repaired[0].GetRunID())
+	s.IsType(&tasks.UpsertExecutionVisibilityTask{}, repaired[1])
+	s.Equal("missing", repaired[1].GetRunID())
+}
+
+func (s *taskSuite) TestRun_RepairDisabled() {
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			s.newMutableState("missing", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, 2*time.Hour),
+		},
+	}, nil)
+	s.mockVisibilityManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("not found"))
+
+	status := s.newTask(false).Run()
+	s.Equal(executor.TaskStatusDone, status)
+}
+
+func (s *taskSuite) TestRun_VisibilityError() {
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			s.newMutableState("unavailable", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, 2*time.Hour),
+			s.newMutableState("missing", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, 2*time.Hour),
+		},
+	}, nil)
+	gomock.InOrder(
+		s.mockVisibilityManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewUnavailable("unavailable")),
+		s.mockVisibilityManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("not found")),
+	)
+	s.mockHistoryClient.EXPECT().AddTasks(gomock.Any(), gomock.Any()).Return(&historyservice.AddTasksResponse{}, nil)
+
+	// the failed check is recorded and skipped instead of rescanning the shard.
+	status := s.newTask(true).Run()
+	s.Equal(executor.TaskStatusDone, status)
+}
+
+func (s *taskSuite) TestRun_PaginationError() {
+	gomock.InOrder(
+		s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+			ShardID:  testShardID,
+			PageSize: executionsPageSize,
+		}).Return(&persistence.ListConcreteExecutionsResponse{
+			States: []*persistencespb.WorkflowMutableState{
+				s.newMutableState("recent", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, time.Minute),
+			},
+			PageToken: []byte("page-2"),
+		}, nil),
+		s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+			ShardID:   testShardID,
+			PageSize:  executionsPageSize,
+			PageToken: []byte("page-2"),
+		}).Return(nil, errors.New("some random error")),
+		// the deferred task resumes from the failed page.
+		s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+			ShardID:   testShardID,
+			PageSize:  executionsPageSize,
+			PageToken: []byte("page-2"),
+		}).Return(&persistence.ListConcreteExecutionsResponse{}, nil),
+	)
+
+	task := s.newTask(true)
+	s.Equal(executor.TaskStatusDefer, task.Run())
+	s.Equal(executor.TaskStatusDone, task.Run())
+}
+
+func (s *taskSuite) newTask(repairEnabled bool) executor.Task {
+	return newTask(
+		context.Background(),
+		testShardID,
+		s.mockExecutionManager,
+		s.mockVisibilityManager,
+		s.mockRegistry,
+		searchattribute.NewTestMapperProvider(nil),
+		s.mockHistoryClient,
+		metrics.NoopMetricsHandler,
+		log.NewTestLogger(),
+		quotas.NewDefaultOutgoingRateLimiter(func() float64 { return 1000 }),
+		dynamicconfig.GetFloatPropertyFn(1),
+		dynamicconfig.GetDurationPropertyFn(time.Hour),
+		dynamicconfig.GetBoolPropertyFn(repairEnabled),
+	)
+}
+
+func (s *taskSuite) newMutableState(
+	runID string,
+	status enumspb.WorkflowExecutionStatus,
+	age time.Duration,
+) *persistencespb.WorkflowMutableState {
+	state := enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING
+	if status != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		state = enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED
+	}
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId:    testNamespaceID,
+			WorkflowId:     "workflow-" + runID,
+			LastUpdateTime: timestamppb.New(time.Now().Add(-age)),
+			VersionHistories: versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(
+				nil,
+				[]*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(10, 1)},
+			)),
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId:  runID,
+			State:  state,
+			Status: status,
+		},
+	}
+}
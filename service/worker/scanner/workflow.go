@@ -37,6 +37,7 @@ import (
 	"go.temporal.io/server/service/worker/scanner/executions"
 	"go.temporal.io/server/service/worker/scanner/history"
 	"go.temporal.io/server/service/worker/scanner/taskqueue"
+	"go.temporal.io/server/service/worker/scanner/visibility"
 )
 
 const (
@@ -56,6 +57,11 @@ const (
 	executionsScannerWFTypeName     = "temporal-sys-executions-scanner-workflow"
 	executionsScannerTaskQueueName  = "temporal-sys-executions-scanner-taskqueue-0"
 	executionsScavengerActivityName = "temporal-sys-executions-scanner-scvg-activity"
+
+	visibilityScannerWFID           = "temporal-sys-visibility-scanner"
+	visibilityScannerWFTypeName     = "temporal-sys-visibility-scanner-workflow"
+	visibilityScannerTaskQueueName  = "temporal-sys-visibility-scanner-taskqueue-0"
+	visibilityScavengerActivityName = "temporal-sys-visibility-scanner-scvg-activity"
 )
 
 type (
@@ -66,6 +72,7 @@ var (
 	scannerContextKey             = scannerContextKeyType{}
 	tlScavengerHBInterval         = 10 * time.Second
 	executionsScavengerHBInterval = 10 * time.Second
+	visibilityScavengerHBInterval = 10 * time.Second
 
 	activityRetryPolicy = temporal.RetryPolicy{
 		InitialInterval:    10 * time.Second,
@@ -97,6 +104,12 @@ var (
 		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
 		CronSchedule:          "0 */12 * * *",
 	}
+	visibilityScannerWFStartOptions = client.StartWorkflowOptions{
+		ID:                    visibilityScannerWFID,
+		TaskQueue:             visibilityScannerTaskQueueName,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          "0 */12 * * *",
+	}
 )
 
 // TaskQueueScannerWorkflow is the workflow that runs the task queue scanner background daemon
@@ -128,6 +141,14 @@ func ExecutionsScannerWorkflow(
 	return future.Get(ctx, nil)
 }
 
+// VisibilityScannerWorkflow is the workflow that runs the visibility scanner background daemon
+func VisibilityScannerWorkflow(
+	ctx workflow.Context,
+) error {
+	future := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), visibilityScavengerActivityName)
+	return future.Get(ctx, nil)
+}
+
 // HistoryScavengerActivity is the activity that runs history scavenger
 func HistoryScavengerActivity(
 	activityCtx context.Context,
@@ -215,3 +236,39 @@ func ExecutionsScavengerActivity(
 	}
 	return nil
 }
+
+// VisibilityScavengerActivity is the activity that runs visibility scavenger
+func VisibilityScavengerActivity(
+	activityCtx context.Context,
+) error {
+	ctx := activityCtx.Value(scannerContextKey).(scannerContext)
+
+	scavenger := visibility.NewScavenger(
+		activityCtx,
+		ctx.cfg.Persistence.NumHistoryShards,
+		ctx.cfg.VisibilityScannerPerHostQPS,
+		ctx.cfg.VisibilityScannerPerShardQPS,
+		ctx.cfg.VisibilityScannerWorkerCount,
+		ctx.cfg.VisibilityScannerSampleRate,
+		ctx.cfg.VisibilityScannerExecutionMinAge,
+		ctx.cfg.VisibilityScannerRepairEnabled,
+		ctx.executionManager,
+		ctx.visibilityManager,
+		ctx.namespaceRegistry,
+		ctx.mapperProvider,
+		ctx.historyClient,
+		ctx.metricsHandler,
+		ctx.logger,
+	)
+	scavenger.Start()
+	for scavenger.Alive() {
+		activity.RecordHeartbeat(activityCtx)
+		if activityCtx.Err() != nil {
+			ctx.logger.Info("activity context error, stopping scavenger", tag.Error(activityCtx.Err()))
+			scavenger.Stop()
+			return activityCtx.Err()
+		}
+		time.Sleep(visibilityScavengerHBInterval)
+	}
+	return nil
+}
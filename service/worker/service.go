@@ -45,6 +45,7 @@ import (
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/worker/batcher"
 	"go.temporal.io/server/service/worker/parentclosepolicy"
 	"go.temporal.io/server/service/worker/replicator"
@@ -69,6 +70,7 @@ type (
 		namespaceRegistry      namespace.Registry
 		workerServiceResolver  membership.ServiceResolver
 		visibilityManager      manager.VisibilityManager
+		saMapperProvider       searchattribute.MapperProvider
 
 		namespaceReplicationQueue persistence.NamespaceReplicationQueue
 
@@ -135,6 +137,7 @@ func NewService(
 	workerManager *workerManager,
 	perNamespaceWorkerManager *perNamespaceWorkerManager,
 	visibilityManager manager.VisibilityManager,
+	saMapperProvider searchattribute.MapperProvider,
 	matchingClient resource.MatchingClient,
 	namespaceReplicationTaskExecutor namespace.ReplicationTaskExecutor,
 ) (*Service, error) {
@@ -162,6 +165,7 @@ func NewService(
 		taskManager:               taskManager,
 		historyClient:             historyClient,
 		visibilityManager:         visibilityManager,
+		saMapperProvider:          saMapperProvider,
 
 		workerManager:                    workerManager,
 		perNamespaceWorkerManager:        perNamespaceWorkerManager,
@@ -206,6 +210,13 @@ func NewConfig(
 			ExecutionDataDurationBuffer:             dynamicconfig.ExecutionDataDurationBuffer.Get(dc),
 			ExecutionScannerWorkerCount:             dynamicconfig.ExecutionScannerWorkerCount.Get(dc),
 			ExecutionScannerHistoryEventIdValidator: dynamicconfig.ExecutionScannerHistoryEventIdValidator.Get(dc),
+			VisibilityScannerEnabled:                dynamicconfig.VisibilityScannerEnabled.Get(dc),
+			VisibilityScannerSampleRate:             dynamicconfig.VisibilityScannerSampleRate.Get(dc),
+			VisibilityScannerPerHostQPS:             dynamicconfig.VisibilityScannerPerHostQPS.Get(dc),
+			VisibilityScannerPerShardQPS:            dynamicconfig.VisibilityScannerPerShardQPS.Get(dc),
+			VisibilityScannerWorkerCount:            dynamicconfig.VisibilityScannerWorkerCount.Get(dc),
+			VisibilityScannerExecutionMinAge:        dynamicconfig.VisibilityScannerExecutionMinAge.Get(dc),
+			VisibilityScannerRepairEnabled:          dynamicconfig.VisibilityScannerRepairEnabled.Get(dc),
 			RemovableBuildIdDurationSinceDefault:    dynamicconfig.RemovableBuildIdDurationSinceDefault.Get(dc),
 			BuildIdScavengerVisibilityRPS:           dynamicconfig.BuildIdScavengerVisibilityRPS.Get(dc),
 		},
@@ -345,6 +356,7 @@ func (s *Service) initScanner() error {
 		adminClient,
 		s.matchingClient,
 		s.namespaceRegistry,
+		s.saMapperProvider,
 		currentCluster,
 	)
 	return nil
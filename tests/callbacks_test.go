@@ -43,6 +43,7 @@ import (
 	"go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/worker"
 	"go.temporal.io/sdk/workflow"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/components/callbacks"
 	"go.temporal.io/server/internal/temporalite"
@@ -95,6 +96,11 @@ func (s *FunctionalSuite) TestWorkflowCallbacks_InvalidArgument() {
 		header  map[string]string
 		message string
 		allow   bool
+		// Attach the URLs as webhook callbacks.
+		webhook      bool
+		bodyTemplate string
+		// Attach the URLs as gRPC callback addresses.
+		grpc bool
 	}{
 		{
 			name:    "disabled",
@@ -139,6 +145,43 @@ func (s *FunctionalSuite) TestWorkflowCallbacks_InvalidArgument() {
 			allow:   true,
 			message: "invalid url: callback address does not allow insecure connections: http://some-secure-address",
 		},
+		{
+			name:    "webhook url not configured",
+			urls:    []string{"http://some-unconfigured-address"},
+			allow:   true,
+			webhook: true,
+			message: "invalid url: url does not match any configured callback address: http://some-unconfigured-address",
+		},
+		{
+			name:    "webhook header-size-too-large",
+			urls:    []string{"http://some-ignored-address"},
+			header:  map[string]string{"too": "long"},
+			allow:   true,
+			webhook: true,
+			message: "invalid header: header size longer than max allowed size of 6",
+		},
+		{
+			name:         "webhook invalid body template",
+			urls:         []string{"http://some-ignored-address"},
+			allow:        true,
+			webhook:      true,
+			bodyTemplate: "{{.Status",
+			message:      "invalid body template: template: body:1: unclosed action",
+		},
+		{
+			name:    "grpc address not configured",
+			urls:    []string{"some-unconfigured-address:7777"},
+			allow:   true,
+			grpc:    true,
+			message: "invalid address: address does not match any configured callback address: some-unconfigured-address:7777",
+		},
+		{
+			name:    "grpc insecure",
+			urls:    []string{"some-secure-address"},
+			allow:   true,
+			grpc:    true,
+			message: "invalid address: callback address does not allow insecure connections: some-secure-address",
+		},
 	}
 
 	s.OverrideDynamicConfig(dynamicconfig.FrontendCallbackURLMaxLength, 50)
@@ -154,6 +197,26 @@ func (s *FunctionalSuite) TestWorkflowCallbacks_InvalidArgument() {
 			s.OverrideDynamicConfig(dynamicconfig.EnableNexus, tc.allow)
 			cbs := make([]*commonpb.Callback, 0, len(tc.urls))
 			for _, url := range tc.urls {
+				if tc.grpc {
+					cb, err := callbacks.NewGRPCCallback(&persistencespb.Callback_GRPC{
+						Address: url,
+						Service: "my.package.CompletionService",
+						Method:  "Complete",
+					})
+					s.NoError(err)
+					cbs = append(cbs, cb)
+					continue
+				}
+				if tc.webhook {
+					cb, err := callbacks.NewWebhookCallback(&persistencespb.Callback_Webhook{
+						Url:          url,
+						Header:       tc.header,
+						BodyTemplate: tc.bodyTemplate,
+					})
+					s.NoError(err)
+					cbs = append(cbs, cb)
+					continue
+				}
 				cbs = append(cbs, &commonpb.Callback{
 					Variant: &commonpb.Callback_Nexus_{
 						Nexus: &commonpb.Callback_Nexus{
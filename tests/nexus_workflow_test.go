@@ -52,6 +52,7 @@ import (
 	commonnexus "go.temporal.io/server/common/nexus"
 	"go.temporal.io/server/common/nexus/nexustest"
 	"go.temporal.io/server/common/testing/protorequire"
+	"go.temporal.io/server/components/callbacks"
 	"go.temporal.io/server/components/nexusoperations"
 	"go.temporal.io/server/service/frontend/configs"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -849,6 +850,32 @@ func (s *ClientFunctionalSuite) TestNexusOperationAsyncCompletionErrors() {
 		s.Subset(snap["nexus_completion_requests"][0].Tags, map[string]string{"namespace": s.namespace, "outcome": "error_bad_request"})
 	})
 
+	s.Run("InvalidSignature", func() {
+		s.OverrideDynamicConfig(nexusoperations.CallbackRequireSignature, true)
+		s.OverrideDynamicConfig(callbacks.SigningKeys, []map[string]any{{"ID": "key-1", "Key": "secret"}})
+		publicCallbackUrl := "http://" + s.httpAPIAddress + "/" + commonnexus.RouteCompletionCallback.Path(s.namespace)
+
+		// unsigned requests are rejected before being processed
+		res, snap := s.sendNexusCompletionRequest(ctx, s.T(), publicCallbackUrl, completion, "")
+		s.Equal(http.StatusUnauthorized, res.StatusCode)
+		s.Equal(1, len(snap["nexus_completion_request_preprocess_errors"]))
+		s.Equal(0, len(snap["nexus_completion_requests"]))
+
+		// signed requests pass verification and fail on the invalid token
+		capture := s.testCluster.host.captureMetricsHandler.StartCapture()
+		defer s.testCluster.host.captureMetricsHandler.StopCapture(capture)
+		req, err := nexus.NewCompletionHTTPRequest(ctx, publicCallbackUrl, completion)
+		s.NoError(err)
+		s.NoError(commonnexus.SignRequest(req, []byte("secret"), "key-1", time.Now()))
+		res, err = http.DefaultClient.Do(req)
+		s.NoError(err)
+		_, err = io.ReadAll(res.Body)
+		s.NoError(err)
+		defer res.Body.Close()
+		s.Equal(http.StatusBadRequest, res.StatusCode)
+		s.Equal(0, len(capture.Snapshot()["nexus_completion_request_preprocess_errors"]))
+	})
+
 	s.Run("InvalidClientVersion", func() {
 		publicCallbackUrl := "http://" + s.httpAPIAddress + "/" + commonnexus.RouteCompletionCallback.Path(s.namespace)
 		capture := s.testCluster.host.captureMetricsHandler.StartCapture()
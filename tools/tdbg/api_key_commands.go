@@ -0,0 +1,163 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newAdminAPIKeyCommands(clientFactory ClientFactory) []*cli.Command {
+	idFlag := &cli.StringFlag{
+		Name:     FlagAPIKeyID,
+		Usage:    "API key ID",
+		Required: true,
+	}
+
+	return []*cli.Command{
+		{
+			Name:  "create",
+			Usage: "Create an API key, the key is only printed once",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagAPIKeySubject,
+					Usage:    "Subject of calls made with the key",
+					Required: true,
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagAPIKeyPermission,
+					Usage: "Permission in the <namespace>:<read|write|worker|admin> format, can be repeated",
+				},
+				&cli.StringFlag{
+					Name:  FlagAPIKeyDescription,
+					Usage: "Description of the key",
+				},
+				&cli.StringFlag{
+					Name:  FlagAPIKeyExpireTime,
+					Usage: "Time the key expires at, in RFC3339 format, e.g. 2024-01-02T15:04:05Z",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminCreateAPIKey(c, clientFactory)
+			},
+		},
+		{
+			Name:  "list",
+			Usage: "List API keys",
+			Action: func(c *cli.Context) error {
+				return AdminListAPIKeys(c, clientFactory)
+			},
+		},
+		{
+			Name:  "rotate",
+			Usage: "Replace the secret of an API key, the new key is only printed once",
+			Flags: []cli.Flag{idFlag},
+			Action: func(c *cli.Context) error {
+				return AdminRotateAPIKey(c, clientFactory)
+			},
+		},
+		{
+			Name:  "revoke",
+			Usage: "Delete an API key",
+			Flags: []cli.Flag{idFlag},
+			Action: func(c *cli.Context) error {
+				return AdminRevokeAPIKey(c, clientFactory)
+			},
+		},
+	}
+}
+
+// AdminCreateAPIKey creates an API key
+func AdminCreateAPIKey(c *cli.Context, clientFactory ClientFactory) error {
+	var expireTime *timestamppb.Timestamp
+	if c.IsSet(FlagAPIKeyExpireTime) {
+		t, err := time.Parse(time.RFC3339, c.String(FlagAPIKeyExpireTime))
+		if err != nil {
+			return fmt.Errorf("invalid expire time: %s", err)
+		}
+		expireTime = timestamppb.New(t)
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.CreateAPIKey(ctx, &adminservice.CreateAPIKeyRequest{
+		Subject:     c.String(FlagAPIKeySubject),
+		Permissions: c.StringSlice(FlagAPIKeyPermission),
+		Description: c.String(FlagAPIKeyDescription),
+		ExpireTime:  expireTime,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create API key: %s", err)
+	}
+
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminListAPIKeys lists API keys
+func AdminListAPIKeys(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.ListAPIKeys(ctx, &adminservice.ListAPIKeysRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to list API keys: %s", err)
+	}
+
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminRotateAPIKey replaces the secret of an API key
+func AdminRotateAPIKey(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.RotateAPIKey(ctx, &adminservice.RotateAPIKeyRequest{
+		Id: c.String(FlagAPIKeyID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to rotate API key: %s", err)
+	}
+
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminRevokeAPIKey deletes an API key
+func AdminRevokeAPIKey(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if _, err := adminClient.RevokeAPIKey(ctx, &adminservice.RevokeAPIKeyRequest{
+		Id: c.String(FlagAPIKeyID),
+	}); err != nil {
+		return fmt.Errorf("unable to revoke API key: %s", err)
+	}
+	return nil
+}
@@ -497,6 +497,144 @@ func AdminDescribeShard(c *cli.Context, clientFactory ClientFactory) error {
 	return nil
 }
 
+// AdminDescribeHistoryQueue describes the readers, slices and alerts of a history task queue
+func AdminDescribeHistoryQueue(c *cli.Context, clientFactory ClientFactory, registry tasks.TaskCategoryRegistry) error {
+	sid := int32(c.Int(FlagShardID))
+	category, err := getCategory(registry, c.String(FlagTaskCategory))
+	if err != nil {
+		return err
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.DescribeHistoryQueue(ctx, &adminservice.DescribeHistoryQueueRequest{
+		ShardId:  sid,
+		Category: int32(category.ID()),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe history queue: %s", err)
+	}
+
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminSplitHistoryQueueSlice moves tasks of given namespaces in one queue slice to the next reader
+func AdminSplitHistoryQueueSlice(c *cli.Context, clientFactory ClientFactory, registry tasks.TaskCategoryRegistry) error {
+	readerID := c.Int64(FlagReaderID)
+	return mitigateHistoryQueue(c, clientFactory, registry, func(request *adminservice.MitigateHistoryQueueRequest) {
+		request.Action = &adminservice.MitigateHistoryQueueRequest_SplitSlice{
+			SplitSlice: &adminservice.SplitHistoryQueueSliceAction{
+				NamespaceIds: c.StringSlice(FlagNamespaceID),
+				ReaderId:     readerID,
+				SliceIndex:   int32(c.Int(FlagSliceIndex)),
+			},
+		}
+	})
+}
+
+// AdminMoveHistoryQueueNamespace moves tasks of given namespaces in a queue reader to another reader
+func AdminMoveHistoryQueueNamespace(c *cli.Context, clientFactory ClientFactory, registry tasks.TaskCategoryRegistry) error {
+	sourceReaderID := c.Int64(FlagReaderID)
+	targetReaderID := sourceReaderID + 1
+	if c.IsSet(FlagTargetReaderID) {
+		targetReaderID = c.Int64(FlagTargetReaderID)
+	}
+	return mitigateHistoryQueue(c, clientFactory, registry, func(request *adminservice.MitigateHistoryQueueRequest) {
+		request.Action = &adminservice.MitigateHistoryQueueRequest_MoveNamespace{
+			MoveNamespace: &adminservice.MoveHistoryQueueNamespaceAction{
+				NamespaceIds:   c.StringSlice(FlagNamespaceID),
+				SourceReaderId: sourceReaderID,
+				TargetReaderId: targetReaderID,
+			},
+		}
+	})
+}
+
+// AdminThrottleHistoryQueueNamespace unloads tasks of given namespaces and pauses loading them
+func AdminThrottleHistoryQueueNamespace(c *cli.Context, clientFactory ClientFactory, registry tasks.TaskCategoryRegistry) error {
+	return mitigateHistoryQueue(c, clientFactory, registry, func(request *adminservice.MitigateHistoryQueueRequest) {
+		request.Action = &adminservice.MitigateHistoryQueueRequest_ThrottleNamespace{
+			ThrottleNamespace: &adminservice.ThrottleHistoryQueueNamespaceAction{
+				NamespaceIds: c.StringSlice(FlagNamespaceID),
+				Duration:     durationpb.New(c.Duration(FlagDuration)),
+			},
+		}
+	})
+}
+
+func mitigateHistoryQueue(
+	c *cli.Context,
+	clientFactory ClientFactory,
+	registry tasks.TaskCategoryRegistry,
+	setAction func(*adminservice.MitigateHistoryQueueRequest),
+) error {
+	category, err := getCategory(registry, c.String(FlagTaskCategory))
+	if err != nil {
+		return err
+	}
+
+	request := &adminservice.MitigateHistoryQueueRequest{
+		ShardId:  int32(c.Int(FlagShardID)),
+		Category: int32(category.ID()),
+	}
+	setAction(request)
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if _, err := adminClient.MitigateHistoryQueue(ctx, request); err != nil {
+		return fmt.Errorf("unable to mitigate history queue: %s", err)
+	}
+	return nil
+}
+
+// AdminDescribeOutboundQueue lists the destinations of the outbound queue with their circuit breaker states
+func AdminDescribeOutboundQueue(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.DescribeOutboundQueue(ctx, &adminservice.DescribeOutboundQueueRequest{
+		ShardId: int32(c.Int(FlagShardID)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe outbound queue: %s", err)
+	}
+
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminUpdateOutboundQueueCircuitBreaker forces the circuit breaker of an outbound queue destination open or closed
+func AdminUpdateOutboundQueueCircuitBreaker(c *cli.Context, clientFactory ClientFactory) error {
+	var action enumsspb.CircuitBreakerAction
+	switch actionFlag := c.String(FlagCircuitBreakerAction); actionFlag {
+	case "force-open":
+		action = enumsspb.CIRCUIT_BREAKER_ACTION_FORCE_OPEN
+	case "force-closed":
+		action = enumsspb.CIRCUIT_BREAKER_ACTION_FORCE_CLOSED
+	case "reset":
+		action = enumsspb.CIRCUIT_BREAKER_ACTION_RESET
+	default:
+		return fmt.Errorf("invalid circuit breaker action: %q, must be one of force-open, force-closed or reset", actionFlag)
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if _, err := adminClient.UpdateOutboundQueueCircuitBreaker(ctx, &adminservice.UpdateOutboundQueueCircuitBreakerRequest{
+		ShardId:     int32(c.Int(FlagShardID)),
+		NamespaceId: c.String(FlagNamespaceID),
+		TaskGroup:   c.String(FlagTaskGroup),
+		Destination: c.String(FlagDestination),
+		Action:      action,
+	}); err != nil {
+		return fmt.Errorf("unable to update circuit breaker: %s", err)
+	}
+	return nil
+}
+
 // AdminShardManagement describes history host
 func AdminShardManagement(c *cli.Context, clientFactory ClientFactory) error {
 	adminClient := clientFactory.AdminClient(c)
@@ -0,0 +1,218 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/payloads"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newAdminDelayedSignalCommands(clientFactory ClientFactory) []*cli.Command {
+	executionFlags := []cli.Flag{
+		&cli.StringFlag{
+			Name:     FlagWorkflowID,
+			Aliases:  FlagWorkflowIDAlias,
+			Usage:    "Workflow ID of the execution hosting the delayed signals",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:    FlagRunID,
+			Aliases: FlagRunIDAlias,
+			Usage:   "Run ID of the execution hosting the delayed signals",
+		},
+	}
+
+	return []*cli.Command{
+		{
+			Name:  "schedule",
+			Usage: "Schedule a signal to be delivered to a workflow at a given time",
+			Flags: append(executionFlags,
+				&cli.StringFlag{
+					Name:  FlagTargetWorkflowID,
+					Usage: "Workflow ID to signal, defaults to the hosting workflow",
+				},
+				&cli.StringFlag{
+					Name:  FlagTargetRunID,
+					Usage: "Run ID to signal, defaults to the current run of the target workflow",
+				},
+				&cli.StringFlag{
+					Name:     FlagSignalName,
+					Usage:    "Signal name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagSignalInput,
+					Usage: "JSON encoded signal input",
+				},
+				&cli.StringFlag{
+					Name:  FlagFireTime,
+					Usage: "Time to deliver the signal at, in RFC3339 format, e.g. 2024-01-02T15:04:05Z",
+				},
+				&cli.DurationFlag{
+					Name:  FlagDelay,
+					Usage: "Delay after which to deliver the signal, e.g. 90m",
+				},
+				&cli.StringFlag{
+					Name:  FlagRequestID,
+					Usage: "Request ID used to deduplicate the request, generated if not set",
+				},
+			),
+			Action: func(c *cli.Context) error {
+				return AdminScheduleDelayedSignal(c, clientFactory)
+			},
+		},
+		{
+			Name:  "cancel",
+			Usage: "Cancel a delayed signal that has not been delivered yet",
+			Flags: append(executionFlags,
+				&cli.StringFlag{
+					Name:     FlagDelayedSignalID,
+					Usage:    "Delayed signal ID",
+					Required: true,
+				},
+			),
+			Action: func(c *cli.Context) error {
+				return AdminCancelDelayedSignal(c, clientFactory)
+			},
+		},
+		{
+			Name:  "list",
+			Usage: "List the delayed signals hosted by a workflow execution",
+			Flags: executionFlags,
+			Action: func(c *cli.Context) error {
+				return AdminListDelayedSignals(c, clientFactory)
+			},
+		},
+	}
+}
+
+// AdminScheduleDelayedSignal schedules a signal to be delivered to a workflow at a given time
+func AdminScheduleDelayedSignal(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	fireTime, err := delayedSignalFireTime(c, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	var input *commonpb.Payloads
+	if c.IsSet(FlagSignalInput) {
+		var value any
+		if err := json.Unmarshal([]byte(c.String(FlagSignalInput)), &value); err != nil {
+			return fmt.Errorf("input is not valid JSON: %s", err)
+		}
+		if input, err = payloads.Encode(value); err != nil {
+			return fmt.Errorf("unable to encode input: %s", err)
+		}
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.ScheduleDelayedSignal(ctx, &adminservice.ScheduleDelayedSignalRequest{
+		Namespace: namespace,
+		Execution: executionFromFlags(c),
+		Target: &commonpb.WorkflowExecution{
+			WorkflowId: c.String(FlagTargetWorkflowID),
+			RunId:      c.String(FlagTargetRunID),
+		},
+		SignalName: c.String(FlagSignalName),
+		Input:      input,
+		FireTime:   timestamppb.New(fireTime),
+		Identity:   getCLIIdentity(),
+		RequestId:  c.String(FlagRequestID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to schedule delayed signal: %s", err)
+	}
+
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminCancelDelayedSignal cancels a delayed signal that has not been delivered yet
+func AdminCancelDelayedSignal(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if _, err := adminClient.CancelDelayedSignal(ctx, &adminservice.CancelDelayedSignalRequest{
+		Namespace: namespace,
+		Execution: executionFromFlags(c),
+		Id:        c.String(FlagDelayedSignalID),
+	}); err != nil {
+		return fmt.Errorf("unable to cancel delayed signal: %s", err)
+	}
+	return nil
+}
+
+// AdminListDelayedSignals lists the delayed signals hosted by a workflow execution
+func AdminListDelayedSignals(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.ListDelayedSignals(ctx, &adminservice.ListDelayedSignalsRequest{
+		Namespace: namespace,
+		Execution: executionFromFlags(c),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list delayed signals: %s", err)
+	}
+
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+func delayedSignalFireTime(c *cli.Context, now time.Time) (time.Time, error) {
+	switch {
+	case c.IsSet(FlagFireTime) && c.IsSet(FlagDelay):
+		return time.Time{}, fmt.Errorf("only one of --%s and --%s can be set", FlagFireTime, FlagDelay)
+	case c.IsSet(FlagFireTime):
+		fireTime, err := time.Parse(defaultDateTimeFormat, c.String(FlagFireTime))
+		if err != nil {
+			return time.Time{}, fmt.Errorf("cannot parse fire time %q, use RFC3339 format: %s", c.String(FlagFireTime), err)
+		}
+		return fireTime, nil
+	case c.IsSet(FlagDelay):
+		return now.Add(c.Duration(FlagDelay)), nil
+	default:
+		return time.Time{}, fmt.Errorf("one of --%s and --%s is required", FlagFireTime, FlagDelay)
+	}
+}
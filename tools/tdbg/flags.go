@@ -91,4 +91,27 @@ var (
 	FlagBuildIDs                   = "select-build-id"
 	FlagUnversioned                = "select-unversioned"
 	FlagAllActive                  = "select-all-active"
+	FlagReaderID                   = "reader-id"
+	FlagTargetReaderID             = "target-reader-id"
+	FlagSliceIndex                 = "slice-index"
+	FlagDuration                   = "duration"
+	FlagTaskGroup                  = "task-group"
+	FlagDestination                = "destination"
+	FlagCircuitBreakerAction       = "action"
+	FlagStateMachinePath           = "path"
+	FlagStateMachineMethod         = "method"
+	FlagStateMachineInput          = "input"
+	FlagTargetWorkflowID           = "target-workflow-id"
+	FlagTargetRunID                = "target-run-id"
+	FlagSignalName                 = "signal-name"
+	FlagSignalInput                = "input"
+	FlagFireTime                   = "fire-time"
+	FlagDelay                      = "delay"
+	FlagRequestID                  = "request-id"
+	FlagDelayedSignalID            = "id"
+	FlagAPIKeySubject              = "subject"
+	FlagAPIKeyPermission           = "permission"
+	FlagAPIKeyDescription          = "description"
+	FlagAPIKeyExpireTime           = "expire-time"
+	FlagAPIKeyID                   = "id"
 )
@@ -0,0 +1,218 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+func newAdminHSMCommands(clientFactory ClientFactory, prompterFactory PrompterFactory) []*cli.Command {
+	executionFlags := []cli.Flag{
+		&cli.StringFlag{
+			Name:     FlagWorkflowID,
+			Aliases:  FlagWorkflowIDAlias,
+			Usage:    "Workflow ID",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:    FlagRunID,
+			Aliases: FlagRunIDAlias,
+			Usage:   "Run ID",
+		},
+	}
+	pathFlag := &cli.StringFlag{
+		Name:     FlagStateMachinePath,
+		Usage:    "Path to the state machine from the root, in the form type:id[/type:id...], e.g. nexusoperations.Operation:5",
+		Required: true,
+	}
+	reasonFlag := &cli.StringFlag{
+		Name:     FlagReason,
+		Usage:    "Reason for the intervention, recorded in the audit log",
+		Required: true,
+	}
+
+	return []*cli.Command{
+		{
+			Name:    "describe",
+			Aliases: []string{"d"},
+			Usage:   "Dump the state machine tree of a workflow execution with each machine's state, pending tasks and transition count",
+			Flags:   executionFlags,
+			Action: func(c *cli.Context) error {
+				return AdminDescribeStateMachines(c, clientFactory)
+			},
+		},
+		{
+			Name:  "invoke",
+			Usage: "Invoke a registered remote method on a state machine",
+			Flags: append(executionFlags,
+				pathFlag,
+				&cli.StringFlag{
+					Name:     FlagStateMachineMethod,
+					Usage:    "Name of the registered remote method",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagStateMachineInput,
+					Usage: "Serialized input to the method",
+				},
+				&cli.StringFlag{
+					Name:  FlagInputFilename,
+					Usage: "File containing the serialized input to the method, takes precedence over --" + FlagStateMachineInput,
+				},
+				reasonFlag,
+			),
+			Action: func(c *cli.Context) error {
+				return AdminInvokeStateMachineMethod(c, clientFactory)
+			},
+		},
+		{
+			Name:  "delete",
+			Usage: "Delete a stuck state machine and its descendants. Not allowed for namespaces replicated to multiple clusters since deletions are not replicated",
+			Flags: append(executionFlags, pathFlag, reasonFlag),
+			Action: func(c *cli.Context) error {
+				return AdminDeleteStateMachineNode(c, clientFactory, prompterFactory(c))
+			},
+		},
+	}
+}
+
+// AdminDescribeStateMachines dumps the state machine tree of a workflow execution
+func AdminDescribeStateMachines(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.DescribeStateMachines(ctx, &adminservice.DescribeStateMachinesRequest{
+		Namespace: namespace,
+		Execution: executionFromFlags(c),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe state machines: %s", err)
+	}
+
+	prettyPrintJSONObject(c, resp.GetRoot())
+	return nil
+}
+
+// AdminInvokeStateMachineMethod invokes a registered remote method on a state machine of a workflow execution
+func AdminInvokeStateMachineMethod(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	path, err := parseStateMachinePath(c.String(FlagStateMachinePath))
+	if err != nil {
+		return err
+	}
+	input := []byte(c.String(FlagStateMachineInput))
+	if c.IsSet(FlagInputFilename) {
+		if input, err = os.ReadFile(c.String(FlagInputFilename)); err != nil {
+			return fmt.Errorf("unable to read input file: %s", err)
+		}
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.InvokeStateMachineMethod(ctx, &adminservice.InvokeStateMachineMethodRequest{
+		Namespace:  namespace,
+		Execution:  executionFromFlags(c),
+		Path:       path,
+		MethodName: c.String(FlagStateMachineMethod),
+		Input:      input,
+		Reason:     c.String(FlagReason),
+		Identity:   getCLIIdentity(),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to invoke state machine method: %s", err)
+	}
+
+	fmt.Fprintln(c.App.Writer, string(resp.GetOutput()))
+	return nil
+}
+
+// AdminDeleteStateMachineNode deletes a state machine and its descendants from a workflow execution
+func AdminDeleteStateMachineNode(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	path, err := parseStateMachinePath(c.String(FlagStateMachinePath))
+	if err != nil {
+		return err
+	}
+	execution := executionFromFlags(c)
+
+	msg := fmt.Sprintf("Namespace: %s WorkflowID: %s RunID: %s Path: %s\nDelete above state machine and its descendants?",
+		namespace, execution.GetWorkflowId(), execution.GetRunId(), c.String(FlagStateMachinePath))
+	prompter.Prompt(msg)
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if _, err := adminClient.DeleteStateMachineNode(ctx, &adminservice.DeleteStateMachineNodeRequest{
+		Namespace: namespace,
+		Execution: execution,
+		Path:      path,
+		Reason:    c.String(FlagReason),
+		Identity:  getCLIIdentity(),
+	}); err != nil {
+		return fmt.Errorf("unable to delete state machine: %s", err)
+	}
+	return nil
+}
+
+func executionFromFlags(c *cli.Context) *commonpb.WorkflowExecution {
+	return &commonpb.WorkflowExecution{
+		WorkflowId: c.String(FlagWorkflowID),
+		RunId:      c.String(FlagRunID),
+	}
+}
+
+func parseStateMachinePath(path string) ([]*persistencespb.StateMachineKey, error) {
+	var keys []*persistencespb.StateMachineKey
+	for _, element := range strings.Split(path, "/") {
+		machineType, id, ok := strings.Cut(element, ":")
+		if !ok || machineType == "" {
+			return nil, fmt.Errorf("invalid state machine path element %q, expected type:id", element)
+		}
+		keys = append(keys, &persistencespb.StateMachineKey{Type: machineType, Id: id})
+	}
+	return keys, nil
+}
+
+func getCLIIdentity() string {
+	hostname, _ := os.Hostname()
+	return fmt.Sprintf("tdbg@%s@%s", os.Getenv("USER"), hostname)
+}
@@ -29,6 +29,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/urfave/cli/v2"
 	commonpb "go.temporal.io/api/common/v1"
@@ -92,6 +93,11 @@ func getCommands(
 			Usage:       "Decode payload",
 			Subcommands: newDecodeCommands(taskBlobEncoder),
 		},
+		{
+			Name:        "api-key",
+			Usage:       "Run admin operation on the built-in API keys",
+			Subcommands: newAdminAPIKeyCommands(clientFactory),
+		},
 	}
 }
 
@@ -238,6 +244,16 @@ func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory Promp
 				return AdminDeleteWorkflow(c, clientFactory, prompterFactory(c))
 			},
 		},
+		{
+			Name:        "hsm",
+			Usage:       "Inspect and intervene on the hierarchical state machines of a workflow execution",
+			Subcommands: newAdminHSMCommands(clientFactory, prompterFactory),
+		},
+		{
+			Name:        "delayed-signal",
+			Usage:       "Schedule, cancel and list signals that are delivered to a workflow at a given time",
+			Subcommands: newAdminDelayedSignalCommands(clientFactory),
+		},
 	}
 }
 
@@ -318,6 +334,152 @@ func newAdminShardManagementCommands(clientFactory ClientFactory, taskCategoryRe
 				return AdminListShardTasks(c, clientFactory, taskCategoryRegistry)
 			},
 		},
+		{
+			Name:  "describe-queue",
+			Usage: "Describe the in-memory state of the history task queue for given shard ID and task category",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of the shard",
+					Required: true,
+				},
+				&listTasksCategory,
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeHistoryQueue(c, clientFactory, taskCategoryRegistry)
+			},
+		},
+		{
+			Name:  "describe-outbound-queue",
+			Usage: "List the destinations of the outbound queue for given shard ID with their pending task counts and circuit breaker states",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of the shard",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeOutboundQueue(c, clientFactory)
+			},
+		},
+		{
+			Name: "update-circuit-breaker",
+			Usage: "Force the circuit breaker of an outbound queue destination open or closed, or reset it. " +
+				"Circuit breakers are per history host, only the host owning the given shard is updated",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of a shard owned by the history host",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagNamespaceID,
+					Usage:    "The ID of the namespace",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagTaskGroup,
+					Usage:    "The task group, as returned by describe-outbound-queue",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagDestination,
+					Usage:    "The destination, as returned by describe-outbound-queue",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagCircuitBreakerAction,
+					Usage:    "One of force-open, force-closed or reset",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateOutboundQueueCircuitBreaker(c, clientFactory)
+			},
+		},
+		{
+			Name:  "split-queue-slice",
+			Usage: "Move tasks of the given namespaces in one slice of a history task queue reader to the next reader",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of the shard",
+					Required: true,
+				},
+				&listTasksCategory,
+				&cli.StringSliceFlag{
+					Name:     FlagNamespaceID,
+					Usage:    "The ID of the namespace to split out, can be specified multiple times",
+					Required: true,
+				},
+				&cli.Int64Flag{
+					Name:  FlagReaderID,
+					Usage: "The ID of the reader owning the slice",
+				},
+				&cli.IntFlag{
+					Name:     FlagSliceIndex,
+					Usage:    "The position of the slice in the reader, as returned by describe-queue",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminSplitHistoryQueueSlice(c, clientFactory, taskCategoryRegistry)
+			},
+		},
+		{
+			Name:  "move-queue-namespace",
+			Usage: "Move tasks of the given namespaces in all slices of a history task queue reader to another reader",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of the shard",
+					Required: true,
+				},
+				&listTasksCategory,
+				&cli.StringSliceFlag{
+					Name:     FlagNamespaceID,
+					Usage:    "The ID of the namespace to move, can be specified multiple times",
+					Required: true,
+				},
+				&cli.Int64Flag{
+					Name:  FlagReaderID,
+					Usage: "The ID of the source reader",
+				},
+				&cli.Int64Flag{
+					Name:  FlagTargetReaderID,
+					Usage: "The ID of the target reader, default to the reader after the source reader",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminMoveHistoryQueueNamespace(c, clientFactory, taskCategoryRegistry)
+			},
+		},
+		{
+			Name:  "throttle-queue-namespace",
+			Usage: "Unload tasks of the given namespaces from a history task queue and pause loading them for a duration",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of the shard",
+					Required: true,
+				},
+				&listTasksCategory,
+				&cli.StringSliceFlag{
+					Name:     FlagNamespaceID,
+					Usage:    "The ID of the namespace to throttle, can be specified multiple times",
+					Required: true,
+				},
+				&cli.DurationFlag{
+					Name:  FlagDuration,
+					Usage: "How long to pause task loading for the given namespaces",
+					Value: time.Minute,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminThrottleHistoryQueueNamespace(c, clientFactory, taskCategoryRegistry)
+			},
+		},
 		{
 			Name:  "close-shard",
 			Usage: "close a shard given a shard id",